@@ -3,8 +3,8 @@
 // This example demonstrates how to:
 // 1. Connect to a Symbiotic Relay server
 // 2. Get the current epoch
-// 3. Sign a message
-// 4. Retrieve aggregation proofs
+// 3. Sign a message and wait for its aggregation proof
+// 4. Inspect the aggregation proof
 // 5. Get validator set information
 // 6. Get individual signatures
 // 7. Get signature request IDs by epoch
@@ -72,22 +72,9 @@ func (rc *RelayClient) GetLastAllCommitted(ctx context.Context) (*client.GetLast
 	return rc.client.GetLastAllCommitted(ctx, req)
 }
 
-// SignMessage signs a message using the specified key tag
-func (rc *RelayClient) SignMessage(ctx context.Context, keyTag uint32, message []byte, requiredEpoch *uint64) (*client.SignMessageResponse, error) {
-	req := &client.SignMessageRequest{
-		KeyTag:        keyTag,
-		Message:       message,
-		RequiredEpoch: requiredEpoch,
-	}
-	return rc.client.SignMessage(ctx, req)
-}
-
-// GetAggregationProof gets aggregation proof for a specific request
-func (rc *RelayClient) GetAggregationProof(ctx context.Context, requestID string) (*client.GetAggregationProofResponse, error) {
-	req := &client.GetAggregationProofRequest{
-		RequestId: requestID,
-	}
-	return rc.client.GetAggregationProof(ctx, req)
+// SignAndWait signs a message using the specified key tag and waits for its aggregation proof
+func (rc *RelayClient) SignAndWait(ctx context.Context, keyTag uint32, message []byte, timeout time.Duration) (*client.AggregationProof, error) {
+	return rc.client.SignAndWait(ctx, keyTag, message, client.WithTimeout(timeout))
 }
 
 // GetSignatures gets individual signatures for a request
@@ -222,35 +209,33 @@ func main() {
 		}
 	}
 
-	// Example 4: Sign a message
+	// Example 4: Sign a message and wait for its aggregation proof
 	fmt.Println("\n=== Signing a Message ===")
 	messageToSign := []byte("Hello, Symbiotic!")
 	keyTag := uint32(15)
 
-	signResponse, err := relayClient.SignMessage(ctx, keyTag, messageToSign, nil)
-	if err != nil {
+	proof, err := relayClient.SignAndWait(ctx, keyTag, messageToSign, 10*time.Second)
+	var timeoutErr *client.SignTimeoutError
+	switch {
+	case errors.As(err, &timeoutErr):
+		// the request keeps being processed, the proof can be fetched later by request id
+		fmt.Printf("Aggregation proof is not ready yet for request %s\n", timeoutErr.RequestID)
+		return
+	case err != nil:
 		log.Printf("Failed to sign message: %v", err)
 		return
 	}
 
-	fmt.Printf("Request id: %s\n", signResponse.GetRequestId())
-	fmt.Printf("Epoch: %d\n", signResponse.Epoch)
-
-	// Example 5: Get aggregation proof (this might fail if signing is not complete)
-	fmt.Println("\n=== Getting Aggregation Proof ===")
-	proofResponse, err := relayClient.GetAggregationProof(ctx, signResponse.GetRequestId())
-	if err != nil {
-		fmt.Printf("Could not get aggregation proof yet: %v\n", err)
-	} else if proofResponse.GetAggregationProof() != nil {
-		proof := proofResponse.GetAggregationProof()
-		fmt.Printf("Request ID: %s\n", proof.GetRequestId())
-		fmt.Printf("Proof length: %d bytes\n", len(proof.GetProof()))
-		fmt.Printf("Message hash length: %d bytes\n", len(proof.GetMessageHash()))
-	}
+	// Example 5: Inspect the aggregation proof
+	fmt.Println("\n=== Aggregation Proof ===")
+	fmt.Printf("Request ID: %s\n", proof.GetRequestId())
+	fmt.Printf("Proof length: %d bytes\n", len(proof.GetProof()))
+	fmt.Printf("Message hash length: %d bytes\n", len(proof.GetMessageHash()))
+	requestID := proof.GetRequestId()
 
 	// Example 6: Get individual signatures
 	fmt.Println("\n=== Getting Individual Signatures ===")
-	signaturesResponse, err := relayClient.GetSignatures(ctx, signResponse.GetRequestId())
+	signaturesResponse, err := relayClient.GetSignatures(ctx, requestID)
 	if err != nil {
 		fmt.Printf("Could not get signatures yet: %v\n", err)
 	} else {
@@ -318,7 +303,7 @@ func main() {
 
 	// Example 9: Get a signature request by request ID
 	fmt.Println("\n=== Getting Signature Request by Request ID ===")
-	sigRequestResp, err := relayClient.GetSignatureRequest(ctx, signResponse.GetRequestId())
+	sigRequestResp, err := relayClient.GetSignatureRequest(ctx, requestID)
 	if err != nil {
 		fmt.Printf("Failed to get signature request: %v\n", err)
 	} else if sigRequestResp.GetSignatureRequest() != nil {
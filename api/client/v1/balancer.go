@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+const endpointsScheme = "symbiotic-relay"
+
+// roundRobinServiceConfig spreads calls across all ready endpoints, endpoints that fail are skipped until they reconnect.
+const roundRobinServiceConfig = `{"loadBalancingConfig": [{"round_robin": {}}]}`
+
+// DialEndpoints creates a connection that balances calls across several relay sidecars in round robin order.
+// Sidecars of the same network serve the same proofs and validator sets, so reads and streams keep working
+// while at least one endpoint is reachable. The caller is responsible for closing the connection.
+func DialEndpoints(endpoints []string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("at least one endpoint is required")
+	}
+
+	r := manual.NewBuilderWithScheme(endpointsScheme)
+	r.InitialState(resolver.State{
+		Addresses: lo.Map(endpoints, func(endpoint string, _ int) resolver.Address {
+			return resolver.Address{Addr: endpoint}
+		}),
+	})
+
+	opts = append([]grpc.DialOption{
+		grpc.WithResolvers(r),
+		grpc.WithDefaultServiceConfig(roundRobinServiceConfig),
+	}, opts...)
+
+	conn, err := grpc.NewClient(endpointsScheme+":///relays", opts...)
+	if err != nil {
+		return nil, errors.Errorf("failed to create balanced connection: %w", err)
+	}
+	return conn, nil
+}
+
+// NewBalancedSymbioticClient creates a client balancing calls across several relay sidecars, see DialEndpoints.
+// The returned connection must be closed by the caller.
+func NewBalancedSymbioticClient(endpoints []string, opts ...grpc.DialOption) (*SymbioticClient, *grpc.ClientConn, error) {
+	conn, err := DialEndpoints(endpoints, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return NewSymbioticClient(conn), conn, nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestNewBalancedSymbioticClient_SpreadsCallsAcrossEndpoints(t *testing.T) {
+	relays := map[string]*fakeRelay{"relay-1": {}, "relay-2": {}}
+	listeners := make(map[string]*bufconn.Listener, len(relays))
+	for addr, relay := range relays {
+		listeners[addr] = serveFakeRelay(t, relay)
+	}
+
+	client, conn, err := NewBalancedSymbioticClient([]string{"relay-1", "relay-2"},
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return listeners[addr].DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	require.Eventually(t, func() bool {
+		_, err := client.GetCurrentEpoch(t.Context(), &GetCurrentEpochRequest{})
+		require.NoError(t, err)
+		return relays["relay-1"].calls() > 0 && relays["relay-2"].calls() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDialEndpoints_RequiresEndpoint(t *testing.T) {
+	_, err := DialEndpoints(nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+)
+
+// fakeRelay is an in-memory relay API, handlers are set per test.
+type fakeRelay struct {
+	apiv1.UnimplementedSymbioticAPIServiceServer
+
+	mu                  sync.Mutex
+	signMessage         func(*SignMessageRequest) (*SignMessageResponse, error)
+	getAggregationProof func(*GetAggregationProofRequest) (*GetAggregationProofResponse, error)
+	listenProofs        func(*ListenProofsRequest, grpc.ServerStreamingServer[ListenProofsResponse]) error
+	currentEpochCalls   int
+}
+
+func (f *fakeRelay) SignMessage(_ context.Context, req *SignMessageRequest) (*SignMessageResponse, error) {
+	return f.signMessage(req)
+}
+
+func (f *fakeRelay) GetAggregationProof(_ context.Context, req *GetAggregationProofRequest) (*GetAggregationProofResponse, error) {
+	return f.getAggregationProof(req)
+}
+
+func (f *fakeRelay) ListenProofs(req *ListenProofsRequest, stream grpc.ServerStreamingServer[ListenProofsResponse]) error {
+	return f.listenProofs(req, stream)
+}
+
+func (f *fakeRelay) GetCurrentEpoch(context.Context, *GetCurrentEpochRequest) (*GetCurrentEpochResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.currentEpochCalls++
+	return &GetCurrentEpochResponse{Epoch: 1}, nil
+}
+
+func (f *fakeRelay) calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.currentEpochCalls
+}
+
+// serveFakeRelay serves the relay over an in-memory listener.
+func serveFakeRelay(t *testing.T, relay *fakeRelay) *bufconn.Listener {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	apiv1.RegisterSymbioticAPIServiceServer(server, relay)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+	return lis
+}
+
+func newFakeRelayClient(t *testing.T, relay *fakeRelay) *SymbioticClient {
+	t.Helper()
+	lis := serveFakeRelay(t, relay)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return NewSymbioticClient(conn)
+}
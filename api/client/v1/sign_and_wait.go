@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-errors/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const defaultPollInterval = 500 * time.Millisecond
+
+// SignTimeoutError is returned by SignAndWait when the aggregation proof is not available before the context is done.
+// The request is still processed by the relay, so the proof can be fetched later by RequestID.
+type SignTimeoutError struct {
+	RequestID string
+	Epoch     uint64
+	Err       error
+}
+
+func (e *SignTimeoutError) Error() string {
+	return fmt.Sprintf("aggregation proof for request %s (epoch %d) is not ready: %v", e.RequestID, e.Epoch, e.Err)
+}
+
+func (e *SignTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// SignOption configures SignAndWait.
+type SignOption func(*signOptions)
+
+type signOptions struct {
+	requiredEpoch *uint64
+	ttl           time.Duration
+	pollInterval  time.Duration
+	timeout       time.Duration
+}
+
+// WithRequiredEpoch signs with the validator set of the given epoch instead of the latest one.
+func WithRequiredEpoch(epoch uint64) SignOption {
+	return func(o *signOptions) {
+		o.requiredEpoch = &epoch
+	}
+}
+
+// WithTTL makes the relays stop working on the request once the ttl passes.
+func WithTTL(ttl time.Duration) SignOption {
+	return func(o *signOptions) {
+		o.ttl = ttl
+	}
+}
+
+// WithPollInterval sets how often the aggregation proof is polled, 500ms by default.
+func WithPollInterval(interval time.Duration) SignOption {
+	return func(o *signOptions) {
+		o.pollInterval = interval
+	}
+}
+
+// WithTimeout limits how long SignAndWait waits for the proof on top of the context deadline.
+func WithTimeout(timeout time.Duration) SignOption {
+	return func(o *signOptions) {
+		o.timeout = timeout
+	}
+}
+
+// SignAndWait requests a signature of the message and blocks until its aggregation proof is available.
+// A *SignTimeoutError is returned when the context is done or the timeout passes before the proof is ready.
+func (c *SymbioticClient) SignAndWait(ctx context.Context, keyTag uint32, msg []byte, opts ...SignOption) (*AggregationProof, error) {
+	options := signOptions{pollInterval: defaultPollInterval}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.timeout)
+		defer cancel()
+	}
+
+	signReq := &SignMessageRequest{
+		KeyTag:        keyTag,
+		Message:       msg,
+		RequiredEpoch: options.requiredEpoch,
+	}
+	if options.ttl > 0 {
+		signReq.Ttl = durationpb.New(options.ttl)
+	}
+
+	signResp, err := c.SignMessage(ctx, signReq)
+	if err != nil {
+		return nil, errors.Errorf("failed to sign message: %w", err)
+	}
+
+	return c.WaitForProof(ctx, signResp.GetRequestId(), signResp.GetEpoch(), options.pollInterval)
+}
+
+// WaitForProof polls the aggregation proof of the request until it is available.
+// A *SignTimeoutError is returned when the context is done before the proof is ready.
+func (c *SymbioticClient) WaitForProof(ctx context.Context, requestID string, epoch uint64, pollInterval time.Duration) (*AggregationProof, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := c.GetAggregationProof(ctx, &GetAggregationProofRequest{RequestId: requestID})
+		if err == nil && resp.GetAggregationProof() != nil {
+			return resp.GetAggregationProof(), nil
+		}
+		if err != nil && !isRetryable(err) {
+			if ctxErr := contextError(ctx, err); ctxErr != nil {
+				return nil, &SignTimeoutError{RequestID: requestID, Epoch: epoch, Err: ctxErr}
+			}
+			return nil, errors.Errorf("failed to get aggregation proof for request %s: %w", requestID, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, &SignTimeoutError{RequestID: requestID, Epoch: epoch, Err: ctx.Err()}
+		case <-ticker.C:
+		}
+	}
+}
+
+// contextError returns the context error the call failed with, the deadline may be reported
+// by gRPC slightly before the context itself is done.
+func contextError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	switch status.Code(err) {
+	case codes.DeadlineExceeded:
+		return context.DeadlineExceeded
+	case codes.Canceled:
+		return context.Canceled
+	default:
+		return nil
+	}
+}
+
+// isRetryable reports whether the call may succeed if repeated: the proof is not aggregated yet or the relay is unavailable.
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.NotFound, codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSignAndWait_ReturnsProofOnceAggregated(t *testing.T) {
+	polls := 0
+	client := newFakeRelayClient(t, &fakeRelay{
+		signMessage: func(req *SignMessageRequest) (*SignMessageResponse, error) {
+			require.Equal(t, uint32(15), req.GetKeyTag())
+			require.Equal(t, uint64(7), req.GetRequiredEpoch())
+			return &SignMessageResponse{RequestId: "0x01", Epoch: 7}, nil
+		},
+		getAggregationProof: func(req *GetAggregationProofRequest) (*GetAggregationProofResponse, error) {
+			polls++
+			if polls < 3 {
+				return nil, status.Error(codes.NotFound, "not aggregated yet")
+			}
+			return &GetAggregationProofResponse{AggregationProof: &AggregationProof{RequestId: req.GetRequestId(), Proof: []byte("proof")}}, nil
+		},
+	})
+
+	proof, err := client.SignAndWait(t.Context(), 15, []byte("msg"), WithRequiredEpoch(7), WithPollInterval(time.Millisecond))
+	require.NoError(t, err)
+	require.Equal(t, "0x01", proof.GetRequestId())
+	require.Equal(t, 3, polls)
+}
+
+func TestSignAndWait_WithTTL_SetsRequestTTL(t *testing.T) {
+	client := newFakeRelayClient(t, &fakeRelay{
+		signMessage: func(req *SignMessageRequest) (*SignMessageResponse, error) {
+			require.Equal(t, time.Minute, req.GetTtl().AsDuration())
+			return &SignMessageResponse{RequestId: "0x01", Epoch: 7}, nil
+		},
+		getAggregationProof: func(req *GetAggregationProofRequest) (*GetAggregationProofResponse, error) {
+			return &GetAggregationProofResponse{AggregationProof: &AggregationProof{RequestId: req.GetRequestId()}}, nil
+		},
+	})
+
+	_, err := client.SignAndWait(t.Context(), 15, []byte("msg"), WithTTL(time.Minute))
+	require.NoError(t, err)
+}
+
+func TestSignAndWait_Timeout_ReturnsTypedError(t *testing.T) {
+	client := newFakeRelayClient(t, &fakeRelay{
+		signMessage: func(*SignMessageRequest) (*SignMessageResponse, error) {
+			return &SignMessageResponse{RequestId: "0x02", Epoch: 3}, nil
+		},
+		getAggregationProof: func(*GetAggregationProofRequest) (*GetAggregationProofResponse, error) {
+			return nil, status.Error(codes.NotFound, "not aggregated yet")
+		},
+	})
+
+	_, err := client.SignAndWait(t.Context(), 15, []byte("msg"), WithTimeout(50*time.Millisecond), WithPollInterval(5*time.Millisecond))
+	require.Error(t, err)
+
+	var timeoutErr *SignTimeoutError
+	require.True(t, errors.As(err, &timeoutErr))
+	require.Equal(t, "0x02", timeoutErr.RequestID)
+	require.Equal(t, uint64(3), timeoutErr.Epoch)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSignAndWait_NonRetryableError_ReturnsImmediately(t *testing.T) {
+	client := newFakeRelayClient(t, &fakeRelay{
+		signMessage: func(*SignMessageRequest) (*SignMessageResponse, error) {
+			return &SignMessageResponse{RequestId: "0x03", Epoch: 3}, nil
+		},
+		getAggregationProof: func(*GetAggregationProofRequest) (*GetAggregationProofResponse, error) {
+			return nil, status.Error(codes.Internal, "boom")
+		},
+	})
+
+	_, err := client.SignAndWait(t.Context(), 15, []byte("msg"), WithPollInterval(time.Millisecond))
+	require.Error(t, err)
+	var timeoutErr *SignTimeoutError
+	require.False(t, errors.As(err, &timeoutErr))
+	require.Equal(t, codes.Internal, status.Code(errors.Unwrap(err)))
+}
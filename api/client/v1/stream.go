@@ -0,0 +1,205 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-errors/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMinReconnectDelay = 500 * time.Millisecond
+	defaultMaxReconnectDelay = 30 * time.Second
+)
+
+// StreamOption configures auto-reconnecting streams.
+type StreamOption func(*streamOptions)
+
+type streamOptions struct {
+	minDelay    time.Duration
+	maxDelay    time.Duration
+	onReconnect func(startEpoch *uint64, err error)
+}
+
+// WithReconnectDelay sets the backoff between reconnection attempts, the delay doubles up to max after each failed attempt.
+func WithReconnectDelay(minDelay, maxDelay time.Duration) StreamOption {
+	return func(o *streamOptions) {
+		o.minDelay = minDelay
+		o.maxDelay = maxDelay
+	}
+}
+
+// WithOnReconnect registers a callback invoked before every reconnection with the epoch the stream resumes from
+// and the error that broke the previous stream.
+func WithOnReconnect(fn func(startEpoch *uint64, err error)) StreamOption {
+	return func(o *streamOptions) {
+		o.onReconnect = fn
+	}
+}
+
+// ListenSignaturesWithReconnect streams signatures to fn and transparently reopens the stream when it breaks.
+// The stream resumes from the epoch of the last received signature, signatures already delivered are skipped.
+// Blocks until the context is done, fn returns an error or the relay rejects the request.
+func (c *SymbioticClient) ListenSignaturesWithReconnect(ctx context.Context, startEpoch *uint64, fn func(*ListenSignaturesResponse) error, opts ...StreamOption) error {
+	return listenWithReconnect(ctx, startEpoch, newStreamOptions(opts),
+		func(ctx context.Context, startEpoch *uint64) (grpc.ServerStreamingClient[ListenSignaturesResponse], error) {
+			return c.ListenSignatures(ctx, &ListenSignaturesRequest{StartEpoch: startEpoch})
+		},
+		func(resp *ListenSignaturesResponse) (uint64, string) {
+			return resp.GetEpoch(), resp.GetRequestId() + string(resp.GetSignature().GetPublicKey())
+		},
+		fn,
+	)
+}
+
+// ListenProofsWithReconnect streams aggregation proofs to fn and transparently reopens the stream when it breaks.
+// The stream resumes from the epoch of the last received proof, proofs already delivered are skipped.
+// Blocks until the context is done, fn returns an error or the relay rejects the request.
+func (c *SymbioticClient) ListenProofsWithReconnect(ctx context.Context, startEpoch *uint64, fn func(*ListenProofsResponse) error, opts ...StreamOption) error {
+	return listenWithReconnect(ctx, startEpoch, newStreamOptions(opts),
+		func(ctx context.Context, startEpoch *uint64) (grpc.ServerStreamingClient[ListenProofsResponse], error) {
+			return c.ListenProofs(ctx, &ListenProofsRequest{StartEpoch: startEpoch})
+		},
+		func(resp *ListenProofsResponse) (uint64, string) {
+			return resp.GetEpoch(), resp.GetRequestId()
+		},
+		fn,
+	)
+}
+
+// ListenValidatorSetWithReconnect streams validator sets to fn and transparently reopens the stream when it breaks.
+// The stream resumes from the epoch of the last received validator set, validator sets already delivered are skipped.
+// Blocks until the context is done, fn returns an error or the relay rejects the request.
+func (c *SymbioticClient) ListenValidatorSetWithReconnect(ctx context.Context, startEpoch *uint64, fn func(*ListenValidatorSetResponse) error, opts ...StreamOption) error {
+	return listenWithReconnect(ctx, startEpoch, newStreamOptions(opts),
+		func(ctx context.Context, startEpoch *uint64) (grpc.ServerStreamingClient[ListenValidatorSetResponse], error) {
+			return c.ListenValidatorSet(ctx, &ListenValidatorSetRequest{StartEpoch: startEpoch})
+		},
+		func(resp *ListenValidatorSetResponse) (uint64, string) {
+			return resp.GetValidatorSet().GetEpoch(), ""
+		},
+		fn,
+	)
+}
+
+func newStreamOptions(opts []StreamOption) streamOptions {
+	options := streamOptions{
+		minDelay: defaultMinReconnectDelay,
+		maxDelay: defaultMaxReconnectDelay,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.maxDelay < options.minDelay {
+		options.maxDelay = options.minDelay
+	}
+	return options
+}
+
+// listenWithReconnect drives a server stream and reopens it from the last seen epoch when it fails.
+// key returns the epoch of an item and its id within the epoch, items of the last seen epoch are
+// remembered by id so that the replay after reconnection is not delivered twice.
+func listenWithReconnect[T any](
+	ctx context.Context,
+	startEpoch *uint64,
+	options streamOptions,
+	open func(ctx context.Context, startEpoch *uint64) (grpc.ServerStreamingClient[T], error),
+	key func(*T) (uint64, string),
+	fn func(*T) error,
+) error {
+	var (
+		lastEpoch *uint64
+		seen      = make(map[string]struct{})
+		delay     = options.minDelay
+	)
+
+	for {
+		resumeFrom := startEpoch
+		if lastEpoch != nil {
+			resumeFrom = lastEpoch
+		}
+
+		err := func() error {
+			streamCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			stream, err := open(streamCtx, resumeFrom)
+			if err != nil {
+				return err
+			}
+
+			for {
+				item, err := stream.Recv()
+				if err != nil {
+					return err
+				}
+				// the stream is healthy again, start the next backoff from scratch
+				delay = options.minDelay
+
+				epoch, id := key(item)
+				if lastEpoch != nil {
+					if epoch < *lastEpoch {
+						continue
+					}
+					if epoch == *lastEpoch {
+						if _, ok := seen[id]; ok || id == "" {
+							continue
+						}
+					}
+				}
+				if lastEpoch == nil || epoch > *lastEpoch {
+					lastEpoch = &epoch
+					clear(seen)
+				}
+				seen[id] = struct{}{}
+
+				if err := fn(item); err != nil {
+					return &handlerError{err: err}
+				}
+			}
+		}()
+
+		var hErr *handlerError
+		if errors.As(err, &hErr) {
+			return hErr.err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !isReconnectable(err) {
+			return errors.Errorf("stream failed: %w", err)
+		}
+
+		if options.onReconnect != nil {
+			options.onReconnect(lastEpoch, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, options.maxDelay)
+	}
+}
+
+type handlerError struct {
+	err error
+}
+
+func (e *handlerError) Error() string {
+	return e.err.Error()
+}
+
+// isReconnectable reports whether reopening the stream may help, requests rejected by the relay are not retried.
+func isReconnectable(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.Unimplemented, codes.PermissionDenied, codes.Unauthenticated, codes.FailedPrecondition:
+		return false
+	default:
+		return true
+	}
+}
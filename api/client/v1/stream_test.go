@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListenProofsWithReconnect_ResumesFromLastSeenEpoch(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		startEpochs []*uint64
+	)
+	proof := func(epoch uint64, requestID string) *ListenProofsResponse {
+		return &ListenProofsResponse{Epoch: epoch, RequestId: requestID}
+	}
+
+	client := newFakeRelayClient(t, &fakeRelay{
+		listenProofs: func(req *ListenProofsRequest, stream grpc.ServerStreamingServer[ListenProofsResponse]) error {
+			mu.Lock()
+			startEpochs = append(startEpochs, req.StartEpoch)
+			attempt := len(startEpochs)
+			mu.Unlock()
+
+			var items []*ListenProofsResponse
+			if attempt == 1 {
+				items = []*ListenProofsResponse{proof(1, "a"), proof(2, "b")}
+			} else {
+				// replay starts from the last seen epoch, so b is sent again
+				items = []*ListenProofsResponse{proof(2, "b"), proof(2, "c"), proof(3, "d")}
+			}
+			for _, item := range items {
+				if err := stream.Send(item); err != nil {
+					return err
+				}
+			}
+			if attempt == 1 {
+				return status.Error(codes.Unavailable, "relay restarted")
+			}
+			<-stream.Context().Done()
+			return nil
+		},
+	})
+
+	errDone := errors.New("done")
+	var received []string
+	reconnects := 0
+	startEpoch := uint64(1)
+
+	err := client.ListenProofsWithReconnect(t.Context(), &startEpoch, func(resp *ListenProofsResponse) error {
+		received = append(received, resp.GetRequestId())
+		if resp.GetRequestId() == "d" {
+			return errDone
+		}
+		return nil
+	},
+		WithReconnectDelay(time.Millisecond, 10*time.Millisecond),
+		WithOnReconnect(func(startEpoch *uint64, err error) {
+			reconnects++
+			require.Equal(t, uint64(2), *startEpoch)
+			require.Equal(t, codes.Unavailable, status.Code(err))
+		}),
+	)
+	require.ErrorIs(t, err, errDone)
+	require.Equal(t, []string{"a", "b", "c", "d"}, received)
+	require.Equal(t, 1, reconnects)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, startEpochs, 2)
+	require.Equal(t, uint64(1), *startEpochs[0])
+	require.Equal(t, uint64(2), *startEpochs[1])
+}
+
+func TestListenProofsWithReconnect_RejectedRequest_ReturnsError(t *testing.T) {
+	attempts := 0
+	client := newFakeRelayClient(t, &fakeRelay{
+		listenProofs: func(*ListenProofsRequest, grpc.ServerStreamingServer[ListenProofsResponse]) error {
+			attempts++
+			return status.Error(codes.InvalidArgument, "bad start epoch")
+		},
+	})
+
+	err := client.ListenProofsWithReconnect(t.Context(), nil, func(*ListenProofsResponse) error { return nil },
+		WithReconnectDelay(time.Millisecond, time.Millisecond))
+	require.Error(t, err)
+	require.Equal(t, 1, attempts)
+}
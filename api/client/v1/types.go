@@ -8,18 +8,35 @@ import (
 // Exported types for client usage
 
 // Enums
+type CommitStatus = apiv1.CommitStatus
 type ErrorCode = apiv1.ErrorCode
+type SignatureRequestStatus = apiv1.SignatureRequestStatus
 type SigningStatus = apiv1.SigningStatus
 type ValidatorSetStatus = apiv1.ValidatorSetStatus
 
 // Enum constants
 const (
+	// CommitStatus values
+	CommitStatus_COMMIT_STATUS_UNSPECIFIED = apiv1.CommitStatus_COMMIT_STATUS_UNSPECIFIED
+	CommitStatus_COMMIT_STATUS_PENDING = apiv1.CommitStatus_COMMIT_STATUS_PENDING
+	CommitStatus_COMMIT_STATUS_SUBMITTED = apiv1.CommitStatus_COMMIT_STATUS_SUBMITTED
+	CommitStatus_COMMIT_STATUS_CONFIRMED = apiv1.CommitStatus_COMMIT_STATUS_CONFIRMED
+	CommitStatus_COMMIT_STATUS_FAILED = apiv1.CommitStatus_COMMIT_STATUS_FAILED
+
 	// ErrorCode values
 	ErrorCode_ERROR_CODE_UNSPECIFIED = apiv1.ErrorCode_ERROR_CODE_UNSPECIFIED
 	ErrorCode_ERROR_CODE_NO_DATA = apiv1.ErrorCode_ERROR_CODE_NO_DATA
 	ErrorCode_ERROR_CODE_INTERNAL = apiv1.ErrorCode_ERROR_CODE_INTERNAL
 	ErrorCode_ERROR_CODE_NOT_AGGREGATOR = apiv1.ErrorCode_ERROR_CODE_NOT_AGGREGATOR
 
+	// SignatureRequestStatus values
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED = apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_PENDING = apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_PENDING
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_SIGNED = apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_SIGNED
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_AGGREGATED = apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_AGGREGATED
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_EXPIRED = apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_EXPIRED
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_CANCELLED = apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_CANCELLED
+
 	// SigningStatus values
 	SigningStatus_SIGNING_STATUS_UNSPECIFIED = apiv1.SigningStatus_SIGNING_STATUS_UNSPECIFIED
 	SigningStatus_SIGNING_STATUS_PENDING = apiv1.SigningStatus_SIGNING_STATUS_PENDING
@@ -35,14 +52,19 @@ const (
 )
 
 // Request types
+type CancelSignatureRequestRequest = apiv1.CancelSignatureRequestRequest
 type GetAggregationProofRequest = apiv1.GetAggregationProofRequest
 type GetAggregationProofsByEpochRequest = apiv1.GetAggregationProofsByEpochRequest
 type GetAggregationStatusRequest = apiv1.GetAggregationStatusRequest
+type GetBatchedMessageProofRequest = apiv1.GetBatchedMessageProofRequest
+type GetCommitStatusRequest = apiv1.GetCommitStatusRequest
 type GetCurrentEpochRequest = apiv1.GetCurrentEpochRequest
 type GetCustomScheduleNodeStatusRequest = apiv1.GetCustomScheduleNodeStatusRequest
+type GetEquivocationEvidenceRequest = apiv1.GetEquivocationEvidenceRequest
 type GetLastAllCommittedRequest = apiv1.GetLastAllCommittedRequest
 type GetLastCommittedRequest = apiv1.GetLastCommittedRequest
 type GetLocalValidatorRequest = apiv1.GetLocalValidatorRequest
+type GetSignalQueueStatusRequest = apiv1.GetSignalQueueStatusRequest
 type GetSignatureRequestIDsByEpochRequest = apiv1.GetSignatureRequestIDsByEpochRequest
 type GetSignatureRequestRequest = apiv1.GetSignatureRequestRequest
 type GetSignatureRequestsByEpochRequest = apiv1.GetSignatureRequestsByEpochRequest
@@ -50,24 +72,32 @@ type GetSignaturesByEpochRequest = apiv1.GetSignaturesByEpochRequest
 type GetSignaturesRequest = apiv1.GetSignaturesRequest
 type GetValidatorByAddressRequest = apiv1.GetValidatorByAddressRequest
 type GetValidatorByKeyRequest = apiv1.GetValidatorByKeyRequest
+type GetValidatorParticipationRequest = apiv1.GetValidatorParticipationRequest
 type GetValidatorSetHeaderRequest = apiv1.GetValidatorSetHeaderRequest
 type GetValidatorSetMetadataRequest = apiv1.GetValidatorSetMetadataRequest
 type GetValidatorSetRequest = apiv1.GetValidatorSetRequest
+type ListenEquivocationEvidenceRequest = apiv1.ListenEquivocationEvidenceRequest
 type ListenProofsRequest = apiv1.ListenProofsRequest
 type ListenSignaturesRequest = apiv1.ListenSignaturesRequest
 type ListenValidatorSetRequest = apiv1.ListenValidatorSetRequest
+type SignMessageBatchRequest = apiv1.SignMessageBatchRequest
 type SignMessageRequest = apiv1.SignMessageRequest
 type SignatureRequest = apiv1.SignatureRequest
 
 // Response types
+type CancelSignatureRequestResponse = apiv1.CancelSignatureRequestResponse
 type GetAggregationProofResponse = apiv1.GetAggregationProofResponse
 type GetAggregationProofsByEpochResponse = apiv1.GetAggregationProofsByEpochResponse
 type GetAggregationStatusResponse = apiv1.GetAggregationStatusResponse
+type GetBatchedMessageProofResponse = apiv1.GetBatchedMessageProofResponse
+type GetCommitStatusResponse = apiv1.GetCommitStatusResponse
 type GetCurrentEpochResponse = apiv1.GetCurrentEpochResponse
 type GetCustomScheduleNodeStatusResponse = apiv1.GetCustomScheduleNodeStatusResponse
+type GetEquivocationEvidenceResponse = apiv1.GetEquivocationEvidenceResponse
 type GetLastAllCommittedResponse = apiv1.GetLastAllCommittedResponse
 type GetLastCommittedResponse = apiv1.GetLastCommittedResponse
 type GetLocalValidatorResponse = apiv1.GetLocalValidatorResponse
+type GetSignalQueueStatusResponse = apiv1.GetSignalQueueStatusResponse
 type GetSignatureRequestIDsByEpochResponse = apiv1.GetSignatureRequestIDsByEpochResponse
 type GetSignatureRequestResponse = apiv1.GetSignatureRequestResponse
 type GetSignatureRequestsByEpochResponse = apiv1.GetSignatureRequestsByEpochResponse
@@ -75,20 +105,33 @@ type GetSignaturesByEpochResponse = apiv1.GetSignaturesByEpochResponse
 type GetSignaturesResponse = apiv1.GetSignaturesResponse
 type GetValidatorByAddressResponse = apiv1.GetValidatorByAddressResponse
 type GetValidatorByKeyResponse = apiv1.GetValidatorByKeyResponse
+type GetValidatorParticipationResponse = apiv1.GetValidatorParticipationResponse
 type GetValidatorSetHeaderResponse = apiv1.GetValidatorSetHeaderResponse
 type GetValidatorSetMetadataResponse = apiv1.GetValidatorSetMetadataResponse
 type GetValidatorSetResponse = apiv1.GetValidatorSetResponse
+type ListenEquivocationEvidenceResponse = apiv1.ListenEquivocationEvidenceResponse
 type ListenProofsResponse = apiv1.ListenProofsResponse
 type ListenSignaturesResponse = apiv1.ListenSignaturesResponse
 type ListenValidatorSetResponse = apiv1.ListenValidatorSetResponse
+type SignMessageBatchResponse = apiv1.SignMessageBatchResponse
 type SignMessageResponse = apiv1.SignMessageResponse
 
 // Data types
 type AggregationProof = apiv1.AggregationProof
 type ChainEpochInfo = apiv1.ChainEpochInfo
+type Eip712Domain = apiv1.Eip712Domain
+type EquivocationEvidence = apiv1.EquivocationEvidence
 type ExtraData = apiv1.ExtraData
 type Key = apiv1.Key
+type SettlementCommitStatus = apiv1.SettlementCommitStatus
+type SignalDeadLetter = apiv1.SignalDeadLetter
+type SignalQueueStatus = apiv1.SignalQueueStatus
 type Signature = apiv1.Signature
+type SignedHeaderCommitment = apiv1.SignedHeaderCommitment
+type TypedData = apiv1.TypedData
+type TypedDataField = apiv1.TypedDataField
+type TypedDataStruct = apiv1.TypedDataStruct
 type Validator = apiv1.Validator
+type ValidatorParticipation = apiv1.ValidatorParticipation
 type ValidatorSet = apiv1.ValidatorSet
 type ValidatorVault = apiv1.ValidatorVault
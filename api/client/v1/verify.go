@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/pkg/proof"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/aggregator"
+)
+
+// ProofVerifier verifies aggregation proofs locally with the same logic relay nodes use, without running a node.
+type ProofVerifier struct {
+	aggregator aggregator.Aggregator
+}
+
+// NewProofVerifier creates a verifier for the verification type of the network.
+// circuitsDir is only required for VerificationTypeBlsBn254ZK and must contain the circuits relay nodes use.
+func NewProofVerifier(verificationType symbiotic.VerificationType, circuitsDir string) (*ProofVerifier, error) {
+	var prover aggregator.Prover
+	if verificationType == symbiotic.VerificationTypeBlsBn254ZK {
+		if circuitsDir == "" {
+			return nil, errors.New("circuits directory is required to verify zk proofs")
+		}
+		prover = proof.NewZkProver(circuitsDir)
+	}
+
+	agg, err := aggregator.NewAggregator(verificationType, prover)
+	if err != nil {
+		return nil, errors.Errorf("failed to create aggregator for %s: %w", verificationType, err)
+	}
+	return &ProofVerifier{aggregator: agg}, nil
+}
+
+// VerifyProof verifies the aggregation proof against the validator set of its epoch.
+// The validator set, e.g. from GetValidatorSet, is accepted only if it matches the header,
+// so the header is the only trusted input and can be read from a settlement contract.
+func (v *ProofVerifier) VerifyProof(ctx context.Context, header symbiotic.ValidatorSetHeader, valset *ValidatorSet, aggProof *AggregationProof) (bool, error) {
+	validatorSet, err := ValidatorSetFromPB(valset)
+	if err != nil {
+		return false, err
+	}
+
+	if err := checkValidatorSetHeader(validatorSet, header); err != nil {
+		return false, err
+	}
+
+	keyTag, err := proofKeyTag(validatorSet, aggProof)
+	if err != nil {
+		return false, err
+	}
+
+	return v.aggregator.Verify(ctx, validatorSet, keyTag, symbiotic.AggregationProof{
+		MessageHash: aggProof.GetMessageHash(),
+		KeyTag:      keyTag,
+		Epoch:       validatorSet.Epoch,
+		Proof:       aggProof.GetProof(),
+	})
+}
+
+func checkValidatorSetHeader(valset symbiotic.ValidatorSet, header symbiotic.ValidatorSetHeader) error {
+	valsetHeader, err := valset.GetHeader()
+	if err != nil {
+		return errors.Errorf("failed to get validator set header: %w", err)
+	}
+	valsetHeaderHash, err := valsetHeader.Hash()
+	if err != nil {
+		return errors.Errorf("failed to hash validator set header: %w", err)
+	}
+	headerHash, err := header.Hash()
+	if err != nil {
+		return errors.Errorf("failed to hash trusted header: %w", err)
+	}
+	if valsetHeaderHash != headerHash {
+		return errors.Errorf("validator set of epoch %d does not match the header", valset.Epoch)
+	}
+	return nil
+}
+
+// proofKeyTag finds the aggregation key tag the proof was made with: the request id commits to the
+// key tag, the epoch and the message hash, so it also binds the proof to the epoch of the validator set.
+func proofKeyTag(valset symbiotic.ValidatorSet, aggProof *AggregationProof) (symbiotic.KeyTag, error) {
+	requestID := common.HexToHash(aggProof.GetRequestId())
+
+	checked := make(map[symbiotic.KeyTag]struct{})
+	for _, validator := range valset.Validators {
+		for _, key := range validator.Keys {
+			if _, ok := checked[key.Tag]; ok || !key.Tag.Type().AggregationKey() {
+				continue
+			}
+			checked[key.Tag] = struct{}{}
+
+			candidate := symbiotic.AggregationProof{KeyTag: key.Tag, Epoch: valset.Epoch, MessageHash: aggProof.GetMessageHash()}
+			if candidate.RequestID() == requestID {
+				return key.Tag, nil
+			}
+		}
+	}
+
+	return 0, errors.Errorf("proof of request %s was not made with an aggregation key of epoch %d", aggProof.GetRequestId(), valset.Epoch)
+}
+
+// ValidatorSetFromPB converts a validator set returned by the API to the entity used by verification logic.
+func ValidatorSetFromPB(valset *ValidatorSet) (symbiotic.ValidatorSet, error) {
+	if valset == nil {
+		return symbiotic.ValidatorSet{}, errors.New("validator set is required")
+	}
+
+	quorumThreshold, err := parseVotingPower(valset.GetQuorumThreshold())
+	if err != nil {
+		return symbiotic.ValidatorSet{}, errors.Errorf("invalid quorum threshold: %w", err)
+	}
+
+	validators := make(symbiotic.Validators, 0, len(valset.GetValidators()))
+	for _, v := range valset.GetValidators() {
+		if !common.IsHexAddress(v.GetOperator()) {
+			return symbiotic.ValidatorSet{}, errors.Errorf("invalid operator address %q", v.GetOperator())
+		}
+		votingPower, err := parseVotingPower(v.GetVotingPower())
+		if err != nil {
+			return symbiotic.ValidatorSet{}, errors.Errorf("invalid voting power of operator %s: %w", v.GetOperator(), err)
+		}
+
+		validator := symbiotic.Validator{
+			Operator:    common.HexToAddress(v.GetOperator()),
+			VotingPower: votingPower,
+			IsActive:    v.GetIsActive(),
+		}
+		for _, key := range v.GetKeys() {
+			validator.Keys = append(validator.Keys, symbiotic.ValidatorKey{
+				Tag:     symbiotic.KeyTag(key.GetTag()),
+				Payload: key.GetPayload(),
+			})
+		}
+		for _, vault := range v.GetVaults() {
+			if !common.IsHexAddress(vault.GetVault()) {
+				return symbiotic.ValidatorSet{}, errors.Errorf("invalid vault address %q", vault.GetVault())
+			}
+			vaultVotingPower, err := parseVotingPower(vault.GetVotingPower())
+			if err != nil {
+				return symbiotic.ValidatorSet{}, errors.Errorf("invalid voting power of vault %s: %w", vault.GetVault(), err)
+			}
+			validator.Vaults = append(validator.Vaults, symbiotic.ValidatorVault{
+				ChainID:     vault.GetChainId(),
+				Vault:       common.HexToAddress(vault.GetVault()),
+				VotingPower: vaultVotingPower,
+			})
+		}
+		validators = append(validators, validator)
+	}
+
+	return symbiotic.ValidatorSet{
+		Version:          uint8(valset.GetVersion()),
+		RequiredKeyTag:   symbiotic.KeyTag(valset.GetRequiredKeyTag()),
+		Epoch:            symbiotic.Epoch(valset.GetEpoch()),
+		CaptureTimestamp: symbiotic.Timestamp(valset.GetCaptureTimestamp().AsTime().Unix()),
+		QuorumThreshold:  quorumThreshold,
+		Validators:       validators,
+	}, nil
+}
+
+func parseVotingPower(value string) (symbiotic.VotingPower, error) {
+	votingPower, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return symbiotic.VotingPower{}, errors.Errorf("%q is not a decimal number", value)
+	}
+	return symbiotic.ToVotingPower(votingPower), nil
+}
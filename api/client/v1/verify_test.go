@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/aggregator/blsBn254Simple"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+// simpleProofFixture signs a message by all validators of a fresh validator set and aggregates the signatures.
+func simpleProofFixture(t *testing.T) (symbiotic.ValidatorSet, *ValidatorSet, *AggregationProof) {
+	t.Helper()
+	keyTag := symbiotic.KeyTag(15)
+	captureTimestamp := time.Unix(1700000000, 0)
+
+	valset := symbiotic.ValidatorSet{
+		Version:          1,
+		RequiredKeyTag:   keyTag,
+		Epoch:            5,
+		CaptureTimestamp: symbiotic.Timestamp(captureTimestamp.Unix()),
+		QuorumThreshold:  symbiotic.ToVotingPower(big.NewInt(200)),
+	}
+	valsetPB := &ValidatorSet{
+		Version:          1,
+		RequiredKeyTag:   uint32(keyTag),
+		Epoch:            5,
+		CaptureTimestamp: timestamppb.New(captureTimestamp),
+		QuorumThreshold:  "200",
+	}
+
+	var signatures []symbiotic.Signature
+	for i := range 3 {
+		pk, err := crypto.GeneratePrivateKey(keyTag.Type())
+		require.NoError(t, err)
+		operator := common.BigToAddress(big.NewInt(int64(i + 1)))
+
+		valset.Validators = append(valset.Validators, symbiotic.Validator{
+			Operator:    operator,
+			VotingPower: symbiotic.ToVotingPower(big.NewInt(100)),
+			IsActive:    true,
+			Keys:        []symbiotic.ValidatorKey{{Tag: keyTag, Payload: pk.PublicKey().OnChain()}},
+		})
+		valsetPB.Validators = append(valsetPB.Validators, &Validator{
+			Operator:    operator.Hex(),
+			VotingPower: "100",
+			IsActive:    true,
+			Keys:        []*Key{{Tag: uint32(keyTag), Payload: pk.PublicKey().OnChain()}},
+		})
+
+		sig, msgHash, err := pk.Sign([]byte("message"))
+		require.NoError(t, err)
+		signatures = append(signatures, symbiotic.Signature{
+			MessageHash: msgHash,
+			KeyTag:      keyTag,
+			Epoch:       valset.Epoch,
+			Signature:   sig,
+			PublicKey:   pk.PublicKey(),
+		})
+	}
+
+	agg, err := blsBn254Simple.NewAggregator()
+	require.NoError(t, err)
+	aggProof, err := agg.Aggregate(t.Context(), valset, signatures)
+	require.NoError(t, err)
+
+	return valset, valsetPB, &AggregationProof{
+		MessageHash: aggProof.MessageHash,
+		Proof:       aggProof.Proof,
+		RequestId:   aggProof.RequestID().Hex(),
+	}
+}
+
+func TestProofVerifier_VerifyProof_SimpleProof(t *testing.T) {
+	valset, valsetPB, aggProof := simpleProofFixture(t)
+	header, err := valset.GetHeader()
+	require.NoError(t, err)
+
+	verifier, err := NewProofVerifier(symbiotic.VerificationTypeBlsBn254Simple, "")
+	require.NoError(t, err)
+
+	ok, err := verifier.VerifyProof(t.Context(), header, valsetPB, aggProof)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestProofVerifier_VerifyProof_RejectsValidatorSetNotMatchingHeader(t *testing.T) {
+	valset, valsetPB, aggProof := simpleProofFixture(t)
+	header, err := valset.GetHeader()
+	require.NoError(t, err)
+
+	verifier, err := NewProofVerifier(symbiotic.VerificationTypeBlsBn254Simple, "")
+	require.NoError(t, err)
+
+	// the relay reports a validator set with inflated voting power
+	valsetPB.Validators[0].VotingPower = "1000"
+
+	_, err = verifier.VerifyProof(t.Context(), header, valsetPB, aggProof)
+	require.ErrorContains(t, err, "does not match the header")
+}
+
+func TestProofVerifier_VerifyProof_RejectsProofOfAnotherMessage(t *testing.T) {
+	valset, valsetPB, aggProof := simpleProofFixture(t)
+	header, err := valset.GetHeader()
+	require.NoError(t, err)
+
+	verifier, err := NewProofVerifier(symbiotic.VerificationTypeBlsBn254Simple, "")
+	require.NoError(t, err)
+
+	aggProof.MessageHash = common.HexToHash("0x1234").Bytes()
+
+	_, err = verifier.VerifyProof(t.Context(), header, valsetPB, aggProof)
+	require.ErrorContains(t, err, "was not made with an aggregation key")
+}
+
+func TestNewProofVerifier_ZkRequiresCircuits(t *testing.T) {
+	_, err := NewProofVerifier(symbiotic.VerificationTypeBlsBn254ZK, "")
+	require.Error(t, err)
+}
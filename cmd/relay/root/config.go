@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io/fs"
+	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
@@ -11,8 +12,10 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/symbioticfi/relay/internal/entity"
 	"github.com/symbioticfi/relay/pkg/signals"
 	"github.com/symbioticfi/relay/symbiotic/client/votingpower"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
 
 	"github.com/go-errors/errors"
 	"github.com/go-playground/validator/v10"
@@ -51,11 +54,16 @@ type config struct {
 	Evm                          EvmConfig                    `mapstructure:"evm" validate:"required"`
 	ExternalVotingPowerProviders []votingpower.ProviderConfig `mapstructure:"external-voting-power-providers"`
 	ForceRole                    ForceRole                    `mapstructure:"force-role"`
+	Committer                    CommitterConfig              `mapstructure:"committer"`
 	Retention                    RetentionConfig              `mapstructure:"retention"`
 	Pruner                       PrunerConfig                 `mapstructure:"pruner"`
+	SignatureBatch               SignatureBatchConfig         `mapstructure:"signature-batch"`
+	Participation                ParticipationConfig          `mapstructure:"participation"`
+	Aggregator                   AggregatorConfig             `mapstructure:"aggregator"`
 	Tracing                      TracingConfig                `mapstructure:"tracing"`
 	Badger                       BadgerConfig                 `mapstructure:"badger"`
 	Bbolt                        BboltConfig                  `mapstructure:"bbolt"`
+	Priority                     PriorityConfig               `mapstructure:"priority"`
 }
 
 type LogConfig struct {
@@ -195,8 +203,12 @@ func (m *CMDGasPriceMap) Type() string {
 }
 
 type KeyStore struct {
-	Path     string `json:"path"`
-	Password string `json:"password"`
+	Path         string   `json:"path"`
+	Password     string   `json:"password"`
+	SeedPath     string   `json:"seed-path" mapstructure:"seed-path"`
+	DerivedKeys  []string `json:"derived-keys" mapstructure:"derived-keys"`
+	Dir          string   `json:"dir"`
+	PasswordFile string   `json:"password-file" mapstructure:"password-file"`
 }
 type CacheConfig struct {
 	NetworkConfigCacheSize int `mapstructure:"network-config-size"`
@@ -234,6 +246,52 @@ type ForceRole struct {
 	Committer  bool `mapstructure:"committer"`
 }
 
+type CommitterConfig struct {
+	TakeoverTimeout  time.Duration `mapstructure:"takeover-timeout" validate:"gte=0"`
+	CatchUpMaxEpochs uint64        `mapstructure:"catch-up-max-epochs"`
+	// MaxFeePerGas and GasWaitDeadline are keyed by settlement chain ID
+	MaxFeePerGas    map[string]string `mapstructure:"max-fee-per-gas"`
+	GasWaitDeadline map[string]string `mapstructure:"gas-wait-deadline"`
+	Simulate        bool              `mapstructure:"simulate"`
+}
+
+func (c CommitterConfig) Policy() (entity.CommitPolicy, error) {
+	policy := entity.CommitPolicy{
+		Chains:   make(map[uint64]entity.CommitStrategy, len(c.MaxFeePerGas)),
+		Simulate: c.Simulate,
+	}
+	for chain, value := range c.MaxFeePerGas {
+		chainID, err := strconv.ParseUint(chain, 10, 64)
+		if err != nil {
+			return entity.CommitPolicy{}, errors.Errorf("invalid chain id %q in committer.max-fee-per-gas: %w", chain, err)
+		}
+		maxFeePerGas, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return entity.CommitPolicy{}, errors.Errorf("invalid max fee per gas %q of chain %d", value, chainID)
+		}
+		strategy := policy.Chains[chainID]
+		strategy.MaxFeePerGas = maxFeePerGas
+		policy.Chains[chainID] = strategy
+	}
+	for chain, value := range c.GasWaitDeadline {
+		chainID, err := strconv.ParseUint(chain, 10, 64)
+		if err != nil {
+			return entity.CommitPolicy{}, errors.Errorf("invalid chain id %q in committer.gas-wait-deadline: %w", chain, err)
+		}
+		deadline, err := time.ParseDuration(value)
+		if err != nil {
+			return entity.CommitPolicy{}, errors.Errorf("invalid gas wait deadline %q of chain %d: %w", value, chainID, err)
+		}
+		strategy := policy.Chains[chainID]
+		strategy.WaitForCheaperGas = deadline
+		policy.Chains[chainID] = strategy
+	}
+	if err := policy.Validate(); err != nil {
+		return entity.CommitPolicy{}, err
+	}
+	return policy, nil
+}
+
 type RetentionConfig struct {
 	ValSetEpochs    uint64 `mapstructure:"valset-epochs"`
 	ProofEpochs     uint64 `mapstructure:"proof-epochs"`
@@ -245,6 +303,21 @@ type PrunerConfig struct {
 	Interval time.Duration `mapstructure:"interval"`
 }
 
+type SignatureBatchConfig struct {
+	Window  time.Duration `mapstructure:"window" validate:"gte=0"`
+	MaxSize int           `mapstructure:"max-size" validate:"gte=0"`
+}
+
+type ParticipationConfig struct {
+	FlushInterval time.Duration `mapstructure:"flush-interval" validate:"gt=0"`
+}
+
+type AggregatorConfig struct {
+	BackupDelay   time.Duration `mapstructure:"backup-delay" validate:"gte=0"`
+	IntentTTL     time.Duration `mapstructure:"intent-ttl" validate:"gte=0"`
+	UpgradeWindow time.Duration `mapstructure:"upgrade-window" validate:"gte=0"`
+}
+
 type TracingConfig struct {
 	Enabled    bool    `mapstructure:"enabled"`
 	Endpoint   string  `mapstructure:"endpoint"`
@@ -268,6 +341,53 @@ type BadgerConfig struct {
 	ValueLogGCDiscardRatio  float64       `mapstructure:"value-log-gc-discard-ratio"`
 }
 
+// PriorityConfig assigns signature requests to the low, normal and high scheduling classes,
+// validator set headers always use the reserved header class
+type PriorityConfig struct {
+	KeyTags      map[string]string `mapstructure:"key-tags"`
+	Clients      map[string]string `mapstructure:"clients"`
+	ClientTokens map[string]string `mapstructure:"client-tokens"`
+	Weights      map[string]int    `mapstructure:"weights"`
+}
+
+func (c PriorityConfig) Policy() (entity.PriorityPolicy, error) {
+	policy := entity.PriorityPolicy{
+		KeyTags:      make(map[symbiotic.KeyTag]symbiotic.PriorityClass, len(c.KeyTags)),
+		Clients:      make(map[string]symbiotic.PriorityClass, len(c.Clients)),
+		ClientTokens: c.ClientTokens,
+		Weights:      make(map[symbiotic.PriorityClass]int, len(c.Weights)),
+	}
+	for keyTag, name := range c.KeyTags {
+		tag, err := strconv.ParseUint(keyTag, 10, 8)
+		if err != nil {
+			return entity.PriorityPolicy{}, errors.Errorf("invalid key tag %q in priority.key-tags: %w", keyTag, err)
+		}
+		class, err := symbiotic.ParsePriorityClass(name)
+		if err != nil {
+			return entity.PriorityPolicy{}, errors.Errorf("invalid priority of key tag %d: %w", tag, err)
+		}
+		policy.KeyTags[symbiotic.KeyTag(tag)] = class
+	}
+	for client, name := range c.Clients {
+		class, err := symbiotic.ParsePriorityClass(name)
+		if err != nil {
+			return entity.PriorityPolicy{}, errors.Errorf("invalid priority of client %q: %w", client, err)
+		}
+		policy.Clients[client] = class
+	}
+	for name, weight := range c.Weights {
+		class, err := symbiotic.ParsePriorityClass(name)
+		if err != nil {
+			return entity.PriorityPolicy{}, errors.Errorf("invalid class in priority.weights: %w", err)
+		}
+		policy.Weights[class] = weight
+	}
+	if err := policy.Validate(); err != nil {
+		return entity.PriorityPolicy{}, err
+	}
+	return policy, nil
+}
+
 func (c config) Validate() error {
 	validate := validator.New()
 	if err := validate.Struct(c); err != nil {
@@ -279,6 +399,18 @@ func (c config) Validate() error {
 		return errors.Errorf("sync.epochs (%d) cannot exceed retention.valset-epochs (%d)", c.Sync.EpochsToSync, c.Retention.ValSetEpochs)
 	}
 
+	if c.KeyStore.SeedPath != "" && len(c.KeyStore.DerivedKeys) == 0 {
+		return errors.New("keystore.derived-keys must list the keys to derive from keystore.seed-path")
+	}
+
+	if _, err := c.Priority.Policy(); err != nil {
+		return errors.Errorf("invalid priority config: %w", err)
+	}
+
+	if _, err := c.Committer.Policy(); err != nil {
+		return errors.Errorf("invalid committer config: %w", err)
+	}
+
 	if c.StorageType != "" && c.StorageType != storageTypeBadger && c.StorageType != storageTypeBbolt {
 		return errors.Errorf("invalid storage-type %q: must be \"badger\" or \"bbolt\"", c.StorageType)
 	}
@@ -327,8 +459,16 @@ func addRootFlags(cmd *cobra.Command) {
 	rootCmd.PersistentFlags().Var(&CMDSecretKeySlice{}, "secret-keys", "Secret keys, comma separated {namespace}/{type}/{id}/{key},..")
 	rootCmd.PersistentFlags().String("keystore.path", "", "Path to optional keystore file, if provided will be used instead of secret-keys flag")
 	rootCmd.PersistentFlags().String("keystore.password", "", "Password for the keystore file, if provided will be used to decrypt the keystore file")
+	rootCmd.PersistentFlags().String("keystore.seed-path", "", "Path to optional encrypted seed file created by 'keys import-mnemonic', if provided keys are derived from it instead of the keystore file, the keystore password decrypts it")
+	rootCmd.PersistentFlags().String("keystore.dir", "", "Path to optional directory of EIP-2335 and geth-style V3 JSON keystore files, if provided keys are read from it instead of the keystore file")
+	rootCmd.PersistentFlags().String("keystore.password-file", "", "File with passwords of the files in keystore.dir, one '<file name>=<password>' per line, '*' for the rest; keystore.password is used for all files if not provided")
+	rootCmd.PersistentFlags().StringSlice("keystore.derived-keys", nil, "Aliases of keys to derive from the seed, comma separated, e.g. symb-bls_bn254-15,evm-ecdsa_secp256k1-0,p2p-ecdsa_secp256k1-1")
 	rootCmd.PersistentFlags().Int64("signal.worker-count", 10, "Signal worker count")
 	rootCmd.PersistentFlags().Int64("signal.buffer-size", 20, "Signal buffer size")
+	rootCmd.PersistentFlags().Bool("signal.durable", false, "Persist signal pipeline events in storage for at-least-once delivery across restarts")
+	rootCmd.PersistentFlags().Int("signal.max-attempts", 5, "Delivery attempts before a durable signal event is moved to dead letters (0 retries forever)")
+	rootCmd.PersistentFlags().Duration("signal.retry-backoff", time.Second, "Initial redelivery delay of failed durable signal events, doubled on every attempt")
+	rootCmd.PersistentFlags().Duration("signal.max-retry-backoff", time.Minute, "Maximum redelivery delay of failed durable signal events")
 	rootCmd.PersistentFlags().Int("cache.network-config-size", 10, "Network config cache size")
 	rootCmd.PersistentFlags().Int("cache.validator-set-size", 10, "Validator set cache size")
 	rootCmd.PersistentFlags().Bool("sync.enabled", true, "Enable signature syncer")
@@ -346,11 +486,26 @@ func addRootFlags(cmd *cobra.Command) {
 	rootCmd.PersistentFlags().Var(&CMDGasPriceMap{}, "evm.fallback-gas-prices", "Per-chain fallback gas prices in wei when eth_maxPriorityFeePerGas is not supported (e.g., --evm.fallback-gas-prices 1=2000000000)")
 	rootCmd.PersistentFlags().Bool("force-role.aggregator", false, "Force node to act as aggregator regardless of deterministic scheduling")
 	rootCmd.PersistentFlags().Bool("force-role.committer", false, "Force node to act as committer regardless of deterministic scheduling")
+	rootCmd.PersistentFlags().Duration("committer.takeover-timeout", 30*time.Second, "Time without commit intents from the active committer before the next committer takes over (0 = wait for own slot)")
+	rootCmd.PersistentFlags().Uint64("committer.catch-up-max-epochs", 10, "Maximum number of missed headers replayed to a lagging settlement per commit attempt (0 = disabled)")
+	rootCmd.PersistentFlags().StringToString("committer.max-fee-per-gas", nil, "Maximum fee per gas in wei of commits per settlement chain id, e.g. 1=50000000000; commits above it are deferred or sent capped")
+	rootCmd.PersistentFlags().StringToString("committer.gas-wait-deadline", nil, "Per settlement chain id, how long before the next epoch commits above committer.max-fee-per-gas stop waiting for cheaper gas and are sent capped, e.g. 1=10m (default: no waiting)")
+	rootCmd.PersistentFlags().Bool("committer.simulate", false, "Simulate every commit with eth_call before sending it, commits with a max fee per gas are always simulated")
 	rootCmd.PersistentFlags().Uint64("retention.valset-epochs", 0, "Number of historical validator set epochs to retain (0 = unlimited)")
 	rootCmd.PersistentFlags().Uint64("retention.proof-epochs", 0, "Number of historical proof epochs to retain (0 = unlimited)")
 	rootCmd.PersistentFlags().Uint64("retention.signature-epochs", 0, "Number of historical signature epochs to retain (0 = unlimited)")
+	rootCmd.PersistentFlags().StringToString("priority.key-tags", nil, "Priority class (low, normal, high) of signature requests per key tag, e.g. 15=high,16=low; unlisted key tags are normal")
+	rootCmd.PersistentFlags().StringToString("priority.clients", nil, "Priority class (low, normal, high) of signature requests per API client sent in the x-client-id header, overrides the key tag class; every client needs a token in priority.client-tokens")
+	rootCmd.PersistentFlags().StringToString("priority.client-tokens", nil, "Secret token per API client of priority.clients that the client sends in the x-client-token header to authenticate its x-client-id, requests with a known client ID and a wrong token are rejected")
+	rootCmd.PersistentFlags().StringToString("priority.weights", nil, "Scheduling weights of the priority classes, e.g. high=4,normal=2,low=1 (default); validator set headers are always served first")
 	rootCmd.PersistentFlags().Bool("pruner.enabled", false, "Enable automatic pruning of old epoch data (default: false)")
 	rootCmd.PersistentFlags().Duration("pruner.interval", time.Hour, "How often to run pruning (default: 1h)")
+	rootCmd.PersistentFlags().Duration("signature-batch.window", 5*time.Millisecond, "How long a received BLS signature waits at most for others to be verified together in one batch, batches are verified early once every signal worker waits. Adds up to this latency per signature under low load, 0 verifies every signature on its own")
+	rootCmd.PersistentFlags().Int("signature-batch.max-size", 256, "Maximum number of signatures verified in one batch, 0 for unlimited. Gossiped signatures are also bounded by signal.worker-count, larger batches only form from synced signatures")
+	rootCmd.PersistentFlags().Duration("participation.flush-interval", 10*time.Second, "How often validator participation of finished signature requests is accounted, also the grace period for late signatures after a request is aggregated")
+	rootCmd.PersistentFlags().Duration("aggregator.backup-delay", 30*time.Second, "How long each backup aggregator of a request waits after the one before it in the per request aggregator order before aggregating itself, 0 lets every aggregator aggregate immediately")
+	rootCmd.PersistentFlags().Duration("aggregator.intent-ttl", 5*time.Minute, "Longest time an aggregation intent of another aggregator defers a request, aggregators after the announcing one stop deferring once their backup delay behind it passed; raise it and aggregator.backup-delay for slow zk proofs, 0 ignores intents")
+	rootCmd.PersistentFlags().Duration("aggregator.upgrade-window", 0, "How long after a proof of a request exists signatures that arrive later are aggregated into a proof with fewer non-signers (blsBn254Simple only), 0 disables proof upgrades")
 	rootCmd.PersistentFlags().Bool("tracing.enabled", false, "Enable distributed tracing")
 	rootCmd.PersistentFlags().String("tracing.endpoint", "localhost:4317", "OTLP endpoint for tracing (e.g., Jaeger)")
 	rootCmd.PersistentFlags().Float64("tracing.sample-rate", 1.0, "Trace sampling rate (0.0 to 1.0)")
@@ -480,12 +635,36 @@ func initConfig(cmd *cobra.Command, _ []string) error {
 	if err := v.BindPFlag("keystore.password", cmd.PersistentFlags().Lookup("keystore.password")); err != nil {
 		return errors.Errorf("failed to bind flag: %w", err)
 	}
+	if err := v.BindPFlag("keystore.seed-path", cmd.PersistentFlags().Lookup("keystore.seed-path")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("keystore.derived-keys", cmd.PersistentFlags().Lookup("keystore.derived-keys")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("keystore.dir", cmd.PersistentFlags().Lookup("keystore.dir")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("keystore.password-file", cmd.PersistentFlags().Lookup("keystore.password-file")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
 	if err := v.BindPFlag("signal.buffer-size", cmd.PersistentFlags().Lookup("signal.buffer-size")); err != nil {
 		return errors.Errorf("failed to bind flag: %w", err)
 	}
 	if err := v.BindPFlag("signal.worker-count", cmd.PersistentFlags().Lookup("signal.worker-count")); err != nil {
 		return errors.Errorf("failed to bind flag: %w", err)
 	}
+	if err := v.BindPFlag("signal.durable", cmd.PersistentFlags().Lookup("signal.durable")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("signal.max-attempts", cmd.PersistentFlags().Lookup("signal.max-attempts")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("signal.retry-backoff", cmd.PersistentFlags().Lookup("signal.retry-backoff")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("signal.max-retry-backoff", cmd.PersistentFlags().Lookup("signal.max-retry-backoff")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
 	if err := v.BindPFlag("cache.network-config-size", cmd.PersistentFlags().Lookup("cache.network-config-size")); err != nil {
 		return errors.Errorf("failed to bind flag: %w", err)
 	}
@@ -537,6 +716,33 @@ func initConfig(cmd *cobra.Command, _ []string) error {
 	if err := v.BindPFlag("force-role.committer", cmd.PersistentFlags().Lookup("force-role.committer")); err != nil {
 		return errors.Errorf("failed to bind flag: %w", err)
 	}
+	if err := v.BindPFlag("committer.takeover-timeout", cmd.PersistentFlags().Lookup("committer.takeover-timeout")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("committer.catch-up-max-epochs", cmd.PersistentFlags().Lookup("committer.catch-up-max-epochs")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("committer.max-fee-per-gas", cmd.PersistentFlags().Lookup("committer.max-fee-per-gas")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("committer.gas-wait-deadline", cmd.PersistentFlags().Lookup("committer.gas-wait-deadline")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("committer.simulate", cmd.PersistentFlags().Lookup("committer.simulate")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("priority.key-tags", cmd.PersistentFlags().Lookup("priority.key-tags")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("priority.clients", cmd.PersistentFlags().Lookup("priority.clients")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("priority.client-tokens", cmd.PersistentFlags().Lookup("priority.client-tokens")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("priority.weights", cmd.PersistentFlags().Lookup("priority.weights")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
 	if err := v.BindPFlag("retention.valset-epochs", cmd.PersistentFlags().Lookup("retention.valset-epochs")); err != nil {
 		return errors.Errorf("failed to bind flag: %w", err)
 	}
@@ -552,6 +758,24 @@ func initConfig(cmd *cobra.Command, _ []string) error {
 	if err := v.BindPFlag("pruner.interval", cmd.PersistentFlags().Lookup("pruner.interval")); err != nil {
 		return errors.Errorf("failed to bind flag: %w", err)
 	}
+	if err := v.BindPFlag("signature-batch.window", cmd.PersistentFlags().Lookup("signature-batch.window")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("signature-batch.max-size", cmd.PersistentFlags().Lookup("signature-batch.max-size")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("aggregator.backup-delay", cmd.PersistentFlags().Lookup("aggregator.backup-delay")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("aggregator.intent-ttl", cmd.PersistentFlags().Lookup("aggregator.intent-ttl")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("aggregator.upgrade-window", cmd.PersistentFlags().Lookup("aggregator.upgrade-window")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
+	if err := v.BindPFlag("participation.flush-interval", cmd.PersistentFlags().Lookup("participation.flush-interval")); err != nil {
+		return errors.Errorf("failed to bind flag: %w", err)
+	}
 	if err := v.BindPFlag("tracing.enabled", cmd.PersistentFlags().Lookup("tracing.enabled")); err != nil {
 		return errors.Errorf("failed to bind flag: %w", err)
 	}
@@ -0,0 +1,145 @@
+package root
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/symbioticfi/relay/internal/devnet"
+	"github.com/symbioticfi/relay/pkg/log"
+)
+
+type devnetFlags struct {
+	Nodes         int
+	EpochDuration time.Duration
+	APIPort       uint16
+	Seed          string
+	Settlements   int
+}
+
+var devnetCfg devnetFlags
+
+func newDevnetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "devnet",
+		Short: "Run a local network of relays in one process",
+		Long: `Runs relays in one process over an in-memory p2p network against a chain stub.
+The driver, settlement, key registry and voting power provider contracts are stubbed in Go, no EVM runs
+and no contract code is executed, the settlement stub verifies quorum proofs of committed headers with the
+verifier of the relay. Contract behavior not mirrored by the stub is only covered by the e2e setup with
+deployed contracts.
+Relay operators are registered and the genesis header is set on start.
+Epochs advance every --epoch-duration and on every Enter pressed in the terminal.`,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			level, err := cmd.Flags().GetString("log.level")
+			if err != nil {
+				return err
+			}
+			mode, err := cmd.Flags().GetString("log.mode")
+			if err != nil {
+				return err
+			}
+			log.Init(level, mode)
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runDevnet(signalContext(cmd.Context()), cmd)
+		},
+	}
+
+	cmd.Flags().IntVar(&devnetCfg.Nodes, "nodes", 3, "Number of relays")
+	cmd.Flags().DurationVar(&devnetCfg.EpochDuration, "epoch-duration", 30*time.Second, "Interval epochs advance automatically at, 0 advances epochs only on Enter")
+	cmd.Flags().Uint16Var(&devnetCfg.APIPort, "api-port", 8080, "API port of the first relay, relay i listens on the port + i")
+	cmd.Flags().IntVar(&devnetCfg.Settlements, "settlements", 1, "Number of settlement contracts")
+	cmd.Flags().StringVar(&devnetCfg.Seed, "seed", "symbiotic relay devnet", "Seed relay keys are derived from")
+
+	return cmd
+}
+
+func runDevnet(ctx context.Context, cmd *cobra.Command) error {
+	storageDir, err := cmd.Flags().GetString("storage-dir")
+	if err != nil {
+		return err
+	}
+	if !cmd.Flags().Changed("storage-dir") {
+		if storageDir, err = os.MkdirTemp("", "relay-devnet-"); err != nil {
+			return errors.Errorf("failed to create storage dir: %w", err)
+		}
+		defer os.RemoveAll(storageDir)
+	}
+
+	cfg := devnet.DefaultConfig(storageDir)
+	cfg.Nodes = devnetCfg.Nodes
+	cfg.Seed = []byte(devnetCfg.Seed)
+	cfg.Chain.NumSettlements = devnetCfg.Settlements
+	if devnetCfg.EpochDuration >= time.Second {
+		cfg.Chain.EpochDuration = uint64(devnetCfg.EpochDuration / time.Second)
+	}
+	for i := range cfg.Nodes {
+		cfg.APIAddresses = append(cfg.APIAddresses, fmt.Sprintf("127.0.0.1:%d", int(devnetCfg.APIPort)+i))
+	}
+
+	d, err := devnet.New(cfg)
+	if err != nil {
+		return errors.Errorf("failed to create devnet: %w", err)
+	}
+	if err := d.Start(ctx); err != nil {
+		return errors.Errorf("failed to start devnet: %w", err)
+	}
+
+	contracts := d.Chain().Contracts()
+	fmt.Printf("Chain ID:              %d\n", cfg.Chain.ChainID)
+	fmt.Printf("Driver:                %s\n", contracts.Driver)
+	for _, settlement := range contracts.Settlements {
+		fmt.Printf("Settlement:            %s\n", settlement)
+	}
+	fmt.Printf("KeyRegistry:           %s\n", contracts.KeyRegistry)
+	fmt.Printf("VotingPowerProvider:   %s\n", contracts.VotingPowerProvider)
+	fmt.Printf("OperatorRegistry:      %s\n", contracts.OperatorRegistry)
+	for _, n := range d.Nodes() {
+		fmt.Printf("%s: operator %s, api %s\n", n.Name, n.Operator, n.APIAddress)
+	}
+	fmt.Println("Press Enter to advance the epoch")
+
+	go advanceEpochs(ctx, d, devnetCfg.EpochDuration)
+
+	return d.Wait()
+}
+
+// advanceEpochs advances the devnet epoch on every line read from stdin and every interval if it is positive.
+func advanceEpochs(ctx context.Context, d *devnet.Devnet, interval time.Duration) {
+	lines := make(chan struct{})
+	go func() {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			select {
+			case lines <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-lines:
+		case <-tick:
+		}
+		slog.InfoContext(ctx, "Advanced devnet epoch", "epoch", d.AdvanceEpoch())
+	}
+}
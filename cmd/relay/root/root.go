@@ -21,6 +21,7 @@ func NewRootCommand() *cobra.Command {
 	)
 
 	addRootFlags(rootCmd)
+	rootCmd.AddCommand(newDevnetCommand())
 
 	return rootCmd
 }
@@ -80,6 +80,20 @@ func parseProviderConfigEntry(entry string) (votingpower.ProviderConfig, error)
 				return votingpower.ProviderConfig{}, errors.Errorf("invalid headers value %q: %w", value, err)
 			}
 			cfg.Headers = headers
+		case "replicas":
+			for url := range strings.SplitSeq(value, "|") {
+				url = strings.TrimSpace(url)
+				if url == "" {
+					continue
+				}
+				cfg.Replicas = append(cfg.Replicas, votingpower.ReplicaConfig{URL: url})
+			}
+		case "agreement":
+			policy, err := votingpower.ParseAgreementPolicy(value)
+			if err != nil {
+				return votingpower.ProviderConfig{}, errors.Errorf("invalid agreement value %q: %w", value, err)
+			}
+			cfg.Agreement = policy
 		default:
 			return votingpower.ProviderConfig{}, errors.Errorf("unknown field %q", key)
 		}
@@ -92,6 +106,14 @@ func parseProviderConfigEntry(entry string) (votingpower.ProviderConfig, error)
 		return votingpower.ProviderConfig{}, errors.New("url is required")
 	}
 
+	// replicas given on the command line share transport settings with the primary url
+	for i := range cfg.Replicas {
+		cfg.Replicas[i].Secure = cfg.Secure
+		cfg.Replicas[i].CACertFile = cfg.CACertFile
+		cfg.Replicas[i].ServerName = cfg.ServerName
+		cfg.Replicas[i].Headers = cfg.Headers
+	}
+
 	return cfg, nil
 }
 
@@ -10,6 +10,7 @@ import (
 
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-errors/errors"
 	"github.com/pterm/pterm"
 	"golang.org/x/term"
@@ -152,3 +153,34 @@ func GetPct(value *big.Int, total *big.Int) float64 {
 	fl, _ := pct.Float64()
 	return fl
 }
+
+// PrintDryRun prints the simulation and calldata of a transaction that was not sent because of --dry-run,
+// it reports whether the result is a dry run.
+func PrintDryRun(title string, result symbiotic.TxResult) bool {
+	if result.DryRun == nil {
+		return false
+	}
+	simulation := result.DryRun
+
+	outcome := pterm.FgGreen.Sprint("success")
+	if simulation.RevertReason != "" {
+		outcome = pterm.FgRed.Sprint("revert: " + simulation.RevertReason)
+	}
+	data := pterm.TableData{
+		{"Result", outcome},
+		{"From", simulation.From.Hex()},
+		{"To", simulation.To.Hex()},
+		{"Calldata", hexutil.Encode(simulation.Calldata)},
+	}
+	if simulation.RevertReason == "" {
+		data = append(data,
+			[]string{"Gas Estimate", strconv.FormatUint(simulation.GasEstimate, 10)},
+			[]string{"Fee Per Gas", fmt.Sprintf("%s wei", simulation.FeePerGas)},
+			[]string{"Cost", fmt.Sprintf("%s wei", simulation.Cost())},
+		)
+	}
+
+	pterm.DefaultSection.Println("Dry run: " + title + " (not sent)")
+	_ = pterm.DefaultTable.WithData(data).Render()
+	return true
+}
@@ -0,0 +1,188 @@
+package cmdhelpers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-errors/errors"
+	"github.com/pterm/pterm"
+	"github.com/spf13/pflag"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+const (
+	// TxExportUnsigned exports the unsigned transaction in eth_signTransaction format
+	TxExportUnsigned = "unsigned"
+	// TxExportSafe exports a Safe Transaction Service proposal
+	TxExportSafe = "safe"
+	// TxExportCalldata exports the target and calldata only
+	TxExportCalldata = "calldata"
+)
+
+var txExportFormats = []string{TxExportUnsigned, TxExportSafe, TxExportCalldata}
+
+// SafeNonceGetter returns the nonce of the next transaction of a Safe multisig
+type SafeNonceGetter interface {
+	GetSafeNonce(ctx context.Context, safe symbiotic.CrossChainAddress) (*big.Int, error)
+}
+
+// TxExportFlags make commands export their transactions for an external signer instead of signing and sending them
+type TxExportFlags struct {
+	Format string
+	From   string
+}
+
+func (f *TxExportFlags) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&f.Format, "export", "", "Export transactions for an external signer instead of sending them (unsigned, safe, calldata)")
+	flags.StringVar(&f.From, "from", "", "Address that signs the exported transactions, e.g. the multisig")
+}
+
+// Enabled reports whether transactions are exported
+func (f TxExportFlags) Enabled() bool {
+	return f.Format != ""
+}
+
+func (f TxExportFlags) Validate() error {
+	if !f.Enabled() {
+		return nil
+	}
+	if !slices.Contains(txExportFormats, f.Format) {
+		return errors.Errorf("invalid export format %q, expected one of %v", f.Format, txExportFormats)
+	}
+	if !common.IsHexAddress(f.From) {
+		return errors.Errorf("--from must be the address signing the exported transactions, got %q", f.From)
+	}
+	return nil
+}
+
+// Sender returns the address the exported transactions are built from, the zero address if exporting is disabled
+func (f TxExportFlags) Sender() common.Address {
+	if !f.Enabled() {
+		return common.Address{}
+	}
+	return common.HexToAddress(f.From)
+}
+
+// Print prints the transaction as JSON in the export format to stdout
+func (f TxExportFlags) Print(ctx context.Context, title string, tx symbiotic.UnsignedTx, safes SafeNonceGetter) error {
+	var export any
+	switch f.Format {
+	case TxExportUnsigned:
+		export = newUnsignedTxExport(tx)
+	case TxExportCalldata:
+		export = calldataExport{
+			ChainID: hexutil.Uint64(tx.ChainID),
+			To:      tx.To,
+			Value:   (*hexutil.Big)(valueOrZero(tx.Value)),
+			Data:    tx.Calldata,
+		}
+	case TxExportSafe:
+		nonce, err := safes.GetSafeNonce(ctx, symbiotic.CrossChainAddress{ChainId: tx.ChainID, Address: tx.From})
+		if err != nil {
+			return errors.Errorf("failed to get safe nonce: %w", err)
+		}
+		proposal, err := newSafeProposal(tx, nonce)
+		if err != nil {
+			return err
+		}
+		export = proposal
+	default:
+		return errors.Errorf("invalid export format %q", f.Format)
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return errors.Errorf("failed to marshal exported transaction: %w", err)
+	}
+
+	// the description goes to stderr so that stdout can be piped to a signer
+	pterm.Info.WithWriter(os.Stderr).Printfln("%s: %s transaction from %s on chain %d", title, f.Format, tx.From.Hex(), tx.ChainID)
+	fmt.Println(string(data))
+	return nil
+}
+
+// unsignedTxExport is the transaction object of eth_signTransaction
+type unsignedTxExport struct {
+	ChainID              hexutil.Uint64 `json:"chainId"`
+	From                 common.Address `json:"from"`
+	To                   common.Address `json:"to"`
+	Value                *hexutil.Big   `json:"value"`
+	Data                 hexutil.Bytes  `json:"data"`
+	Nonce                hexutil.Uint64 `json:"nonce"`
+	Gas                  hexutil.Uint64 `json:"gas"`
+	GasPrice             *hexutil.Big   `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas,omitempty"`
+}
+
+func newUnsignedTxExport(tx symbiotic.UnsignedTx) unsignedTxExport {
+	return unsignedTxExport{
+		ChainID:              hexutil.Uint64(tx.ChainID),
+		From:                 tx.From,
+		To:                   tx.To,
+		Value:                (*hexutil.Big)(valueOrZero(tx.Value)),
+		Data:                 tx.Calldata,
+		Nonce:                hexutil.Uint64(tx.Nonce),
+		Gas:                  hexutil.Uint64(tx.GasLimit),
+		GasPrice:             (*hexutil.Big)(tx.GasPrice),
+		MaxFeePerGas:         (*hexutil.Big)(tx.GasFeeCap),
+		MaxPriorityFeePerGas: (*hexutil.Big)(tx.GasTipCap),
+	}
+}
+
+type calldataExport struct {
+	ChainID hexutil.Uint64 `json:"chainId"`
+	To      common.Address `json:"to"`
+	Value   *hexutil.Big   `json:"value"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+// safeProposal is the body of a multisig transaction proposal of the Safe Transaction Service,
+// the proposing owner adds sender and signature of contractTransactionHash before submitting it
+type safeProposal struct {
+	Safe                    common.Address `json:"safe"`
+	To                      common.Address `json:"to"`
+	Value                   string         `json:"value"`
+	Data                    hexutil.Bytes  `json:"data"`
+	Operation               int            `json:"operation"`
+	SafeTxGas               string         `json:"safeTxGas"`
+	BaseGas                 string         `json:"baseGas"`
+	GasPrice                string         `json:"gasPrice"`
+	GasToken                common.Address `json:"gasToken"`
+	RefundReceiver          common.Address `json:"refundReceiver"`
+	Nonce                   string         `json:"nonce"`
+	ContractTransactionHash common.Hash    `json:"contractTransactionHash"`
+}
+
+func newSafeProposal(tx symbiotic.UnsignedTx, nonce *big.Int) (safeProposal, error) {
+	hash, err := symbiotic.SafeTxHash(tx, nonce)
+	if err != nil {
+		return safeProposal{}, err
+	}
+	return safeProposal{
+		Safe:                    tx.From,
+		To:                      tx.To,
+		Value:                   valueOrZero(tx.Value).String(),
+		Data:                    tx.Calldata,
+		Operation:               symbiotic.SafeOperationCall,
+		SafeTxGas:               "0",
+		BaseGas:                 "0",
+		GasPrice:                "0",
+		Nonce:                   nonce.String(),
+		ContractTransactionHash: hash,
+	}, nil
+}
+
+func valueOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+	return value
+}
@@ -19,33 +19,40 @@ var addKeyCmd = &cobra.Command{
 			return errors.New("add --generate if private key omitted")
 		}
 
-		if !addFlags.EvmNs && !addFlags.RelayNs && !addFlags.P2PNs {
-			return errors.New("either --evm or --relay or --p2p must be specified")
-		}
-		if (addFlags.EvmNs && addFlags.RelayNs) || (addFlags.EvmNs && addFlags.P2PNs) || (addFlags.RelayNs && addFlags.P2PNs) {
-			return errors.New("only one namespace can be specified at a time")
+		ns, keyType, id, err := resolveKey(addFlags.EvmNs, addFlags.RelayNs, addFlags.P2PNs, addFlags.KeyTag, addFlags.ChainID)
+		if err != nil {
+			return err
 		}
+		return addKeyWithNamespace(ns, keyType, id, addFlags.Generate, addFlags.Force, addFlags.PrivateKey)
+	},
+}
+
+// resolveKey maps the namespace flags of a command to the namespace, type and id of the key.
+func resolveKey(evmNs, relayNs, p2pNs bool, keyTag uint8, chainID int64) (string, symbiotic.KeyType, int, error) {
+	if !evmNs && !relayNs && !p2pNs {
+		return "", 0, 0, errors.New("either --evm or --relay or --p2p must be specified")
+	}
+	if (evmNs && relayNs) || (evmNs && p2pNs) || (relayNs && p2pNs) {
+		return "", 0, 0, errors.New("only one namespace can be specified at a time")
+	}
 
-		if addFlags.EvmNs {
-			if addFlags.ChainID < 0 {
-				return errors.New("chain ID is required for evm namespace, use --chain-id=0 for default key for all chains")
-			}
-			return addKeyWithNamespace(keyprovider.EVM_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, int(addFlags.ChainID), addFlags.Generate, addFlags.Force, addFlags.PrivateKey)
-		} else if addFlags.RelayNs {
-			if addFlags.KeyTag == uint8(symbiotic.KeyTypeInvalid) {
-				return errors.New("key tag is required for relay namespace")
-			}
-			kt := symbiotic.KeyTag(addFlags.KeyTag)
-			if kt.Type() == symbiotic.KeyTypeInvalid {
-				return errors.New("invalid key tag, type not supported")
-			}
-			keyId := kt & 0x0F
-			return addKeyWithNamespace(keyprovider.SYMBIOTIC_KEY_NAMESPACE, kt.Type(), int(keyId), addFlags.Generate, addFlags.Force, addFlags.PrivateKey)
-		} else if addFlags.P2PNs {
-			return addKeyWithNamespace(keyprovider.P2P_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, keyprovider.P2P_HOST_IDENTITY_KEY_ID, addFlags.Generate, addFlags.Force, addFlags.PrivateKey)
+	if evmNs {
+		if chainID < 0 {
+			return "", 0, 0, errors.New("chain ID is required for evm namespace, use --chain-id=0 for default key for all chains")
 		}
-		return errors.New("either --evm or --relay or --p2p must be specified")
-	},
+		return keyprovider.EVM_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, int(chainID), nil
+	} else if relayNs {
+		if keyTag == uint8(symbiotic.KeyTypeInvalid) {
+			return "", 0, 0, errors.New("key tag is required for relay namespace")
+		}
+		kt := symbiotic.KeyTag(keyTag)
+		if kt.Type() == symbiotic.KeyTypeInvalid {
+			return "", 0, 0, errors.New("invalid key tag, type not supported")
+		}
+		keyId := kt & 0x0F
+		return keyprovider.SYMBIOTIC_KEY_NAMESPACE, kt.Type(), int(keyId), nil
+	}
+	return keyprovider.P2P_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, keyprovider.P2P_HOST_IDENTITY_KEY_ID, nil
 }
 
 func addKeyWithNamespace(ns string, keyType symbiotic.KeyType, id int, generate bool, force bool, privateKey string) error {
@@ -11,6 +11,10 @@ func NewKeysCmd() *cobra.Command {
 	keysCmd.AddCommand(addKeyCmd)
 	keysCmd.AddCommand(removeKeyCmd)
 	keysCmd.AddCommand(updateKeyCmd)
+	keysCmd.AddCommand(importMnemonicCmd)
+	keysCmd.AddCommand(deriveKeyCmd)
+	keysCmd.AddCommand(importKeysCmd)
+	keysCmd.AddCommand(exportKeysCmd)
 
 	initFlags()
 
@@ -56,11 +60,57 @@ type UpdateFlags struct {
 	Force      bool
 }
 
+type ImportMnemonicFlags struct {
+	SeedPath   string
+	Mnemonic   string
+	Passphrase string
+	Generate   bool
+	Force      bool
+}
+
+type DeriveFlags struct {
+	SeedPath string
+	EvmNs    bool
+	RelayNs  bool
+	P2PNs    bool
+	KeyTag   uint8
+	ChainID  int64
+	Save     bool
+	Force    bool
+}
+
+type ImportFlags struct {
+	From         string
+	KeyPassword  string
+	PasswordFile string
+	EvmNs        bool
+	RelayNs      bool
+	P2PNs        bool
+	KeyTag       uint8
+	ChainID      int64
+	Force        bool
+}
+
+type ExportFlags struct {
+	To          string
+	KeyPassword string
+	EvmNs       bool
+	RelayNs     bool
+	P2PNs       bool
+	KeyTag      uint8
+	ChainID     int64
+	Force       bool
+}
+
 var globalFlags GlobalFlags
 var addFlags AddFlags
 
 var removeFlags RemoveFlags
 var updateFlags UpdateFlags
+var importMnemonicFlags ImportMnemonicFlags
+var deriveFlags DeriveFlags
+var importFlags ImportFlags
+var exportFlags ExportFlags
 
 func initFlags() {
 	keysCmd.PersistentFlags().StringVarP(&globalFlags.Path, "path", "p", "./keystore.jks", "Path to keystore")
@@ -88,4 +138,44 @@ func initFlags() {
 	updateKeyCmd.PersistentFlags().StringVar(&updateFlags.PrivateKey, "private-key", "", "private key to add in hex")
 	updateKeyCmd.PersistentFlags().BoolVar(&updateFlags.Force, "force", false, "force overwrite key")
 	updateKeyCmd.PersistentFlags().BoolVar(&updateFlags.P2PNs, "p2p", false, "use p2p key")
+
+	importMnemonicCmd.PersistentFlags().StringVar(&importMnemonicFlags.SeedPath, "seed-path", "./seed.json", "Path to the encrypted seed file")
+	importMnemonicCmd.PersistentFlags().StringVar(&importMnemonicFlags.Mnemonic, "mnemonic", "", "BIP-39 mnemonic, prompted for if omitted")
+	importMnemonicCmd.PersistentFlags().StringVar(&importMnemonicFlags.Passphrase, "passphrase", "", "optional BIP-39 passphrase")
+	importMnemonicCmd.PersistentFlags().BoolVar(&importMnemonicFlags.Generate, "generate", false, "generate a new 24 words mnemonic")
+	importMnemonicCmd.PersistentFlags().BoolVar(&importMnemonicFlags.Force, "force", false, "force overwrite seed file")
+
+	deriveKeyCmd.PersistentFlags().StringVar(&deriveFlags.SeedPath, "seed-path", "./seed.json", "Path to the encrypted seed file")
+	deriveKeyCmd.PersistentFlags().BoolVar(&deriveFlags.EvmNs, "evm", false, "use evm namespace keys")
+	deriveKeyCmd.PersistentFlags().BoolVar(&deriveFlags.RelayNs, "relay", false, "use relay namespace keys")
+	deriveKeyCmd.PersistentFlags().BoolVar(&deriveFlags.P2PNs, "p2p", false, "use p2p key")
+	deriveKeyCmd.PersistentFlags().Uint8Var(&deriveFlags.KeyTag, "key-tag", uint8(symbiotic.KeyTypeInvalid), "key tag for relay keys")
+	deriveKeyCmd.PersistentFlags().Int64Var(&deriveFlags.ChainID, "chain-id", -1, "chain id for evm keys, use 0 for default key for all chains")
+	deriveKeyCmd.PersistentFlags().BoolVar(&deriveFlags.Save, "save", false, "save the derived key to the keystore")
+	deriveKeyCmd.PersistentFlags().BoolVar(&deriveFlags.Force, "force", false, "force overwrite key")
+
+	importKeysCmd.PersistentFlags().StringVar(&importFlags.From, "from", "", "keystore file or directory of keystore files to import")
+	importKeysCmd.PersistentFlags().StringVar(&importFlags.KeyPassword, "key-password", "", "password of the keystore files")
+	importKeysCmd.PersistentFlags().StringVar(&importFlags.PasswordFile, "password-file", "", "file with passwords of keystore files, one '<file name>=<password>' per line, '*' for the rest")
+	importKeysCmd.PersistentFlags().BoolVar(&importFlags.EvmNs, "evm", false, "use evm namespace keys")
+	importKeysCmd.PersistentFlags().BoolVar(&importFlags.RelayNs, "relay", false, "use relay namespace keys")
+	importKeysCmd.PersistentFlags().BoolVar(&importFlags.P2PNs, "p2p", false, "use p2p key")
+	importKeysCmd.PersistentFlags().Uint8Var(&importFlags.KeyTag, "key-tag", uint8(symbiotic.KeyTypeInvalid), "key tag for relay keys")
+	importKeysCmd.PersistentFlags().Int64Var(&importFlags.ChainID, "chain-id", -1, "chain id for evm keys, use 0 for default key for all chains")
+	importKeysCmd.PersistentFlags().BoolVar(&importFlags.Force, "force", false, "force overwrite key")
+	if err := importKeysCmd.MarkPersistentFlagRequired("from"); err != nil {
+		panic(err)
+	}
+
+	exportKeysCmd.PersistentFlags().StringVar(&exportFlags.To, "to", "", "directory to export keystore files to")
+	exportKeysCmd.PersistentFlags().StringVar(&exportFlags.KeyPassword, "key-password", "", "password of the exported keystore files")
+	exportKeysCmd.PersistentFlags().BoolVar(&exportFlags.EvmNs, "evm", false, "use evm namespace keys")
+	exportKeysCmd.PersistentFlags().BoolVar(&exportFlags.RelayNs, "relay", false, "use relay namespace keys")
+	exportKeysCmd.PersistentFlags().BoolVar(&exportFlags.P2PNs, "p2p", false, "use p2p key")
+	exportKeysCmd.PersistentFlags().Uint8Var(&exportFlags.KeyTag, "key-tag", uint8(symbiotic.KeyTypeInvalid), "key tag for relay keys")
+	exportKeysCmd.PersistentFlags().Int64Var(&exportFlags.ChainID, "chain-id", -1, "chain id for evm keys, use 0 for default key for all chains")
+	exportKeysCmd.PersistentFlags().BoolVar(&exportFlags.Force, "force", false, "force overwrite keystore files")
+	if err := exportKeysCmd.MarkPersistentFlagRequired("to"); err != nil {
+		panic(err)
+	}
 }
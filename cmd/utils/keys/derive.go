@@ -0,0 +1,71 @@
+package keys
+
+import (
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+
+	cmdhelpers "github.com/symbioticfi/relay/cmd/utils/cmd-helpers"
+	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
+)
+
+var deriveKeyCmd = &cobra.Command{
+	Use:   "derive",
+	Short: "Derive a key from the seed stored by import-mnemonic",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ns, keyType, id, err := resolveKey(deriveFlags.EvmNs, deriveFlags.RelayNs, deriveFlags.P2PNs, deriveFlags.KeyTag, deriveFlags.ChainID)
+		if err != nil {
+			return err
+		}
+
+		if globalFlags.Password == "" {
+			globalFlags.Password, err = cmdhelpers.GetPassword()
+			if err != nil {
+				return err
+			}
+		}
+
+		seed, err := keyprovider.LoadSeed(deriveFlags.SeedPath, globalFlags.Password)
+		if err != nil {
+			return err
+		}
+
+		path, err := keyprovider.DerivationPath(ns, keyType, id)
+		if err != nil {
+			return err
+		}
+		key, err := keyprovider.DeriveKey(seed, ns, keyType, id)
+		if err != nil {
+			return err
+		}
+		alias, err := keyprovider.ToAlias(ns, keyType, id)
+		if err != nil {
+			return err
+		}
+		prettyPk, err := key.PublicKey().OnChain().MarshalText()
+		if err != nil {
+			return err
+		}
+
+		if err := pterm.DefaultTable.WithHasHeader().WithData(pterm.TableData{
+			{"Alias", "Derivation Path", "Public Key"},
+			{alias, path, string(prettyPk)},
+		}).Render(); err != nil {
+			return err
+		}
+
+		if !deriveFlags.Save {
+			return nil
+		}
+
+		keyStore, err := keyprovider.NewKeystoreProvider(globalFlags.Path, globalFlags.Password)
+		if err != nil {
+			return err
+		}
+		if err := keyStore.AddKeyByNamespaceTypeId(ns, keyType, id, key, globalFlags.Password, deriveFlags.Force); err != nil {
+			return err
+		}
+		pterm.Success.Println("Key saved to " + globalFlags.Path)
+
+		return nil
+	},
+}
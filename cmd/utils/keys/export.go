@@ -0,0 +1,83 @@
+package keys
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+
+	cmdhelpers "github.com/symbioticfi/relay/cmd/utils/cmd-helpers"
+	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
+)
+
+var exportKeysCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export keys to EIP-2335 or geth-style JSON keystore files",
+	Long: `Exports keys of the relay keystore to --to, one <alias>.json file per key: BLS keys as EIP-2335 keystores
+and secp256k1 keys as geth-style V3 keystores. All keys are exported unless a key is selected with the namespace flags.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		if globalFlags.Password == "" {
+			globalFlags.Password, err = cmdhelpers.GetPassword()
+			if err != nil {
+				return err
+			}
+		}
+		keyStore, err := keyprovider.NewKeystoreProvider(globalFlags.Path, globalFlags.Password)
+		if err != nil {
+			return err
+		}
+
+		aliases := keyStore.GetAliases()
+		if exportFlags.EvmNs || exportFlags.RelayNs || exportFlags.P2PNs {
+			ns, keyType, id, err := resolveKey(exportFlags.EvmNs, exportFlags.RelayNs, exportFlags.P2PNs, exportFlags.KeyTag, exportFlags.ChainID)
+			if err != nil {
+				return err
+			}
+			alias, err := keyprovider.ToAlias(ns, keyType, id)
+			if err != nil {
+				return err
+			}
+			aliases = []string{alias}
+		}
+
+		passwords, err := keystoreFilePasswords("", exportFlags.KeyPassword)
+		if err != nil {
+			return err
+		}
+		password := passwords[keyprovider.DefaultPasswordKey]
+
+		if err := os.MkdirAll(exportFlags.To, 0700); err != nil {
+			return err
+		}
+		for _, alias := range aliases {
+			ns, keyType, id, err := keyprovider.AliasToKeyTypeId(alias)
+			if err != nil {
+				return err
+			}
+			key, err := keyStore.GetPrivateKeyByAlias(alias)
+			if err != nil {
+				return err
+			}
+			// the derivation path lets the key be recognized when imported under another file name
+			path, _ := keyprovider.DerivationPath(ns, keyType, id)
+
+			data, err := keyprovider.EncryptKeystoreJSON(key, keyType, path, password)
+			if err != nil {
+				return err
+			}
+			file := filepath.Join(exportFlags.To, alias+".json")
+			if _, err := os.Stat(file); err == nil && !exportFlags.Force {
+				return os.ErrExist
+			}
+			if err := os.WriteFile(file, data, 0600); err != nil {
+				return err
+			}
+			pterm.Success.Println("Exported " + alias + " to " + file)
+		}
+
+		return nil
+	},
+}
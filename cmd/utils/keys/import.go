@@ -0,0 +1,135 @@
+package keys
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/go-errors/errors"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+
+	cmdhelpers "github.com/symbioticfi/relay/cmd/utils/cmd-helpers"
+	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+var importKeysCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import keys from EIP-2335 or geth-style JSON keystore files",
+	Long: `Imports keys from EIP-2335 BLS keystores and geth-style V3 secp256k1 keystores into the relay keystore.
+--from is a keystore file or a directory of them. The alias of a key is the file name without extension,
+e.g. symb-bls_bn254-15.json, or the relay derivation path of an EIP-2335 keystore. The namespace flags set
+the alias of a single imported file explicitly.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		info, err := os.Stat(importFlags.From)
+		if err != nil {
+			return err
+		}
+
+		passwords, err := keystoreFilePasswords(importFlags.PasswordFile, importFlags.KeyPassword)
+		if err != nil {
+			return err
+		}
+
+		var files []keyprovider.KeystoreFile
+		if info.IsDir() {
+			if importFlags.EvmNs || importFlags.RelayNs || importFlags.P2PNs {
+				return errors.New("namespace flags can only be used to import a single file")
+			}
+			files, err = keyprovider.ReadKeystoreDir(importFlags.From, passwords)
+		} else {
+			var file keyprovider.KeystoreFile
+			file, err = readKeystoreFile(importFlags.From, passwords)
+			files = []keyprovider.KeystoreFile{file}
+		}
+		if err != nil {
+			return err
+		}
+
+		if globalFlags.Password == "" {
+			globalFlags.Password, err = cmdhelpers.GetPassword()
+			if err != nil {
+				return err
+			}
+		}
+		keyStore, err := keyprovider.NewKeystoreProvider(globalFlags.Path, globalFlags.Password)
+		if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			ns, keyType, id, err := keyprovider.AliasToKeyTypeId(file.Alias)
+			if err != nil {
+				return err
+			}
+			if err := keyStore.AddKeyByNamespaceTypeId(ns, keyType, id, file.Key, globalFlags.Password, importFlags.Force); err != nil {
+				return errors.Errorf("failed to import %s as %s: %w", file.Name, file.Alias, err)
+			}
+			pterm.Success.Println("Imported " + file.Name + " as " + file.Alias)
+		}
+
+		return nil
+	},
+}
+
+// readKeystoreFile decrypts a single keystore file, its alias is taken from the namespace flags if they are set.
+func readKeystoreFile(path string, passwords map[string]string) (keyprovider.KeystoreFile, error) {
+	name := filepath.Base(path)
+	password, ok := passwords[name]
+	if !ok {
+		password = passwords[keyprovider.DefaultPasswordKey]
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return keyprovider.KeystoreFile{}, err
+	}
+	decrypted, err := keyprovider.DecryptKeystoreJSON(data, password)
+	if err != nil {
+		return keyprovider.KeystoreFile{}, err
+	}
+
+	fileName := name
+	if importFlags.EvmNs || importFlags.RelayNs || importFlags.P2PNs {
+		ns, keyType, id, err := resolveKey(importFlags.EvmNs, importFlags.RelayNs, importFlags.P2PNs, importFlags.KeyTag, importFlags.ChainID)
+		if err != nil {
+			return keyprovider.KeystoreFile{}, err
+		}
+		fileName, err = keyprovider.ToAlias(ns, keyType, id)
+		if err != nil {
+			return keyprovider.KeystoreFile{}, err
+		}
+	}
+	alias, err := keyprovider.KeystoreFileAlias(fileName, decrypted)
+	if err != nil {
+		return keyprovider.KeystoreFile{}, err
+	}
+
+	_, keyType, _, err := keyprovider.AliasToKeyTypeId(alias)
+	if err != nil {
+		return keyprovider.KeystoreFile{}, err
+	}
+	key, err := crypto.NewPrivateKey(keyType, decrypted.Secret)
+	if err != nil {
+		return keyprovider.KeystoreFile{}, err
+	}
+	return keyprovider.KeystoreFile{Name: name, Alias: alias, Key: key}, nil
+}
+
+// keystoreFilePasswords returns passwords of keystore files from the password file or the single password for all files,
+// the password is prompted for if neither is set.
+func keystoreFilePasswords(passwordFile, password string) (map[string]string, error) {
+	if passwordFile != "" {
+		return keyprovider.ParsePasswordFile(passwordFile)
+	}
+	if password == "" {
+		var err error
+		password, err = pterm.DefaultInteractiveTextInput.WithMask("*").Show("Enter password of keystore files")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return map[string]string{keyprovider.DefaultPasswordKey: password}, nil
+}
@@ -0,0 +1,62 @@
+package keys
+
+import (
+	"github.com/go-errors/errors"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+
+	cmdhelpers "github.com/symbioticfi/relay/cmd/utils/cmd-helpers"
+	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto/hd"
+)
+
+var importMnemonicCmd = &cobra.Command{
+	Use:   "import-mnemonic",
+	Short: "Store the seed of a BIP-39 mnemonic encrypted with the keystore password",
+	Long: `Stores the seed of a BIP-39 mnemonic encrypted with the keystore password.
+All relay keys can be derived from the seed with "keys derive" or at relay startup with --keystore.seed-path,
+so the mnemonic is the only backup needed to recover them.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+
+		mnemonic := importMnemonicFlags.Mnemonic
+		if importMnemonicFlags.Generate {
+			if mnemonic != "" {
+				return errors.New("either --mnemonic or --generate can be specified")
+			}
+			mnemonic, err = hd.NewMnemonic()
+			if err != nil {
+				return err
+			}
+		} else if mnemonic == "" {
+			mnemonic, err = pterm.DefaultInteractiveTextInput.WithMask("*").Show("Enter mnemonic")
+			if err != nil {
+				return err
+			}
+		}
+
+		seed, err := hd.SeedFromMnemonic(mnemonic, importMnemonicFlags.Passphrase)
+		if err != nil {
+			return err
+		}
+
+		if globalFlags.Password == "" {
+			globalFlags.Password, err = cmdhelpers.GetPassword()
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := keyprovider.SaveSeed(importMnemonicFlags.SeedPath, seed, globalFlags.Password, importMnemonicFlags.Force); err != nil {
+			return err
+		}
+
+		if importMnemonicFlags.Generate {
+			pterm.Warning.Println("Write down the mnemonic and keep it offline, it is the only way to recover the keys:")
+			pterm.Println(mnemonic)
+		}
+		pterm.Success.Println("Seed saved to " + importMnemonicFlags.SeedPath)
+
+		return nil
+	},
+}
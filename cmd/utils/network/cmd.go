@@ -15,6 +15,8 @@ import (
 func NewNetworkCmd() *cobra.Command {
 	networkCmd.AddCommand(infoCmd)
 	networkCmd.AddCommand(genesisCmd)
+	networkCmd.AddCommand(diffCmd)
+	networkCmd.AddCommand(simulateCmd)
 
 	initFlags()
 
@@ -24,6 +26,9 @@ func NewNetworkCmd() *cobra.Command {
 var networkCmd = &cobra.Command{
 	Use:   "network",
 	Short: "Network tool",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return globalFlags.Export.Validate()
+	},
 }
 
 type GlobalFlags struct {
@@ -32,6 +37,8 @@ type GlobalFlags struct {
 	DriverChainId                uint64
 	Epoch                        uint64
 	ExternalVotingPowerProviders []string
+	DryRun                       bool
+	Export                       cmdhelpers.TxExportFlags
 }
 
 type InfoFlags struct {
@@ -39,6 +46,7 @@ type InfoFlags struct {
 	ValidatorsFull bool
 	Addresses      bool
 	Settlement     bool
+	RelayAPI       string
 }
 
 type GenesisFlags struct {
@@ -49,20 +57,40 @@ type GenesisFlags struct {
 	Secrets cmdhelpers.SecretKeyMapFlag
 }
 
+type DiffFlags struct {
+	FromEpoch   int64
+	ToEpoch     int64
+	AtTimestamp string
+	Json        bool
+}
+
+type SimulateFlags struct {
+	Stakes          []string
+	AddOperators    []string
+	RemoveOperators []string
+	Config          map[string]string
+	Json            bool
+}
+
 var globalFlags GlobalFlags
 var infoFlags InfoFlags
 var genesisFlags GenesisFlags
+var diffFlags DiffFlags
+var simulateFlags SimulateFlags
 
 func initFlags() {
 	networkCmd.PersistentFlags().StringSliceVarP(&globalFlags.Chains, "chains", "c", nil, "Chains rpc url, comma separated")
 	networkCmd.PersistentFlags().StringVar(&globalFlags.DriverAddress, "driver.address", "", "Driver contract address")
 	networkCmd.PersistentFlags().Uint64Var(&globalFlags.DriverChainId, "driver.chainid", 0, "Driver contract chain id")
 	networkCmd.PersistentFlags().Uint64VarP(&globalFlags.Epoch, "epoch", "e", 0, "Network epoch to fetch info")
+	networkCmd.PersistentFlags().BoolVar(&globalFlags.DryRun, "dry-run", false, "Simulate transactions and print the result and calldata without broadcasting them")
+	globalFlags.Export.AddFlags(networkCmd.PersistentFlags())
+	networkCmd.MarkFlagsMutuallyExclusive("dry-run", "export")
 	networkCmd.PersistentFlags().StringArrayVar(
 		&globalFlags.ExternalVotingPowerProviders,
 		"external-voting-power-provider",
 		nil,
-		"External voting power provider config in format 'id=<id>,url=<url>[,secure=<bool>][,ca-cert-file=<path>][,server-name=<name>][,timeout=<duration>][,headers=<k:v|k2:v2>]'",
+		"External voting power provider config in format 'id=<id>,url=<url>[,secure=<bool>][,ca-cert-file=<path>][,server-name=<name>][,timeout=<duration>][,headers=<k:v|k2:v2>][,replicas=<url|url2>][,agreement=<first-healthy|majority|all-equal>]'",
 	)
 	if err := networkCmd.MarkPersistentFlagRequired("chains"); err != nil {
 		panic(err)
@@ -78,12 +106,24 @@ func initFlags() {
 	infoCmd.PersistentFlags().BoolVarP(&infoFlags.ValidatorsFull, "validators-full", "V", false, "Print full validators info")
 	infoCmd.PersistentFlags().BoolVarP(&infoFlags.Addresses, "addresses", "a", false, "Print addresses")
 	infoCmd.PersistentFlags().BoolVarP(&infoFlags.Settlement, "settlement", "s", false, "Print settlement info")
+	infoCmd.PersistentFlags().StringVar(&infoFlags.RelayAPI, "relay-api", "", "Relay gRPC API address (host:port) to include the committer status in settlement info")
 
 	genesisCmd.PersistentFlags().BoolVar(&genesisFlags.Commit, "commit", false, "Commit genesis flag")
 	genesisCmd.PersistentFlags().Var(&genesisFlags.Secrets, "secret-keys", "Secret key for genesis commit  in format 'chainId:key,chainId:key' (e.g. '1:0xabc,137:0xdef')")
 	genesisCmd.PersistentFlags().BoolVarP(&genesisFlags.Json, "json", "j", false, "Print as json")
 	genesisCmd.PersistentFlags().StringVarP(&genesisFlags.Output, "output", "o", "", "Output file path")
 	genesisCmd.PersistentFlags().Int64VarP(&genesisFlags.Epoch, "epoch", "e", -1, "Epoch to generate genesis for (default: current epoch - 1)")
+
+	diffCmd.PersistentFlags().Int64Var(&diffFlags.FromEpoch, "from-epoch", -1, "Epoch to diff from (default: the epoch before --to-epoch)")
+	diffCmd.PersistentFlags().Int64Var(&diffFlags.ToEpoch, "to-epoch", -1, "Epoch to diff to (default: current epoch)")
+	diffCmd.PersistentFlags().StringVar(&diffFlags.AtTimestamp, "at-timestamp", "", "Preview the next epoch captured at this unix timestamp or \"now\" instead of diffing to an existing epoch")
+	diffCmd.PersistentFlags().BoolVarP(&diffFlags.Json, "json", "j", false, "Print as json")
+
+	simulateCmd.PersistentFlags().StringArrayVar(&simulateFlags.Stakes, "stake", nil, "Change the voting power of an operator in format 'operator=+amount', 'operator=-amount' or 'operator=amount' to set it")
+	simulateCmd.PersistentFlags().StringArrayVar(&simulateFlags.AddOperators, "add-operator", nil, "Add an operator with placeholder keys derived from its address in format 'operator=votingPower'")
+	simulateCmd.PersistentFlags().StringSliceVar(&simulateFlags.RemoveOperators, "remove-operator", nil, "Remove operators, comma separated")
+	simulateCmd.PersistentFlags().StringToStringVar(&simulateFlags.Config, "config", nil, "Override network config in format 'key=value' (max-voting-power, min-inclusion-voting-power, max-validators-count, num-aggregators, num-committers, quorum-threshold in percent of the header key tag)")
+	simulateCmd.PersistentFlags().BoolVarP(&simulateFlags.Json, "json", "j", false, "Print as json")
 }
 
 // signalContext returns a context that is canceled if either SIGTERM or SIGINT signal is received.
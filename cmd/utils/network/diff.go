@@ -0,0 +1,205 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"time"
+
+	cmdhelpers "github.com/symbioticfi/relay/cmd/utils/cmd-helpers"
+	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
+	"github.com/symbioticfi/relay/internal/usecase/metrics"
+	"github.com/symbioticfi/relay/symbiotic/client/evm"
+	"github.com/symbioticfi/relay/symbiotic/client/votingpower"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	valsetDeriver "github.com/symbioticfi/relay/symbiotic/usecase/valset-deriver"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Print the changes of network config and validator set between epochs",
+	Long: `Compares the network config and the active validators of two epochs.
+With --at-timestamp the later side is a preview of the next epoch as if its validator set was captured at the timestamp,
+e.g. to review a driver parameter change before the next epoch starts.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := signalContext(cmd.Context())
+
+		kp, err := keyprovider.NewSimpleKeystoreProvider()
+		if err != nil {
+			return err
+		}
+
+		evmClient, err := evm.NewEvmClient(ctx, evm.Config{
+			ChainURLs: globalFlags.Chains,
+			DriverAddress: symbiotic.CrossChainAddress{
+				ChainId: globalFlags.DriverChainId,
+				Address: common.HexToAddress(globalFlags.DriverAddress),
+			},
+			RequestTimeout: 5 * time.Second,
+			KeyProvider:    kp,
+			Metrics:        metrics.New(metrics.Config{}),
+		})
+		if err != nil {
+			return err
+		}
+
+		providerConfigs, err := cmdhelpers.ExternalVotingPowerProviderConfigs(globalFlags.ExternalVotingPowerProviders)
+		if err != nil {
+			return err
+		}
+
+		var externalVPClient *votingpower.Client
+		if len(providerConfigs) > 0 {
+			externalVPClient, err = votingpower.NewClient(ctx, providerConfigs)
+			if err != nil {
+				return errors.Errorf("failed to create external voting power client: %w", err)
+			}
+			defer func() {
+				if err := externalVPClient.Close(); err != nil {
+					slog.WarnContext(ctx, "Failed to close external voting power client", "error", err)
+				}
+			}()
+		}
+
+		currentEpoch, err := evmClient.GetCurrentEpoch(ctx)
+		if err != nil {
+			return errors.Errorf("failed to get current epoch: %w", err)
+		}
+
+		// the later side is the --to-epoch, the current epoch or the preview of the next epoch
+		toEpoch := currentEpoch
+		var previewTimestamp symbiotic.Timestamp
+		switch {
+		case diffFlags.AtTimestamp != "":
+			if diffFlags.ToEpoch >= 0 {
+				return errors.New("--to-epoch and --at-timestamp can't be used together")
+			}
+			previewTimestamp, err = parsePreviewTimestamp(diffFlags.AtTimestamp)
+			if err != nil {
+				return err
+			}
+			toEpoch = currentEpoch + 1
+		case diffFlags.ToEpoch >= 0:
+			toEpoch = symbiotic.Epoch(diffFlags.ToEpoch)
+		}
+
+		fromEpoch := toEpoch - 1
+		if diffFlags.FromEpoch >= 0 {
+			fromEpoch = symbiotic.Epoch(diffFlags.FromEpoch)
+		} else if toEpoch == 0 {
+			return errors.New("--from-epoch is required to diff epoch 0")
+		}
+
+		fromConfig, fromValset, err := getEpochState(ctx, evmClient, externalVPClient, fromEpoch, 0)
+		if err != nil {
+			return err
+		}
+		toConfig, toValset, err := getEpochState(ctx, evmClient, externalVPClient, toEpoch, previewTimestamp)
+		if err != nil {
+			return err
+		}
+
+		diff := networkDiff{
+			Config:       symbiotic.DiffNetworkConfigs(fromConfig, toConfig),
+			ValidatorSet: symbiotic.DiffValidatorSets(fromValset, toValset),
+		}
+		if previewTimestamp != 0 {
+			diff.PreviewTimestamp = &previewTimestamp
+		}
+
+		if diffFlags.Json {
+			data, err := json.MarshalIndent(diff, "", "  ")
+			if err != nil {
+				return errors.Errorf("failed to marshal diff: %w", err)
+			}
+			pterm.Println(string(data))
+			return nil
+		}
+
+		title := "Epoch " + strconv.FormatUint(uint64(fromEpoch), 10) + " → " + strconv.FormatUint(uint64(toEpoch), 10)
+		if previewTimestamp != 0 {
+			title += " (preview at " + time.Unix(int64(previewTimestamp), 0).UTC().Format(time.RFC3339) + ")"
+		}
+		pterm.DefaultSection.Println(title)
+		pterm.DefaultPanel.WithPanels(pterm.Panels{
+			{{Data: pterm.DefaultBox.WithTitle("Network config").Sprint(printConfigDiff(diff.Config))}},
+			{{Data: pterm.DefaultBox.WithTitle("Validator set").Sprint(printValidatorSetDiff(diff.ValidatorSet))}},
+		}).Render()
+
+		return nil
+	},
+}
+
+// networkDiff is the JSON output of the diff command
+type networkDiff struct {
+	// PreviewTimestamp is the capture timestamp of the previewed validator set, nil if both sides are existing epochs
+	PreviewTimestamp *symbiotic.Timestamp       `json:"previewTimestamp,omitempty"`
+	Config           []symbiotic.ConfigChange   `json:"config"`
+	ValidatorSet     symbiotic.ValidatorSetDiff `json:"validatorSet"`
+}
+
+// getEpochState returns the network config and validator set of the epoch, or of the epoch
+// captured at previewTimestamp instead of its start if previewTimestamp is set.
+func getEpochState(
+	ctx context.Context,
+	evmClient *evm.Client,
+	externalVPClient *votingpower.Client,
+	epoch symbiotic.Epoch,
+	previewTimestamp symbiotic.Timestamp,
+) (symbiotic.NetworkConfig, symbiotic.ValidatorSet, error) {
+	captureTimestamp := previewTimestamp
+	if captureTimestamp == 0 {
+		var err error
+		captureTimestamp, err = evmClient.GetEpochStart(ctx, epoch)
+		if err != nil {
+			return symbiotic.NetworkConfig{}, symbiotic.ValidatorSet{}, errors.Errorf("failed to get start of epoch %d: %w", epoch, err)
+		}
+	}
+
+	networkConfig, err := evmClient.GetConfig(ctx, captureTimestamp, epoch)
+	if err != nil {
+		return symbiotic.NetworkConfig{}, symbiotic.ValidatorSet{}, errors.Errorf("failed to get config of epoch %d: %w", epoch, err)
+	}
+
+	deriver, err := valsetDeriver.NewDeriver(previewEvmClient{Client: evmClient, epoch: epoch, timestamp: previewTimestamp}, externalVPClient)
+	if err != nil {
+		return symbiotic.NetworkConfig{}, symbiotic.ValidatorSet{}, errors.Errorf("failed to create deriver: %w", err)
+	}
+	valset, err := deriver.GetValidatorSet(ctx, epoch, networkConfig)
+	if err != nil {
+		return symbiotic.NetworkConfig{}, symbiotic.ValidatorSet{}, errors.Errorf("failed to get validator set of epoch %d: %w", epoch, err)
+	}
+	return networkConfig, valset, nil
+}
+
+// previewEvmClient makes the deriver capture the validator set of an epoch at the preview timestamp instead of the epoch start
+type previewEvmClient struct {
+	*evm.Client
+	epoch     symbiotic.Epoch
+	timestamp symbiotic.Timestamp
+}
+
+func (c previewEvmClient) GetEpochStart(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.Timestamp, error) {
+	if c.timestamp != 0 && epoch == c.epoch {
+		return c.timestamp, nil
+	}
+	return c.Client.GetEpochStart(ctx, epoch)
+}
+
+// parsePreviewTimestamp parses a unix timestamp in seconds or "now"
+func parsePreviewTimestamp(value string) (symbiotic.Timestamp, error) {
+	if value == "now" {
+		return symbiotic.Timestamp(uint64(time.Now().Unix())), nil
+	}
+	timestamp, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || timestamp == 0 {
+		return 0, errors.Errorf("invalid --at-timestamp %q, expected unix seconds or \"now\"", value)
+	}
+	return symbiotic.Timestamp(timestamp), nil
+}
@@ -40,12 +40,14 @@ var genesisCmd = &cobra.Command{
 			},
 			RequestTimeout: 5 * time.Second,
 			KeyProvider:    kp,
+			DryRun:         globalFlags.DryRun,
+			UnsignedFrom:   globalFlags.Export.Sender(),
 		})
 		if err != nil {
 			return err
 		}
 
-		if genesisFlags.Commit {
+		if genesisFlags.Commit && !globalFlags.Export.Enabled() {
 			privateKeyInput := pterm.DefaultInteractiveTextInput.WithMask("*")
 			for _, chainId := range evmClient.GetChains() {
 				secret, ok := genesisFlags.Secrets.Secrets[chainId]
@@ -181,11 +183,23 @@ var genesisCmd = &cobra.Command{
 					settlement,
 					header,
 					extraData)
+				if txResult.DryRun != nil || txResult.Unsigned != nil {
+					_ = spinner.Stop()
+				}
+				dryRun := cmdhelpers.PrintDryRun("set genesis on "+settlement.Address.String(), txResult)
 				if err != nil {
 					spinner.Fail("Transaction failed: ", err)
 					return errors.Errorf("failed to set genesis for network %d: %w", settlement.ChainId, err)
 				}
-				spinner.Success("Transaction hash: ", txResult.TxHash.String())
+				switch {
+				case dryRun:
+				case txResult.Unsigned != nil:
+					if err := globalFlags.Export.Print(ctx, "set genesis on "+settlement.Address.String(), *txResult.Unsigned, evmClient); err != nil {
+						return err
+					}
+				default:
+					spinner.Success("Transaction hash: ", txResult.TxHash.String())
+				}
 			}
 		}
 
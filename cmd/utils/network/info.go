@@ -1,9 +1,11 @@
 package network
 
 import (
+	"context"
 	"log/slog"
 	"time"
 
+	client "github.com/symbioticfi/relay/api/client/v1"
 	cmdhelpers "github.com/symbioticfi/relay/cmd/utils/cmd-helpers"
 	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
 	"github.com/symbioticfi/relay/internal/usecase/metrics"
@@ -18,6 +20,8 @@ import (
 	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 var infoCmd = &cobra.Command{
@@ -167,6 +171,13 @@ var infoCmd = &cobra.Command{
 			if err := eg.Wait(); err != nil {
 				return err
 			}
+
+			if infoFlags.RelayAPI != "" {
+				if err := fillRelayCommitStatus(ctx, infoFlags.RelayAPI, epoch, networkConfig, settlementData); err != nil {
+					return err
+				}
+			}
+
 			header, err := valset.GetHeader()
 			if err != nil {
 				return errors.Errorf("Failed to get header: %w", err)
@@ -198,3 +209,28 @@ var infoCmd = &cobra.Command{
 		return nil
 	},
 }
+
+// fillRelayCommitStatus attaches the commit state tracked by the relay to every settlement.
+func fillRelayCommitStatus(ctx context.Context, address string, epoch symbiotic.Epoch, networkConfig symbiotic.NetworkConfig, settlementData []settlementReplicaData) error {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return errors.Errorf("Failed to connect to relay API: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	epochValue := uint64(epoch)
+	resp, err := client.NewSymbioticClient(conn).GetCommitStatus(ctx, &client.GetCommitStatusRequest{Epoch: &epochValue})
+	if err != nil {
+		return errors.Errorf("Failed to get commit status from relay API: %w", err)
+	}
+
+	for i, settlement := range networkConfig.Settlements {
+		settlementData[i].RelayStatus, _ = lo.Find(resp.GetSettlements(), func(status *client.SettlementCommitStatus) bool {
+			return status.GetChainId() == settlement.ChainId && common.HexToAddress(status.GetAddress()) == settlement.Address
+		})
+	}
+	return nil
+}
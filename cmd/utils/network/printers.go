@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	client "github.com/symbioticfi/relay/api/client/v1"
 	cmdhelpers "github.com/symbioticfi/relay/cmd/utils/cmd-helpers"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
 
@@ -22,6 +23,8 @@ type settlementReplicaData struct {
 	HeaderHash               common.Hash
 	MissedEpochs             uint64
 	LastCommittedHeaderEpoch uint64
+	// RelayStatus is the commit state reported by the relay API, nil if not requested
+	RelayStatus *client.SettlementCommitStatus
 }
 
 func printAddresses(driver symbiotic.CrossChainAddress, networkConfig *symbiotic.NetworkConfig) string {
@@ -212,9 +215,13 @@ func printSettlementData(
 	networkConfig symbiotic.NetworkConfig,
 	settlementData []settlementReplicaData,
 ) string {
-	tableData := pterm.TableData{
-		{"Address", "ChainID", "Status", "Integrity", "Latest Committed Epoch", "Missed Epochs", "Header hash"},
+	withRelayStatus := lo.SomeBy(settlementData, func(data settlementReplicaData) bool { return data.RelayStatus != nil })
+
+	header := []string{"Address", "ChainID", "Status", "Integrity", "Latest Committed Epoch", "Missed Epochs", "Header hash"}
+	if withRelayStatus {
+		header = append(header, "Relay Status", "Attempts", "Tx Hash", "Last Error")
 	}
+	tableData := pterm.TableData{header}
 
 	for i, settlement := range networkConfig.Settlements {
 		hash := "N/A"
@@ -236,7 +243,7 @@ func printSettlementData(
 			integrity = "Ok"
 		}
 
-		tableData = append(tableData, []string{
+		row := []string{
 			settlement.Address.String(),
 			strconv.FormatUint(settlement.ChainId, 10),
 			status,
@@ -244,9 +251,133 @@ func printSettlementData(
 			strconv.FormatUint(settlementData[i].LastCommittedHeaderEpoch, 10),
 			strconv.FormatUint(settlementData[i].MissedEpochs, 10),
 			hash,
-		})
+		}
+		if withRelayStatus {
+			row = append(row, relayStatusColumns(settlementData[i].RelayStatus)...)
+		}
+		tableData = append(tableData, row)
 	}
 
 	text, _ := pterm.DefaultTable.WithHasHeader().WithData(tableData).Srender()
 	return text
 }
+
+func relayStatusColumns(relayStatus *client.SettlementCommitStatus) []string {
+	if relayStatus == nil || relayStatus.GetStatus() == client.CommitStatus_COMMIT_STATUS_UNSPECIFIED {
+		return []string{"N/A", "N/A", "N/A", "N/A"}
+	}
+
+	txHash := relayStatus.GetTxHash()
+	if txHash == "" {
+		txHash = "N/A"
+	}
+	lastError := relayStatus.GetLastError()
+	if lastError == "" {
+		lastError = "N/A"
+	}
+
+	return []string{
+		strings.TrimPrefix(relayStatus.GetStatus().String(), "COMMIT_STATUS_"),
+		strconv.FormatUint(uint64(relayStatus.GetAttempts()), 10),
+		txHash,
+		lastError,
+	}
+}
+
+func printConfigDiff(changes []symbiotic.ConfigChange) string {
+	if len(changes) == 0 {
+		return "No changes"
+	}
+	tableData := pterm.TableData{
+		{"Field", "From", "To"},
+	}
+	for _, change := range changes {
+		if change.Added == nil && change.Removed == nil {
+			tableData = append(tableData, []string{change.Field, change.From, change.To})
+			continue
+		}
+		for _, removed := range change.Removed {
+			tableData = append(tableData, []string{change.Field, pterm.FgRed.Sprint("- " + removed), ""})
+		}
+		for _, added := range change.Added {
+			tableData = append(tableData, []string{change.Field, "", pterm.FgGreen.Sprint("+ " + added)})
+		}
+	}
+	text, _ := pterm.DefaultTable.WithHasHeader().WithData(tableData).Srender()
+	return text
+}
+
+func printValidatorSetDiff(diff symbiotic.ValidatorSetDiff) string {
+	summaryData := pterm.TableData{
+		{"", "Epoch " + strconv.FormatUint(uint64(diff.FromEpoch), 10), "Epoch " + strconv.FormatUint(uint64(diff.ToEpoch), 10)},
+		{"Active validators", strconv.FormatInt(diff.ActiveValidatorsFrom, 10), strconv.FormatInt(diff.ActiveValidatorsTo, 10)},
+		{"Total voting power", diff.TotalVotingPowerFrom.String(), diff.TotalVotingPowerTo.String()},
+		{"Quorum threshold", diff.QuorumThresholdFrom.String(), diff.QuorumThresholdTo.String()},
+	}
+	text, _ := pterm.DefaultTable.WithHasHeader().WithData(summaryData).Srender()
+	if len(diff.Validators) == 0 {
+		return text + "\nNo validator changes"
+	}
+
+	tableData := pterm.TableData{
+		{"Address", "Change", "Voting Power", "Changed Keys"},
+	}
+	for _, change := range diff.Validators {
+		var status, votingPower string
+		switch change.Type {
+		case symbiotic.ValidatorJoined:
+			status = pterm.FgGreen.Sprint(string(change.Type))
+			votingPower = change.VotingPowerTo.String()
+		case symbiotic.ValidatorLeft:
+			status = pterm.FgRed.Sprint(string(change.Type))
+			votingPower = change.VotingPowerFrom.String()
+		default:
+			status = pterm.FgYellow.Sprint(string(change.Type))
+			votingPower = change.VotingPowerFrom.String() + " → " + change.VotingPowerTo.String()
+		}
+		tableData = append(tableData, []string{
+			change.Operator.String(),
+			status,
+			votingPower,
+			strings.Join(lo.Map(change.ChangedKeyTags, func(item symbiotic.KeyTag, _ int) string {
+				return strconv.FormatUint(uint64(item), 10)
+			}), ", "),
+		})
+	}
+	validatorsText, _ := pterm.DefaultTable.WithHasHeader().WithData(tableData).Srender()
+	return text + "\n" + validatorsText
+}
+
+func printSimulationResult(result simulationResult) string {
+	text := fmt.Sprintf("Header hash: %s\n", result.HeaderHash.Hex())
+	text += fmt.Sprintf("Total voting power: %v\n", result.TotalVotingPower)
+	text += fmt.Sprintf("Header quorum threshold: %v\n", result.QuorumThreshold)
+	if result.Note != "" {
+		text += fmt.Sprintf("Note: %s\n", result.Note)
+	}
+
+	tableData := pterm.TableData{
+		{"Address", "Status", "Voting Power", "Roles"},
+	}
+	for _, validator := range result.Validators {
+		status := pterm.FgRed.Sprint("inactive")
+		if validator.IsActive {
+			status = pterm.FgGreen.Sprint("active")
+		}
+		var roles []string
+		if validator.Aggregator {
+			roles = append(roles, "aggregator")
+		}
+		if validator.Committer {
+			roles = append(roles, "committer")
+		}
+		tableData = append(tableData, []string{
+			validator.Operator.String(),
+			status,
+			validator.VotingPower.String(),
+			strings.Join(roles, ", "),
+		})
+	}
+	tableText, _ := pterm.DefaultTable.WithHasHeader().WithData(tableData).Srender()
+	return text + "\n" + tableText
+}
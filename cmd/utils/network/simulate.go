@@ -0,0 +1,324 @@
+package network
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	cmdhelpers "github.com/symbioticfi/relay/cmd/utils/cmd-helpers"
+	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
+	"github.com/symbioticfi/relay/internal/usecase/metrics"
+	"github.com/symbioticfi/relay/symbiotic/client/evm"
+	"github.com/symbioticfi/relay/symbiotic/client/votingpower"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	valsetDeriver "github.com/symbioticfi/relay/symbiotic/usecase/valset-deriver"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Simulate the validator set of an epoch with modified stake or config",
+	Long: `Loads the operator voting powers and keys of an epoch, applies the modifications and derives the validator set
+with the inclusion rules of the network config. Prints the resulting validators, quorum threshold, header hash and
+aggregator and committer roles together with the changes to the real validator set. Nothing is written on-chain.
+
+Added stake is attributed to a simulated vault, removed stake is taken from the largest vaults first.
+Added operators get placeholder keys for all required key tags, derived from their address so every run gives the
+same result. The header hash covers all validator keys and the aggregator and committer roles are picked from it,
+so they change once the added operators register their real keys.`,
+	Example: `  utils network simulate --chains http://localhost:8545 --driver.address 0x... --driver.chainid 1 \
+    --stake 0xOperatorA=+1000000 --stake 0xOperatorB=-500 --add-operator 0xOperatorC=2000000 \
+    --config num-committers=3,quorum-threshold=75`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		ctx := signalContext(cmd.Context())
+
+		kp, err := keyprovider.NewSimpleKeystoreProvider()
+		if err != nil {
+			return err
+		}
+
+		evmClient, err := evm.NewEvmClient(ctx, evm.Config{
+			ChainURLs: globalFlags.Chains,
+			DriverAddress: symbiotic.CrossChainAddress{
+				ChainId: globalFlags.DriverChainId,
+				Address: common.HexToAddress(globalFlags.DriverAddress),
+			},
+			RequestTimeout: 5 * time.Second,
+			KeyProvider:    kp,
+			Metrics:        metrics.New(metrics.Config{}),
+		})
+		if err != nil {
+			return err
+		}
+
+		providerConfigs, err := cmdhelpers.ExternalVotingPowerProviderConfigs(globalFlags.ExternalVotingPowerProviders)
+		if err != nil {
+			return err
+		}
+
+		var externalVPClient *votingpower.Client
+		if len(providerConfigs) > 0 {
+			externalVPClient, err = votingpower.NewClient(ctx, providerConfigs)
+			if err != nil {
+				return errors.Errorf("failed to create external voting power client: %w", err)
+			}
+			defer func() {
+				if err := externalVPClient.Close(); err != nil {
+					slog.WarnContext(ctx, "Failed to close external voting power client", "error", err)
+				}
+			}()
+		}
+
+		deriver, err := valsetDeriver.NewDeriver(evmClient, externalVPClient)
+		if err != nil {
+			return errors.Errorf("failed to create deriver: %w", err)
+		}
+
+		epoch := symbiotic.Epoch(globalFlags.Epoch)
+		if globalFlags.Epoch == 0 {
+			epoch, err = evmClient.GetCurrentEpoch(ctx)
+			if err != nil {
+				return errors.Errorf("failed to get current epoch: %w", err)
+			}
+		}
+
+		captureTimestamp, err := evmClient.GetEpochStart(ctx, epoch)
+		if err != nil {
+			return errors.Errorf("failed to get capture timestamp: %w", err)
+		}
+
+		networkConfig, err := evmClient.GetConfig(ctx, captureTimestamp, epoch)
+		if err != nil {
+			return errors.Errorf("failed to get config: %w", err)
+		}
+
+		inputs, err := deriver.GetValidatorSetInputs(ctx, epoch, networkConfig)
+		if err != nil {
+			return errors.Errorf("failed to get validator set inputs: %w", err)
+		}
+
+		valset, err := deriver.DeriveValidatorSet(ctx, epoch, networkConfig, inputs)
+		if err != nil {
+			return errors.Errorf("failed to derive validator set: %w", err)
+		}
+
+		simulatedConfig, err := applyConfigChanges(networkConfig, simulateFlags.Config)
+		if err != nil {
+			return err
+		}
+		simulatedInputs, err := applyStakeChanges(inputs.Clone(), simulatedConfig)
+		if err != nil {
+			return err
+		}
+
+		simulatedValset, err := deriver.DeriveValidatorSet(ctx, epoch, simulatedConfig, simulatedInputs)
+		if err != nil {
+			return errors.Errorf("failed to derive simulated validator set: %w", err)
+		}
+		header, err := simulatedValset.GetHeader()
+		if err != nil {
+			return errors.Errorf("failed to get simulated header: %w", err)
+		}
+		headerHash, err := header.Hash()
+		if err != nil {
+			return errors.Errorf("failed to hash simulated header: %w", err)
+		}
+
+		result := simulationResult{
+			Epoch:            epoch,
+			HeaderHash:       headerHash,
+			QuorumThreshold:  simulatedValset.QuorumThreshold,
+			TotalVotingPower: simulatedValset.GetTotalActiveVotingPower(),
+			Validators:       simulatedValidators(simulatedValset),
+			ConfigChanges:    symbiotic.DiffNetworkConfigs(networkConfig, simulatedConfig),
+			Changes:          symbiotic.DiffValidatorSets(valset, simulatedValset),
+		}
+		if len(simulateFlags.AddOperators) > 0 {
+			result.Note = "added operators use placeholder keys derived from their address, the header hash and roles change with their real keys"
+		}
+
+		if simulateFlags.Json {
+			data, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return errors.Errorf("failed to marshal simulation: %w", err)
+			}
+			pterm.Println(string(data))
+			return nil
+		}
+
+		pterm.DefaultSection.Println("Simulated validator set of epoch " + strconv.FormatUint(uint64(epoch), 10))
+		pterm.DefaultPanel.WithPanels(pterm.Panels{
+			{{Data: pterm.DefaultBox.WithTitle("Config changes").Sprint(printConfigDiff(result.ConfigChanges))}},
+			{{Data: pterm.DefaultBox.WithTitle("Changes to the real validator set").Sprint(printValidatorSetDiff(result.Changes))}},
+			{{Data: pterm.DefaultBox.WithTitle("Simulated validator set").Sprint(printSimulationResult(result))}},
+		}).Render()
+
+		return nil
+	},
+}
+
+// simulationResult is the JSON output of the simulate command
+type simulationResult struct {
+	Epoch            symbiotic.Epoch            `json:"epoch"`
+	HeaderHash       common.Hash                `json:"headerHash"`
+	QuorumThreshold  symbiotic.VotingPower      `json:"quorumThreshold"`
+	TotalVotingPower symbiotic.VotingPower      `json:"totalVotingPower"`
+	Validators       []simulatedValidator       `json:"validators"`
+	ConfigChanges    []symbiotic.ConfigChange   `json:"configChanges"`
+	Changes          symbiotic.ValidatorSetDiff `json:"changes"`
+	Note             string                     `json:"note,omitempty"`
+}
+
+type simulatedValidator struct {
+	Operator    common.Address        `json:"operator"`
+	VotingPower symbiotic.VotingPower `json:"votingPower"`
+	IsActive    bool                  `json:"isActive"`
+	Aggregator  bool                  `json:"aggregator"`
+	Committer   bool                  `json:"committer"`
+}
+
+func simulatedValidators(valset symbiotic.ValidatorSet) []simulatedValidator {
+	validators := make([]simulatedValidator, len(valset.Validators))
+	for i, validator := range valset.Validators {
+		validators[i] = simulatedValidator{
+			Operator:    validator.Operator,
+			VotingPower: validator.VotingPower,
+			IsActive:    validator.IsActive,
+		}
+	}
+	for _, index := range valset.AggregatorIndices {
+		validators[index].Aggregator = true
+	}
+	for _, index := range valset.CommitterIndices {
+		validators[index].Committer = true
+	}
+	return validators
+}
+
+// applyStakeChanges applies the operator and stake modifications of the flags to the inputs
+func applyStakeChanges(inputs valsetDeriver.ValidatorSetInputs, config symbiotic.NetworkConfig) (valsetDeriver.ValidatorSetInputs, error) {
+	for _, operator := range simulateFlags.RemoveOperators {
+		if !common.IsHexAddress(operator) {
+			return inputs, errors.Errorf("invalid --remove-operator address %q", operator)
+		}
+		if err := inputs.RemoveOperator(common.HexToAddress(operator)); err != nil {
+			return inputs, err
+		}
+	}
+
+	for _, entry := range simulateFlags.AddOperators {
+		operator, amount, err := parseOperatorAmount(entry)
+		if err != nil {
+			return inputs, errors.Errorf("invalid --add-operator %q: %w", entry, err)
+		}
+		if amount.Sign() < 0 {
+			return inputs, errors.Errorf("invalid --add-operator %q: voting power must not be negative", entry)
+		}
+		keys, err := valsetDeriver.PlaceholderKeys(operator, config.RequiredKeyTags)
+		if err != nil {
+			return inputs, err
+		}
+		if err := inputs.AddOperator(operator, amount, keys); err != nil {
+			return inputs, err
+		}
+	}
+
+	for _, entry := range simulateFlags.Stakes {
+		operator, amount, err := parseOperatorAmount(entry)
+		if err != nil {
+			return inputs, errors.Errorf("invalid --stake %q: %w", entry, err)
+		}
+		// an unsigned amount sets the voting power, a signed one changes it
+		value := entry[strings.Index(entry, "=")+1:]
+		if !strings.HasPrefix(value, "+") && !strings.HasPrefix(value, "-") {
+			current, _ := inputs.OperatorVotingPower(operator)
+			amount.Sub(amount, current)
+		}
+		if err := inputs.ChangeStake(operator, amount); err != nil {
+			return inputs, err
+		}
+	}
+
+	return inputs, nil
+}
+
+// parseOperatorAmount parses an 'operator=amount' entry, the amount may be signed
+func parseOperatorAmount(entry string) (common.Address, *big.Int, error) {
+	operator, value, ok := strings.Cut(entry, "=")
+	if !ok {
+		return common.Address{}, nil, errors.New("expected format 'operator=amount'")
+	}
+	if !common.IsHexAddress(operator) {
+		return common.Address{}, nil, errors.Errorf("invalid operator address %q", operator)
+	}
+	amount, ok := new(big.Int).SetString(strings.TrimPrefix(value, "+"), 10)
+	if !ok {
+		return common.Address{}, nil, errors.Errorf("invalid amount %q", value)
+	}
+	return common.HexToAddress(operator), amount, nil
+}
+
+// applyConfigChanges returns the network config with the --config overrides applied
+func applyConfigChanges(config symbiotic.NetworkConfig, changes map[string]string) (symbiotic.NetworkConfig, error) {
+	config.QuorumThresholds = append([]symbiotic.QuorumThreshold(nil), config.QuorumThresholds...)
+	for key, value := range changes {
+		var err error
+		switch key {
+		case "max-voting-power":
+			config.MaxVotingPower, err = parseVotingPower(value)
+		case "min-inclusion-voting-power":
+			config.MinInclusionVotingPower, err = parseVotingPower(value)
+		case "max-validators-count":
+			config.MaxValidatorsCount, err = parseVotingPower(value)
+		case "num-aggregators":
+			config.NumAggregators, err = strconv.ParseUint(value, 10, 64)
+		case "num-committers":
+			config.NumCommitters, err = strconv.ParseUint(value, 10, 64)
+		case "quorum-threshold":
+			err = setHeaderQuorumThreshold(&config, value)
+		default:
+			return config, errors.Errorf("unknown --config key %q", key)
+		}
+		if err != nil {
+			return config, errors.Errorf("invalid --config %s=%s: %v", key, value, err)
+		}
+	}
+	return config, nil
+}
+
+func parseVotingPower(value string) (symbiotic.VotingPower, error) {
+	votingPower, ok := new(big.Int).SetString(value, 10)
+	if !ok || votingPower.Sign() < 0 {
+		return symbiotic.VotingPower{}, errors.New("expected a non-negative integer")
+	}
+	return symbiotic.ToVotingPower(votingPower), nil
+}
+
+// setHeaderQuorumThreshold sets the quorum threshold of the header key tag from a percentage
+func setHeaderQuorumThreshold(config *symbiotic.NetworkConfig, value string) error {
+	pct, ok := new(big.Float).SetPrec(256).SetString(value)
+	if !ok || pct.Sign() <= 0 || pct.Cmp(big.NewFloat(100)) > 0 {
+		return errors.New("expected a percentage in (0, 100]")
+	}
+	// thresholds are scaled to 10^18 for 100%
+	threshold, _ := new(big.Float).Mul(pct, big.NewFloat(1e16)).Int(nil)
+	for i, quorumThreshold := range config.QuorumThresholds {
+		if quorumThreshold.KeyTag == config.RequiredHeaderKeyTag {
+			config.QuorumThresholds[i].QuorumThreshold = symbiotic.ToQuorumThresholdPct(threshold)
+			return nil
+		}
+	}
+	config.QuorumThresholds = append(config.QuorumThresholds, symbiotic.QuorumThreshold{
+		KeyTag:          config.RequiredHeaderKeyTag,
+		QuorumThreshold: symbiotic.ToQuorumThresholdPct(threshold),
+	})
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 func NewOperatorCmd() *cobra.Command {
 	operatorCmd.AddCommand(infoCmd)
 	operatorCmd.AddCommand(registerKeyCmd)
+	operatorCmd.AddCommand(rotateKeyCmd)
 	operatorCmd.AddCommand(invalidateOldSignaturesCmd)
 	operatorCmd.AddCommand(registerOperatorWithSignatureCmd)
 	operatorCmd.AddCommand(unregisterOperatorWithSignatureCmd)
@@ -32,6 +33,9 @@ func NewOperatorCmd() *cobra.Command {
 var operatorCmd = &cobra.Command{
 	Use:   "operator",
 	Short: "Operator tool",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return globalFlags.Export.Validate()
+	},
 }
 
 type GlobalFlags struct {
@@ -39,6 +43,8 @@ type GlobalFlags struct {
 	DriverAddress         string
 	DriverChainId         uint64
 	VotingProviderChainId uint64
+	DryRun                bool
+	Export                cmdhelpers.TxExportFlags
 }
 
 type InfoFlags struct {
@@ -56,6 +62,15 @@ type RegisterKeyFlags struct {
 	KeyTag   uint8
 }
 
+type RotateKeyFlags struct {
+	Secrets  cmdhelpers.SecretKeyMapFlag
+	Path     string
+	Password string
+	KeyTag   uint8
+	Force    bool
+	Finalize bool
+}
+
 type InvalidateOldSignaturesFlags struct {
 	Secrets cmdhelpers.SecretKeyMapFlag
 }
@@ -79,6 +94,7 @@ type UnregisterOperatorFlags struct {
 var globalFlags GlobalFlags
 var infoFlags InfoFlags
 var registerKeyFlags RegisterKeyFlags
+var rotateKeyFlags RotateKeyFlags
 var invalidateOldSignaturesFlags InvalidateOldSignaturesFlags
 var registerOperatorWithSignatureFlags RegisterOperatorWithSignatureFlags
 var unregisterOperatorWithSignatureFlags UnregisterOperatorWithSignatureFlags
@@ -90,6 +106,9 @@ func initFlags() {
 	operatorCmd.PersistentFlags().StringVar(&globalFlags.DriverAddress, "driver.address", "", "Driver contract address")
 	operatorCmd.PersistentFlags().Uint64Var(&globalFlags.DriverChainId, "driver.chainid", 0, "Driver contract chain id")
 	operatorCmd.PersistentFlags().Uint64Var(&globalFlags.VotingProviderChainId, "voting-provider-chain-id", 0, "Voting power provider chain id")
+	operatorCmd.PersistentFlags().BoolVar(&globalFlags.DryRun, "dry-run", false, "Simulate transactions and print the result and calldata without broadcasting them")
+	globalFlags.Export.AddFlags(operatorCmd.PersistentFlags())
+	operatorCmd.MarkFlagsMutuallyExclusive("dry-run", "export")
 	if err := operatorCmd.MarkPersistentFlagRequired("chains"); err != nil {
 		panic(err)
 	}
@@ -111,7 +130,7 @@ func initFlags() {
 		&infoFlags.ExternalVotingPowerProviders,
 		"external-voting-power-provider",
 		nil,
-		"External voting power provider config in format 'id=<id>,url=<url>[,secure=<bool>][,ca-cert-file=<path>][,server-name=<name>][,timeout=<duration>][,headers=<k:v|k2:v2>]'",
+		"External voting power provider config in format 'id=<id>,url=<url>[,secure=<bool>][,ca-cert-file=<path>][,server-name=<name>][,timeout=<duration>][,headers=<k:v|k2:v2>][,replicas=<url|url2>][,agreement=<first-healthy|majority|all-equal>]'",
 	)
 	if err := infoCmd.MarkPersistentFlagRequired("key-tag"); err != nil {
 		panic(err)
@@ -125,6 +144,16 @@ func initFlags() {
 		panic(err)
 	}
 
+	rotateKeyCmd.PersistentFlags().Var(&rotateKeyFlags.Secrets, "secret-keys", "Secret key for key register in format 'chainId:key' (e.g. '1:0xabc')")
+	rotateKeyCmd.PersistentFlags().StringVarP(&rotateKeyFlags.Path, "path", "p", "./keystore.jks", "Path to keystore")
+	rotateKeyCmd.PersistentFlags().StringVar(&rotateKeyFlags.Password, "password", "", "Keystore password")
+	rotateKeyCmd.PersistentFlags().Uint8Var(&rotateKeyFlags.KeyTag, "key-tag", uint8(symbiotic.KeyTypeInvalid), "key tag")
+	rotateKeyCmd.PersistentFlags().BoolVar(&rotateKeyFlags.Force, "force", false, "Replace the next key if a rotation is already in progress")
+	rotateKeyCmd.PersistentFlags().BoolVar(&rotateKeyFlags.Finalize, "finalize", false, "Replace the current key with the next key after the rotation")
+	if err := rotateKeyCmd.MarkPersistentFlagRequired("key-tag"); err != nil {
+		panic(err)
+	}
+
 	invalidateOldSignaturesCmd.PersistentFlags().Var(&invalidateOldSignaturesFlags.Secrets, "secret-keys", "Secret key for signing in format 'chainId:key' (e.g. '1:0xabc')")
 	registerOperatorWithSignatureCmd.PersistentFlags().Var(&registerOperatorWithSignatureFlags.Secrets, "secret-keys", "Secret key for signing in format 'chainId:key' (e.g. '1:0xabc')")
 	unregisterOperatorWithSignatureCmd.PersistentFlags().Var(&unregisterOperatorWithSignatureFlags.Secrets, "secret-keys", "Secret key for signing in format 'chainId:key' (e.g. '1:0xabc')")
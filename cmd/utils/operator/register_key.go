@@ -1,6 +1,7 @@
 package operator
 
 import (
+	"context"
 	"log/slog"
 	"strconv"
 	"time"
@@ -27,46 +28,7 @@ var registerKeyCmd = &cobra.Command{
 		var err error
 		ctx := signalContext(cmd.Context())
 
-		kp, err := keyprovider.NewSimpleKeystoreProvider()
-		if err != nil {
-			return err
-		}
-
-		evmClient, err := evm.NewEvmClient(ctx, evm.Config{
-			ChainURLs: globalFlags.Chains,
-			DriverAddress: symbiotic.CrossChainAddress{
-				ChainId: globalFlags.DriverChainId,
-				Address: common.HexToAddress(globalFlags.DriverAddress),
-			},
-			RequestTimeout: 5 * time.Second,
-			KeyProvider:    kp,
-			Metrics:        metrics.New(metrics.Config{}),
-		})
-		if err != nil {
-			return err
-		}
-
-		// TODO multiple chains key registration support
-		if len(evmClient.GetChains()) != 1 {
-			return errors.New("only single chain is supported")
-		}
-		chainId := evmClient.GetChains()[0]
-
-		privateKeyInput := pterm.DefaultInteractiveTextInput.WithMask("*")
-		secret, ok := registerKeyFlags.Secrets.Secrets[chainId]
-		if !ok {
-			secret, _ = privateKeyInput.Show("Enter private key for chain with ID: " + strconv.Itoa(int(chainId)))
-		}
-		evmPK, err := symbioticCrypto.NewPrivateKey(symbiotic.KeyTypeEcdsaSecp256k1, common.FromHex(secret))
-		if err != nil {
-			return err
-		}
-		err = kp.AddKeyByNamespaceTypeId(
-			keyprovider.EVM_KEY_NAMESPACE,
-			symbiotic.KeyTypeEcdsaSecp256k1,
-			int(chainId),
-			evmPK,
-		)
+		evmClient, operator, err := newOperatorEvmClient(ctx, registerKeyFlags.Secrets)
 		if err != nil {
 			return err
 		}
@@ -89,12 +51,6 @@ var registerKeyCmd = &cobra.Command{
 			return errors.Errorf("failed to get private key  for keyTag %v from keystore: %w", kt, err)
 		}
 
-		ecdsaPk, err := crypto.HexToECDSA(secret)
-		if err != nil {
-			return err
-		}
-		operator := crypto.PubkeyToAddress(ecdsaPk.PublicKey)
-
 		keyReg, err := key_registerer.NewRegisterer(key_registerer.Config{
 			EVMClient: evmClient,
 		})
@@ -104,12 +60,81 @@ var registerKeyCmd = &cobra.Command{
 
 		// Use the adjusted signature for registration
 		txResult, err := keyReg.Register(ctx, pk, kt, operator)
+		dryRun := cmdhelpers.PrintDryRun("register key", txResult)
 		if err != nil {
 			return errors.Errorf("failed to register key: %w", err)
 		}
+		if dryRun {
+			return nil
+		}
+		if txResult.Unsigned != nil {
+			return globalFlags.Export.Print(ctx, "register key", *txResult.Unsigned, evmClient)
+		}
 
 		slog.InfoContext(ctx, "Operator Key registered!", "txHash", txResult.TxHash.String(), "key-tag", kt)
 
 		return nil
 	},
 }
+
+// newOperatorEvmClient creates an evm client signing with the operator key, the key is prompted for if not passed in secrets.
+// When exporting transactions the operator is the --from address and no key is needed.
+func newOperatorEvmClient(ctx context.Context, secrets cmdhelpers.SecretKeyMapFlag) (*evm.Client, common.Address, error) {
+	kp, err := keyprovider.NewSimpleKeystoreProvider()
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	evmClient, err := evm.NewEvmClient(ctx, evm.Config{
+		ChainURLs: globalFlags.Chains,
+		DriverAddress: symbiotic.CrossChainAddress{
+			ChainId: globalFlags.DriverChainId,
+			Address: common.HexToAddress(globalFlags.DriverAddress),
+		},
+		RequestTimeout: 5 * time.Second,
+		KeyProvider:    kp,
+		Metrics:        metrics.New(metrics.Config{}),
+		DryRun:         globalFlags.DryRun,
+		UnsignedFrom:   globalFlags.Export.Sender(),
+	})
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	// TODO multiple chains key registration support
+	if len(evmClient.GetChains()) != 1 {
+		return nil, common.Address{}, errors.New("only single chain is supported")
+	}
+	chainId := evmClient.GetChains()[0]
+
+	// exported transactions are signed externally by the operator
+	if globalFlags.Export.Enabled() {
+		return evmClient, globalFlags.Export.Sender(), nil
+	}
+
+	privateKeyInput := pterm.DefaultInteractiveTextInput.WithMask("*")
+	secret, ok := secrets.Secrets[chainId]
+	if !ok {
+		secret, _ = privateKeyInput.Show("Enter private key for chain with ID: " + strconv.Itoa(int(chainId)))
+	}
+	evmPK, err := symbioticCrypto.NewPrivateKey(symbiotic.KeyTypeEcdsaSecp256k1, common.FromHex(secret))
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	err = kp.AddKeyByNamespaceTypeId(
+		keyprovider.EVM_KEY_NAMESPACE,
+		symbiotic.KeyTypeEcdsaSecp256k1,
+		int(chainId),
+		evmPK,
+	)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	ecdsaPk, err := crypto.HexToECDSA(secret)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return evmClient, crypto.PubkeyToAddress(ecdsaPk.PublicKey), nil
+}
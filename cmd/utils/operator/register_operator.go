@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"time"
 
+	cmdhelpers "github.com/symbioticfi/relay/cmd/utils/cmd-helpers"
 	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
 	"github.com/symbioticfi/relay/internal/usecase/metrics"
 	"github.com/symbioticfi/relay/symbiotic/client/evm"
@@ -36,6 +37,8 @@ var registerOperatorCmd = &cobra.Command{
 			RequestTimeout: 5 * time.Second,
 			KeyProvider:    kp,
 			Metrics:        metrics.New(metrics.Config{}),
+			DryRun:         globalFlags.DryRun,
+			UnsignedFrom:   globalFlags.Export.Sender(),
 		})
 		if err != nil {
 			return err
@@ -65,31 +68,40 @@ var registerOperatorCmd = &cobra.Command{
 			return err
 		}
 
-		// Load the operator key for the voting power provider's chain
-		privateKeyInput := pterm.DefaultInteractiveTextInput.WithMask("*")
-		secret, ok := registerOperatorFlags.Secrets.Secrets[votingPowerProvider.ChainId]
-		if !ok {
-			secret, _ = privateKeyInput.Show("Enter operator private key for chain with ID: " + strconv.Itoa(int(votingPowerProvider.ChainId)))
-		}
-
-		pk, err := symbioticCrypto.NewPrivateKey(symbiotic.KeyTypeEcdsaSecp256k1, common.FromHex(secret))
-		if err != nil {
-			return err
-		}
-		err = kp.AddKeyByNamespaceTypeId(
-			keyprovider.EVM_KEY_NAMESPACE,
-			symbiotic.KeyTypeEcdsaSecp256k1,
-			int(votingPowerProvider.ChainId),
-			pk,
-		)
-		if err != nil {
-			return err
+		if !globalFlags.Export.Enabled() {
+			// Load the operator key for the voting power provider's chain
+			privateKeyInput := pterm.DefaultInteractiveTextInput.WithMask("*")
+			secret, ok := registerOperatorFlags.Secrets.Secrets[votingPowerProvider.ChainId]
+			if !ok {
+				secret, _ = privateKeyInput.Show("Enter operator private key for chain with ID: " + strconv.Itoa(int(votingPowerProvider.ChainId)))
+			}
+
+			pk, err := symbioticCrypto.NewPrivateKey(symbiotic.KeyTypeEcdsaSecp256k1, common.FromHex(secret))
+			if err != nil {
+				return err
+			}
+			err = kp.AddKeyByNamespaceTypeId(
+				keyprovider.EVM_KEY_NAMESPACE,
+				symbiotic.KeyTypeEcdsaSecp256k1,
+				int(votingPowerProvider.ChainId),
+				pk,
+			)
+			if err != nil {
+				return err
+			}
 		}
 
 		txResult, err := evmClient.RegisterOperatorVotingPowerProvider(ctx, votingPowerProvider)
+		dryRun := cmdhelpers.PrintDryRun("register operator", txResult)
 		if err != nil {
 			return errors.Errorf("failed to register operator: %w", err)
 		}
+		if dryRun {
+			return nil
+		}
+		if txResult.Unsigned != nil {
+			return globalFlags.Export.Print(ctx, "register operator", *txResult.Unsigned, evmClient)
+		}
 
 		pterm.Success.Println("Operator registered! TxHash:", txResult.TxHash.String())
 
@@ -0,0 +1,120 @@
+package operator
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/spf13/cobra"
+
+	cmdhelpers "github.com/symbioticfi/relay/cmd/utils/cmd-helpers"
+	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
+	key_registerer "github.com/symbioticfi/relay/internal/usecase/key-registerer"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	symbioticCrypto "github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+var rotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Rotate operator key: generate a next key and register it in key registry",
+	Long: `Generates a new key for the key tag, stores it in the keystore as the next key and registers it in key registry.
+The relay keeps signing with the current key until a validator set captures the next key and switches over from that epoch on.
+A relay reading the same file with --keystore.path reloads it when it changes and picks up the next key by itself.
+Relays with keys from another source (--keystore.dir, --keystore.seed-path or --secret-keys) must be restarted
+with the next key before the activation epoch starts.
+Once requests of epochs before the activation are no longer needed, run the command with --finalize to replace the current key with the next one,
+the relay reloads the file again and signs with the promoted key as its current key.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		ctx := signalContext(cmd.Context())
+
+		if rotateKeyFlags.Password == "" {
+			rotateKeyFlags.Password, err = cmdhelpers.GetPassword()
+			if err != nil {
+				return err
+			}
+		}
+
+		keyStore, err := keyprovider.NewKeystoreProvider(rotateKeyFlags.Path, rotateKeyFlags.Password)
+		if err != nil {
+			return err
+		}
+
+		kt := symbiotic.KeyTag(rotateKeyFlags.KeyTag)
+		if rotateKeyFlags.Finalize {
+			if globalFlags.DryRun {
+				slog.InfoContext(ctx, "Dry run, the next key would become the current key", "key-tag", kt)
+				return nil
+			}
+			if err := keyStore.PromoteNextKey(kt, rotateKeyFlags.Password); err != nil {
+				return errors.Errorf("failed to promote next key for keyTag %v: %w", kt, err)
+			}
+			slog.InfoContext(ctx, "Next key is now the current key, a relay using this keystore file reloads it by itself", "key-tag", kt)
+			return nil
+		}
+
+		exists, err := keyStore.HasKey(kt)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.Errorf("no current key for keyTag %v, use register-key for the first registration", kt)
+		}
+
+		evmClient, operator, err := newOperatorEvmClient(ctx, rotateKeyFlags.Secrets)
+		if err != nil {
+			return err
+		}
+
+		nextKey, err := symbioticCrypto.GeneratePrivateKey(kt.Type())
+		if err != nil {
+			return errors.Errorf("failed to generate key: %w", err)
+		}
+		// store the key before registration so that it is not lost if the registration succeeds but the command fails later,
+		// a dry run doesn't register the key and discards it
+		if !globalFlags.DryRun {
+			if err := keyStore.AddNextKey(kt, nextKey, rotateKeyFlags.Password, rotateKeyFlags.Force); err != nil {
+				return errors.Errorf("failed to store next key for keyTag %v: %w", kt, err)
+			}
+		}
+
+		keyReg, err := key_registerer.NewRegisterer(key_registerer.Config{
+			EVMClient: evmClient,
+		})
+		if err != nil {
+			return errors.Errorf("failed to create registerer: %w", err)
+		}
+
+		txResult, err := keyReg.Register(ctx, nextKey, kt, operator)
+		dryRun := cmdhelpers.PrintDryRun("register next key", txResult)
+		if err != nil {
+			return errors.Errorf("failed to register next key: %w", err)
+		}
+		if dryRun {
+			return nil
+		}
+		if txResult.Unsigned != nil {
+			// the next key is stored, it is registered once the exported transaction is executed
+			return globalFlags.Export.Print(ctx, "register next key", *txResult.Unsigned, evmClient)
+		}
+
+		currentEpoch, err := evmClient.GetCurrentEpoch(ctx)
+		if err != nil {
+			return errors.Errorf("failed to get current epoch: %w", err)
+		}
+		activationEpoch := currentEpoch + 1
+		activationStart, err := evmClient.GetEpochStart(ctx, activationEpoch)
+		if err != nil {
+			return errors.Errorf("failed to get start of epoch %d: %w", activationEpoch, err)
+		}
+
+		slog.InfoContext(ctx, "Next operator key registered, a relay using this keystore file picks it up by itself, restart relays with other key sources before the activation epoch starts",
+			"txHash", txResult.TxHash.String(),
+			"key-tag", kt,
+			"activationEpoch", activationEpoch,
+			"activationStart", time.Unix(int64(activationStart), 0).UTC(),
+		)
+
+		return nil
+	},
+}
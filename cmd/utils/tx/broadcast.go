@@ -0,0 +1,102 @@
+package tx
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-errors/errors"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+var broadcastCmd = &cobra.Command{
+	Use:   "broadcast",
+	Short: "Broadcast an externally signed transaction",
+	Long: `Broadcasts a transaction exported with --export unsigned and signed externally, e.g. with a hardware wallet.
+The transaction is sent to the chain of --chains matching its chain id.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := signalContext(cmd.Context())
+
+		tx, err := readSignedTx(broadcastFlags.SignedTx)
+		if err != nil {
+			return err
+		}
+		signer := types.LatestSignerForChainID(tx.ChainId())
+		from, err := types.Sender(signer, tx)
+		if err != nil {
+			return errors.Errorf("failed to recover transaction sender, is the transaction signed?: %w", err)
+		}
+
+		client, err := dialChain(ctx, broadcastFlags.Chains, tx.ChainId().Uint64())
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		if err := client.SendTransaction(ctx, tx); err != nil {
+			return errors.Errorf("failed to send transaction: %w", err)
+		}
+		pterm.Info.Println("Transaction sent from", from.Hex(), "TxHash:", tx.Hash().Hex())
+		if broadcastFlags.NoWait {
+			return nil
+		}
+
+		receipt, err := bind.WaitMined(ctx, client, tx)
+		if err != nil {
+			return errors.Errorf("failed to wait for transaction: %w", err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return errors.Errorf("transaction %s reverted in block %d", tx.Hash().Hex(), receipt.BlockNumber)
+		}
+
+		pterm.Success.Println("Transaction mined in block", receipt.BlockNumber, "TxHash:", tx.Hash().Hex())
+		return nil
+	},
+}
+
+// readSignedTx decodes a signed raw transaction given as hex or as a file containing the hex
+func readSignedTx(input string) (*types.Transaction, error) {
+	raw := strings.TrimSpace(input)
+	if !strings.HasPrefix(raw, "0x") {
+		data, err := os.ReadFile(raw)
+		if err != nil {
+			return nil, errors.Errorf("signed transaction is neither 0x-prefixed hex nor a readable file: %w", err)
+		}
+		raw = strings.TrimSpace(string(data))
+	}
+
+	encoded, err := hexutil.Decode(raw)
+	if err != nil {
+		return nil, errors.Errorf("failed to decode signed transaction hex: %w", err)
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(encoded); err != nil {
+		return nil, errors.Errorf("failed to decode signed transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// dialChain connects to the rpc url of the chain with the given id
+func dialChain(ctx context.Context, urls []string, chainID uint64) (*ethclient.Client, error) {
+	for _, url := range urls {
+		client, err := ethclient.DialContext(ctx, url)
+		if err != nil {
+			return nil, errors.Errorf("failed to connect to %s: %w", url, err)
+		}
+		id, err := client.ChainID(ctx)
+		if err != nil {
+			client.Close()
+			return nil, errors.Errorf("failed to get chain id of %s: %w", url, err)
+		}
+		if id.Uint64() == chainID {
+			return client, nil
+		}
+		client.Close()
+	}
+	return nil, errors.Errorf("no rpc url for chain id %d in --chains", chainID)
+}
@@ -0,0 +1,60 @@
+package tx
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewTxCmd() *cobra.Command {
+	txCmd.AddCommand(broadcastCmd)
+
+	initFlags()
+
+	return txCmd
+}
+
+var txCmd = &cobra.Command{
+	Use:   "tx",
+	Short: "Transaction tool",
+}
+
+type BroadcastFlags struct {
+	Chains   []string
+	SignedTx string
+	NoWait   bool
+}
+
+var broadcastFlags BroadcastFlags
+
+func initFlags() {
+	broadcastCmd.PersistentFlags().StringSliceVarP(&broadcastFlags.Chains, "chains", "c", nil, "Chains rpc url, comma separated")
+	broadcastCmd.PersistentFlags().StringVar(&broadcastFlags.SignedTx, "signed-tx", "", "Signed raw transaction as hex or path to a file containing it")
+	broadcastCmd.PersistentFlags().BoolVar(&broadcastFlags.NoWait, "no-wait", false, "Don't wait for the transaction to be mined")
+	if err := broadcastCmd.MarkPersistentFlagRequired("chains"); err != nil {
+		panic(err)
+	}
+	if err := broadcastCmd.MarkPersistentFlagRequired("signed-tx"); err != nil {
+		panic(err)
+	}
+}
+
+// signalContext returns a context that is canceled if either SIGTERM or SIGINT signal is received.
+func signalContext(ctx context.Context) context.Context {
+	cnCtx, cancel := context.WithCancel(ctx)
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGTERM, syscall.SIGINT)
+
+	go func() {
+		<-c
+		pterm.Warning.Println("Received termination signal, shutting down...")
+		cancel()
+	}()
+
+	return cnCtx
+}
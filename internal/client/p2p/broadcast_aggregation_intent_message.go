@@ -0,0 +1,28 @@
+package p2p
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"google.golang.org/protobuf/proto"
+
+	prototypes "github.com/symbioticfi/relay/internal/client/p2p/proto/v1"
+	"github.com/symbioticfi/relay/internal/entity"
+)
+
+func (s *Service) BroadcastAggregationIntentMessage(ctx context.Context, msg entity.AggregationIntent) error {
+	dto := prototypes.AggregationIntent{
+		RequestId: msg.RequestID.Bytes(),
+		Epoch:     uint64(msg.Epoch),
+		Timestamp: uint64(msg.Timestamp),
+		PublicKey: msg.PublicKey,
+		Signature: msg.Signature,
+	}
+
+	data, err := proto.Marshal(&dto)
+	if err != nil {
+		return errors.Errorf("failed to marshal aggregation intent message: %w", err)
+	}
+
+	return s.broadcast(ctx, topicAggIntent, data)
+}
@@ -0,0 +1,33 @@
+package p2p
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"google.golang.org/protobuf/proto"
+
+	prototypes "github.com/symbioticfi/relay/internal/client/p2p/proto/v1"
+	"github.com/symbioticfi/relay/internal/entity"
+)
+
+func (s *Service) BroadcastCommitIntentMessage(ctx context.Context, msg entity.CommitIntent) error {
+	dto := prototypes.CommitIntent{
+		Epoch:             uint64(msg.Epoch),
+		SettlementChainId: msg.Settlement.ChainId,
+		SettlementAddress: msg.Settlement.Address.Bytes(),
+		Timestamp:         uint64(msg.Timestamp),
+		PublicKey:         msg.PublicKey,
+		Signature:         msg.Signature,
+	}
+	if msg.TxHash != (common.Hash{}) {
+		dto.TxHash = msg.TxHash.Bytes()
+	}
+
+	data, err := proto.Marshal(&dto)
+	if err != nil {
+		return errors.Errorf("failed to marshal commit intent message: %w", err)
+	}
+
+	return s.broadcast(ctx, topicCommitIntent, data)
+}
@@ -18,6 +18,15 @@ func (s *Service) BroadcastSignatureGeneratedMessage(ctx context.Context, msg sy
 		PublicKey:   msg.PublicKey.Raw(),
 		Signature:   msg.Signature,
 	}
+	if msg.HeaderCommitment != nil {
+		dto.HeaderCommitment = &prototypes.HeaderCommitment{
+			DomainSeparator: msg.HeaderCommitment.DomainSeparator.Bytes(),
+			Subnetwork:      msg.HeaderCommitment.Subnetwork.Bytes(),
+			Epoch:           uint64(msg.HeaderCommitment.Epoch),
+			HeaderHash:      msg.HeaderCommitment.HeaderHash.Bytes(),
+			ExtraDataHash:   msg.HeaderCommitment.ExtraDataHash.Bytes(),
+		}
+	}
 
 	data, err := proto.Marshal(&dto)
 	if err != nil {
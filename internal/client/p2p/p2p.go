@@ -34,12 +34,24 @@ const (
 
 	topicSignatureReady = topicPrefix + "/signature/ready"
 	topicAggProofReady  = topicPrefix + "/proof/ready"
+	topicCommitIntent   = topicPrefix + "/commit/intent"
+	topicAggIntent      = topicPrefix + "/proof/intent"
 
 	maxP2PMessageSize = 1<<20 + 1024 // 1 MiB + 1 KiB for overhead
 	maxPubKeySize     = 144          // BLS12381 pubkey is 144 bytes
 	maxSignatureSize  = 96
 	maxMsgHashSize    = 64
 	maxProofSize      = 1 << 20
+	maxTxHashSize     = 32
+	maxAddressSize    = 20
+)
+
+// Gossip topics, exported for fault injection in tests.
+const (
+	TopicSignatureReady = topicSignatureReady
+	TopicAggProofReady  = topicAggProofReady
+	TopicCommitIntent   = topicCommitIntent
+	TopicAggIntent      = topicAggIntent
 )
 
 type metrics interface {
@@ -98,6 +110,9 @@ type Config struct {
 	Discovery       DiscoveryConfig `validate:"required"`
 	EventTracer     pubsub.EventTracer
 	Handler         prototypes.SymbioticP2PServiceServer `validate:"required"`
+	// Interceptor, if set, is called for every received gossip message and returns how many times
+	// the message is handled, zero drops it. It is used to inject gossip faults in tests.
+	Interceptor func(topic string, msg *pubsub.Message) int
 }
 
 func (c Config) Validate() error {
@@ -114,9 +129,12 @@ type Service struct {
 	host                        host.Host
 	signatureReceivedHandler    *signals.Signal[p2pEntity.P2PMessage[symbiotic.Signature]]
 	signaturesAggregatedHandler *signals.Signal[p2pEntity.P2PMessage[symbiotic.AggregationProof]]
+	commitIntentHandler         *signals.Signal[p2pEntity.P2PMessage[p2pEntity.CommitIntent]]
+	aggIntentHandler            *signals.Signal[p2pEntity.P2PMessage[p2pEntity.AggregationIntent]]
 	metrics                     metrics
 	topicsMap                   map[string]*pubsub.Topic
 	p2pGRPCHandler              prototypes.SymbioticP2PServiceServer
+	interceptor                 func(topic string, msg *pubsub.Message) int
 }
 
 // NewService creates a new P2P service with the given configuration
@@ -163,22 +181,47 @@ func NewService(ctx context.Context, cfg Config, signalCfg signals.Config) (*Ser
 		return nil, errors.Errorf("failed to subscribe to agg proof ready topic: %w", err)
 	}
 
+	commitIntentTopic, err := ps.Join(topicCommitIntent)
+	if err != nil {
+		return nil, errors.Errorf("failed to join commit intent topic: %w", err)
+	}
+	commitIntentSub, err := commitIntentTopic.Subscribe()
+	if err != nil {
+		return nil, errors.Errorf("failed to subscribe to commit intent topic: %w", err)
+	}
+
+	aggIntentTopic, err := ps.Join(topicAggIntent)
+	if err != nil {
+		return nil, errors.Errorf("failed to join aggregation intent topic: %w", err)
+	}
+	aggIntentSub, err := aggIntentTopic.Subscribe()
+	if err != nil {
+		return nil, errors.Errorf("failed to subscribe to aggregation intent topic: %w", err)
+	}
+
 	service := &Service{
 		ctx:                         log.WithAttrs(ctx, slog.String("component", "p2p")),
 		host:                        h,
 		signatureReceivedHandler:    signals.New[p2pEntity.P2PMessage[symbiotic.Signature]](signalCfg, "signatureReceive", nil),
 		signaturesAggregatedHandler: signals.New[p2pEntity.P2PMessage[symbiotic.AggregationProof]](signalCfg, "signaturesAggregated", nil),
+		commitIntentHandler:         signals.New[p2pEntity.P2PMessage[p2pEntity.CommitIntent]](signalCfg, "commitIntent", nil),
+		aggIntentHandler:            signals.New[p2pEntity.P2PMessage[p2pEntity.AggregationIntent]](signalCfg, "aggregationIntent", nil),
 		metrics:                     cfg.Metrics,
 
 		topicsMap: map[string]*pubsub.Topic{
 			topicSignatureReady: signatureReadyTopic,
 			topicAggProofReady:  proofReadyTopic,
+			topicCommitIntent:   commitIntentTopic,
+			topicAggIntent:      aggIntentTopic,
 		},
 		p2pGRPCHandler: cfg.Handler,
+		interceptor:    cfg.Interceptor,
 	}
 
 	go service.listenForMessages(ctx, signatureReadySub, signatureReadyTopic, service.handleSignatureReadyMessage)
 	go service.listenForMessages(ctx, proofReadySub, proofReadyTopic, service.handleAggregatedProofReadyMessage)
+	go service.listenForMessages(ctx, commitIntentSub, commitIntentTopic, service.handleCommitIntentMessage)
+	go service.listenForMessages(ctx, aggIntentSub, aggIntentTopic, service.handleAggregationIntentMessage)
 
 	h.Network().Notify(service)
 
@@ -207,9 +250,15 @@ func (s *Service) listenForMessages(ctx context.Context, sub *pubsub.Subscriptio
 		}
 
 		slog.DebugContext(ctx, "Received message from p2p", "topic", msg.Topic, "from", msg.ReceivedFrom)
-		if err := handler(msg); err != nil {
-			slog.ErrorContext(ctx, "Failed to handle message", "error", err, "message", msg)
-			continue
+		deliveries := 1
+		if s.interceptor != nil {
+			deliveries = s.interceptor(msg.GetTopic(), msg)
+		}
+		for range deliveries {
+			if err := handler(msg); err != nil {
+				slog.ErrorContext(ctx, "Failed to handle message", "error", err, "message", msg)
+				break
+			}
 		}
 	}
 }
@@ -228,6 +277,20 @@ func (s *Service) StartSignaturesAggregatedMessageListener(mh func(ctx context.C
 	return s.signaturesAggregatedHandler.StartWorkers(s.ctx)
 }
 
+func (s *Service) StartCommitIntentMessageListener(mh func(ctx context.Context, msg p2pEntity.P2PMessage[p2pEntity.CommitIntent]) error) error {
+	if err := s.commitIntentHandler.SetHandlers(mh); err != nil {
+		return errors.Errorf("failed to set commit intent message handler: %w", err)
+	}
+	return s.commitIntentHandler.StartWorkers(s.ctx)
+}
+
+func (s *Service) StartAggregationIntentMessageListener(mh func(ctx context.Context, msg p2pEntity.P2PMessage[p2pEntity.AggregationIntent]) error) error {
+	if err := s.aggIntentHandler.SetHandlers(mh); err != nil {
+		return errors.Errorf("failed to set aggregation intent message handler: %w", err)
+	}
+	return s.aggIntentHandler.StartWorkers(s.ctx)
+}
+
 func (s *Service) addPeer(pi peer.AddrInfo) error {
 	if pi.ID == s.host.ID() {
 		slog.InfoContext(s.ctx, "Skipping self-connection", "peer", pi.ID)
@@ -1,6 +1,7 @@
 package p2p
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-errors/errors"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"google.golang.org/protobuf/proto"
@@ -41,6 +42,13 @@ func (s *Service) handleSignatureReadyMessage(pubSubMsg *pubsub.Message) error {
 		Signature:   signature.GetSignature(),
 		MessageHash: signature.GetMessageHash(),
 	}
+	if signature.GetHeaderCommitment() != nil {
+		commitment, err := headerCommitmentFromProto(signature.GetHeaderCommitment())
+		if err != nil {
+			return errors.Errorf("failed to parse header commitment: %w", err)
+		}
+		msg.HeaderCommitment = &commitment
+	}
 
 	si, err := extractSenderInfo(pubSubMsg)
 	if err != nil {
@@ -54,6 +62,23 @@ func (s *Service) handleSignatureReadyMessage(pubSubMsg *pubsub.Message) error {
 	})
 }
 
+func headerCommitmentFromProto(commitment *prototypes.HeaderCommitment) (symbiotic.ValSetHeaderCommitment, error) {
+	hashes := [][]byte{commitment.GetDomainSeparator(), commitment.GetSubnetwork(), commitment.GetHeaderHash(), commitment.GetExtraDataHash()}
+	for _, hash := range hashes {
+		if len(hash) != common.HashLength {
+			return symbiotic.ValSetHeaderCommitment{}, errors.Errorf("invalid header commitment hash %x: expected %d bytes", hash, common.HashLength)
+		}
+	}
+
+	return symbiotic.ValSetHeaderCommitment{
+		DomainSeparator: common.BytesToHash(commitment.GetDomainSeparator()),
+		Subnetwork:      common.BytesToHash(commitment.GetSubnetwork()),
+		Epoch:           symbiotic.Epoch(commitment.GetEpoch()),
+		HeaderHash:      common.BytesToHash(commitment.GetHeaderHash()),
+		ExtraDataHash:   common.BytesToHash(commitment.GetExtraDataHash()),
+	}, nil
+}
+
 func (s *Service) handleAggregatedProofReadyMessage(pubSubMsg *pubsub.Message) error {
 	var signaturesAggregated prototypes.AggregationProof
 	p2pMsg, err := unmarshalMessage(pubSubMsg, &signaturesAggregated)
@@ -88,6 +113,89 @@ func (s *Service) handleAggregatedProofReadyMessage(pubSubMsg *pubsub.Message) e
 	})
 }
 
+func (s *Service) handleCommitIntentMessage(pubSubMsg *pubsub.Message) error {
+	var intent prototypes.CommitIntent
+	p2pMsg, err := unmarshalMessage(pubSubMsg, &intent)
+	if err != nil {
+		return errors.Errorf("failed to unmarshal commit intent message: %w", err)
+	}
+
+	// Validate the commit intent message
+	if len(intent.GetSettlementAddress()) != maxAddressSize {
+		return errors.Errorf("commit intent settlement address %x must be %d bytes", intent.GetSettlementAddress(), maxAddressSize)
+	}
+	if len(intent.GetTxHash()) != 0 && len(intent.GetTxHash()) != maxTxHashSize {
+		return errors.Errorf("commit intent tx hash %x must be empty or %d bytes", intent.GetTxHash(), maxTxHashSize)
+	}
+	if len(intent.GetPublicKey()) > maxPubKeySize {
+		return errors.Errorf("public key %x size exceeds maximum allowed size: %d bytes", intent.GetPublicKey(), maxPubKeySize)
+	}
+	if len(intent.GetSignature()) > maxSignatureSize {
+		return errors.Errorf("signature %x size exceeds maximum allowed size: %d bytes", intent.GetSignature(), maxSignatureSize)
+	}
+
+	msg := p2pEntity.CommitIntent{
+		Epoch: symbiotic.Epoch(intent.GetEpoch()),
+		Settlement: symbiotic.CrossChainAddress{
+			ChainId: intent.GetSettlementChainId(),
+			Address: common.BytesToAddress(intent.GetSettlementAddress()),
+		},
+		TxHash:    common.BytesToHash(intent.GetTxHash()),
+		Timestamp: symbiotic.Timestamp(intent.GetTimestamp()),
+		PublicKey: intent.GetPublicKey(),
+		Signature: intent.GetSignature(),
+	}
+
+	si, err := extractSenderInfo(pubSubMsg)
+	if err != nil {
+		return errors.Errorf("failed to extract sender info from received message: %w", err)
+	}
+
+	return s.commitIntentHandler.Emit(p2pEntity.P2PMessage[p2pEntity.CommitIntent]{
+		SenderInfo:   si,
+		Message:      msg,
+		TraceContext: p2pMsg.GetTraceContext(),
+	})
+}
+
+func (s *Service) handleAggregationIntentMessage(pubSubMsg *pubsub.Message) error {
+	var intent prototypes.AggregationIntent
+	p2pMsg, err := unmarshalMessage(pubSubMsg, &intent)
+	if err != nil {
+		return errors.Errorf("failed to unmarshal aggregation intent message: %w", err)
+	}
+
+	// Validate the aggregation intent message
+	if len(intent.GetRequestId()) != common.HashLength {
+		return errors.Errorf("aggregation intent request id %x must be %d bytes", intent.GetRequestId(), common.HashLength)
+	}
+	if len(intent.GetPublicKey()) > maxPubKeySize {
+		return errors.Errorf("public key %x size exceeds maximum allowed size: %d bytes", intent.GetPublicKey(), maxPubKeySize)
+	}
+	if len(intent.GetSignature()) > maxSignatureSize {
+		return errors.Errorf("signature %x size exceeds maximum allowed size: %d bytes", intent.GetSignature(), maxSignatureSize)
+	}
+
+	msg := p2pEntity.AggregationIntent{
+		RequestID: common.BytesToHash(intent.GetRequestId()),
+		Epoch:     symbiotic.Epoch(intent.GetEpoch()),
+		Timestamp: symbiotic.Timestamp(intent.GetTimestamp()),
+		PublicKey: intent.GetPublicKey(),
+		Signature: intent.GetSignature(),
+	}
+
+	si, err := extractSenderInfo(pubSubMsg)
+	if err != nil {
+		return errors.Errorf("failed to extract sender info from received message: %w", err)
+	}
+
+	return s.aggIntentHandler.Emit(p2pEntity.P2PMessage[p2pEntity.AggregationIntent]{
+		SenderInfo:   si,
+		Message:      msg,
+		TraceContext: p2pMsg.GetTraceContext(),
+	})
+}
+
 func extractSenderInfo(pubSubMsg *pubsub.Message) (p2pEntity.SenderInfo, error) {
 	// try to extract public key from sender peer.ID
 	pubKey, err := pubSubMsg.ReceivedFrom.ExtractPublicKey()
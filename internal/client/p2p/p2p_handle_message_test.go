@@ -2,9 +2,11 @@ package p2p
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/libp2p/go-libp2p"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
@@ -65,6 +67,13 @@ func TestService_IntegrationSuccessful(t *testing.T) {
 		MessageHash: symbiotic.RawMessageHash("test message hash"),
 		Signature:   symbiotic.RawSignature("test signature"),
 		PublicKey:   priv.PublicKey(),
+		HeaderCommitment: &symbiotic.ValSetHeaderCommitment{
+			DomainSeparator: common.HexToHash("0x01"),
+			Subnetwork:      common.HexToHash("0x02"),
+			Epoch:           124,
+			HeaderHash:      common.HexToHash("0x03"),
+			ExtraDataHash:   common.HexToHash("0x04"),
+		},
 	}
 
 	// Send the message from service1
@@ -81,6 +90,7 @@ func TestService_IntegrationSuccessful(t *testing.T) {
 		assert.Equal(t, testSignatureMsg.MessageHash, receivedMsg.Message.MessageHash)
 		assert.Equal(t, testSignatureMsg.Signature, receivedMsg.Message.Signature)
 		assert.Equal(t, testSignatureMsg.PublicKey, receivedMsg.Message.PublicKey)
+		assert.Equal(t, testSignatureMsg.HeaderCommitment, receivedMsg.Message.HeaderCommitment)
 	case <-ctx.Done():
 		require.Fail(t, "Test timed out waiting for message")
 	}
@@ -129,7 +139,7 @@ func TestService_IntegrationFailedSignature(t *testing.T) {
 	}
 }
 
-func createTestService(t *testing.T, skipMessageSigning bool, tracer pubsub.EventTracer) *Service {
+func createTestService(t *testing.T, skipMessageSigning bool, tracer pubsub.EventTracer, opts ...func(cfg *Config)) *Service {
 	t.Helper()
 
 	p2pIdentityPKRaw, err := symbioticCrypto.GeneratePrivateKey(symbiotic.KeyTypeEcdsaSecp256k1)
@@ -151,14 +161,19 @@ func createTestService(t *testing.T, skipMessageSigning bool, tracer pubsub.Even
 		assert.NoError(t, h.Close())
 	})
 
-	service, err := NewService(t.Context(), Config{
+	cfg := Config{
 		Host:            h,
 		SkipMessageSign: skipMessageSigning,
 		Metrics:         &mockMetrics{},
 		Discovery:       DefaultDiscoveryConfig(),
 		EventTracer:     tracer,
 		Handler:         myHandler{},
-	}, signals.Config{
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	service, err := NewService(t.Context(), cfg, signals.Config{
 		BufferSize:  5,
 		WorkerCount: 1,
 	})
@@ -246,3 +261,159 @@ func TestService_AggregatedProofIntegrationSuccessful(t *testing.T) {
 		require.Fail(t, "Test timed out waiting for aggregated proof message")
 	}
 }
+
+func TestService_CommitIntentIntegrationSuccessful(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	service1 := createTestService(t, false, nil)
+	service2 := createTestService(t, false, nil)
+
+	host1Addr := host.InfoFromHost(service1.host)
+	err := service2.addPeer(*host1Addr)
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return len(service1.host.Peerstore().Peers()) > 0 && len(service2.host.Peerstore().Peers()) > 0
+	}, time.Second, time.Millisecond*100)
+
+	var receivedMsg p2pEntity.P2PMessage[p2pEntity.CommitIntent]
+
+	done := make(chan struct{})
+	require.NoError(t, service2.StartCommitIntentMessageListener(func(ctx context.Context, msg p2pEntity.P2PMessage[p2pEntity.CommitIntent]) error {
+		receivedMsg = msg
+		close(done)
+		return nil
+	}))
+
+	testIntent := p2pEntity.CommitIntent{
+		Epoch: symbiotic.Epoch(789),
+		Settlement: symbiotic.CrossChainAddress{
+			ChainId: 31337,
+			Address: common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		},
+		TxHash:    common.HexToHash("0xabcdef"),
+		Timestamp: symbiotic.Timestamp(1700000000),
+		PublicKey: symbiotic.RawPublicKey("test public key"),
+		Signature: symbiotic.RawSignature("test signature"),
+	}
+
+	err = service1.BroadcastCommitIntentMessage(ctx, testIntent)
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+		assert.Equal(t, service1.host.ID().String(), receivedMsg.SenderInfo.Sender)
+		assert.Equal(t, testIntent, receivedMsg.Message)
+	case <-ctx.Done():
+		require.Fail(t, "Test timed out waiting for commit intent message")
+	}
+}
+
+func TestService_AggregationIntentIntegrationSuccessful(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	service1 := createTestService(t, false, nil)
+	service2 := createTestService(t, false, nil)
+
+	host1Addr := host.InfoFromHost(service1.host)
+	err := service2.addPeer(*host1Addr)
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return len(service1.host.Peerstore().Peers()) > 0 && len(service2.host.Peerstore().Peers()) > 0
+	}, time.Second, time.Millisecond*100)
+
+	var receivedMsg p2pEntity.P2PMessage[p2pEntity.AggregationIntent]
+
+	done := make(chan struct{})
+	require.NoError(t, service2.StartAggregationIntentMessageListener(func(ctx context.Context, msg p2pEntity.P2PMessage[p2pEntity.AggregationIntent]) error {
+		receivedMsg = msg
+		close(done)
+		return nil
+	}))
+
+	testIntent := p2pEntity.AggregationIntent{
+		RequestID: common.HexToHash("0xabcdef"),
+		Epoch:     symbiotic.Epoch(789),
+		Timestamp: symbiotic.Timestamp(1700000000),
+		PublicKey: symbiotic.RawPublicKey("test public key"),
+		Signature: symbiotic.RawSignature("test signature"),
+	}
+
+	err = service1.BroadcastAggregationIntentMessage(ctx, testIntent)
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+		assert.Equal(t, service1.host.ID().String(), receivedMsg.SenderInfo.Sender)
+		assert.Equal(t, testIntent, receivedMsg.Message)
+	case <-ctx.Done():
+		require.Fail(t, "Test timed out waiting for aggregation intent message")
+	}
+}
+
+func TestService_InterceptorDropsAndDuplicatesMessages(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	var intercepted atomic.Int32
+	service1 := createTestService(t, false, nil)
+	service2 := createTestService(t, false, nil, func(cfg *Config) {
+		cfg.Interceptor = func(topic string, msg *pubsub.Message) int {
+			assert.Equal(t, topicAggProofReady, topic)
+			// the first message is dropped, the next ones are handled twice
+			if intercepted.Add(1) == 1 {
+				return 0
+			}
+			return 2
+		}
+	})
+
+	host1Addr := host.InfoFromHost(service1.host)
+	err := service2.addPeer(*host1Addr)
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return len(service1.host.Peerstore().Peers()) > 0 && len(service2.host.Peerstore().Peers()) > 0
+	}, time.Second, time.Millisecond*100)
+
+	received := make(chan symbiotic.Epoch, 4)
+	require.NoError(t, service2.StartSignaturesAggregatedMessageListener(func(ctx context.Context, msg p2pEntity.P2PMessage[symbiotic.AggregationProof]) error {
+		received <- msg.Message.Epoch
+		return nil
+	}))
+
+	for _, epoch := range []symbiotic.Epoch{1, 2} {
+		require.NoError(t, service1.BroadcastSignatureAggregatedMessage(ctx, symbiotic.AggregationProof{
+			KeyTag:      symbiotic.KeyTag(1),
+			Epoch:       epoch,
+			MessageHash: symbiotic.RawMessageHash("test aggregation proof hash"),
+			Proof:       symbiotic.RawProof("test aggregation proof data"),
+		}))
+		require.Eventually(t, func() bool {
+			return intercepted.Load() == int32(epoch)
+		}, 2*time.Second, 10*time.Millisecond)
+	}
+
+	for range 2 {
+		select {
+		case epoch := <-received:
+			assert.Equal(t, symbiotic.Epoch(2), epoch)
+		case <-ctx.Done():
+			require.Fail(t, "Test timed out waiting for duplicated message")
+		}
+	}
+	select {
+	case epoch := <-received:
+		require.Failf(t, "unexpected message", "epoch %d", epoch)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
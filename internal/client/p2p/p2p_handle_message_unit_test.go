@@ -229,6 +229,82 @@ func TestHandleAggregatedProofReadyMessage_WithOversizedProof_ReturnsError(t *te
 	assert.Contains(t, err.Error(), fmt.Sprintf("aggregation proof %x size exceeds maximum", oversizedProof))
 }
 
+func TestHandleCommitIntentMessage_WithInvalidFields_ReturnsError(t *testing.T) {
+	service := createTestService(t, false, nil)
+
+	validAddress := make([]byte, maxAddressSize)
+	tests := []struct {
+		name        string
+		intent      *prototypes.CommitIntent
+		expectedErr string
+	}{
+		{
+			name:        "invalid settlement address",
+			intent:      &prototypes.CommitIntent{SettlementAddress: []byte("short")},
+			expectedErr: "settlement address",
+		},
+		{
+			name:        "invalid tx hash",
+			intent:      &prototypes.CommitIntent{SettlementAddress: validAddress, TxHash: []byte("short")},
+			expectedErr: "tx hash",
+		},
+		{
+			name:        "oversized public key",
+			intent:      &prototypes.CommitIntent{SettlementAddress: validAddress, PublicKey: make([]byte, maxPubKeySize+1)},
+			expectedErr: "size exceeds maximum",
+		},
+		{
+			name:        "oversized signature",
+			intent:      &prototypes.CommitIntent{SettlementAddress: validAddress, Signature: make([]byte, maxSignatureSize+1)},
+			expectedErr: "size exceeds maximum",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			intentData, err := proto.Marshal(tt.intent)
+			require.NoError(t, err)
+
+			p2pMsgData, err := proto.Marshal(&prototypes.P2PMessage{Data: intentData})
+			require.NoError(t, err)
+
+			pubSubMsg := &pubsub.Message{
+				Message: &pubsub_pb.Message{
+					Data: p2pMsgData,
+					From: []byte(service.host.ID()),
+				},
+				ReceivedFrom: service.host.ID(),
+			}
+
+			err = service.handleCommitIntentMessage(pubSubMsg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestHandleAggregationIntentMessage_WithInvalidRequestID_ReturnsError(t *testing.T) {
+	service := createTestService(t, false, nil)
+
+	intentData, err := proto.Marshal(&prototypes.AggregationIntent{RequestId: []byte("short")})
+	require.NoError(t, err)
+
+	p2pMsgData, err := proto.Marshal(&prototypes.P2PMessage{Data: intentData})
+	require.NoError(t, err)
+
+	pubSubMsg := &pubsub.Message{
+		Message: &pubsub_pb.Message{
+			Data: p2pMsgData,
+			From: []byte(service.host.ID()),
+		},
+		ReceivedFrom: service.host.ID(),
+	}
+
+	err = service.handleAggregationIntentMessage(pubSubMsg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "request id")
+}
+
 func TestUnmarshalMessage_WithInvalidP2PMessage_ReturnsError(t *testing.T) {
 	invalidData := []byte("invalid protobuf data")
 
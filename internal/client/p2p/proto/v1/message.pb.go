@@ -90,6 +90,176 @@ func (x *AggregationProof) GetProof() []byte {
 	return nil
 }
 
+// CommitIntent announces a validator set header commit to a settlement
+type CommitIntent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Epoch             uint64                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	SettlementChainId uint64                 `protobuf:"varint,2,opt,name=settlement_chain_id,json=settlementChainId,proto3" json:"settlement_chain_id,omitempty"`
+	SettlementAddress []byte                 `protobuf:"bytes,3,opt,name=settlement_address,json=settlementAddress,proto3" json:"settlement_address,omitempty"`
+	TxHash            []byte                 `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"` // empty until the commit transaction is sent
+	Timestamp         uint64                 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PublicKey         []byte                 `protobuf:"bytes,6,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature         []byte                 `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CommitIntent) Reset() {
+	*x = CommitIntent{}
+	mi := &file_v1_message_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitIntent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitIntent) ProtoMessage() {}
+
+func (x *CommitIntent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_message_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitIntent.ProtoReflect.Descriptor instead.
+func (*CommitIntent) Descriptor() ([]byte, []int) {
+	return file_v1_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CommitIntent) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CommitIntent) GetSettlementChainId() uint64 {
+	if x != nil {
+		return x.SettlementChainId
+	}
+	return 0
+}
+
+func (x *CommitIntent) GetSettlementAddress() []byte {
+	if x != nil {
+		return x.SettlementAddress
+	}
+	return nil
+}
+
+func (x *CommitIntent) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *CommitIntent) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CommitIntent) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *CommitIntent) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// AggregationIntent announces that an aggregator started aggregating the proof of a request
+type AggregationIntent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     []byte                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Epoch         uint64                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Timestamp     uint64                 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PublicKey     []byte                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregationIntent) Reset() {
+	*x = AggregationIntent{}
+	mi := &file_v1_message_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregationIntent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregationIntent) ProtoMessage() {}
+
+func (x *AggregationIntent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_message_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregationIntent.ProtoReflect.Descriptor instead.
+func (*AggregationIntent) Descriptor() ([]byte, []int) {
+	return file_v1_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AggregationIntent) GetRequestId() []byte {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+func (x *AggregationIntent) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *AggregationIntent) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AggregationIntent) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *AggregationIntent) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 // P2PMessage represents a peer-to-peer message wrapper
 type P2PMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -103,7 +273,7 @@ type P2PMessage struct {
 
 func (x *P2PMessage) Reset() {
 	*x = P2PMessage{}
-	mi := &file_v1_message_proto_msgTypes[1]
+	mi := &file_v1_message_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -115,7 +285,7 @@ func (x *P2PMessage) String() string {
 func (*P2PMessage) ProtoMessage() {}
 
 func (x *P2PMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_message_proto_msgTypes[1]
+	mi := &file_v1_message_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -128,7 +298,7 @@ func (x *P2PMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use P2PMessage.ProtoReflect.Descriptor instead.
 func (*P2PMessage) Descriptor() ([]byte, []int) {
-	return file_v1_message_proto_rawDescGZIP(), []int{1}
+	return file_v1_message_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *P2PMessage) GetSender() string {
@@ -169,7 +339,7 @@ type WantSignaturesRequest struct {
 
 func (x *WantSignaturesRequest) Reset() {
 	*x = WantSignaturesRequest{}
-	mi := &file_v1_message_proto_msgTypes[2]
+	mi := &file_v1_message_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -181,7 +351,7 @@ func (x *WantSignaturesRequest) String() string {
 func (*WantSignaturesRequest) ProtoMessage() {}
 
 func (x *WantSignaturesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_message_proto_msgTypes[2]
+	mi := &file_v1_message_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -194,7 +364,7 @@ func (x *WantSignaturesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WantSignaturesRequest.ProtoReflect.Descriptor instead.
 func (*WantSignaturesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_message_proto_rawDescGZIP(), []int{2}
+	return file_v1_message_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *WantSignaturesRequest) GetWantSignatures() map[string][]byte {
@@ -214,7 +384,7 @@ type WantSignaturesResponse struct {
 
 func (x *WantSignaturesResponse) Reset() {
 	*x = WantSignaturesResponse{}
-	mi := &file_v1_message_proto_msgTypes[3]
+	mi := &file_v1_message_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -226,7 +396,7 @@ func (x *WantSignaturesResponse) String() string {
 func (*WantSignaturesResponse) ProtoMessage() {}
 
 func (x *WantSignaturesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_message_proto_msgTypes[3]
+	mi := &file_v1_message_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,7 +409,7 @@ func (x *WantSignaturesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WantSignaturesResponse.ProtoReflect.Descriptor instead.
 func (*WantSignaturesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_message_proto_rawDescGZIP(), []int{3}
+	return file_v1_message_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *WantSignaturesResponse) GetSignatures() map[string]*ValidatorSignatureList {
@@ -259,7 +429,7 @@ type ValidatorSignatureList struct {
 
 func (x *ValidatorSignatureList) Reset() {
 	*x = ValidatorSignatureList{}
-	mi := &file_v1_message_proto_msgTypes[4]
+	mi := &file_v1_message_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -271,7 +441,7 @@ func (x *ValidatorSignatureList) String() string {
 func (*ValidatorSignatureList) ProtoMessage() {}
 
 func (x *ValidatorSignatureList) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_message_proto_msgTypes[4]
+	mi := &file_v1_message_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -284,7 +454,7 @@ func (x *ValidatorSignatureList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidatorSignatureList.ProtoReflect.Descriptor instead.
 func (*ValidatorSignatureList) Descriptor() ([]byte, []int) {
-	return file_v1_message_proto_rawDescGZIP(), []int{4}
+	return file_v1_message_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ValidatorSignatureList) GetSignatures() []*ValidatorSignature {
@@ -305,7 +475,7 @@ type ValidatorSignature struct {
 
 func (x *ValidatorSignature) Reset() {
 	*x = ValidatorSignature{}
-	mi := &file_v1_message_proto_msgTypes[5]
+	mi := &file_v1_message_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +487,7 @@ func (x *ValidatorSignature) String() string {
 func (*ValidatorSignature) ProtoMessage() {}
 
 func (x *ValidatorSignature) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_message_proto_msgTypes[5]
+	mi := &file_v1_message_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +500,7 @@ func (x *ValidatorSignature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidatorSignature.ProtoReflect.Descriptor instead.
 func (*ValidatorSignature) Descriptor() ([]byte, []int) {
-	return file_v1_message_proto_rawDescGZIP(), []int{5}
+	return file_v1_message_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ValidatorSignature) GetValidatorIndex() uint32 {
@@ -349,19 +519,21 @@ func (x *ValidatorSignature) GetSignature() *Signature {
 
 // Signature represents extended signature data
 type Signature struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageHash   []byte                 `protobuf:"bytes,1,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
-	KeyTag        uint32                 `protobuf:"varint,2,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
-	Epoch         uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Signature     []byte                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
-	PublicKey     []byte                 `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	MessageHash []byte                 `protobuf:"bytes,1,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
+	KeyTag      uint32                 `protobuf:"varint,2,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
+	Epoch       uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Signature   []byte                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	PublicKey   []byte                 `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Signed content of validator set header signatures, unset for other signatures
+	HeaderCommitment *HeaderCommitment `protobuf:"bytes,6,opt,name=header_commitment,json=headerCommitment,proto3" json:"header_commitment,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Signature) Reset() {
 	*x = Signature{}
-	mi := &file_v1_message_proto_msgTypes[6]
+	mi := &file_v1_message_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -373,7 +545,7 @@ func (x *Signature) String() string {
 func (*Signature) ProtoMessage() {}
 
 func (x *Signature) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_message_proto_msgTypes[6]
+	mi := &file_v1_message_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,7 +558,7 @@ func (x *Signature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Signature.ProtoReflect.Descriptor instead.
 func (*Signature) Descriptor() ([]byte, []int) {
-	return file_v1_message_proto_rawDescGZIP(), []int{6}
+	return file_v1_message_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Signature) GetMessageHash() []byte {
@@ -424,6 +596,90 @@ func (x *Signature) GetPublicKey() []byte {
 	return nil
 }
 
+func (x *Signature) GetHeaderCommitment() *HeaderCommitment {
+	if x != nil {
+		return x.HeaderCommitment
+	}
+	return nil
+}
+
+// HeaderCommitment is the content of a validator set header commitment message
+type HeaderCommitment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DomainSeparator []byte                 `protobuf:"bytes,1,opt,name=domain_separator,json=domainSeparator,proto3" json:"domain_separator,omitempty"`
+	Subnetwork      []byte                 `protobuf:"bytes,2,opt,name=subnetwork,proto3" json:"subnetwork,omitempty"`
+	Epoch           uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	HeaderHash      []byte                 `protobuf:"bytes,4,opt,name=header_hash,json=headerHash,proto3" json:"header_hash,omitempty"`
+	ExtraDataHash   []byte                 `protobuf:"bytes,5,opt,name=extra_data_hash,json=extraDataHash,proto3" json:"extra_data_hash,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HeaderCommitment) Reset() {
+	*x = HeaderCommitment{}
+	mi := &file_v1_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeaderCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderCommitment) ProtoMessage() {}
+
+func (x *HeaderCommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderCommitment.ProtoReflect.Descriptor instead.
+func (*HeaderCommitment) Descriptor() ([]byte, []int) {
+	return file_v1_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HeaderCommitment) GetDomainSeparator() []byte {
+	if x != nil {
+		return x.DomainSeparator
+	}
+	return nil
+}
+
+func (x *HeaderCommitment) GetSubnetwork() []byte {
+	if x != nil {
+		return x.Subnetwork
+	}
+	return nil
+}
+
+func (x *HeaderCommitment) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *HeaderCommitment) GetHeaderHash() []byte {
+	if x != nil {
+		return x.HeaderHash
+	}
+	return nil
+}
+
+func (x *HeaderCommitment) GetExtraDataHash() []byte {
+	if x != nil {
+		return x.ExtraDataHash
+	}
+	return nil
+}
+
 type WantAggregationProofsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// List of request ids for which aggregation proofs are needed
@@ -434,7 +690,7 @@ type WantAggregationProofsRequest struct {
 
 func (x *WantAggregationProofsRequest) Reset() {
 	*x = WantAggregationProofsRequest{}
-	mi := &file_v1_message_proto_msgTypes[7]
+	mi := &file_v1_message_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -446,7 +702,7 @@ func (x *WantAggregationProofsRequest) String() string {
 func (*WantAggregationProofsRequest) ProtoMessage() {}
 
 func (x *WantAggregationProofsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_message_proto_msgTypes[7]
+	mi := &file_v1_message_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +715,7 @@ func (x *WantAggregationProofsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WantAggregationProofsRequest.ProtoReflect.Descriptor instead.
 func (*WantAggregationProofsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_message_proto_rawDescGZIP(), []int{7}
+	return file_v1_message_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *WantAggregationProofsRequest) GetRequestIds() []string {
@@ -479,7 +735,7 @@ type WantAggregationProofsResponse struct {
 
 func (x *WantAggregationProofsResponse) Reset() {
 	*x = WantAggregationProofsResponse{}
-	mi := &file_v1_message_proto_msgTypes[8]
+	mi := &file_v1_message_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +747,7 @@ func (x *WantAggregationProofsResponse) String() string {
 func (*WantAggregationProofsResponse) ProtoMessage() {}
 
 func (x *WantAggregationProofsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_message_proto_msgTypes[8]
+	mi := &file_v1_message_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +760,7 @@ func (x *WantAggregationProofsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WantAggregationProofsResponse.ProtoReflect.Descriptor instead.
 func (*WantAggregationProofsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_message_proto_rawDescGZIP(), []int{8}
+	return file_v1_message_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WantAggregationProofsResponse) GetProofs() map[string]*AggregationProof {
@@ -523,7 +779,24 @@ const file_v1_message_proto_rawDesc = "" +
 	"\akey_tag\x18\x02 \x01(\rR\x06keyTag\x12\x14\n" +
 	"\x05epoch\x18\x03 \x01(\x04R\x05epoch\x12!\n" +
 	"\fmessage_hash\x18\x04 \x01(\fR\vmessageHash\x12\x14\n" +
-	"\x05proof\x18\x05 \x01(\fR\x05proof\"\xf8\x01\n" +
+	"\x05proof\x18\x05 \x01(\fR\x05proof\"\xf7\x01\n" +
+	"\fCommitIntent\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\x04R\x05epoch\x12.\n" +
+	"\x13settlement_chain_id\x18\x02 \x01(\x04R\x11settlementChainId\x12-\n" +
+	"\x12settlement_address\x18\x03 \x01(\fR\x11settlementAddress\x12\x17\n" +
+	"\atx_hash\x18\x04 \x01(\fR\x06txHash\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x04R\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x06 \x01(\fR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\a \x01(\fR\tsignature\"\xa3\x01\n" +
+	"\x11AggregationIntent\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\fR\trequestId\x12\x14\n" +
+	"\x05epoch\x18\x02 \x01(\x04R\x05epoch\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x04R\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\fR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\fR\tsignature\"\xf8\x01\n" +
 	"\n" +
 	"P2PMessage\x12\x16\n" +
 	"\x06sender\x18\x01 \x01(\tR\x06sender\x12\x1c\n" +
@@ -551,14 +824,24 @@ const file_v1_message_proto_rawDesc = "" +
 	"signatures\"\x84\x01\n" +
 	"\x12ValidatorSignature\x12'\n" +
 	"\x0fvalidator_index\x18\x01 \x01(\rR\x0evalidatorIndex\x12E\n" +
-	"\tsignature\x18\x02 \x01(\v2'.internal.client.p2p.proto.v1.SignatureR\tsignature\"\x9a\x01\n" +
+	"\tsignature\x18\x02 \x01(\v2'.internal.client.p2p.proto.v1.SignatureR\tsignature\"\xf7\x01\n" +
 	"\tSignature\x12!\n" +
 	"\fmessage_hash\x18\x01 \x01(\fR\vmessageHash\x12\x17\n" +
 	"\akey_tag\x18\x02 \x01(\rR\x06keyTag\x12\x14\n" +
 	"\x05epoch\x18\x03 \x01(\x04R\x05epoch\x12\x1c\n" +
 	"\tsignature\x18\x04 \x01(\fR\tsignature\x12\x1d\n" +
 	"\n" +
-	"public_key\x18\x05 \x01(\fR\tpublicKey\"?\n" +
+	"public_key\x18\x05 \x01(\fR\tpublicKey\x12[\n" +
+	"\x11header_commitment\x18\x06 \x01(\v2..internal.client.p2p.proto.v1.HeaderCommitmentR\x10headerCommitment\"\xbc\x01\n" +
+	"\x10HeaderCommitment\x12)\n" +
+	"\x10domain_separator\x18\x01 \x01(\fR\x0fdomainSeparator\x12\x1e\n" +
+	"\n" +
+	"subnetwork\x18\x02 \x01(\fR\n" +
+	"subnetwork\x12\x14\n" +
+	"\x05epoch\x18\x03 \x01(\x04R\x05epoch\x12\x1f\n" +
+	"\vheader_hash\x18\x04 \x01(\fR\n" +
+	"headerHash\x12&\n" +
+	"\x0fextra_data_hash\x18\x05 \x01(\fR\rextraDataHash\"?\n" +
 	"\x1cWantAggregationProofsRequest\x12\x1f\n" +
 	"\vrequest_ids\x18\x01 \x03(\tR\n" +
 	"requestIds\"\xeb\x01\n" +
@@ -584,40 +867,44 @@ func file_v1_message_proto_rawDescGZIP() []byte {
 	return file_v1_message_proto_rawDescData
 }
 
-var file_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_v1_message_proto_goTypes = []any{
 	(*AggregationProof)(nil),              // 0: internal.client.p2p.proto.v1.AggregationProof
-	(*P2PMessage)(nil),                    // 1: internal.client.p2p.proto.v1.P2PMessage
-	(*WantSignaturesRequest)(nil),         // 2: internal.client.p2p.proto.v1.WantSignaturesRequest
-	(*WantSignaturesResponse)(nil),        // 3: internal.client.p2p.proto.v1.WantSignaturesResponse
-	(*ValidatorSignatureList)(nil),        // 4: internal.client.p2p.proto.v1.ValidatorSignatureList
-	(*ValidatorSignature)(nil),            // 5: internal.client.p2p.proto.v1.ValidatorSignature
-	(*Signature)(nil),                     // 6: internal.client.p2p.proto.v1.Signature
-	(*WantAggregationProofsRequest)(nil),  // 7: internal.client.p2p.proto.v1.WantAggregationProofsRequest
-	(*WantAggregationProofsResponse)(nil), // 8: internal.client.p2p.proto.v1.WantAggregationProofsResponse
-	nil,                                   // 9: internal.client.p2p.proto.v1.P2PMessage.TraceContextEntry
-	nil,                                   // 10: internal.client.p2p.proto.v1.WantSignaturesRequest.WantSignaturesEntry
-	nil,                                   // 11: internal.client.p2p.proto.v1.WantSignaturesResponse.SignaturesEntry
-	nil,                                   // 12: internal.client.p2p.proto.v1.WantAggregationProofsResponse.ProofsEntry
+	(*CommitIntent)(nil),                  // 1: internal.client.p2p.proto.v1.CommitIntent
+	(*AggregationIntent)(nil),             // 2: internal.client.p2p.proto.v1.AggregationIntent
+	(*P2PMessage)(nil),                    // 3: internal.client.p2p.proto.v1.P2PMessage
+	(*WantSignaturesRequest)(nil),         // 4: internal.client.p2p.proto.v1.WantSignaturesRequest
+	(*WantSignaturesResponse)(nil),        // 5: internal.client.p2p.proto.v1.WantSignaturesResponse
+	(*ValidatorSignatureList)(nil),        // 6: internal.client.p2p.proto.v1.ValidatorSignatureList
+	(*ValidatorSignature)(nil),            // 7: internal.client.p2p.proto.v1.ValidatorSignature
+	(*Signature)(nil),                     // 8: internal.client.p2p.proto.v1.Signature
+	(*HeaderCommitment)(nil),              // 9: internal.client.p2p.proto.v1.HeaderCommitment
+	(*WantAggregationProofsRequest)(nil),  // 10: internal.client.p2p.proto.v1.WantAggregationProofsRequest
+	(*WantAggregationProofsResponse)(nil), // 11: internal.client.p2p.proto.v1.WantAggregationProofsResponse
+	nil,                                   // 12: internal.client.p2p.proto.v1.P2PMessage.TraceContextEntry
+	nil,                                   // 13: internal.client.p2p.proto.v1.WantSignaturesRequest.WantSignaturesEntry
+	nil,                                   // 14: internal.client.p2p.proto.v1.WantSignaturesResponse.SignaturesEntry
+	nil,                                   // 15: internal.client.p2p.proto.v1.WantAggregationProofsResponse.ProofsEntry
 }
 var file_v1_message_proto_depIdxs = []int32{
-	9,  // 0: internal.client.p2p.proto.v1.P2PMessage.trace_context:type_name -> internal.client.p2p.proto.v1.P2PMessage.TraceContextEntry
-	10, // 1: internal.client.p2p.proto.v1.WantSignaturesRequest.want_signatures:type_name -> internal.client.p2p.proto.v1.WantSignaturesRequest.WantSignaturesEntry
-	11, // 2: internal.client.p2p.proto.v1.WantSignaturesResponse.signatures:type_name -> internal.client.p2p.proto.v1.WantSignaturesResponse.SignaturesEntry
-	5,  // 3: internal.client.p2p.proto.v1.ValidatorSignatureList.signatures:type_name -> internal.client.p2p.proto.v1.ValidatorSignature
-	6,  // 4: internal.client.p2p.proto.v1.ValidatorSignature.signature:type_name -> internal.client.p2p.proto.v1.Signature
-	12, // 5: internal.client.p2p.proto.v1.WantAggregationProofsResponse.proofs:type_name -> internal.client.p2p.proto.v1.WantAggregationProofsResponse.ProofsEntry
-	4,  // 6: internal.client.p2p.proto.v1.WantSignaturesResponse.SignaturesEntry.value:type_name -> internal.client.p2p.proto.v1.ValidatorSignatureList
-	0,  // 7: internal.client.p2p.proto.v1.WantAggregationProofsResponse.ProofsEntry.value:type_name -> internal.client.p2p.proto.v1.AggregationProof
-	2,  // 8: internal.client.p2p.proto.v1.SymbioticP2PService.WantSignatures:input_type -> internal.client.p2p.proto.v1.WantSignaturesRequest
-	7,  // 9: internal.client.p2p.proto.v1.SymbioticP2PService.WantAggregationProofs:input_type -> internal.client.p2p.proto.v1.WantAggregationProofsRequest
-	3,  // 10: internal.client.p2p.proto.v1.SymbioticP2PService.WantSignatures:output_type -> internal.client.p2p.proto.v1.WantSignaturesResponse
-	8,  // 11: internal.client.p2p.proto.v1.SymbioticP2PService.WantAggregationProofs:output_type -> internal.client.p2p.proto.v1.WantAggregationProofsResponse
-	10, // [10:12] is the sub-list for method output_type
-	8,  // [8:10] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	12, // 0: internal.client.p2p.proto.v1.P2PMessage.trace_context:type_name -> internal.client.p2p.proto.v1.P2PMessage.TraceContextEntry
+	13, // 1: internal.client.p2p.proto.v1.WantSignaturesRequest.want_signatures:type_name -> internal.client.p2p.proto.v1.WantSignaturesRequest.WantSignaturesEntry
+	14, // 2: internal.client.p2p.proto.v1.WantSignaturesResponse.signatures:type_name -> internal.client.p2p.proto.v1.WantSignaturesResponse.SignaturesEntry
+	7,  // 3: internal.client.p2p.proto.v1.ValidatorSignatureList.signatures:type_name -> internal.client.p2p.proto.v1.ValidatorSignature
+	8,  // 4: internal.client.p2p.proto.v1.ValidatorSignature.signature:type_name -> internal.client.p2p.proto.v1.Signature
+	9,  // 5: internal.client.p2p.proto.v1.Signature.header_commitment:type_name -> internal.client.p2p.proto.v1.HeaderCommitment
+	15, // 6: internal.client.p2p.proto.v1.WantAggregationProofsResponse.proofs:type_name -> internal.client.p2p.proto.v1.WantAggregationProofsResponse.ProofsEntry
+	6,  // 7: internal.client.p2p.proto.v1.WantSignaturesResponse.SignaturesEntry.value:type_name -> internal.client.p2p.proto.v1.ValidatorSignatureList
+	0,  // 8: internal.client.p2p.proto.v1.WantAggregationProofsResponse.ProofsEntry.value:type_name -> internal.client.p2p.proto.v1.AggregationProof
+	4,  // 9: internal.client.p2p.proto.v1.SymbioticP2PService.WantSignatures:input_type -> internal.client.p2p.proto.v1.WantSignaturesRequest
+	10, // 10: internal.client.p2p.proto.v1.SymbioticP2PService.WantAggregationProofs:input_type -> internal.client.p2p.proto.v1.WantAggregationProofsRequest
+	5,  // 11: internal.client.p2p.proto.v1.SymbioticP2PService.WantSignatures:output_type -> internal.client.p2p.proto.v1.WantSignaturesResponse
+	11, // 12: internal.client.p2p.proto.v1.SymbioticP2PService.WantAggregationProofs:output_type -> internal.client.p2p.proto.v1.WantAggregationProofsResponse
+	11, // [11:13] is the sub-list for method output_type
+	9,  // [9:11] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_v1_message_proto_init() }
@@ -631,7 +918,7 @@ func file_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_message_proto_rawDesc), len(file_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
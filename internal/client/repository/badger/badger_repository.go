@@ -52,6 +52,8 @@ type Repository struct {
 	proofsMutexMap    sync.Map // map[requestId]*mutexWithUseTime
 	valsetMutexMap    sync.Map // map[epoch]*mutexWithUseTime
 
+	signalEventMutexMap sync.Map // map[signalID]*mutexWithUseTime
+
 	cleanupStop chan struct{}
 	gcStop      chan struct{}
 }
@@ -3,8 +3,10 @@ package badger
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/dgraph-io/badger/v4"
 	"github.com/ethereum/go-ethereum/common"
@@ -18,6 +20,11 @@ func keyAggregationProof(requestID common.Hash) []byte {
 	return fmt.Appendf(nil, "aggregation_proof:%s", requestID.Hex())
 }
 
+// keyAggregationProofCreatedAt stores when the first proof of the request was saved, upgrades keep it.
+func keyAggregationProofCreatedAt(requestID common.Hash) []byte {
+	return fmt.Appendf(nil, "aggregation_proof_created_at:%s", requestID.Hex())
+}
+
 const aggregationProofPendingPrefix = "aggregation_proof_pending:"
 
 func keyAggregationProofPending(epoch symbiotic.Epoch, requestID common.Hash) []byte {
@@ -52,6 +59,10 @@ func (r *Repository) saveAggregationProof(ctx context.Context, requestID common.
 			return errors.Errorf("failed to store aggregation proof: %w", err)
 		}
 
+		if err = txn.Set(keyAggregationProofCreatedAt(requestID), binary.BigEndian.AppendUint64(nil, uint64(time.Now().UnixNano()))); err != nil {
+			return errors.Errorf("failed to store aggregation proof creation time: %w", err)
+		}
+
 		reqIDEpochKey := keyRequestIDEpoch(ap.Epoch, requestID)
 
 		_, err = txn.Get(reqIDEpochKey)
@@ -70,6 +81,49 @@ func (r *Repository) saveAggregationProof(ctx context.Context, requestID common.
 	}, &r.proofsMutexMap, requestID)
 }
 
+// UpgradeProof replaces the stored aggregation proof of the request with the given one if it improves on it,
+// it returns ErrEntityNotFound if no proof is stored and ErrEntityAlreadyExist if the stored proof is as good.
+func (r *Repository) UpgradeProof(ctx context.Context, ap symbiotic.AggregationProof) error {
+	requestID := ap.RequestID()
+	proofBytes, err := aggregationProofToBytes(ap)
+	if err != nil {
+		return errors.Errorf("failed to marshal aggregation proof: %w", err)
+	}
+
+	return r.doUpdateInTxWithLock(ctx, "UpgradeProof", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+
+		valueKey := keyAggregationProof(requestID)
+
+		item, err := txn.Get(valueKey)
+		if err != nil {
+			if errors.Is(err, badger.ErrKeyNotFound) {
+				return errors.Errorf("no aggregation proof found for request id %s: %w", requestID.Hex(), entity.ErrEntityNotFound)
+			}
+			return errors.Errorf("failed to get aggregation proof: %w", err)
+		}
+
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return errors.Errorf("failed to copy aggregation proof value: %w", err)
+		}
+
+		stored, err := bytesToAggregationProof(value)
+		if err != nil {
+			return errors.Errorf("failed to unmarshal aggregation proof: %w", err)
+		}
+		if !ap.Improves(stored) {
+			return errors.Errorf("stored aggregation proof is as good: %w", entity.ErrEntityAlreadyExist)
+		}
+
+		if err = txn.Set(valueKey, proofBytes); err != nil {
+			return errors.Errorf("failed to store aggregation proof: %w", err)
+		}
+
+		return nil
+	}, &r.proofsMutexMap, requestID)
+}
+
 func (r *Repository) GetAggregationProof(ctx context.Context, requestID common.Hash) (symbiotic.AggregationProof, error) {
 	var ap symbiotic.AggregationProof
 
@@ -98,6 +152,34 @@ func (r *Repository) GetAggregationProof(ctx context.Context, requestID common.H
 	})
 }
 
+// GetAggregationProofCreatedAt returns when the first proof of the request was saved,
+// it returns ErrEntityNotFound for requests without a proof or with one saved before creation times were kept.
+func (r *Repository) GetAggregationProofCreatedAt(ctx context.Context, requestID common.Hash) (time.Time, error) {
+	var createdAt time.Time
+
+	return createdAt, r.doViewInTx(ctx, "GetAggregationProofCreatedAt", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+		item, err := txn.Get(keyAggregationProofCreatedAt(requestID))
+		if err != nil {
+			if errors.Is(err, badger.ErrKeyNotFound) {
+				return errors.Errorf("no aggregation proof creation time found for request id %s: %w", requestID.Hex(), entity.ErrEntityNotFound)
+			}
+			return errors.Errorf("failed to get aggregation proof creation time: %w", err)
+		}
+
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return errors.Errorf("failed to copy aggregation proof creation time value: %w", err)
+		}
+		if len(value) != 8 {
+			return errors.Errorf("invalid aggregation proof creation time length: %d", len(value))
+		}
+
+		createdAt = time.Unix(0, int64(binary.BigEndian.Uint64(value)))
+		return nil
+	})
+}
+
 func (r *Repository) GetAggregationProofsStartingFromEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.AggregationProof, error) {
 	var proofs []symbiotic.AggregationProof
 
@@ -0,0 +1,164 @@
+package badger
+
+import (
+	"context"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/client/repository/codec"
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+const (
+	headerCommitmentSignaturePrefix = "header_commitment_signature:"
+	equivocationEvidencePrefix      = "equivocation_evidence:"
+)
+
+// Key format: header_commitment_signature:epoch(8):keyTag(1):operator(20)
+func keyHeaderCommitmentSignaturePrefix(epoch symbiotic.Epoch) []byte {
+	return append(append([]byte(headerCommitmentSignaturePrefix), epoch.Bytes()...), colonByte)
+}
+
+func keyHeaderCommitmentSignature(epoch symbiotic.Epoch, keyTag symbiotic.KeyTag, operator common.Address) []byte {
+	return append(append(keyHeaderCommitmentSignaturePrefix(epoch), uint8(keyTag)), operator.Bytes()...)
+}
+
+// Key format: equivocation_evidence:epoch(8):operator(20):keyTag(1)
+func keyEquivocationEvidencePrefix(epoch symbiotic.Epoch) []byte {
+	return append(append([]byte(equivocationEvidencePrefix), epoch.Bytes()...), colonByte)
+}
+
+func keyEquivocationEvidence(evidence symbiotic.EquivocationEvidence) []byte {
+	return append(append(keyEquivocationEvidencePrefix(evidence.Epoch()), evidence.Operator.Bytes()...), uint8(evidence.KeyTag))
+}
+
+// SaveHeaderCommitmentSignature stores the header commitment signature of a validator unless one is stored for
+// the epoch of the commitment already, and returns the stored one.
+func (r *Repository) SaveHeaderCommitmentSignature(ctx context.Context, keyTag symbiotic.KeyTag, operator common.Address, signed symbiotic.SignedHeaderCommitment) (symbiotic.SignedHeaderCommitment, error) {
+	stored := signed
+
+	err := r.doUpdateInTx(ctx, "SaveHeaderCommitmentSignature", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+		key := keyHeaderCommitmentSignature(signed.Commitment.Epoch, keyTag, operator)
+
+		item, err := txn.Get(key)
+		if err == nil {
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return errors.Errorf("failed to copy header commitment signature: %w", err)
+			}
+			stored, err = codec.BytesToSignedHeaderCommitment(value)
+			if err != nil {
+				return errors.Errorf("failed to unmarshal header commitment signature: %w", err)
+			}
+			return nil
+		}
+		if !errors.Is(err, badger.ErrKeyNotFound) {
+			return errors.Errorf("failed to get header commitment signature: %w", err)
+		}
+
+		data, err := codec.SignedHeaderCommitmentToBytes(signed)
+		if err != nil {
+			return errors.Errorf("failed to marshal header commitment signature: %w", err)
+		}
+		if err := txn.Set(key, data); err != nil {
+			return errors.Errorf("failed to store header commitment signature: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return symbiotic.SignedHeaderCommitment{}, err
+	}
+	return stored, nil
+}
+
+// SaveEquivocationEvidence stores the evidence, only the first evidence of a validator key per epoch is kept.
+func (r *Repository) SaveEquivocationEvidence(ctx context.Context, evidence symbiotic.EquivocationEvidence) error {
+	return r.doUpdateInTx(ctx, "SaveEquivocationEvidence", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+		key := keyEquivocationEvidence(evidence)
+
+		_, err := txn.Get(key)
+		if err == nil {
+			return errors.Errorf("equivocation evidence already exists: %w", entity.ErrEntityAlreadyExist)
+		}
+		if !errors.Is(err, badger.ErrKeyNotFound) {
+			return errors.Errorf("failed to get equivocation evidence: %w", err)
+		}
+
+		data, err := codec.EquivocationEvidenceToBytes(evidence)
+		if err != nil {
+			return errors.Errorf("failed to marshal equivocation evidence: %w", err)
+		}
+		if err := txn.Set(key, data); err != nil {
+			return errors.Errorf("failed to store equivocation evidence: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetEquivocationEvidenceByEpoch returns the evidence of the epoch ordered by operator.
+func (r *Repository) GetEquivocationEvidenceByEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.EquivocationEvidence, error) {
+	return r.getEquivocationEvidence(ctx, "GetEquivocationEvidenceByEpoch", keyEquivocationEvidencePrefix(epoch), keyEquivocationEvidencePrefix(epoch))
+}
+
+// GetEquivocationEvidenceStartingFromEpoch returns the evidence of the epoch and all later ones ordered by epoch
+// and operator.
+func (r *Repository) GetEquivocationEvidenceStartingFromEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.EquivocationEvidence, error) {
+	return r.getEquivocationEvidence(ctx, "GetEquivocationEvidenceStartingFromEpoch", []byte(equivocationEvidencePrefix), keyEquivocationEvidencePrefix(epoch))
+}
+
+func (r *Repository) getEquivocationEvidence(ctx context.Context, name string, prefix, start []byte) ([]symbiotic.EquivocationEvidence, error) {
+	var evidence []symbiotic.EquivocationEvidence
+
+	err := r.doViewInTx(ctx, name, func(ctx context.Context) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := getTxn(ctx).NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(start); it.ValidForPrefix(prefix); it.Next() {
+			value, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return errors.Errorf("failed to copy equivocation evidence: %w", err)
+			}
+
+			item, err := codec.BytesToEquivocationEvidence(value)
+			if err != nil {
+				return errors.Errorf("failed to unmarshal equivocation evidence: %w", err)
+			}
+			evidence = append(evidence, item)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+func (r *Repository) pruneEquivocationEntities(ctx context.Context, epoch symbiotic.Epoch) error {
+	return r.doUpdateInTx(ctx, "pruneEquivocationEntities", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+
+		for _, prefix := range [][]byte{keyHeaderCommitmentSignaturePrefix(epoch), keyEquivocationEvidencePrefix(epoch)} {
+			opts := badger.DefaultIteratorOptions
+			opts.Prefix = prefix
+			opts.PrefetchValues = false
+
+			it := txn.NewIterator(opts)
+			for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+				if err := txn.Delete(it.Item().KeyCopy(nil)); err != nil {
+					it.Close()
+					return errors.Errorf("failed to delete equivocation entity: %w", err)
+				}
+			}
+			it.Close()
+		}
+		return nil
+	})
+}
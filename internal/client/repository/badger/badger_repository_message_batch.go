@@ -0,0 +1,75 @@
+package badger
+
+import (
+	"context"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/client/repository/codec"
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+const (
+	messageBatchPrefix = "message_batch:"
+)
+
+func keyMessageBatch(requestID common.Hash) []byte {
+	return append([]byte(messageBatchPrefix), requestID.Bytes()...)
+}
+
+func (r *Repository) SaveMessageBatch(ctx context.Context, batch symbiotic.MessageBatch) error {
+	data, err := codec.MessageBatchToBytes(batch)
+	if err != nil {
+		return errors.Errorf("failed to marshal message batch: %w", err)
+	}
+
+	return r.doUpdateInTx(ctx, "SaveMessageBatch", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+		key := keyMessageBatch(batch.RequestID)
+
+		_, err := txn.Get(key)
+		if err == nil {
+			return errors.Errorf("message batch for request %s already exists: %w", batch.RequestID.Hex(), entity.ErrEntityAlreadyExist)
+		}
+		if !errors.Is(err, badger.ErrKeyNotFound) {
+			return errors.Errorf("failed to check message batch: %w", err)
+		}
+
+		if err := txn.Set(key, data); err != nil {
+			return errors.Errorf("failed to store message batch: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *Repository) GetMessageBatch(ctx context.Context, requestID common.Hash) (symbiotic.MessageBatch, error) {
+	var batch symbiotic.MessageBatch
+
+	err := r.doViewInTx(ctx, "GetMessageBatch", func(ctx context.Context) error {
+		item, err := getTxn(ctx).Get(keyMessageBatch(requestID))
+		if err != nil {
+			if errors.Is(err, badger.ErrKeyNotFound) {
+				return errors.Errorf("no message batch found for request id %s: %w", requestID.Hex(), entity.ErrEntityNotFound)
+			}
+			return errors.Errorf("failed to get message batch: %w", err)
+		}
+
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return errors.Errorf("failed to copy message batch value: %w", err)
+		}
+
+		batch, err = codec.BytesToMessageBatch(value)
+		if err != nil {
+			return errors.Errorf("failed to unmarshal message batch: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return symbiotic.MessageBatch{}, err
+	}
+	return batch, nil
+}
@@ -0,0 +1,42 @@
+package badger
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func TestBadgerRepository_MessageBatch(t *testing.T) {
+	t.Parallel()
+	repo := setupTestRepository(t)
+
+	batch := symbiotic.MessageBatch{
+		RequestID: common.HexToHash("0x01"),
+		Epoch:     7,
+		KeyTag:    symbiotic.KeyTag(15),
+		Root:      common.HexToHash("0xaa"),
+		Leaves:    []common.Hash{common.HexToHash("0xbb"), common.HexToHash("0xcc")},
+	}
+
+	t.Run("missing batch returns not found", func(t *testing.T) {
+		_, err := repo.GetMessageBatch(t.Context(), batch.RequestID)
+		require.ErrorIs(t, err, entity.ErrEntityNotFound)
+	})
+
+	t.Run("save and get batch", func(t *testing.T) {
+		require.NoError(t, repo.SaveMessageBatch(t.Context(), batch))
+
+		got, err := repo.GetMessageBatch(t.Context(), batch.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, batch, got)
+	})
+
+	t.Run("save duplicate batch fails", func(t *testing.T) {
+		err := repo.SaveMessageBatch(t.Context(), batch)
+		require.ErrorIs(t, err, entity.ErrEntityAlreadyExist)
+	})
+}
@@ -45,6 +45,13 @@ func (r *Repository) PruneProofEntities(ctx context.Context, epoch symbiotic.Epo
 }
 
 func (r *Repository) PruneSignatureEntitiesForEpoch(ctx context.Context, epoch symbiotic.Epoch) error {
+	if err := r.pruneValidatorParticipations(ctx, epoch); err != nil {
+		return errors.Errorf("failed to prune validator participations: %w", err)
+	}
+	if err := r.pruneEquivocationEntities(ctx, epoch); err != nil {
+		return errors.Errorf("failed to prune equivocation entities: %w", err)
+	}
+
 	requestIDs, err := r.getRequestIDsByEpoch(ctx, epoch)
 	if err != nil {
 		return errors.Errorf("failed to get request IDs: %w", err)
@@ -69,6 +76,20 @@ func (r *Repository) pruneProofCommits(ctx context.Context, epoch symbiotic.Epoc
 		if err := txn.Delete(keyAggregationProofCommited(epoch)); err != nil {
 			return errors.Errorf("failed to delete proof commit: %w", err)
 		}
+
+		prefix := keySettlementCommitPrefix(epoch)
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		opts.PrefetchValues = false
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			if err := txn.Delete(it.Item().KeyCopy(nil)); err != nil {
+				return errors.Errorf("failed to delete settlement commit state: %w", err)
+			}
+		}
 		return nil
 	})
 }
@@ -218,10 +239,18 @@ func (r *Repository) pruneAggregationProof(ctx context.Context, epoch symbiotic.
 			return errors.Errorf("failed to delete aggregation proof: %w", err)
 		}
 
+		if err := txn.Delete(keyAggregationProofCreatedAt(requestID)); err != nil {
+			return errors.Errorf("failed to delete aggregation proof creation time: %w", err)
+		}
+
 		if err := txn.Delete(keyAggregationProofPending(epoch, requestID)); err != nil {
 			return errors.Errorf("failed to delete aggregation proof pending: %w", err)
 		}
 
+		if err := txn.Delete(keyMessageBatch(requestID)); err != nil {
+			return errors.Errorf("failed to delete message batch: %w", err)
+		}
+
 		return nil
 	}, &r.proofsMutexMap, requestID)
 }
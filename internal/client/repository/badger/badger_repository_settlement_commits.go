@@ -0,0 +1,102 @@
+package badger
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/client/repository/codec"
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+const (
+	settlementCommitPrefix = "settlement_commit:"
+)
+
+// Key format: settlement_commit:epoch(8):chainID(8)address(20)
+func keySettlementCommitPrefix(epoch symbiotic.Epoch) []byte {
+	return append(append([]byte(settlementCommitPrefix), epoch.Bytes()...), colonByte)
+}
+
+func keySettlementCommit(epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress) []byte {
+	key := binary.BigEndian.AppendUint64(keySettlementCommitPrefix(epoch), settlement.ChainId)
+	return append(key, settlement.Address.Bytes()...)
+}
+
+func (r *Repository) SaveSettlementCommitState(ctx context.Context, state symbiotic.SettlementCommitState) error {
+	data, err := codec.SettlementCommitStateToBytes(state)
+	if err != nil {
+		return errors.Errorf("failed to marshal settlement commit state: %w", err)
+	}
+
+	return r.doUpdateInTx(ctx, "SaveSettlementCommitState", func(ctx context.Context) error {
+		if err := getTxn(ctx).Set(keySettlementCommit(state.Epoch, state.Settlement), data); err != nil {
+			return errors.Errorf("failed to store settlement commit state: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *Repository) GetSettlementCommitState(ctx context.Context, epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress) (symbiotic.SettlementCommitState, error) {
+	var state symbiotic.SettlementCommitState
+
+	err := r.doViewInTx(ctx, "GetSettlementCommitState", func(ctx context.Context) error {
+		item, err := getTxn(ctx).Get(keySettlementCommit(epoch, settlement))
+		if err != nil {
+			if errors.Is(err, badger.ErrKeyNotFound) {
+				return errors.Errorf("no commit state for epoch %d at settlement %d/%s: %w", epoch, settlement.ChainId, settlement.Address.Hex(), entity.ErrEntityNotFound)
+			}
+			return errors.Errorf("failed to get settlement commit state: %w", err)
+		}
+
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return errors.Errorf("failed to copy settlement commit state: %w", err)
+		}
+
+		state, err = codec.BytesToSettlementCommitState(value)
+		if err != nil {
+			return errors.Errorf("failed to unmarshal settlement commit state: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return symbiotic.SettlementCommitState{}, err
+	}
+	return state, nil
+}
+
+// GetSettlementCommitStatesByEpoch returns commit states of all settlements for the epoch ordered by chain id and address.
+func (r *Repository) GetSettlementCommitStatesByEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.SettlementCommitState, error) {
+	var states []symbiotic.SettlementCommitState
+
+	err := r.doViewInTx(ctx, "GetSettlementCommitStatesByEpoch", func(ctx context.Context) error {
+		prefix := keySettlementCommitPrefix(epoch)
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := getTxn(ctx).NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			value, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return errors.Errorf("failed to copy settlement commit state: %w", err)
+			}
+
+			state, err := codec.BytesToSettlementCommitState(value)
+			if err != nil {
+				return errors.Errorf("failed to unmarshal settlement commit state: %w", err)
+			}
+			states = append(states, state)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return states, nil
+}
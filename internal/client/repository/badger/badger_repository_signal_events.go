@@ -0,0 +1,251 @@
+package badger
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/client/repository/codec"
+	"github.com/symbioticfi/relay/internal/entity"
+	"github.com/symbioticfi/relay/pkg/signals"
+)
+
+const (
+	signalEventPrefix      = "signal_event:"
+	signalDeadLetterPrefix = "signal_dead_letter:"
+	signalEventSeqPrefix   = "signal_event_seq:"
+)
+
+// Key format: prefix + signalID + ":" + seq(8)
+func keySignalEventPrefix(prefix, signalID string) []byte {
+	return append([]byte(prefix+signalID), colonByte)
+}
+
+func keySignalEvent(prefix, signalID string, seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(keySignalEventPrefix(prefix, signalID), seq)
+}
+
+func keySignalEventSeq(signalID string) []byte {
+	return []byte(signalEventSeqPrefix + signalID)
+}
+
+func (r *Repository) SaveSignalEvent(ctx context.Context, signalID string, payload []byte) (signals.StoredEvent, error) {
+	var event signals.StoredEvent
+
+	// sequence counter is read and incremented under a per-signal lock to avoid transaction conflicts
+	err := r.doUpdateInTxWithLock(ctx, "SaveSignalEvent", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+
+		var seq uint64
+		item, err := txn.Get(keySignalEventSeq(signalID))
+		switch {
+		case err == nil:
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return errors.Errorf("failed to copy signal event sequence: %w", err)
+			}
+			if len(value) != epochLen {
+				return errors.Errorf("invalid signal event sequence length: %d", len(value))
+			}
+			seq = binary.BigEndian.Uint64(value)
+		case !errors.Is(err, badger.ErrKeyNotFound):
+			return errors.Errorf("failed to get signal event sequence: %w", err)
+		}
+		seq++
+
+		now := time.Now()
+		event = signals.StoredEvent{
+			Seq:           seq,
+			Payload:       payload,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		}
+		data, err := codec.SignalEventToBytes(event)
+		if err != nil {
+			return errors.Errorf("failed to marshal signal event: %w", err)
+		}
+
+		if err := txn.Set(keySignalEventSeq(signalID), binary.BigEndian.AppendUint64(nil, seq)); err != nil {
+			return errors.Errorf("failed to store signal event sequence: %w", err)
+		}
+		if err := txn.Set(keySignalEvent(signalEventPrefix, signalID, seq), data); err != nil {
+			return errors.Errorf("failed to store signal event: %w", err)
+		}
+		return nil
+	}, &r.signalEventMutexMap, signalID)
+	if err != nil {
+		return signals.StoredEvent{}, err
+	}
+
+	return event, nil
+}
+
+func (r *Repository) GetDueSignalEvents(ctx context.Context, signalID string, now time.Time, limit int) ([]signals.StoredEvent, error) {
+	var events []signals.StoredEvent
+
+	err := r.doViewInTx(ctx, "GetDueSignalEvents", func(ctx context.Context) error {
+		var err error
+		events, err = iterateSignalEvents(getTxn(ctx), signalEventPrefix, signalID, limit, func(event signals.StoredEvent) bool {
+			return !event.NextAttemptAt.After(now)
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *Repository) UpdateSignalEvent(ctx context.Context, signalID string, event signals.StoredEvent) error {
+	return r.doUpdateInTx(ctx, "UpdateSignalEvent", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+		key := keySignalEvent(signalEventPrefix, signalID, event.Seq)
+
+		if err := ensureSignalEventExists(txn, key, signalID, event.Seq); err != nil {
+			return err
+		}
+
+		data, err := codec.SignalEventToBytes(event)
+		if err != nil {
+			return errors.Errorf("failed to marshal signal event: %w", err)
+		}
+		if err := txn.Set(key, data); err != nil {
+			return errors.Errorf("failed to store signal event: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *Repository) RemoveSignalEvent(ctx context.Context, signalID string, seq uint64) error {
+	return r.doUpdateInTx(ctx, "RemoveSignalEvent", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+		key := keySignalEvent(signalEventPrefix, signalID, seq)
+
+		if err := ensureSignalEventExists(txn, key, signalID, seq); err != nil {
+			return err
+		}
+		if err := txn.Delete(key); err != nil {
+			return errors.Errorf("failed to delete signal event: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *Repository) MoveSignalEventToDeadLetter(ctx context.Context, signalID string, event signals.StoredEvent) error {
+	return r.doUpdateInTx(ctx, "MoveSignalEventToDeadLetter", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+		key := keySignalEvent(signalEventPrefix, signalID, event.Seq)
+
+		if err := ensureSignalEventExists(txn, key, signalID, event.Seq); err != nil {
+			return err
+		}
+		if err := txn.Delete(key); err != nil {
+			return errors.Errorf("failed to delete signal event: %w", err)
+		}
+
+		data, err := codec.SignalEventToBytes(event)
+		if err != nil {
+			return errors.Errorf("failed to marshal signal event: %w", err)
+		}
+		if err := txn.Set(keySignalEvent(signalDeadLetterPrefix, signalID, event.Seq), data); err != nil {
+			return errors.Errorf("failed to store signal dead letter: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *Repository) GetDeadLetterSignalEvents(ctx context.Context, signalID string, limit int) ([]signals.StoredEvent, error) {
+	var events []signals.StoredEvent
+
+	err := r.doViewInTx(ctx, "GetDeadLetterSignalEvents", func(ctx context.Context) error {
+		var err error
+		events, err = iterateSignalEvents(getTxn(ctx), signalDeadLetterPrefix, signalID, limit, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *Repository) CountSignalEvents(ctx context.Context, signalID string) (int, int, error) {
+	var pending, deadLetters int
+
+	err := r.doViewInTx(ctx, "CountSignalEvents", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+		pending = countKeysWithPrefix(txn, keySignalEventPrefix(signalEventPrefix, signalID))
+		deadLetters = countKeysWithPrefix(txn, keySignalEventPrefix(signalDeadLetterPrefix, signalID))
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return pending, deadLetters, nil
+}
+
+func ensureSignalEventExists(txn *badger.Txn, key []byte, signalID string, seq uint64) error {
+	_, err := txn.Get(key)
+	if err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return errors.Errorf("signal event %d not found for %s signal: %w", seq, signalID, entity.ErrEntityNotFound)
+		}
+		return errors.Errorf("failed to get signal event: %w", err)
+	}
+	return nil
+}
+
+// iterateSignalEvents returns up to limit events of the signal in sequence order, skipping those rejected by filter.
+func iterateSignalEvents(txn *badger.Txn, prefix, signalID string, limit int, filter func(signals.StoredEvent) bool) ([]signals.StoredEvent, error) {
+	var events []signals.StoredEvent
+
+	keyPrefix := keySignalEventPrefix(prefix, signalID)
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = keyPrefix
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	for it.Seek(keyPrefix); it.ValidForPrefix(keyPrefix); it.Next() {
+		if limit > 0 && len(events) >= limit {
+			break
+		}
+
+		key := it.Item().Key()
+		if len(key) != len(keyPrefix)+epochLen {
+			continue
+		}
+		value, err := it.Item().ValueCopy(nil)
+		if err != nil {
+			return nil, errors.Errorf("failed to copy signal event value: %w", err)
+		}
+		event, err := codec.BytesToSignalEvent(binary.BigEndian.Uint64(key[len(keyPrefix):]), value)
+		if err != nil {
+			return nil, errors.Errorf("failed to unmarshal signal event: %w", err)
+		}
+		if filter != nil && !filter(event) {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func countKeysWithPrefix(txn *badger.Txn, prefix []byte) int {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Prefix = prefix
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	var count int
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		count++
+	}
+	return count
+}
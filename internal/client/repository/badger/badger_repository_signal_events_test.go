@@ -0,0 +1,95 @@
+package badger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/symbioticfi/relay/internal/entity"
+)
+
+func TestBadgerRepository_SignalEvents(t *testing.T) {
+	t.Parallel()
+	repo := setupTestRepository(t)
+
+	first, err := repo.SaveSignalEvent(t.Context(), "sig", []byte("first"))
+	require.NoError(t, err)
+	second, err := repo.SaveSignalEvent(t.Context(), "sig", []byte("second"))
+	require.NoError(t, err)
+	other, err := repo.SaveSignalEvent(t.Context(), "other", []byte("other"))
+	require.NoError(t, err)
+	require.Less(t, first.Seq, second.Seq)
+	require.NotZero(t, other.Seq)
+
+	t.Run("due events are returned in sequence order", func(t *testing.T) {
+		events, err := repo.GetDueSignalEvents(t.Context(), "sig", time.Now(), 0)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, first.Seq, events[0].Seq)
+		require.Equal(t, []byte("first"), events[0].Payload)
+		require.Equal(t, second.Seq, events[1].Seq)
+
+		limited, err := repo.GetDueSignalEvents(t.Context(), "sig", time.Now(), 1)
+		require.NoError(t, err)
+		require.Len(t, limited, 1)
+	})
+
+	t.Run("events scheduled for retry are not due yet", func(t *testing.T) {
+		retry := first
+		retry.Attempts = 1
+		retry.LastError = "boom"
+		retry.NextAttemptAt = time.Now().Add(time.Hour)
+		require.NoError(t, repo.UpdateSignalEvent(t.Context(), "sig", retry))
+
+		events, err := repo.GetDueSignalEvents(t.Context(), "sig", time.Now(), 0)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, second.Seq, events[0].Seq)
+
+		events, err = repo.GetDueSignalEvents(t.Context(), "sig", time.Now().Add(2*time.Hour), 0)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.EqualValues(t, 1, events[0].Attempts)
+		require.Equal(t, "boom", events[0].LastError)
+	})
+
+	t.Run("dead-lettered events leave the pending queue", func(t *testing.T) {
+		dead := first
+		dead.Attempts = 5
+		dead.LastError = "permanent"
+		require.NoError(t, repo.MoveSignalEventToDeadLetter(t.Context(), "sig", dead))
+
+		pending, deadLetters, err := repo.CountSignalEvents(t.Context(), "sig")
+		require.NoError(t, err)
+		require.Equal(t, 1, pending)
+		require.Equal(t, 1, deadLetters)
+
+		events, err := repo.GetDeadLetterSignalEvents(t.Context(), "sig", 0)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, first.Seq, events[0].Seq)
+		require.EqualValues(t, 5, events[0].Attempts)
+		require.Equal(t, "permanent", events[0].LastError)
+	})
+
+	t.Run("acknowledged events are removed", func(t *testing.T) {
+		require.NoError(t, repo.RemoveSignalEvent(t.Context(), "sig", second.Seq))
+		require.ErrorIs(t, repo.RemoveSignalEvent(t.Context(), "sig", second.Seq), entity.ErrEntityNotFound)
+		require.ErrorIs(t, repo.UpdateSignalEvent(t.Context(), "sig", second), entity.ErrEntityNotFound)
+
+		pending, _, err := repo.CountSignalEvents(t.Context(), "sig")
+		require.NoError(t, err)
+		require.Zero(t, pending)
+
+		pending, _, err = repo.CountSignalEvents(t.Context(), "other")
+		require.NoError(t, err)
+		require.Equal(t, 1, pending)
+	})
+
+	t.Run("sequence keeps growing after events are removed", func(t *testing.T) {
+		next, err := repo.SaveSignalEvent(t.Context(), "sig", []byte("next"))
+		require.NoError(t, err)
+		require.Greater(t, next.Seq, second.Seq)
+	})
+}
@@ -131,6 +131,37 @@ func (r *Repository) RemoveSignaturePending(ctx context.Context, epoch symbiotic
 	})
 }
 
+// CancelSignatureRequest marks the request as cancelled and removes it from the signature and aggregation pending collections
+func (r *Repository) CancelSignatureRequest(ctx context.Context, requestID common.Hash) error {
+	return r.doUpdateInTx(ctx, "CancelSignatureRequest", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+
+		req, err := r.GetSignatureRequest(ctx, requestID)
+		if err != nil {
+			return err
+		}
+		req.Cancelled = true
+
+		requestBytes, err := signatureRequestToBytes(req)
+		if err != nil {
+			return errors.Errorf("failed to marshal signature request: %w", err)
+		}
+		if err := txn.Set(keySignatureRequest(req.RequiredEpoch, requestID), requestBytes); err != nil {
+			return errors.Errorf("failed to store signature request: %w", err)
+		}
+
+		// deleting missing keys is a no-op in badger
+		if err := txn.Delete(keySignatureRequestPending(req.RequiredEpoch, requestID)); err != nil {
+			return errors.Errorf("failed to remove pending signature: %w", err)
+		}
+		if err := txn.Delete(keyAggregationProofPending(req.RequiredEpoch, requestID)); err != nil {
+			return errors.Errorf("failed to remove pending aggregation proof: %w", err)
+		}
+
+		return nil
+	})
+}
+
 var (
 	signatureRequestToBytes = codec.SignatureRequestToBytes
 	bytesToSignatureRequest = codec.BytesToSignatureRequest
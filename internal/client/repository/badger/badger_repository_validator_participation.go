@@ -0,0 +1,115 @@
+package badger
+
+import (
+	"context"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/client/repository/codec"
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+const (
+	validatorParticipationPrefix = "validator_participation:"
+)
+
+// Key format: validator_participation:epoch(8):operator(20)
+func keyValidatorParticipationPrefix(epoch symbiotic.Epoch) []byte {
+	return append(append([]byte(validatorParticipationPrefix), epoch.Bytes()...), colonByte)
+}
+
+func keyValidatorParticipation(participation entity.ValidatorParticipation) []byte {
+	return append(keyValidatorParticipationPrefix(participation.Epoch), participation.Operator.Bytes()...)
+}
+
+// AddValidatorParticipations adds the counts of the records to the stored records of the same validator and epoch.
+func (r *Repository) AddValidatorParticipations(ctx context.Context, participations []entity.ValidatorParticipation) error {
+	return r.doUpdateInTx(ctx, "AddValidatorParticipations", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+		for _, participation := range participations {
+			key := keyValidatorParticipation(participation)
+
+			stored := entity.ValidatorParticipation{Epoch: participation.Epoch, Operator: participation.Operator}
+			item, err := txn.Get(key)
+			switch {
+			case err == nil:
+				value, err := item.ValueCopy(nil)
+				if err != nil {
+					return errors.Errorf("failed to copy validator participation: %w", err)
+				}
+				stored, err = codec.BytesToValidatorParticipation(value)
+				if err != nil {
+					return errors.Errorf("failed to unmarshal validator participation: %w", err)
+				}
+			case !errors.Is(err, badger.ErrKeyNotFound):
+				return errors.Errorf("failed to get validator participation: %w", err)
+			}
+
+			stored.Add(participation)
+
+			data, err := codec.ValidatorParticipationToBytes(stored)
+			if err != nil {
+				return errors.Errorf("failed to marshal validator participation: %w", err)
+			}
+			if err := txn.Set(key, data); err != nil {
+				return errors.Errorf("failed to store validator participation: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetValidatorParticipations returns the participation records of all validators for the epoch ordered by operator.
+func (r *Repository) GetValidatorParticipations(ctx context.Context, epoch symbiotic.Epoch) ([]entity.ValidatorParticipation, error) {
+	var participations []entity.ValidatorParticipation
+
+	err := r.doViewInTx(ctx, "GetValidatorParticipations", func(ctx context.Context) error {
+		prefix := keyValidatorParticipationPrefix(epoch)
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := getTxn(ctx).NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			value, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return errors.Errorf("failed to copy validator participation: %w", err)
+			}
+
+			participation, err := codec.BytesToValidatorParticipation(value)
+			if err != nil {
+				return errors.Errorf("failed to unmarshal validator participation: %w", err)
+			}
+			participations = append(participations, participation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return participations, nil
+}
+
+func (r *Repository) pruneValidatorParticipations(ctx context.Context, epoch symbiotic.Epoch) error {
+	return r.doUpdateInTx(ctx, "pruneValidatorParticipations", func(ctx context.Context) error {
+		txn := getTxn(ctx)
+
+		prefix := keyValidatorParticipationPrefix(epoch)
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		opts.PrefetchValues = false
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			if err := txn.Delete(it.Item().KeyCopy(nil)); err != nil {
+				return errors.Errorf("failed to delete validator participation: %w", err)
+			}
+		}
+		return nil
+	})
+}
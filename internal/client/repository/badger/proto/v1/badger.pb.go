@@ -594,6 +594,14 @@ type SignatureRequest struct {
 	KeyTag        uint32                 `protobuf:"varint,1,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
 	RequiredEpoch uint64                 `protobuf:"varint,2,opt,name=required_epoch,json=requiredEpoch,proto3" json:"required_epoch,omitempty"`
 	Message       []byte                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// JSON encoded EIP-712 typed data, empty for raw messages
+	TypedData []byte `protobuf:"bytes,4,opt,name=typed_data,json=typedData,proto3" json:"typed_data,omitempty"`
+	// Expiry time in unix nanoseconds, zero if the request never expires
+	Deadline  int64 `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Submitted bool  `protobuf:"varint,6,opt,name=submitted,proto3" json:"submitted,omitempty"`
+	Cancelled bool  `protobuf:"varint,7,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// Scheduling class, zero if it is derived from the key tag
+	Priority      uint32 `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -649,6 +657,41 @@ func (x *SignatureRequest) GetMessage() []byte {
 	return nil
 }
 
+func (x *SignatureRequest) GetTypedData() []byte {
+	if x != nil {
+		return x.TypedData
+	}
+	return nil
+}
+
+func (x *SignatureRequest) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *SignatureRequest) GetSubmitted() bool {
+	if x != nil {
+		return x.Submitted
+	}
+	return false
+}
+
+func (x *SignatureRequest) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *SignatureRequest) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 type SignatureMap struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	RequestId              []byte                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
@@ -977,6 +1020,502 @@ func (x *QuorumThreshold) GetQuorumThreshold() string {
 	return ""
 }
 
+type SignalEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payload       []byte                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Attempts      uint32                 `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt int64                  `protobuf:"varint,3,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastError     string                 `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignalEvent) Reset() {
+	*x = SignalEvent{}
+	mi := &file_v1_badger_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignalEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalEvent) ProtoMessage() {}
+
+func (x *SignalEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_badger_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalEvent.ProtoReflect.Descriptor instead.
+func (*SignalEvent) Descriptor() ([]byte, []int) {
+	return file_v1_badger_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SignalEvent) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SignalEvent) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *SignalEvent) GetNextAttemptAt() int64 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+func (x *SignalEvent) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *SignalEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type SettlementCommitState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint64                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Settlement    *CrossChainAddress     `protobuf:"bytes,2,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	Status        uint32                 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	TxHash        []byte                 `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Attempts      uint32                 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettlementCommitState) Reset() {
+	*x = SettlementCommitState{}
+	mi := &file_v1_badger_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementCommitState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementCommitState) ProtoMessage() {}
+
+func (x *SettlementCommitState) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_badger_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementCommitState.ProtoReflect.Descriptor instead.
+func (*SettlementCommitState) Descriptor() ([]byte, []int) {
+	return file_v1_badger_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SettlementCommitState) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SettlementCommitState) GetSettlement() *CrossChainAddress {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+func (x *SettlementCommitState) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SettlementCommitState) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *SettlementCommitState) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *SettlementCommitState) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *SettlementCommitState) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type MessageBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     []byte                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Epoch         uint64                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	KeyTag        uint32                 `protobuf:"varint,3,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
+	Root          []byte                 `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	Leaves        [][]byte               `protobuf:"bytes,5,rep,name=leaves,proto3" json:"leaves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageBatch) Reset() {
+	*x = MessageBatch{}
+	mi := &file_v1_badger_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBatch) ProtoMessage() {}
+
+func (x *MessageBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_badger_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageBatch.ProtoReflect.Descriptor instead.
+func (*MessageBatch) Descriptor() ([]byte, []int) {
+	return file_v1_badger_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MessageBatch) GetRequestId() []byte {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+func (x *MessageBatch) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *MessageBatch) GetKeyTag() uint32 {
+	if x != nil {
+		return x.KeyTag
+	}
+	return 0
+}
+
+func (x *MessageBatch) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *MessageBatch) GetLeaves() [][]byte {
+	if x != nil {
+		return x.Leaves
+	}
+	return nil
+}
+
+type ValidatorParticipation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Epoch          uint64                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Operator       []byte                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Seen           uint64                 `protobuf:"varint,3,opt,name=seen,proto3" json:"seen,omitempty"`
+	Signed         uint64                 `protobuf:"varint,4,opt,name=signed,proto3" json:"signed,omitempty"`
+	Missed         uint64                 `protobuf:"varint,5,opt,name=missed,proto3" json:"missed,omitempty"`
+	LatencyBuckets []uint64               `protobuf:"varint,6,rep,packed,name=latency_buckets,json=latencyBuckets,proto3" json:"latency_buckets,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ValidatorParticipation) Reset() {
+	*x = ValidatorParticipation{}
+	mi := &file_v1_badger_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorParticipation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorParticipation) ProtoMessage() {}
+
+func (x *ValidatorParticipation) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_badger_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorParticipation.ProtoReflect.Descriptor instead.
+func (*ValidatorParticipation) Descriptor() ([]byte, []int) {
+	return file_v1_badger_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ValidatorParticipation) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorParticipation) GetOperator() []byte {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+func (x *ValidatorParticipation) GetSeen() uint64 {
+	if x != nil {
+		return x.Seen
+	}
+	return 0
+}
+
+func (x *ValidatorParticipation) GetSigned() uint64 {
+	if x != nil {
+		return x.Signed
+	}
+	return 0
+}
+
+func (x *ValidatorParticipation) GetMissed() uint64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+func (x *ValidatorParticipation) GetLatencyBuckets() []uint64 {
+	if x != nil {
+		return x.LatencyBuckets
+	}
+	return nil
+}
+
+type SignedHeaderCommitment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DomainSeparator []byte                 `protobuf:"bytes,1,opt,name=domain_separator,json=domainSeparator,proto3" json:"domain_separator,omitempty"`
+	Subnetwork      []byte                 `protobuf:"bytes,2,opt,name=subnetwork,proto3" json:"subnetwork,omitempty"`
+	Epoch           uint64                 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	HeaderHash      []byte                 `protobuf:"bytes,4,opt,name=header_hash,json=headerHash,proto3" json:"header_hash,omitempty"`
+	ExtraDataHash   []byte                 `protobuf:"bytes,5,opt,name=extra_data_hash,json=extraDataHash,proto3" json:"extra_data_hash,omitempty"`
+	Signature       []byte                 `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SignedHeaderCommitment) Reset() {
+	*x = SignedHeaderCommitment{}
+	mi := &file_v1_badger_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedHeaderCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedHeaderCommitment) ProtoMessage() {}
+
+func (x *SignedHeaderCommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_badger_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedHeaderCommitment.ProtoReflect.Descriptor instead.
+func (*SignedHeaderCommitment) Descriptor() ([]byte, []int) {
+	return file_v1_badger_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SignedHeaderCommitment) GetDomainSeparator() []byte {
+	if x != nil {
+		return x.DomainSeparator
+	}
+	return nil
+}
+
+func (x *SignedHeaderCommitment) GetSubnetwork() []byte {
+	if x != nil {
+		return x.Subnetwork
+	}
+	return nil
+}
+
+func (x *SignedHeaderCommitment) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SignedHeaderCommitment) GetHeaderHash() []byte {
+	if x != nil {
+		return x.HeaderHash
+	}
+	return nil
+}
+
+func (x *SignedHeaderCommitment) GetExtraDataHash() []byte {
+	if x != nil {
+		return x.ExtraDataHash
+	}
+	return nil
+}
+
+func (x *SignedHeaderCommitment) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type EquivocationEvidence struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Operator      []byte                  `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	KeyTag        uint32                  `protobuf:"varint,2,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
+	PublicKey     []byte                  `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	First         *SignedHeaderCommitment `protobuf:"bytes,4,opt,name=first,proto3" json:"first,omitempty"`
+	Second        *SignedHeaderCommitment `protobuf:"bytes,5,opt,name=second,proto3" json:"second,omitempty"`
+	DetectedAt    int64                   `protobuf:"varint,6,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquivocationEvidence) Reset() {
+	*x = EquivocationEvidence{}
+	mi := &file_v1_badger_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquivocationEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquivocationEvidence) ProtoMessage() {}
+
+func (x *EquivocationEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_badger_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquivocationEvidence.ProtoReflect.Descriptor instead.
+func (*EquivocationEvidence) Descriptor() ([]byte, []int) {
+	return file_v1_badger_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EquivocationEvidence) GetOperator() []byte {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+func (x *EquivocationEvidence) GetKeyTag() uint32 {
+	if x != nil {
+		return x.KeyTag
+	}
+	return 0
+}
+
+func (x *EquivocationEvidence) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *EquivocationEvidence) GetFirst() *SignedHeaderCommitment {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *EquivocationEvidence) GetSecond() *SignedHeaderCommitment {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *EquivocationEvidence) GetDetectedAt() int64 {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return 0
+}
+
 var File_v1_badger_proto protoreflect.FileDescriptor
 
 const file_v1_badger_proto_rawDesc = "" +
@@ -1026,11 +1565,17 @@ const file_v1_badger_proto_rawDesc = "" +
 	"\akey_tag\x18\x02 \x01(\rR\x06keyTag\x12\x14\n" +
 	"\x05epoch\x18\x03 \x01(\x04R\x05epoch\x12\x1c\n" +
 	"\tsignature\x18\x04 \x01(\fR\tsignature\x12$\n" +
-	"\x0eraw_public_key\x18\x05 \x01(\fR\frawPublicKey\"l\n" +
+	"\x0eraw_public_key\x18\x05 \x01(\fR\frawPublicKey\"\xff\x01\n" +
 	"\x10SignatureRequest\x12\x17\n" +
 	"\akey_tag\x18\x01 \x01(\rR\x06keyTag\x12%\n" +
 	"\x0erequired_epoch\x18\x02 \x01(\x04R\rrequiredEpoch\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\fR\amessage\"\xda\x01\n" +
+	"\amessage\x18\x03 \x01(\fR\amessage\x12\x1d\n" +
+	"\n" +
+	"typed_data\x18\x04 \x01(\fR\ttypedData\x12\x1a\n" +
+	"\bdeadline\x18\x05 \x01(\x03R\bdeadline\x12\x1c\n" +
+	"\tsubmitted\x18\x06 \x01(\bR\tsubmitted\x12\x1c\n" +
+	"\tcancelled\x18\a \x01(\bR\tcancelled\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\rR\bpriority\"\xda\x01\n" +
 	"\fSignatureMap\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\fR\trequestId\x12\x14\n" +
@@ -1059,7 +1604,60 @@ const file_v1_badger_proto_rawDesc = "" +
 	"\bchain_id\x18\x02 \x01(\x04R\achainId\"U\n" +
 	"\x0fQuorumThreshold\x12\x17\n" +
 	"\akey_tag\x18\x01 \x01(\rR\x06keyTag\x12)\n" +
-	"\x10quorum_threshold\x18\x02 \x01(\tR\x0fquorumThresholdB\xd5\x02\n" +
+	"\x10quorum_threshold\x18\x02 \x01(\tR\x0fquorumThreshold\"\xa9\x01\n" +
+	"\vSignalEvent\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\x12\x1a\n" +
+	"\battempts\x18\x02 \x01(\rR\battempts\x12&\n" +
+	"\x0fnext_attempt_at\x18\x03 \x01(\x03R\rnextAttemptAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x04 \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"\x97\x02\n" +
+	"\x15SettlementCommitState\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\x04R\x05epoch\x12]\n" +
+	"\n" +
+	"settlement\x18\x02 \x01(\v2=.internal.client.repository.badger.proto.v1.CrossChainAddressR\n" +
+	"settlement\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\rR\x06status\x12\x17\n" +
+	"\atx_hash\x18\x04 \x01(\fR\x06txHash\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\rR\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\"\x88\x01\n" +
+	"\fMessageBatch\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\fR\trequestId\x12\x14\n" +
+	"\x05epoch\x18\x02 \x01(\x04R\x05epoch\x12\x17\n" +
+	"\akey_tag\x18\x03 \x01(\rR\x06keyTag\x12\x12\n" +
+	"\x04root\x18\x04 \x01(\fR\x04root\x12\x16\n" +
+	"\x06leaves\x18\x05 \x03(\fR\x06leaves\"\xb7\x01\n" +
+	"\x16ValidatorParticipation\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\x04R\x05epoch\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\fR\boperator\x12\x12\n" +
+	"\x04seen\x18\x03 \x01(\x04R\x04seen\x12\x16\n" +
+	"\x06signed\x18\x04 \x01(\x04R\x06signed\x12\x16\n" +
+	"\x06missed\x18\x05 \x01(\x04R\x06missed\x12'\n" +
+	"\x0flatency_buckets\x18\x06 \x03(\x04R\x0elatencyBuckets\"\xe0\x01\n" +
+	"\x16SignedHeaderCommitment\x12)\n" +
+	"\x10domain_separator\x18\x01 \x01(\fR\x0fdomainSeparator\x12\x1e\n" +
+	"\n" +
+	"subnetwork\x18\x02 \x01(\fR\n" +
+	"subnetwork\x12\x14\n" +
+	"\x05epoch\x18\x03 \x01(\x04R\x05epoch\x12\x1f\n" +
+	"\vheader_hash\x18\x04 \x01(\fR\n" +
+	"headerHash\x12&\n" +
+	"\x0fextra_data_hash\x18\x05 \x01(\fR\rextraDataHash\x12\x1c\n" +
+	"\tsignature\x18\x06 \x01(\fR\tsignature\"\xc1\x02\n" +
+	"\x14EquivocationEvidence\x12\x1a\n" +
+	"\boperator\x18\x01 \x01(\fR\boperator\x12\x17\n" +
+	"\akey_tag\x18\x02 \x01(\rR\x06keyTag\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\fR\tpublicKey\x12X\n" +
+	"\x05first\x18\x04 \x01(\v2B.internal.client.repository.badger.proto.v1.SignedHeaderCommitmentR\x05first\x12Z\n" +
+	"\x06second\x18\x05 \x01(\v2B.internal.client.repository.badger.proto.v1.SignedHeaderCommitmentR\x06second\x12\x1f\n" +
+	"\vdetected_at\x18\x06 \x01(\x03R\n" +
+	"detectedAtB\xd5\x02\n" +
 	".com.internal.client.repository.badger.proto.v1B\vBadgerProtoP\x01ZGgithub.com/symbioticfi/relay/internal/client/repository/badger/proto/v1\xa2\x02\x05ICRBP\xaa\x02*Internal.Client.Repository.Badger.Proto.V1\xca\x02*Internal\\Client\\Repository\\Badger\\Proto\\V1\xe2\x026Internal\\Client\\Repository\\Badger\\Proto\\V1\\GPBMetadata\xea\x02/Internal::Client::Repository::Badger::Proto::V1b\x06proto3"
 
 var (
@@ -1074,21 +1672,27 @@ func file_v1_badger_proto_rawDescGZIP() []byte {
 	return file_v1_badger_proto_rawDescData
 }
 
-var file_v1_badger_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_v1_badger_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_v1_badger_proto_goTypes = []any{
-	(*Validator)(nil),            // 0: internal.client.repository.badger.proto.v1.Validator
-	(*ValidatorKey)(nil),         // 1: internal.client.repository.badger.proto.v1.ValidatorKey
-	(*ValidatorVault)(nil),       // 2: internal.client.repository.badger.proto.v1.ValidatorVault
-	(*ValidatorSetHeader)(nil),   // 3: internal.client.repository.badger.proto.v1.ValidatorSetHeader
-	(*ValidatorSetMetadata)(nil), // 4: internal.client.repository.badger.proto.v1.ValidatorSetMetadata
-	(*ExtraData)(nil),            // 5: internal.client.repository.badger.proto.v1.ExtraData
-	(*AggregationProof)(nil),     // 6: internal.client.repository.badger.proto.v1.AggregationProof
-	(*Signature)(nil),            // 7: internal.client.repository.badger.proto.v1.Signature
-	(*SignatureRequest)(nil),     // 8: internal.client.repository.badger.proto.v1.SignatureRequest
-	(*SignatureMap)(nil),         // 9: internal.client.repository.badger.proto.v1.SignatureMap
-	(*NetworkConfig)(nil),        // 10: internal.client.repository.badger.proto.v1.NetworkConfig
-	(*CrossChainAddress)(nil),    // 11: internal.client.repository.badger.proto.v1.CrossChainAddress
-	(*QuorumThreshold)(nil),      // 12: internal.client.repository.badger.proto.v1.QuorumThreshold
+	(*Validator)(nil),              // 0: internal.client.repository.badger.proto.v1.Validator
+	(*ValidatorKey)(nil),           // 1: internal.client.repository.badger.proto.v1.ValidatorKey
+	(*ValidatorVault)(nil),         // 2: internal.client.repository.badger.proto.v1.ValidatorVault
+	(*ValidatorSetHeader)(nil),     // 3: internal.client.repository.badger.proto.v1.ValidatorSetHeader
+	(*ValidatorSetMetadata)(nil),   // 4: internal.client.repository.badger.proto.v1.ValidatorSetMetadata
+	(*ExtraData)(nil),              // 5: internal.client.repository.badger.proto.v1.ExtraData
+	(*AggregationProof)(nil),       // 6: internal.client.repository.badger.proto.v1.AggregationProof
+	(*Signature)(nil),              // 7: internal.client.repository.badger.proto.v1.Signature
+	(*SignatureRequest)(nil),       // 8: internal.client.repository.badger.proto.v1.SignatureRequest
+	(*SignatureMap)(nil),           // 9: internal.client.repository.badger.proto.v1.SignatureMap
+	(*NetworkConfig)(nil),          // 10: internal.client.repository.badger.proto.v1.NetworkConfig
+	(*CrossChainAddress)(nil),      // 11: internal.client.repository.badger.proto.v1.CrossChainAddress
+	(*QuorumThreshold)(nil),        // 12: internal.client.repository.badger.proto.v1.QuorumThreshold
+	(*SignalEvent)(nil),            // 13: internal.client.repository.badger.proto.v1.SignalEvent
+	(*SettlementCommitState)(nil),  // 14: internal.client.repository.badger.proto.v1.SettlementCommitState
+	(*MessageBatch)(nil),           // 15: internal.client.repository.badger.proto.v1.MessageBatch
+	(*ValidatorParticipation)(nil), // 16: internal.client.repository.badger.proto.v1.ValidatorParticipation
+	(*SignedHeaderCommitment)(nil), // 17: internal.client.repository.badger.proto.v1.SignedHeaderCommitment
+	(*EquivocationEvidence)(nil),   // 18: internal.client.repository.badger.proto.v1.EquivocationEvidence
 }
 var file_v1_badger_proto_depIdxs = []int32{
 	1,  // 0: internal.client.repository.badger.proto.v1.Validator.keys:type_name -> internal.client.repository.badger.proto.v1.ValidatorKey
@@ -1098,11 +1702,14 @@ var file_v1_badger_proto_depIdxs = []int32{
 	11, // 4: internal.client.repository.badger.proto.v1.NetworkConfig.keys_provider:type_name -> internal.client.repository.badger.proto.v1.CrossChainAddress
 	11, // 5: internal.client.repository.badger.proto.v1.NetworkConfig.settlements:type_name -> internal.client.repository.badger.proto.v1.CrossChainAddress
 	12, // 6: internal.client.repository.badger.proto.v1.NetworkConfig.quorum_thresholds:type_name -> internal.client.repository.badger.proto.v1.QuorumThreshold
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	11, // 7: internal.client.repository.badger.proto.v1.SettlementCommitState.settlement:type_name -> internal.client.repository.badger.proto.v1.CrossChainAddress
+	17, // 8: internal.client.repository.badger.proto.v1.EquivocationEvidence.first:type_name -> internal.client.repository.badger.proto.v1.SignedHeaderCommitment
+	17, // 9: internal.client.repository.badger.proto.v1.EquivocationEvidence.second:type_name -> internal.client.repository.badger.proto.v1.SignedHeaderCommitment
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_v1_badger_proto_init() }
@@ -1116,7 +1723,7 @@ func file_v1_badger_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_badger_proto_rawDesc), len(file_v1_badger_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
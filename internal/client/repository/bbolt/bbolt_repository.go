@@ -37,6 +37,7 @@ var (
 	bucketRequestIDEpochs     = []byte("request_id_epochs")
 	bucketAggregationProofs   = []byte("aggregation_proofs")
 	bucketAggProofPending     = []byte("agg_proof_pending")
+	bucketAggProofCreatedAt   = []byte("agg_proof_created_at")
 	bucketAggProofCommits     = []byte("agg_proof_commits")
 	bucketValidatorSetHeaders = []byte("validator_set_headers")
 	bucketValidatorSetStatus  = []byte("validator_set_status")
@@ -46,6 +47,13 @@ var (
 	bucketActiveValCounts     = []byte("active_validator_counts")
 	bucketNetworkConfigs      = []byte("network_configs")
 	bucketMeta                = []byte("meta")
+	bucketSignalEvents        = []byte("signal_events")
+	bucketSignalDeadLetters   = []byte("signal_dead_letters")
+	bucketSettlementCommits   = []byte("settlement_commits")
+	bucketMessageBatches      = []byte("message_batches")
+	bucketValParticipation    = []byte("validator_participation")
+	bucketHeaderCommitSigs    = []byte("header_commitment_signatures")
+	bucketEquivocations       = []byte("equivocation_evidence")
 )
 
 var allBuckets = [][]byte{
@@ -53,7 +61,8 @@ var allBuckets = [][]byte{
 	bucketRequestIDIndex, bucketRequestIDEpochs, bucketAggregationProofs, bucketAggProofPending,
 	bucketAggProofCommits, bucketValidatorSetHeaders, bucketValidatorSetStatus, bucketValidatorSetMeta,
 	bucketValidators, bucketValidatorKeyLookups, bucketActiveValCounts, bucketNetworkConfigs,
-	bucketMeta,
+	bucketMeta, bucketSignalEvents, bucketSignalDeadLetters, bucketSettlementCommits, bucketMessageBatches,
+	bucketValParticipation, bucketHeaderCommitSigs, bucketEquivocations, bucketAggProofCreatedAt,
 }
 
 type mutexWithUseTime struct {
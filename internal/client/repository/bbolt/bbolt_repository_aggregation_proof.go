@@ -3,7 +3,9 @@ package bbolt
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"log/slog"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-errors/errors"
@@ -24,6 +26,11 @@ func putAggregationProofTx(tx *bolt.Tx, requestIDBytes []byte, data []byte, epoc
 		return errors.Errorf("failed to store aggregation proof: %w", err)
 	}
 
+	// upgrades keep the creation time of the first proof
+	if err := tx.Bucket(bucketAggProofCreatedAt).Put(requestIDBytes, binary.BigEndian.AppendUint64(nil, uint64(time.Now().UnixNano()))); err != nil {
+		return errors.Errorf("failed to store aggregation proof creation time: %w", err)
+	}
+
 	// Maintain request_id_epochs index
 	epochKey := epochHashKey(uint64(epoch), requestIDBytes)
 	if tx.Bucket(bucketRequestIDEpochs).Get(epochKey) == nil {
@@ -46,6 +53,37 @@ func (r *Repository) saveAggregationProof(ctx context.Context, requestID common.
 	})
 }
 
+// UpgradeProof replaces the stored aggregation proof of the request with the given one if it improves on it,
+// it returns ErrEntityNotFound if no proof is stored and ErrEntityAlreadyExist if the stored proof is as good.
+func (r *Repository) UpgradeProof(ctx context.Context, ap symbiotic.AggregationProof) error {
+	requestID := ap.RequestID()
+	data, err := codec.AggregationProofToBytes(ap)
+	if err != nil {
+		return errors.Errorf("failed to marshal aggregation proof: %w", err)
+	}
+
+	return r.doUpdate(ctx, "UpgradeProof", func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketAggregationProofs)
+		v := b.Get(requestID.Bytes())
+		if v == nil {
+			return errors.Errorf("no aggregation proof found for request id %s: %w", requestID.Hex(), entity.ErrEntityNotFound)
+		}
+
+		stored, err := codec.BytesToAggregationProof(v)
+		if err != nil {
+			return errors.Errorf("failed to unmarshal aggregation proof: %w", err)
+		}
+		if !ap.Improves(stored) {
+			return errors.Errorf("stored aggregation proof is as good: %w", entity.ErrEntityAlreadyExist)
+		}
+
+		if err := b.Put(requestID.Bytes(), data); err != nil {
+			return errors.Errorf("failed to store aggregation proof: %w", err)
+		}
+		return nil
+	})
+}
+
 func (r *Repository) GetAggregationProof(ctx context.Context, requestID common.Hash) (symbiotic.AggregationProof, error) {
 	var ap symbiotic.AggregationProof
 
@@ -65,6 +103,26 @@ func (r *Repository) GetAggregationProof(ctx context.Context, requestID common.H
 	return ap, err
 }
 
+// GetAggregationProofCreatedAt returns when the first proof of the request was saved,
+// it returns ErrEntityNotFound for requests without a proof or with one saved before creation times were kept.
+func (r *Repository) GetAggregationProofCreatedAt(ctx context.Context, requestID common.Hash) (time.Time, error) {
+	var createdAt time.Time
+
+	err := r.doView(ctx, "GetAggregationProofCreatedAt", func(tx *bolt.Tx) error {
+		v := tx.Bucket(bucketAggProofCreatedAt).Get(requestID.Bytes())
+		if v == nil {
+			return errors.Errorf("no aggregation proof creation time found for request id %s: %w", requestID.Hex(), entity.ErrEntityNotFound)
+		}
+		if len(v) != 8 {
+			return errors.Errorf("invalid aggregation proof creation time length: %d", len(v))
+		}
+
+		createdAt = time.Unix(0, int64(binary.BigEndian.Uint64(v)))
+		return nil
+	})
+	return createdAt, err
+}
+
 func (r *Repository) GetAggregationProofsStartingFromEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.AggregationProof, error) {
 	var proofs []symbiotic.AggregationProof
 
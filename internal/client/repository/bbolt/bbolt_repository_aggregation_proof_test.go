@@ -1,7 +1,9 @@
 package bbolt
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -27,6 +29,43 @@ func TestRepository_AggregationProof(t *testing.T) {
 	require.Equal(t, ap, loadedProof)
 }
 
+func TestRepository_UpgradeProof(t *testing.T) {
+	t.Parallel()
+	repo := setupTestRepository(t)
+
+	ap := randomAggregationProof(t)
+	require.ErrorIs(t, repo.UpgradeProof(t.Context(), ap), entity.ErrEntityNotFound)
+	_, err := repo.GetAggregationProofCreatedAt(t.Context(), ap.RequestID())
+	require.ErrorIs(t, err, entity.ErrEntityNotFound)
+	require.NoError(t, repo.saveAggregationProof(t.Context(), ap.RequestID(), ap))
+
+	worse := ap
+	worse.Proof = append(bytes.Clone(ap.Proof), 0x00, 0x01)
+	require.ErrorIs(t, repo.UpgradeProof(t.Context(), worse), entity.ErrEntityAlreadyExist)
+	require.ErrorIs(t, repo.UpgradeProof(t.Context(), ap), entity.ErrEntityAlreadyExist)
+
+	createdAt, err := repo.GetAggregationProofCreatedAt(t.Context(), ap.RequestID())
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), createdAt, time.Minute)
+
+	better := ap
+	better.Proof = ap.Proof[:len(ap.Proof)-2]
+	require.NoError(t, repo.UpgradeProof(t.Context(), better))
+
+	// upgrades keep the creation time of the first proof
+	upgradedAt, err := repo.GetAggregationProofCreatedAt(t.Context(), ap.RequestID())
+	require.NoError(t, err)
+	require.Equal(t, createdAt, upgradedAt)
+
+	loaded, err := repo.GetAggregationProof(t.Context(), ap.RequestID())
+	require.NoError(t, err)
+	require.Equal(t, better, loaded)
+
+	proofs, err := repo.GetAggregationProofsByEpoch(t.Context(), ap.Epoch)
+	require.NoError(t, err)
+	require.Equal(t, []symbiotic.AggregationProof{better}, proofs)
+}
+
 func TestRepository_GetAggregationProofsStartingFromEpoch(t *testing.T) {
 	t.Parallel()
 
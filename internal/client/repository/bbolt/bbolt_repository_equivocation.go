@@ -0,0 +1,112 @@
+package bbolt
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/symbioticfi/relay/internal/client/repository/codec"
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// Key format: epoch(8) + keyTag(1) + operator(20)
+func headerCommitmentSignatureKey(epoch symbiotic.Epoch, keyTag symbiotic.KeyTag, operator common.Address) []byte {
+	return append(append(epochBytes(uint64(epoch)), uint8(keyTag)), operator.Bytes()...)
+}
+
+// Key format: epoch(8) + operator(20) + keyTag(1)
+func equivocationEvidenceKey(evidence symbiotic.EquivocationEvidence) []byte {
+	return append(append(epochBytes(uint64(evidence.Epoch())), evidence.Operator.Bytes()...), uint8(evidence.KeyTag))
+}
+
+// SaveHeaderCommitmentSignature stores the header commitment signature of a validator unless one is stored for
+// the epoch of the commitment already, and returns the stored one.
+func (r *Repository) SaveHeaderCommitmentSignature(ctx context.Context, keyTag symbiotic.KeyTag, operator common.Address, signed symbiotic.SignedHeaderCommitment) (symbiotic.SignedHeaderCommitment, error) {
+	stored := signed
+
+	err := r.doUpdate(ctx, "SaveHeaderCommitmentSignature", func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketHeaderCommitSigs)
+		key := headerCommitmentSignatureKey(signed.Commitment.Epoch, keyTag, operator)
+
+		if v := bucket.Get(key); v != nil {
+			var err error
+			stored, err = codec.BytesToSignedHeaderCommitment(v)
+			if err != nil {
+				return errors.Errorf("failed to unmarshal header commitment signature: %w", err)
+			}
+			return nil
+		}
+
+		data, err := codec.SignedHeaderCommitmentToBytes(signed)
+		if err != nil {
+			return errors.Errorf("failed to marshal header commitment signature: %w", err)
+		}
+		if err := bucket.Put(key, data); err != nil {
+			return errors.Errorf("failed to store header commitment signature: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return symbiotic.SignedHeaderCommitment{}, err
+	}
+	return stored, nil
+}
+
+// SaveEquivocationEvidence stores the evidence, only the first evidence of a validator key per epoch is kept.
+func (r *Repository) SaveEquivocationEvidence(ctx context.Context, evidence symbiotic.EquivocationEvidence) error {
+	return r.doUpdate(ctx, "SaveEquivocationEvidence", func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketEquivocations)
+		key := equivocationEvidenceKey(evidence)
+
+		if bucket.Get(key) != nil {
+			return errors.Errorf("equivocation evidence already exists: %w", entity.ErrEntityAlreadyExist)
+		}
+
+		data, err := codec.EquivocationEvidenceToBytes(evidence)
+		if err != nil {
+			return errors.Errorf("failed to marshal equivocation evidence: %w", err)
+		}
+		if err := bucket.Put(key, data); err != nil {
+			return errors.Errorf("failed to store equivocation evidence: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetEquivocationEvidenceByEpoch returns the evidence of the epoch ordered by operator.
+func (r *Repository) GetEquivocationEvidenceByEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.EquivocationEvidence, error) {
+	prefix := epochBytes(uint64(epoch))
+	return r.getEquivocationEvidence(ctx, "GetEquivocationEvidenceByEpoch", prefix, func(k []byte) bool {
+		return bytes.HasPrefix(k, prefix)
+	})
+}
+
+// GetEquivocationEvidenceStartingFromEpoch returns the evidence of the epoch and all later ones ordered by epoch
+// and operator.
+func (r *Repository) GetEquivocationEvidenceStartingFromEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.EquivocationEvidence, error) {
+	return r.getEquivocationEvidence(ctx, "GetEquivocationEvidenceStartingFromEpoch", epochBytes(uint64(epoch)), func([]byte) bool {
+		return true
+	})
+}
+
+func (r *Repository) getEquivocationEvidence(ctx context.Context, name string, start []byte, inRange func(k []byte) bool) ([]symbiotic.EquivocationEvidence, error) {
+	var evidence []symbiotic.EquivocationEvidence
+
+	err := r.doView(ctx, name, func(tx *bolt.Tx) error {
+		c := tx.Bucket(bucketEquivocations).Cursor()
+
+		for k, v := c.Seek(start); k != nil && inRange(k); k, v = c.Next() {
+			item, err := codec.BytesToEquivocationEvidence(v)
+			if err != nil {
+				return errors.Errorf("failed to unmarshal equivocation evidence: %w", err)
+			}
+			evidence = append(evidence, item)
+		}
+		return nil
+	})
+	return evidence, err
+}
@@ -0,0 +1,107 @@
+package bbolt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func signedHeaderCommitment(epoch symbiotic.Epoch, headerHash string) symbiotic.SignedHeaderCommitment {
+	return symbiotic.SignedHeaderCommitment{
+		Commitment: symbiotic.ValSetHeaderCommitment{
+			DomainSeparator: common.HexToHash("0xd0"),
+			Subnetwork:      common.HexToHash("0x5b"),
+			Epoch:           epoch,
+			HeaderHash:      common.HexToHash(headerHash),
+			ExtraDataHash:   common.HexToHash("0xed"),
+		},
+		Signature: []byte("signature-" + headerHash),
+	}
+}
+
+func TestRepository_HeaderCommitmentSignature(t *testing.T) {
+	t.Parallel()
+	repo := setupTestRepository(t)
+
+	operator := common.HexToAddress("0x01")
+	first := signedHeaderCommitment(5, "0xaa")
+
+	stored, err := repo.SaveHeaderCommitmentSignature(t.Context(), 15, operator, first)
+	require.NoError(t, err)
+	require.Equal(t, first, stored)
+
+	// a conflicting signature doesn't replace the first one
+	stored, err = repo.SaveHeaderCommitmentSignature(t.Context(), 15, operator, signedHeaderCommitment(5, "0xbb"))
+	require.NoError(t, err)
+	require.Equal(t, first, stored)
+
+	// other key tags, operators and epochs are independent
+	other := signedHeaderCommitment(6, "0xbb")
+	stored, err = repo.SaveHeaderCommitmentSignature(t.Context(), 15, operator, other)
+	require.NoError(t, err)
+	require.Equal(t, other, stored)
+	stored, err = repo.SaveHeaderCommitmentSignature(t.Context(), 16, operator, other)
+	require.NoError(t, err)
+	require.Equal(t, other, stored)
+}
+
+func TestRepository_EquivocationEvidence(t *testing.T) {
+	t.Parallel()
+	repo := setupTestRepository(t)
+
+	evidence := func(epoch symbiotic.Epoch, operator string) symbiotic.EquivocationEvidence {
+		return symbiotic.EquivocationEvidence{
+			Operator:   common.HexToAddress(operator),
+			KeyTag:     15,
+			PublicKey:  []byte("public-key"),
+			First:      signedHeaderCommitment(epoch, "0xaa"),
+			Second:     signedHeaderCommitment(epoch, "0xbb"),
+			DetectedAt: time.Unix(1700000000, 0),
+		}
+	}
+
+	t.Run("no evidence", func(t *testing.T) {
+		items, err := repo.GetEquivocationEvidenceByEpoch(t.Context(), 5)
+		require.NoError(t, err)
+		require.Empty(t, items)
+	})
+
+	second := evidence(5, "0x02")
+	first := evidence(5, "0x01")
+	later := evidence(7, "0x01")
+	t.Run("save and get", func(t *testing.T) {
+		require.NoError(t, repo.SaveEquivocationEvidence(t.Context(), second))
+		require.NoError(t, repo.SaveEquivocationEvidence(t.Context(), first))
+		require.NoError(t, repo.SaveEquivocationEvidence(t.Context(), later))
+		require.NoError(t, repo.SaveEquivocationEvidence(t.Context(), evidence(4, "0x01")))
+
+		items, err := repo.GetEquivocationEvidenceByEpoch(t.Context(), 5)
+		require.NoError(t, err)
+		require.Equal(t, []symbiotic.EquivocationEvidence{first, second}, items)
+
+		items, err = repo.GetEquivocationEvidenceStartingFromEpoch(t.Context(), 5)
+		require.NoError(t, err)
+		require.Equal(t, []symbiotic.EquivocationEvidence{first, second, later}, items)
+	})
+
+	t.Run("first evidence is kept", func(t *testing.T) {
+		again := evidence(5, "0x01")
+		again.Second = signedHeaderCommitment(5, "0xcc")
+		require.ErrorIs(t, repo.SaveEquivocationEvidence(t.Context(), again), entity.ErrEntityAlreadyExist)
+	})
+
+	t.Run("prune signature entities removes evidence of the epoch", func(t *testing.T) {
+		require.NoError(t, repo.PruneSignatureEntitiesForEpoch(t.Context(), 5))
+
+		items, err := repo.GetEquivocationEvidenceStartingFromEpoch(t.Context(), 0)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		require.Equal(t, symbiotic.Epoch(4), items[0].Epoch())
+		require.Equal(t, later, items[1])
+	})
+}
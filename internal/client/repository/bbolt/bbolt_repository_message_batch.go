@@ -0,0 +1,47 @@
+package bbolt
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/symbioticfi/relay/internal/client/repository/codec"
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func (r *Repository) SaveMessageBatch(ctx context.Context, batch symbiotic.MessageBatch) error {
+	data, err := codec.MessageBatchToBytes(batch)
+	if err != nil {
+		return errors.Errorf("failed to marshal message batch: %w", err)
+	}
+
+	return r.doUpdate(ctx, "SaveMessageBatch", func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketMessageBatches)
+		if b.Get(batch.RequestID.Bytes()) != nil {
+			return errors.Errorf("message batch for request %s already exists: %w", batch.RequestID.Hex(), entity.ErrEntityAlreadyExist)
+		}
+		return b.Put(batch.RequestID.Bytes(), data)
+	})
+}
+
+func (r *Repository) GetMessageBatch(ctx context.Context, requestID common.Hash) (symbiotic.MessageBatch, error) {
+	var batch symbiotic.MessageBatch
+
+	err := r.doView(ctx, "GetMessageBatch", func(tx *bolt.Tx) error {
+		v := tx.Bucket(bucketMessageBatches).Get(requestID.Bytes())
+		if v == nil {
+			return errors.Errorf("no message batch found for request id %s: %w", requestID.Hex(), entity.ErrEntityNotFound)
+		}
+
+		var err error
+		batch, err = codec.BytesToMessageBatch(v)
+		if err != nil {
+			return errors.Errorf("failed to unmarshal message batch: %w", err)
+		}
+		return nil
+	})
+	return batch, err
+}
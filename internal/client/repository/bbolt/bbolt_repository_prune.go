@@ -55,6 +55,11 @@ func (r *Repository) PruneProofEntities(ctx context.Context, epoch symbiotic.Epo
 			return errors.Errorf("failed to delete proof commits: %w", err)
 		}
 
+		// Delete settlement commit states
+		if err := deletePrefixedKeys(tx.Bucket(bucketSettlementCommits), ek); err != nil {
+			return errors.Errorf("failed to delete settlement commit states: %w", err)
+		}
+
 		// Find all request IDs for this epoch
 		requestIDs := getRequestIDsByEpochTx(tx, epoch)
 
@@ -64,11 +69,20 @@ func (r *Repository) PruneProofEntities(ctx context.Context, epoch symbiotic.Epo
 				return errors.Errorf("failed to delete aggregation proof: %w", err)
 			}
 
+			if err := tx.Bucket(bucketAggProofCreatedAt).Delete(requestID.Bytes()); err != nil {
+				return errors.Errorf("failed to delete aggregation proof creation time: %w", err)
+			}
+
 			// Delete aggregation proof pending
 			pendingKey := epochHashKey(uint64(epoch), requestID.Bytes())
 			if err := tx.Bucket(bucketAggProofPending).Delete(pendingKey); err != nil {
 				return errors.Errorf("failed to delete pending agg proof: %w", err)
 			}
+
+			// Delete message batch
+			if err := tx.Bucket(bucketMessageBatches).Delete(requestID.Bytes()); err != nil {
+				return errors.Errorf("failed to delete message batch: %w", err)
+			}
 		}
 
 		return nil
@@ -83,6 +97,19 @@ func (r *Repository) PruneSignatureEntitiesForEpoch(ctx context.Context, epoch s
 	slog.DebugContext(ctx, "Pruning signature entities", "requestCount", len(requestIDs))
 
 	return r.doUpdate(ctx, "PruneSignatureEntitiesForEpoch", func(tx *bolt.Tx) error {
+		// Delete validator participation records
+		if err := deletePrefixedKeys(tx.Bucket(bucketValParticipation), epochBytes(uint64(epoch))); err != nil {
+			return errors.Errorf("failed to delete validator participations: %w", err)
+		}
+
+		// Delete header commitment signatures and equivocation evidence
+		if err := deletePrefixedKeys(tx.Bucket(bucketHeaderCommitSigs), epochBytes(uint64(epoch))); err != nil {
+			return errors.Errorf("failed to delete header commitment signatures: %w", err)
+		}
+		if err := deletePrefixedKeys(tx.Bucket(bucketEquivocations), epochBytes(uint64(epoch))); err != nil {
+			return errors.Errorf("failed to delete equivocation evidence: %w", err)
+		}
+
 		for _, requestID := range requestIDs {
 			// Delete all signatures for this requestID
 			sigPrefix := requestID.Bytes()
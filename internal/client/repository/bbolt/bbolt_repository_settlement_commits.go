@@ -0,0 +1,73 @@
+package bbolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/go-errors/errors"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/symbioticfi/relay/internal/client/repository/codec"
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// Key format: epoch(8) + chainID(8) + address(20)
+func settlementCommitKey(epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress) []byte {
+	key := binary.BigEndian.AppendUint64(epochBytes(uint64(epoch)), settlement.ChainId)
+	return append(key, settlement.Address.Bytes()...)
+}
+
+func (r *Repository) SaveSettlementCommitState(ctx context.Context, state symbiotic.SettlementCommitState) error {
+	data, err := codec.SettlementCommitStateToBytes(state)
+	if err != nil {
+		return errors.Errorf("failed to marshal settlement commit state: %w", err)
+	}
+
+	return r.doUpdate(ctx, "SaveSettlementCommitState", func(tx *bolt.Tx) error {
+		if err := tx.Bucket(bucketSettlementCommits).Put(settlementCommitKey(state.Epoch, state.Settlement), data); err != nil {
+			return errors.Errorf("failed to store settlement commit state: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *Repository) GetSettlementCommitState(ctx context.Context, epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress) (symbiotic.SettlementCommitState, error) {
+	var state symbiotic.SettlementCommitState
+
+	err := r.doView(ctx, "GetSettlementCommitState", func(tx *bolt.Tx) error {
+		v := tx.Bucket(bucketSettlementCommits).Get(settlementCommitKey(epoch, settlement))
+		if v == nil {
+			return errors.Errorf("no commit state for epoch %d at settlement %d/%s: %w", epoch, settlement.ChainId, settlement.Address.Hex(), entity.ErrEntityNotFound)
+		}
+
+		var err error
+		state, err = codec.BytesToSettlementCommitState(v)
+		if err != nil {
+			return errors.Errorf("failed to unmarshal settlement commit state: %w", err)
+		}
+		return nil
+	})
+	return state, err
+}
+
+// GetSettlementCommitStatesByEpoch returns commit states of all settlements for the epoch ordered by chain id and address.
+func (r *Repository) GetSettlementCommitStatesByEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.SettlementCommitState, error) {
+	var states []symbiotic.SettlementCommitState
+
+	err := r.doView(ctx, "GetSettlementCommitStatesByEpoch", func(tx *bolt.Tx) error {
+		prefix := epochBytes(uint64(epoch))
+		c := tx.Bucket(bucketSettlementCommits).Cursor()
+
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			state, err := codec.BytesToSettlementCommitState(v)
+			if err != nil {
+				return errors.Errorf("failed to unmarshal settlement commit state: %w", err)
+			}
+			states = append(states, state)
+		}
+		return nil
+	})
+	return states, err
+}
@@ -0,0 +1,85 @@
+package bbolt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func TestRepository_SettlementCommitState(t *testing.T) {
+	t.Parallel()
+	repo := setupTestRepository(t)
+
+	epoch := symbiotic.Epoch(10)
+	first := symbiotic.CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x01")}
+	second := symbiotic.CrossChainAddress{ChainId: 2, Address: common.HexToAddress("0x02")}
+
+	t.Run("missing state returns not found", func(t *testing.T) {
+		_, err := repo.GetSettlementCommitState(t.Context(), epoch, first)
+		require.ErrorIs(t, err, entity.ErrEntityNotFound)
+	})
+
+	submitted := symbiotic.SettlementCommitState{
+		Epoch:      epoch,
+		Settlement: first,
+		Status:     symbiotic.SettlementCommitSubmitted,
+		TxHash:     common.HexToHash("0xaa"),
+		Attempts:   1,
+		UpdatedAt:  time.Unix(0, time.Now().UnixNano()),
+	}
+	failed := symbiotic.SettlementCommitState{
+		Epoch:      epoch,
+		Settlement: second,
+		Status:     symbiotic.SettlementCommitFailed,
+		Attempts:   2,
+		LastError:  "execution reverted",
+		UpdatedAt:  time.Unix(0, time.Now().UnixNano()),
+	}
+
+	t.Run("save and get state", func(t *testing.T) {
+		require.NoError(t, repo.SaveSettlementCommitState(t.Context(), failed))
+		require.NoError(t, repo.SaveSettlementCommitState(t.Context(), submitted))
+
+		got, err := repo.GetSettlementCommitState(t.Context(), epoch, first)
+		require.NoError(t, err)
+		require.Equal(t, submitted, got)
+	})
+
+	t.Run("save overwrites state", func(t *testing.T) {
+		confirmed := submitted
+		confirmed.Status = symbiotic.SettlementCommitConfirmed
+		require.NoError(t, repo.SaveSettlementCommitState(t.Context(), confirmed))
+
+		got, err := repo.GetSettlementCommitState(t.Context(), epoch, first)
+		require.NoError(t, err)
+		require.Equal(t, confirmed, got)
+		submitted = confirmed
+	})
+
+	t.Run("get states by epoch", func(t *testing.T) {
+		require.NoError(t, repo.SaveSettlementCommitState(t.Context(), symbiotic.SettlementCommitState{Epoch: epoch + 1, Settlement: first}))
+
+		states, err := repo.GetSettlementCommitStatesByEpoch(t.Context(), epoch)
+		require.NoError(t, err)
+		require.Equal(t, []symbiotic.SettlementCommitState{submitted, failed}, states)
+
+		states, err = repo.GetSettlementCommitStatesByEpoch(t.Context(), epoch+2)
+		require.NoError(t, err)
+		require.Empty(t, states)
+	})
+	t.Run("prune proof entities removes states of the epoch", func(t *testing.T) {
+		require.NoError(t, repo.PruneProofEntities(t.Context(), epoch))
+
+		states, err := repo.GetSettlementCommitStatesByEpoch(t.Context(), epoch)
+		require.NoError(t, err)
+		require.Empty(t, states)
+
+		_, err = repo.GetSettlementCommitState(t.Context(), epoch+1, first)
+		require.NoError(t, err)
+	})
+}
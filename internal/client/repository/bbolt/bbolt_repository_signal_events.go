@@ -0,0 +1,165 @@
+package bbolt
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/go-errors/errors"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/symbioticfi/relay/internal/client/repository/codec"
+	"github.com/symbioticfi/relay/internal/entity"
+	"github.com/symbioticfi/relay/pkg/signals"
+)
+
+// Signal events are stored in a nested bucket per signal id, keyed by seq(8).
+// The nested bucket of bucketSignalEvents also provides the sequence for new events.
+
+func (r *Repository) SaveSignalEvent(ctx context.Context, signalID string, payload []byte) (signals.StoredEvent, error) {
+	var event signals.StoredEvent
+
+	err := r.doUpdate(ctx, "SaveSignalEvent", func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(bucketSignalEvents).CreateBucketIfNotExists([]byte(signalID))
+		if err != nil {
+			return errors.Errorf("failed to create signal events bucket: %w", err)
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return errors.Errorf("failed to get next signal event sequence: %w", err)
+		}
+
+		now := time.Now()
+		event = signals.StoredEvent{
+			Seq:           seq,
+			Payload:       payload,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		}
+		data, err := codec.SignalEventToBytes(event)
+		if err != nil {
+			return errors.Errorf("failed to marshal signal event: %w", err)
+		}
+		return b.Put(epochBytes(seq), data)
+	})
+	if err != nil {
+		return signals.StoredEvent{}, err
+	}
+
+	return event, nil
+}
+
+func (r *Repository) GetDueSignalEvents(ctx context.Context, signalID string, now time.Time, limit int) ([]signals.StoredEvent, error) {
+	var events []signals.StoredEvent
+
+	err := r.doView(ctx, "GetDueSignalEvents", func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketSignalEvents).Bucket([]byte(signalID))
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if limit > 0 && len(events) >= limit {
+				break
+			}
+			event, err := codec.BytesToSignalEvent(binary.BigEndian.Uint64(k), v)
+			if err != nil {
+				return errors.Errorf("failed to unmarshal signal event: %w", err)
+			}
+			if event.NextAttemptAt.After(now) {
+				continue
+			}
+			events = append(events, event)
+		}
+		return nil
+	})
+
+	return events, err
+}
+
+func (r *Repository) UpdateSignalEvent(ctx context.Context, signalID string, event signals.StoredEvent) error {
+	return r.doUpdate(ctx, "UpdateSignalEvent", func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketSignalEvents).Bucket([]byte(signalID))
+		if b == nil || b.Get(epochBytes(event.Seq)) == nil {
+			return errors.Errorf("signal event %d not found for %s signal: %w", event.Seq, signalID, entity.ErrEntityNotFound)
+		}
+		data, err := codec.SignalEventToBytes(event)
+		if err != nil {
+			return errors.Errorf("failed to marshal signal event: %w", err)
+		}
+		return b.Put(epochBytes(event.Seq), data)
+	})
+}
+
+func (r *Repository) RemoveSignalEvent(ctx context.Context, signalID string, seq uint64) error {
+	return r.doUpdate(ctx, "RemoveSignalEvent", func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketSignalEvents).Bucket([]byte(signalID))
+		if b == nil || b.Get(epochBytes(seq)) == nil {
+			return errors.Errorf("signal event %d not found for %s signal: %w", seq, signalID, entity.ErrEntityNotFound)
+		}
+		return b.Delete(epochBytes(seq))
+	})
+}
+
+func (r *Repository) MoveSignalEventToDeadLetter(ctx context.Context, signalID string, event signals.StoredEvent) error {
+	return r.doUpdate(ctx, "MoveSignalEventToDeadLetter", func(tx *bolt.Tx) error {
+		pending := tx.Bucket(bucketSignalEvents).Bucket([]byte(signalID))
+		if pending == nil || pending.Get(epochBytes(event.Seq)) == nil {
+			return errors.Errorf("signal event %d not found for %s signal: %w", event.Seq, signalID, entity.ErrEntityNotFound)
+		}
+		if err := pending.Delete(epochBytes(event.Seq)); err != nil {
+			return errors.Errorf("failed to delete signal event: %w", err)
+		}
+
+		deadLetters, err := tx.Bucket(bucketSignalDeadLetters).CreateBucketIfNotExists([]byte(signalID))
+		if err != nil {
+			return errors.Errorf("failed to create signal dead letters bucket: %w", err)
+		}
+		data, err := codec.SignalEventToBytes(event)
+		if err != nil {
+			return errors.Errorf("failed to marshal signal event: %w", err)
+		}
+		return deadLetters.Put(epochBytes(event.Seq), data)
+	})
+}
+
+func (r *Repository) GetDeadLetterSignalEvents(ctx context.Context, signalID string, limit int) ([]signals.StoredEvent, error) {
+	var events []signals.StoredEvent
+
+	err := r.doView(ctx, "GetDeadLetterSignalEvents", func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketSignalDeadLetters).Bucket([]byte(signalID))
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if limit > 0 && len(events) >= limit {
+				break
+			}
+			event, err := codec.BytesToSignalEvent(binary.BigEndian.Uint64(k), v)
+			if err != nil {
+				return errors.Errorf("failed to unmarshal signal event: %w", err)
+			}
+			events = append(events, event)
+		}
+		return nil
+	})
+
+	return events, err
+}
+
+func (r *Repository) CountSignalEvents(ctx context.Context, signalID string) (int, int, error) {
+	var pending, deadLetters int
+
+	err := r.doView(ctx, "CountSignalEvents", func(tx *bolt.Tx) error {
+		if b := tx.Bucket(bucketSignalEvents).Bucket([]byte(signalID)); b != nil {
+			pending = b.Stats().KeyN
+		}
+		if b := tx.Bucket(bucketSignalDeadLetters).Bucket([]byte(signalID)); b != nil {
+			deadLetters = b.Stats().KeyN
+		}
+		return nil
+	})
+
+	return pending, deadLetters, err
+}
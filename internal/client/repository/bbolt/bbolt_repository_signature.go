@@ -231,6 +231,46 @@ func (r *Repository) GetSignatureRequest(ctx context.Context, requestID common.H
 	return req, err
 }
 
+// CancelSignatureRequest marks the request as cancelled and removes it from the signature and aggregation pending collections
+func (r *Repository) CancelSignatureRequest(ctx context.Context, requestID common.Hash) error {
+	return r.doUpdate(ctx, "CancelSignatureRequest", func(tx *bolt.Tx) error {
+		epochVal := tx.Bucket(bucketRequestIDIndex).Get(requestID.Bytes())
+		if epochVal == nil {
+			return errors.Errorf("no signature request found for request id %s: %w", requestID.String(), entity.ErrEntityNotFound)
+		}
+
+		key := epochHashKey(binary.BigEndian.Uint64(epochVal), requestID.Bytes())
+		b := tx.Bucket(bucketSignatureRequests)
+		v := b.Get(key)
+		if v == nil {
+			return errors.Errorf("failed to get signature request: %w", entity.ErrEntityNotFound)
+		}
+
+		req, err := codec.BytesToSignatureRequest(v)
+		if err != nil {
+			return errors.Errorf("failed to unmarshal signature request: %w", err)
+		}
+		req.Cancelled = true
+
+		data, err := codec.SignatureRequestToBytes(req)
+		if err != nil {
+			return errors.Errorf("failed to marshal signature request: %w", err)
+		}
+		if err := b.Put(key, data); err != nil {
+			return errors.Errorf("failed to store signature request: %w", err)
+		}
+
+		// pending markers share the key of the request
+		if err := tx.Bucket(bucketSignaturePending).Delete(key); err != nil {
+			return errors.Errorf("failed to remove pending signature: %w", err)
+		}
+		if err := tx.Bucket(bucketAggProofPending).Delete(key); err != nil {
+			return errors.Errorf("failed to remove pending aggregation proof: %w", err)
+		}
+		return nil
+	})
+}
+
 func (r *Repository) GetSignatureRequestsByEpoch(ctx context.Context, epoch symbiotic.Epoch, limit int, lastHash common.Hash) ([]symbiotic.SignatureRequest, error) {
 	var requests []symbiotic.SignatureRequest
 
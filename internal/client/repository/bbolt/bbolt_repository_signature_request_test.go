@@ -4,10 +4,12 @@ import (
 	"sort"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
+	"github.com/symbioticfi/relay/internal/entity"
 	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
 
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
@@ -67,6 +69,70 @@ func TestRepository_SignatureRequest(t *testing.T) {
 	require.Equal(t, req, loadedReq)
 }
 
+func TestRepository_SignatureRequest_WithTypedData(t *testing.T) {
+	t.Parallel()
+	repo := setupTestRepository(t)
+
+	typedData, err := symbiotic.ParseTypedData([]byte(`{
+		"types": {
+			"EIP712Domain": [{"name": "name", "type": "string"}, {"name": "chainId", "type": "uint256"}],
+			"Vote": [{"name": "proposal", "type": "uint256"}, {"name": "support", "type": "bool"}]
+		},
+		"primaryType": "Vote",
+		"domain": {"name": "Governor", "chainId": 1},
+		"message": {"proposal": 42, "support": true}
+	}`))
+	require.NoError(t, err)
+	message, err := symbiotic.EncodeTypedData(typedData)
+	require.NoError(t, err)
+
+	req := symbiotic.SignatureRequest{
+		KeyTag:        symbiotic.KeyTag(15),
+		RequiredEpoch: symbiotic.Epoch(1),
+		Message:       message,
+		TypedData:     &typedData,
+	}
+	requestId := signatureRequestID(t, req)
+	require.NoError(t, repo.SaveSignatureRequest(t.Context(), requestId, req))
+
+	loadedReq, err := repo.GetSignatureRequest(t.Context(), requestId)
+	require.NoError(t, err)
+	require.Equal(t, req, loadedReq)
+}
+
+func TestRepository_CancelSignatureRequest(t *testing.T) {
+	t.Parallel()
+	repo := setupTestRepository(t)
+
+	req := randomSignatureRequest(t)
+	req.Deadline = time.Unix(1_900_000_000, 0)
+	req.Submitted = true
+	requestId := signatureRequestID(t, req)
+	require.NoError(t, repo.SaveSignatureRequest(t.Context(), requestId, req))
+	require.NoError(t, repo.saveAggregationProofPending(t.Context(), requestId, req.RequiredEpoch))
+
+	loadedReq, err := repo.GetSignatureRequest(t.Context(), requestId)
+	require.NoError(t, err)
+	require.Equal(t, req, loadedReq)
+
+	require.NoError(t, repo.CancelSignatureRequest(t.Context(), requestId))
+
+	loadedReq, err = repo.GetSignatureRequest(t.Context(), requestId)
+	require.NoError(t, err)
+	req.Cancelled = true
+	require.Equal(t, req, loadedReq)
+
+	pending, err := repo.GetSignaturePending(t.Context(), 0)
+	require.NoError(t, err)
+	require.NotContains(t, pending, requestId)
+	withoutProof, err := repo.GetSignatureRequestsWithoutAggregationProof(t.Context(), req.RequiredEpoch, 0, common.Hash{})
+	require.NoError(t, err)
+	require.Empty(t, withoutProof)
+
+	err = repo.CancelSignatureRequest(t.Context(), common.HexToHash("0x01"))
+	require.ErrorIs(t, err, entity.ErrEntityNotFound)
+}
+
 func TestRepository_GetSignatureRequestsByEpoch(t *testing.T) {
 	t.Parallel()
 	repo := setupTestRepository(t)
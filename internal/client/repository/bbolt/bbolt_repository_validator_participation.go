@@ -0,0 +1,68 @@
+package bbolt
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/go-errors/errors"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/symbioticfi/relay/internal/client/repository/codec"
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// Key format: epoch(8) + operator(20)
+func validatorParticipationKey(participation entity.ValidatorParticipation) []byte {
+	return append(epochBytes(uint64(participation.Epoch)), participation.Operator.Bytes()...)
+}
+
+// AddValidatorParticipations adds the counts of the records to the stored records of the same validator and epoch.
+func (r *Repository) AddValidatorParticipations(ctx context.Context, participations []entity.ValidatorParticipation) error {
+	return r.doUpdate(ctx, "AddValidatorParticipations", func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketValParticipation)
+		for _, participation := range participations {
+			key := validatorParticipationKey(participation)
+
+			stored := entity.ValidatorParticipation{Epoch: participation.Epoch, Operator: participation.Operator}
+			if v := bucket.Get(key); v != nil {
+				var err error
+				stored, err = codec.BytesToValidatorParticipation(v)
+				if err != nil {
+					return errors.Errorf("failed to unmarshal validator participation: %w", err)
+				}
+			}
+
+			stored.Add(participation)
+
+			data, err := codec.ValidatorParticipationToBytes(stored)
+			if err != nil {
+				return errors.Errorf("failed to marshal validator participation: %w", err)
+			}
+			if err := bucket.Put(key, data); err != nil {
+				return errors.Errorf("failed to store validator participation: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetValidatorParticipations returns the participation records of all validators for the epoch ordered by operator.
+func (r *Repository) GetValidatorParticipations(ctx context.Context, epoch symbiotic.Epoch) ([]entity.ValidatorParticipation, error) {
+	var participations []entity.ValidatorParticipation
+
+	err := r.doView(ctx, "GetValidatorParticipations", func(tx *bolt.Tx) error {
+		prefix := epochBytes(uint64(epoch))
+		c := tx.Bucket(bucketValParticipation).Cursor()
+
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			participation, err := codec.BytesToValidatorParticipation(v)
+			if err != nil {
+				return errors.Errorf("failed to unmarshal validator participation: %w", err)
+			}
+			participations = append(participations, participation)
+		}
+		return nil
+	})
+	return participations, err
+}
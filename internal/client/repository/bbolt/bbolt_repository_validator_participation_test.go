@@ -0,0 +1,68 @@
+package bbolt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func TestRepository_ValidatorParticipation(t *testing.T) {
+	t.Parallel()
+	repo := setupTestRepository(t)
+
+	epoch := symbiotic.Epoch(10)
+	first := common.HexToAddress("0x01")
+	second := common.HexToAddress("0x02")
+
+	signed := entity.ValidatorParticipation{Epoch: epoch, Operator: first, Seen: 1, Signed: 1}
+	signed.ObserveLatency(200 * time.Millisecond)
+	missed := entity.ValidatorParticipation{Epoch: epoch, Operator: second, Seen: 1, Missed: 1}
+
+	t.Run("no records", func(t *testing.T) {
+		participations, err := repo.GetValidatorParticipations(t.Context(), epoch)
+		require.NoError(t, err)
+		require.Empty(t, participations)
+	})
+
+	t.Run("add creates records", func(t *testing.T) {
+		require.NoError(t, repo.AddValidatorParticipations(t.Context(), []entity.ValidatorParticipation{missed, signed}))
+
+		participations, err := repo.GetValidatorParticipations(t.Context(), epoch)
+		require.NoError(t, err)
+		require.Equal(t, []entity.ValidatorParticipation{signed, missed}, participations)
+	})
+
+	t.Run("add accumulates counts", func(t *testing.T) {
+		again := entity.ValidatorParticipation{Epoch: epoch, Operator: first, Seen: 2, Signed: 1, Missed: 1}
+		again.ObserveLatency(2 * time.Second)
+		require.NoError(t, repo.AddValidatorParticipations(t.Context(), []entity.ValidatorParticipation{
+			again,
+			{Epoch: epoch + 1, Operator: first, Seen: 1, Signed: 1},
+		}))
+
+		participations, err := repo.GetValidatorParticipations(t.Context(), epoch)
+		require.NoError(t, err)
+		require.Len(t, participations, 2)
+		require.Equal(t, uint64(3), participations[0].Seen)
+		require.Equal(t, uint64(2), participations[0].Signed)
+		require.Equal(t, uint64(1), participations[0].Missed)
+		require.Equal(t, []uint64{0, 0, 1, 0, 0, 1, 0, 0, 0, 0, 0}, participations[0].LatencyBuckets)
+	})
+
+	t.Run("prune signature entities removes records of the epoch", func(t *testing.T) {
+		require.NoError(t, repo.PruneSignatureEntitiesForEpoch(t.Context(), epoch))
+
+		participations, err := repo.GetValidatorParticipations(t.Context(), epoch)
+		require.NoError(t, err)
+		require.Empty(t, participations)
+
+		participations, err = repo.GetValidatorParticipations(t.Context(), epoch+1)
+		require.NoError(t, err)
+		require.Len(t, participations, 1)
+	})
+}
@@ -2,12 +2,14 @@ package cached
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-errors/errors"
 
 	"github.com/symbioticfi/relay/internal/client/repository/cache"
 	"github.com/symbioticfi/relay/internal/entity"
+	"github.com/symbioticfi/relay/pkg/signals"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
 )
 
@@ -33,10 +35,13 @@ type Repository interface {
 	GetSignatureRequestIDsByEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]common.Hash, error)
 	GetSignaturePending(ctx context.Context, limit int) ([]common.Hash, error)
 	RemoveSignaturePending(ctx context.Context, epoch symbiotic.Epoch, requestID common.Hash) error
+	CancelSignatureRequest(ctx context.Context, requestID common.Hash) error
 
 	// Aggregation Proofs
 	SaveProof(ctx context.Context, aggregationProof symbiotic.AggregationProof) error
+	UpgradeProof(ctx context.Context, aggregationProof symbiotic.AggregationProof) error
 	GetAggregationProof(ctx context.Context, requestID common.Hash) (symbiotic.AggregationProof, error)
+	GetAggregationProofCreatedAt(ctx context.Context, requestID common.Hash) (time.Time, error)
 	GetAggregationProofsByEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.AggregationProof, error)
 	GetAggregationProofsStartingFromEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.AggregationProof, error)
 	GetSignatureRequestsWithoutAggregationProof(ctx context.Context, epoch symbiotic.Epoch, limit int, lastHash common.Hash) ([]symbiotic.SignatureRequestWithID, error)
@@ -67,6 +72,34 @@ type Repository interface {
 	// Proof Commits
 	GetPendingProofCommitsSinceEpoch(ctx context.Context, epoch symbiotic.Epoch, limit int) ([]symbiotic.ProofCommitKey, error)
 
+	// Settlement Commits
+	SaveSettlementCommitState(ctx context.Context, state symbiotic.SettlementCommitState) error
+	GetSettlementCommitState(ctx context.Context, epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress) (symbiotic.SettlementCommitState, error)
+	GetSettlementCommitStatesByEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.SettlementCommitState, error)
+
+	// Validator Participation
+	AddValidatorParticipations(ctx context.Context, participations []entity.ValidatorParticipation) error
+	GetValidatorParticipations(ctx context.Context, epoch symbiotic.Epoch) ([]entity.ValidatorParticipation, error)
+
+	// Equivocation Evidence
+	SaveHeaderCommitmentSignature(ctx context.Context, keyTag symbiotic.KeyTag, operator common.Address, signed symbiotic.SignedHeaderCommitment) (symbiotic.SignedHeaderCommitment, error)
+	SaveEquivocationEvidence(ctx context.Context, evidence symbiotic.EquivocationEvidence) error
+	GetEquivocationEvidenceByEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.EquivocationEvidence, error)
+	GetEquivocationEvidenceStartingFromEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.EquivocationEvidence, error)
+
+	// Message Batches
+	SaveMessageBatch(ctx context.Context, batch symbiotic.MessageBatch) error
+	GetMessageBatch(ctx context.Context, requestID common.Hash) (symbiotic.MessageBatch, error)
+
+	// Signal Events
+	SaveSignalEvent(ctx context.Context, signalID string, payload []byte) (signals.StoredEvent, error)
+	GetDueSignalEvents(ctx context.Context, signalID string, now time.Time, limit int) ([]signals.StoredEvent, error)
+	UpdateSignalEvent(ctx context.Context, signalID string, event signals.StoredEvent) error
+	RemoveSignalEvent(ctx context.Context, signalID string, seq uint64) error
+	MoveSignalEventToDeadLetter(ctx context.Context, signalID string, event signals.StoredEvent) error
+	GetDeadLetterSignalEvents(ctx context.Context, signalID string, limit int) ([]signals.StoredEvent, error)
+	CountSignalEvents(ctx context.Context, signalID string) (pending int, deadLetters int, err error)
+
 	// Composite Operations
 	SaveNextValsetData(ctx context.Context, data entity.NextValsetData) error
 
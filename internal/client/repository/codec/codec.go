@@ -1,7 +1,9 @@
 package codec
 
 import (
+	"encoding/json"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
@@ -11,6 +13,7 @@ import (
 
 	pb "github.com/symbioticfi/relay/internal/client/repository/badger/proto/v1"
 	"github.com/symbioticfi/relay/internal/entity"
+	"github.com/symbioticfi/relay/pkg/signals"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
 	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
 )
@@ -112,10 +115,29 @@ func BytesToSignatureMap(data []byte) (entity.SignatureMap, error) {
 // SignatureRequest
 
 func SignatureRequestToBytes(req symbiotic.SignatureRequest) ([]byte, error) {
+	var typedData []byte
+	if req.TypedData != nil {
+		var err error
+		typedData, err = json.Marshal(req.TypedData)
+		if err != nil {
+			return nil, errors.Errorf("failed to marshal typed data: %w", err)
+		}
+	}
+
+	var deadline int64
+	if !req.Deadline.IsZero() {
+		deadline = req.Deadline.UnixNano()
+	}
+
 	return MarshalProto(&pb.SignatureRequest{
 		KeyTag:        uint32(req.KeyTag),
 		RequiredEpoch: uint64(req.RequiredEpoch),
 		Message:       req.Message,
+		TypedData:     typedData,
+		Deadline:      deadline,
+		Submitted:     req.Submitted,
+		Cancelled:     req.Cancelled,
+		Priority:      uint32(req.Priority),
 	})
 }
 
@@ -125,11 +147,26 @@ func BytesToSignatureRequest(data []byte) (symbiotic.SignatureRequest, error) {
 		return symbiotic.SignatureRequest{}, errors.Errorf("failed to unmarshal signature request: %w", err)
 	}
 
-	return symbiotic.SignatureRequest{
+	req := symbiotic.SignatureRequest{
 		KeyTag:        symbiotic.KeyTag(signatureRequest.GetKeyTag()),
 		RequiredEpoch: symbiotic.Epoch(signatureRequest.GetRequiredEpoch()),
 		Message:       signatureRequest.GetMessage(),
-	}, nil
+		Submitted:     signatureRequest.GetSubmitted(),
+		Cancelled:     signatureRequest.GetCancelled(),
+		Priority:      symbiotic.PriorityClass(signatureRequest.GetPriority()),
+	}
+	if deadline := signatureRequest.GetDeadline(); deadline != 0 {
+		req.Deadline = time.Unix(0, deadline)
+	}
+	if len(signatureRequest.GetTypedData()) > 0 {
+		typedData, err := symbiotic.ParseTypedData(signatureRequest.GetTypedData())
+		if err != nil {
+			return symbiotic.SignatureRequest{}, errors.Errorf("failed to unmarshal typed data: %w", err)
+		}
+		req.TypedData = &typedData
+	}
+
+	return req, nil
 }
 
 // AggregationProof
@@ -461,3 +498,200 @@ func BytesToNetworkConfig(data []byte) (symbiotic.NetworkConfig, error) {
 func ValidatorKeyHash(publicKey []byte) common.Hash {
 	return ethcrypto.Keccak256Hash(publicKey)
 }
+
+// SignalEvent
+
+func SignalEventToBytes(event signals.StoredEvent) ([]byte, error) {
+	return MarshalProto(&pb.SignalEvent{
+		Payload:       event.Payload,
+		Attempts:      event.Attempts,
+		NextAttemptAt: event.NextAttemptAt.UnixNano(),
+		LastError:     event.LastError,
+		CreatedAt:     event.CreatedAt.UnixNano(),
+	})
+}
+
+func BytesToSignalEvent(seq uint64, data []byte) (signals.StoredEvent, error) {
+	eventPB := &pb.SignalEvent{}
+	if err := UnmarshalProto(data, eventPB); err != nil {
+		return signals.StoredEvent{}, errors.Errorf("failed to unmarshal signal event: %w", err)
+	}
+
+	return signals.StoredEvent{
+		Seq:           seq,
+		Payload:       eventPB.GetPayload(),
+		Attempts:      eventPB.GetAttempts(),
+		NextAttemptAt: time.Unix(0, eventPB.GetNextAttemptAt()),
+		LastError:     eventPB.GetLastError(),
+		CreatedAt:     time.Unix(0, eventPB.GetCreatedAt()),
+	}, nil
+}
+
+// SettlementCommitState
+
+func SettlementCommitStateToBytes(state symbiotic.SettlementCommitState) ([]byte, error) {
+	return MarshalProto(&pb.SettlementCommitState{
+		Epoch: uint64(state.Epoch),
+		Settlement: &pb.CrossChainAddress{
+			Address: state.Settlement.Address.Bytes(),
+			ChainId: state.Settlement.ChainId,
+		},
+		Status:    uint32(state.Status),
+		TxHash:    state.TxHash.Bytes(),
+		Attempts:  state.Attempts,
+		LastError: state.LastError,
+		UpdatedAt: state.UpdatedAt.UnixNano(),
+	})
+}
+
+func BytesToSettlementCommitState(data []byte) (symbiotic.SettlementCommitState, error) {
+	statePB := &pb.SettlementCommitState{}
+	if err := UnmarshalProto(data, statePB); err != nil {
+		return symbiotic.SettlementCommitState{}, errors.Errorf("failed to unmarshal settlement commit state: %w", err)
+	}
+
+	return symbiotic.SettlementCommitState{
+		Epoch: symbiotic.Epoch(statePB.GetEpoch()),
+		Settlement: symbiotic.CrossChainAddress{
+			ChainId: statePB.GetSettlement().GetChainId(),
+			Address: common.BytesToAddress(statePB.GetSettlement().GetAddress()),
+		},
+		Status:    symbiotic.SettlementCommitStatus(statePB.GetStatus()),
+		TxHash:    common.BytesToHash(statePB.GetTxHash()),
+		Attempts:  statePB.GetAttempts(),
+		LastError: statePB.GetLastError(),
+		UpdatedAt: time.Unix(0, statePB.GetUpdatedAt()),
+	}, nil
+}
+
+func MessageBatchToBytes(batch symbiotic.MessageBatch) ([]byte, error) {
+	leaves := make([][]byte, len(batch.Leaves))
+	for i, leaf := range batch.Leaves {
+		leaves[i] = leaf.Bytes()
+	}
+
+	return MarshalProto(&pb.MessageBatch{
+		RequestId: batch.RequestID.Bytes(),
+		Epoch:     uint64(batch.Epoch),
+		KeyTag:    uint32(batch.KeyTag),
+		Root:      batch.Root.Bytes(),
+		Leaves:    leaves,
+	})
+}
+
+func BytesToMessageBatch(data []byte) (symbiotic.MessageBatch, error) {
+	batchPB := &pb.MessageBatch{}
+	if err := UnmarshalProto(data, batchPB); err != nil {
+		return symbiotic.MessageBatch{}, errors.Errorf("failed to unmarshal message batch: %w", err)
+	}
+
+	leaves := make([]common.Hash, len(batchPB.GetLeaves()))
+	for i, leaf := range batchPB.GetLeaves() {
+		leaves[i] = common.BytesToHash(leaf)
+	}
+
+	return symbiotic.MessageBatch{
+		RequestID: common.BytesToHash(batchPB.GetRequestId()),
+		Epoch:     symbiotic.Epoch(batchPB.GetEpoch()),
+		KeyTag:    symbiotic.KeyTag(batchPB.GetKeyTag()),
+		Root:      common.BytesToHash(batchPB.GetRoot()),
+		Leaves:    leaves,
+	}, nil
+}
+
+// ValidatorParticipation
+
+func ValidatorParticipationToBytes(participation entity.ValidatorParticipation) ([]byte, error) {
+	return MarshalProto(&pb.ValidatorParticipation{
+		Epoch:          uint64(participation.Epoch),
+		Operator:       participation.Operator.Bytes(),
+		Seen:           participation.Seen,
+		Signed:         participation.Signed,
+		Missed:         participation.Missed,
+		LatencyBuckets: participation.LatencyBuckets,
+	})
+}
+
+func BytesToValidatorParticipation(data []byte) (entity.ValidatorParticipation, error) {
+	participationPB := &pb.ValidatorParticipation{}
+	if err := UnmarshalProto(data, participationPB); err != nil {
+		return entity.ValidatorParticipation{}, errors.Errorf("failed to unmarshal validator participation: %w", err)
+	}
+
+	return entity.ValidatorParticipation{
+		Epoch:          symbiotic.Epoch(participationPB.GetEpoch()),
+		Operator:       common.BytesToAddress(participationPB.GetOperator()),
+		Seen:           participationPB.GetSeen(),
+		Signed:         participationPB.GetSigned(),
+		Missed:         participationPB.GetMissed(),
+		LatencyBuckets: participationPB.GetLatencyBuckets(),
+	}, nil
+}
+
+// SignedHeaderCommitment
+
+func signedHeaderCommitmentToPB(signed symbiotic.SignedHeaderCommitment) *pb.SignedHeaderCommitment {
+	return &pb.SignedHeaderCommitment{
+		DomainSeparator: signed.Commitment.DomainSeparator.Bytes(),
+		Subnetwork:      signed.Commitment.Subnetwork.Bytes(),
+		Epoch:           uint64(signed.Commitment.Epoch),
+		HeaderHash:      signed.Commitment.HeaderHash.Bytes(),
+		ExtraDataHash:   signed.Commitment.ExtraDataHash.Bytes(),
+		Signature:       signed.Signature,
+	}
+}
+
+func signedHeaderCommitmentFromPB(signedPB *pb.SignedHeaderCommitment) symbiotic.SignedHeaderCommitment {
+	return symbiotic.SignedHeaderCommitment{
+		Commitment: symbiotic.ValSetHeaderCommitment{
+			DomainSeparator: common.BytesToHash(signedPB.GetDomainSeparator()),
+			Subnetwork:      common.BytesToHash(signedPB.GetSubnetwork()),
+			Epoch:           symbiotic.Epoch(signedPB.GetEpoch()),
+			HeaderHash:      common.BytesToHash(signedPB.GetHeaderHash()),
+			ExtraDataHash:   common.BytesToHash(signedPB.GetExtraDataHash()),
+		},
+		Signature: signedPB.GetSignature(),
+	}
+}
+
+func SignedHeaderCommitmentToBytes(signed symbiotic.SignedHeaderCommitment) ([]byte, error) {
+	return MarshalProto(signedHeaderCommitmentToPB(signed))
+}
+
+func BytesToSignedHeaderCommitment(data []byte) (symbiotic.SignedHeaderCommitment, error) {
+	signedPB := &pb.SignedHeaderCommitment{}
+	if err := UnmarshalProto(data, signedPB); err != nil {
+		return symbiotic.SignedHeaderCommitment{}, errors.Errorf("failed to unmarshal signed header commitment: %w", err)
+	}
+
+	return signedHeaderCommitmentFromPB(signedPB), nil
+}
+
+// EquivocationEvidence
+
+func EquivocationEvidenceToBytes(evidence symbiotic.EquivocationEvidence) ([]byte, error) {
+	return MarshalProto(&pb.EquivocationEvidence{
+		Operator:   evidence.Operator.Bytes(),
+		KeyTag:     uint32(evidence.KeyTag),
+		PublicKey:  evidence.PublicKey,
+		First:      signedHeaderCommitmentToPB(evidence.First),
+		Second:     signedHeaderCommitmentToPB(evidence.Second),
+		DetectedAt: evidence.DetectedAt.UnixNano(),
+	})
+}
+
+func BytesToEquivocationEvidence(data []byte) (symbiotic.EquivocationEvidence, error) {
+	evidencePB := &pb.EquivocationEvidence{}
+	if err := UnmarshalProto(data, evidencePB); err != nil {
+		return symbiotic.EquivocationEvidence{}, errors.Errorf("failed to unmarshal equivocation evidence: %w", err)
+	}
+
+	return symbiotic.EquivocationEvidence{
+		Operator:   common.BytesToAddress(evidencePB.GetOperator()),
+		KeyTag:     symbiotic.KeyTag(evidencePB.GetKeyTag()),
+		PublicKey:  evidencePB.GetPublicKey(),
+		First:      signedHeaderCommitmentFromPB(evidencePB.GetFirst()),
+		Second:     signedHeaderCommitmentFromPB(evidencePB.GetSecond()),
+		DetectedAt: time.Unix(0, evidencePB.GetDetectedAt()),
+	}, nil
+}
@@ -0,0 +1,494 @@
+package devnet
+
+import (
+	"maps"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// Deployer is the account the stubbed contracts are deployed from, contract addresses are derived from it
+// the same way they would be on a fresh anvil chain.
+var Deployer = common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+// ChainStubConfig is the network configuration the stubbed driver contract is deployed with.
+type ChainStubConfig struct {
+	ChainID uint64
+	// EpochDuration is the nominal epoch duration in seconds reported by the driver,
+	// epochs only start when AdvanceEpoch is called
+	EpochDuration         uint64
+	VerificationType      symbiotic.VerificationType
+	RequiredKeyTags       []symbiotic.KeyTag
+	RequiredHeaderKeyTag  symbiotic.KeyTag
+	QuorumThreshold       *big.Int // 10^18 is 100%
+	NumAggregators        uint64
+	NumCommitters         uint64
+	CommitterSlotDuration uint64 // in seconds
+	// NumSettlements is the number of settlement contracts headers are committed to
+	NumSettlements int
+	// FinalityDelay is the time until committed headers are final, only headers that are not final
+	// can be dropped by reorgs
+	FinalityDelay time.Duration
+}
+
+// DefaultChainStubConfig returns the config of a BLS BN254 network with 2/3 quorum.
+func DefaultChainStubConfig() ChainStubConfig {
+	keyTag := symbiotic.KeyTag(15) // BLS BN254 key with id 15
+	return ChainStubConfig{
+		ChainID:               31337,
+		EpochDuration:         1,
+		VerificationType:      symbiotic.VerificationTypeBlsBn254Simple,
+		RequiredKeyTags:       []symbiotic.KeyTag{keyTag},
+		RequiredHeaderKeyTag:  keyTag,
+		QuorumThreshold:       big.NewInt(666666666666666667),
+		NumAggregators:        1,
+		NumCommitters:         1,
+		CommitterSlotDuration: 10,
+		NumSettlements:        1,
+	}
+}
+
+// Contracts holds the addresses of the stubbed contracts.
+type Contracts struct {
+	Driver              common.Address
+	Settlements         []common.Address
+	KeyRegistry         common.Address
+	VotingPowerProvider common.Address
+	OperatorRegistry    common.Address
+}
+
+type operatorState struct {
+	inRegistry  bool
+	registered  bool // registered in the voting power provider
+	votingPower *big.Int
+	keys        map[symbiotic.KeyTag]symbiotic.CompactPublicKey
+}
+
+// stateChange is a change of operator state that is visible to validator sets captured from the given epoch on.
+type stateChange struct {
+	epoch    symbiotic.Epoch
+	operator common.Address
+	apply    func(state *operatorState)
+}
+
+const (
+	// txGasUsed and txGasPrice are the gas used and the gas price in wei of every devnet transaction
+	txGasUsed  = 21000
+	txGasPrice = 1_000_000_000
+)
+
+type committedHeader struct {
+	header      symbiotic.ValidatorSetHeader
+	extraData   []symbiotic.ExtraData
+	proof       []byte
+	finalizedAt time.Time
+}
+
+type settlementState struct {
+	headers       map[symbiotic.Epoch]committedHeader
+	genesis       symbiotic.Epoch
+	genesisSet    bool
+	lastCommitted symbiotic.Epoch
+}
+
+// lastCommittedEpoch returns the epoch of the latest committed header, or of the latest final one if finalized is set.
+func (s *settlementState) lastCommittedEpoch(finalized bool, now time.Time) symbiotic.Epoch {
+	if !finalized {
+		return s.lastCommitted
+	}
+	epoch := s.genesis
+	for e, header := range s.headers {
+		if e > epoch && !now.Before(header.finalizedAt) {
+			epoch = e
+		}
+	}
+	return epoch
+}
+
+// ChainStub stands in for a chain with the driver, settlement, key registry, operator registry and voting power provider
+// contracts of a symbiotic network. It is not an EVM: the contract state is kept in Go and answers the same queries
+// as the contracts, so relays run against it without an RPC endpoint, but the contract code, its ABI encoding and
+// the transaction and revert handling of evm.Client are not exercised. The e2e suite runs relays against the
+// deployed contracts.
+// Quorum proofs of committed headers are verified by Client with the Go verifier of the verification type,
+// the chain only checks that the proof was verified against the validator set of the last committed header.
+//
+// Operator changes made before the genesis header is set are part of the current epoch,
+// later changes are captured by the next epoch.
+type ChainStub struct {
+	cfg       ChainStubConfig
+	contracts Contracts
+
+	mu          sync.RWMutex
+	epochStarts []symbiotic.Timestamp
+	changes     []stateChange
+	settlements map[common.Address]*settlementState
+	genesisSet  bool
+	nonces      map[common.Address]int64
+	txCount     uint64
+}
+
+func NewChainStub(cfg ChainStubConfig) (*ChainStub, error) {
+	if cfg.ChainID == 0 {
+		return nil, errors.New("chain id is required")
+	}
+	if cfg.EpochDuration == 0 {
+		return nil, errors.New("epoch duration is required")
+	}
+	if cfg.QuorumThreshold == nil || cfg.QuorumThreshold.Sign() <= 0 {
+		return nil, errors.New("quorum threshold must be positive")
+	}
+	if !slices.Contains(cfg.RequiredKeyTags, cfg.RequiredHeaderKeyTag) {
+		return nil, errors.Errorf("required header key tag %d is not a required key tag", cfg.RequiredHeaderKeyTag)
+	}
+	if cfg.NumSettlements < 1 {
+		return nil, errors.New("at least one settlement is required")
+	}
+	if cfg.VerificationType != symbiotic.VerificationTypeBlsBn254Simple {
+		// zk proofs can't be verified without the circuits of a prover
+		return nil, errors.Errorf("unsupported verification type %d", cfg.VerificationType)
+	}
+
+	contracts := Contracts{
+		Driver:              crypto.CreateAddress(Deployer, 0),
+		Settlements:         []common.Address{crypto.CreateAddress(Deployer, 1)},
+		KeyRegistry:         crypto.CreateAddress(Deployer, 2),
+		VotingPowerProvider: crypto.CreateAddress(Deployer, 3),
+		OperatorRegistry:    crypto.CreateAddress(Deployer, 4),
+	}
+	// additional settlements are deployed after the other contracts
+	for i := 1; i < cfg.NumSettlements; i++ {
+		contracts.Settlements = append(contracts.Settlements, crypto.CreateAddress(Deployer, uint64(4+i)))
+	}
+	settlements := make(map[common.Address]*settlementState, len(contracts.Settlements))
+	for _, settlement := range contracts.Settlements {
+		settlements[settlement] = &settlementState{headers: make(map[symbiotic.Epoch]committedHeader)}
+	}
+
+	return &ChainStub{
+		cfg:         cfg,
+		contracts:   contracts,
+		epochStarts: []symbiotic.Timestamp{symbiotic.Timestamp(uint64(time.Now().Unix()))},
+		settlements: settlements,
+		nonces:      make(map[common.Address]int64),
+	}, nil
+}
+
+func (c *ChainStub) Config() ChainStubConfig {
+	return c.cfg
+}
+
+func (c *ChainStub) Contracts() Contracts {
+	contracts := c.contracts
+	contracts.Settlements = slices.Clone(c.contracts.Settlements)
+	return contracts
+}
+
+// AdvanceEpoch starts the next epoch now and returns it.
+func (c *ChainStub) AdvanceEpoch() symbiotic.Epoch {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	start := symbiotic.Timestamp(uint64(time.Now().Unix()))
+	// epoch starts must be strictly increasing for timestamps to map to a single epoch
+	if last := c.epochStarts[len(c.epochStarts)-1]; start <= last {
+		start = last + 1
+	}
+	c.epochStarts = append(c.epochStarts, start)
+	return c.currentEpoch()
+}
+
+func (c *ChainStub) CurrentEpoch() symbiotic.Epoch {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.currentEpoch()
+}
+
+// LastCommittedEpoch returns the latest epoch finally committed to all settlements
+// and false if genesis is not set on some of them.
+func (c *ChainStub) LastCommittedEpoch() (symbiotic.Epoch, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var (
+		epoch symbiotic.Epoch
+		first = true
+		now   = time.Now()
+	)
+	for _, state := range c.settlements {
+		if !state.genesisSet {
+			return 0, false
+		}
+		if last := state.lastCommittedEpoch(true, now); first || last < epoch {
+			epoch = last
+			first = false
+		}
+	}
+	return epoch, true
+}
+
+// LastCommittedEpochAt returns the epoch of the latest header finally committed to the settlement
+// and false if genesis is not set on it.
+func (c *ChainStub) LastCommittedEpochAt(settlement common.Address) (symbiotic.Epoch, bool) {
+	return c.lastCommittedEpoch(settlement, true)
+}
+
+// Reorg drops up to depth latest headers committed to the settlement, as if the blocks with their commit
+// transactions were reorganized out of the chain. Final headers are never dropped, so the reorg stops at
+// the first final one. It returns the epochs whose headers were dropped.
+func (c *ChainStub) Reorg(settlement common.Address, depth int) ([]symbiotic.Epoch, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state, ok := c.settlements[settlement]
+	if !ok {
+		return nil, errors.Errorf("no settlement at %s", settlement.Hex())
+	}
+
+	epochs := slices.Sorted(maps.Keys(state.headers))
+	now := time.Now()
+	var dropped []symbiotic.Epoch
+	for i := len(epochs) - 1; i >= 0 && len(dropped) < depth; i-- {
+		if !now.Before(state.headers[epochs[i]].finalizedAt) {
+			break
+		}
+		dropped = append(dropped, epochs[i])
+		delete(state.headers, epochs[i])
+	}
+	slices.Reverse(dropped)
+
+	state.lastCommitted = state.genesis
+	for epoch := range state.headers {
+		state.lastCommitted = max(state.lastCommitted, epoch)
+	}
+	return dropped, nil
+}
+
+// AddOperator registers the operator in the operator registry and the voting power provider with the given voting power.
+func (c *ChainStub) AddOperator(operator common.Address, votingPower *big.Int) {
+	vp := new(big.Int).Set(votingPower)
+	c.change(operator, func(state *operatorState) {
+		state.inRegistry = true
+		state.registered = true
+		state.votingPower = vp
+	})
+}
+
+// RemoveOperator unregisters the operator from the voting power provider.
+func (c *ChainStub) RemoveOperator(operator common.Address) {
+	c.change(operator, func(state *operatorState) {
+		state.registered = false
+	})
+}
+
+func (c *ChainStub) SetVotingPower(operator common.Address, votingPower *big.Int) {
+	vp := new(big.Int).Set(votingPower)
+	c.change(operator, func(state *operatorState) {
+		state.votingPower = vp
+	})
+}
+
+// SetKey sets the key of the operator in the key registry.
+func (c *ChainStub) SetKey(operator common.Address, keyTag symbiotic.KeyTag, key symbiotic.CompactPublicKey) {
+	key = slices.Clone(key)
+	c.change(operator, func(state *operatorState) {
+		state.keys[keyTag] = key
+	})
+}
+
+func (c *ChainStub) change(operator common.Address, apply func(state *operatorState)) symbiotic.TxResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	epoch := c.currentEpoch()
+	if c.genesisSet {
+		epoch++
+	}
+	c.changes = append(c.changes, stateChange{epoch: epoch, operator: operator, apply: apply})
+	return c.nextTx()
+}
+
+func (c *ChainStub) currentEpoch() symbiotic.Epoch {
+	return symbiotic.Epoch(len(c.epochStarts) - 1)
+}
+
+func (c *ChainStub) epochStart(epoch symbiotic.Epoch) symbiotic.Timestamp {
+	current := c.currentEpoch()
+	if epoch <= current {
+		return c.epochStarts[epoch]
+	}
+	// future epochs start after the nominal duration
+	return c.epochStarts[current] + symbiotic.Timestamp(uint64(epoch-current)*c.cfg.EpochDuration)
+}
+
+// epochAt returns the epoch the state at the timestamp belongs to.
+func (c *ChainStub) epochAt(timestamp symbiotic.Timestamp) (symbiotic.Epoch, error) {
+	if timestamp < c.epochStarts[0] {
+		return 0, errors.Errorf("timestamp %d is before the chain start", timestamp)
+	}
+	i, found := slices.BinarySearch(c.epochStarts, timestamp)
+	if !found {
+		i--
+	}
+	return symbiotic.Epoch(i), nil
+}
+
+// operatorsAt returns operator states at the timestamp sorted by operator address.
+func (c *ChainStub) operatorsAt(timestamp symbiotic.Timestamp) ([]common.Address, map[common.Address]*operatorState, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	epoch, err := c.epochAt(timestamp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	states := make(map[common.Address]*operatorState)
+	var operators []common.Address
+	for _, change := range c.changes {
+		if change.epoch > epoch {
+			continue
+		}
+		state, ok := states[change.operator]
+		if !ok {
+			state = &operatorState{
+				votingPower: big.NewInt(0),
+				keys:        make(map[symbiotic.KeyTag]symbiotic.CompactPublicKey),
+			}
+			states[change.operator] = state
+			operators = append(operators, change.operator)
+		}
+		change.apply(state)
+	}
+	slices.SortFunc(operators, func(a, b common.Address) int {
+		return a.Cmp(b)
+	})
+	return operators, states, nil
+}
+
+// setHeader commits the header to the settlement, provenAt is the epoch of the validator set the proof was verified against
+// and is ignored for the genesis header.
+func (c *ChainStub) setHeader(settlement common.Address, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte, provenAt symbiotic.Epoch, genesis bool) (symbiotic.TxResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state, ok := c.settlements[settlement]
+	if !ok {
+		return symbiotic.TxResult{}, errors.Errorf("no settlement at %s", settlement.Hex())
+	}
+	if err := c.checkHeader(state, header, proof, provenAt, genesis); err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	// the genesis header is final right away
+	var finalizedAt time.Time
+	if !genesis {
+		finalizedAt = time.Now().Add(c.cfg.FinalityDelay)
+	}
+
+	state.headers[header.Epoch] = committedHeader{
+		header:      header,
+		extraData:   slices.Clone(extraData),
+		proof:       slices.Clone(proof),
+		finalizedAt: finalizedAt,
+	}
+	state.lastCommitted = header.Epoch
+	if genesis {
+		state.genesis = header.Epoch
+		state.genesisSet = true
+	}
+	c.genesisSet = true
+	return c.nextTx(), nil
+}
+
+// header returns the header committed to the settlement in the epoch, only a final one if finalized is set.
+func (c *ChainStub) header(settlement common.Address, epoch symbiotic.Epoch, finalized bool) (committedHeader, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	state, ok := c.settlements[settlement]
+	if !ok {
+		return committedHeader{}, false
+	}
+	header, ok := state.headers[epoch]
+	if !ok || (finalized && time.Now().Before(header.finalizedAt)) {
+		return committedHeader{}, false
+	}
+	return header, true
+}
+
+func (c *ChainStub) lastCommittedEpoch(settlement common.Address, finalized bool) (symbiotic.Epoch, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	state, ok := c.settlements[settlement]
+	if !ok {
+		return 0, false
+	}
+	return state.lastCommittedEpoch(finalized, time.Now()), state.genesisSet
+}
+
+func (c *ChainStub) invalidateNonce(operator common.Address) symbiotic.TxResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nonces[operator]++
+	return c.nextTx()
+}
+
+func (c *ChainStub) nonce(operator common.Address) int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.nonces[operator]
+}
+
+// simulateHeader predicts the outcome of committing a header without changing the settlement.
+func (c *ChainStub) simulateHeader(settlement common.Address, header symbiotic.ValidatorSetHeader, proof []byte, provenAt symbiotic.Epoch) (symbiotic.TxSimulation, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state, ok := c.settlements[settlement]
+	if !ok {
+		return symbiotic.TxSimulation{}, errors.Errorf("no settlement at %s", settlement.Hex())
+	}
+	if err := c.checkHeader(state, header, proof, provenAt, false); err != nil {
+		return symbiotic.TxSimulation{}, err
+	}
+	return symbiotic.TxSimulation{GasEstimate: txGasUsed, FeePerGas: big.NewInt(txGasPrice)}, nil
+}
+
+// checkHeader returns the error the settlement reverts with on committing the header, must be called with mu held.
+func (c *ChainStub) checkHeader(state *settlementState, header symbiotic.ValidatorSetHeader, proof []byte, provenAt symbiotic.Epoch, genesis bool) error {
+	if !genesis {
+		if !state.genesisSet {
+			return errors.New("genesis header is not set")
+		}
+		if header.Epoch <= state.lastCommitted {
+			return errors.Errorf("header of epoch %d is older than the last committed epoch %d", header.Epoch, state.lastCommitted)
+		}
+		if len(proof) == 0 {
+			return errors.New("empty proof")
+		}
+		// the settlement verifies the proof with the validator set of the last committed header
+		if provenAt != state.lastCommitted {
+			return errors.Errorf("proof is verified against epoch %d, last committed epoch is %d", provenAt, state.lastCommitted)
+		}
+	}
+	if header.Epoch > c.currentEpoch() {
+		return errors.Errorf("header of epoch %d is from the future, current epoch is %d", header.Epoch, c.currentEpoch())
+	}
+	return nil
+}
+
+func (c *ChainStub) nextTx() symbiotic.TxResult {
+	c.txCount++
+	return symbiotic.TxResult{
+		TxHash:            crypto.Keccak256Hash(new(big.Int).SetUint64(c.cfg.ChainID).Bytes(), new(big.Int).SetUint64(c.txCount).Bytes()),
+		GasUsed:           txGasUsed,
+		EffectiveGasPrice: big.NewInt(txGasPrice),
+	}
+}
@@ -0,0 +1,150 @@
+package devnet
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func TestChain_OperatorChangesAfterGenesisAreCapturedByNextEpoch(t *testing.T) {
+	chain, err := NewChainStub(DefaultChainStubConfig())
+	require.NoError(t, err)
+
+	first := common.HexToAddress("0x01")
+	second := common.HexToAddress("0x02")
+	chain.AddOperator(first, big.NewInt(10))
+	settlement := chain.Contracts().Settlements[0]
+
+	operators, _, err := chain.operatorsAt(chain.epochStart(0))
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{first}, operators)
+
+	_, err = chain.setHeader(settlement, symbiotic.ValidatorSetHeader{Epoch: 0}, nil, nil, 0, true)
+	require.NoError(t, err)
+
+	chain.AddOperator(second, big.NewInt(20))
+	chain.SetVotingPower(first, big.NewInt(30))
+
+	operators, states, err := chain.operatorsAt(chain.epochStart(0))
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{first}, operators)
+	require.Equal(t, big.NewInt(10), states[first].votingPower)
+
+	require.Equal(t, symbiotic.Epoch(1), chain.AdvanceEpoch())
+	operators, states, err = chain.operatorsAt(chain.epochStart(1))
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{first, second}, operators)
+	require.Equal(t, big.NewInt(30), states[first].votingPower)
+}
+
+func TestChain_AdvanceEpochKeepsStartsIncreasing(t *testing.T) {
+	chain, err := NewChainStub(DefaultChainStubConfig())
+	require.NoError(t, err)
+
+	chain.AdvanceEpoch()
+	chain.AdvanceEpoch()
+
+	require.Equal(t, symbiotic.Epoch(2), chain.CurrentEpoch())
+	require.Less(t, chain.epochStart(0), chain.epochStart(1))
+	require.Less(t, chain.epochStart(1), chain.epochStart(2))
+
+	epoch, err := chain.epochAt(chain.epochStart(1))
+	require.NoError(t, err)
+	require.Equal(t, symbiotic.Epoch(1), epoch)
+}
+
+func TestChain_SetHeader(t *testing.T) {
+	chain, err := NewChainStub(DefaultChainStubConfig())
+	require.NoError(t, err)
+	settlement := chain.Contracts().Settlements[0]
+
+	_, err = chain.setHeader(settlement, symbiotic.ValidatorSetHeader{Epoch: 0}, nil, []byte{1}, 0, false)
+	require.ErrorContains(t, err, "genesis header is not set")
+
+	_, ok := chain.LastCommittedEpoch()
+	require.False(t, ok)
+
+	_, err = chain.setHeader(settlement, symbiotic.ValidatorSetHeader{Epoch: 0}, nil, nil, 0, true)
+	require.NoError(t, err)
+
+	_, err = chain.setHeader(settlement, symbiotic.ValidatorSetHeader{Epoch: 1}, nil, []byte{1}, 0, false)
+	require.ErrorContains(t, err, "from the future")
+
+	chain.AdvanceEpoch()
+	_, err = chain.setHeader(settlement, symbiotic.ValidatorSetHeader{Epoch: 1}, nil, nil, 0, false)
+	require.ErrorContains(t, err, "empty proof")
+
+	_, err = chain.setHeader(settlement, symbiotic.ValidatorSetHeader{Epoch: 1}, nil, []byte{1}, 1, false)
+	require.ErrorContains(t, err, "proof is verified against epoch 1, last committed epoch is 0")
+
+	_, err = chain.setHeader(settlement, symbiotic.ValidatorSetHeader{Epoch: 1}, nil, []byte{1}, 0, false)
+	require.NoError(t, err)
+
+	_, err = chain.setHeader(settlement, symbiotic.ValidatorSetHeader{Epoch: 1}, nil, []byte{1}, 0, false)
+	require.ErrorContains(t, err, "older than the last committed epoch")
+
+	epoch, ok := chain.LastCommittedEpoch()
+	require.True(t, ok)
+	require.Equal(t, symbiotic.Epoch(1), epoch)
+}
+
+func TestChain_Reorg(t *testing.T) {
+	cfg := DefaultChainStubConfig()
+	cfg.NumSettlements = 2
+	chain, err := NewChainStub(cfg)
+	require.NoError(t, err)
+	first, second := chain.Contracts().Settlements[0], chain.Contracts().Settlements[1]
+
+	for _, settlement := range []common.Address{first, second} {
+		_, err = chain.setHeader(settlement, symbiotic.ValidatorSetHeader{Epoch: 0}, nil, nil, 0, true)
+		require.NoError(t, err)
+	}
+	for epoch := symbiotic.Epoch(1); epoch <= 3; epoch++ {
+		// the header of epoch 1 is final right away, later ones are not
+		if epoch == 2 {
+			chain.cfg.FinalityDelay = time.Hour
+		}
+		chain.AdvanceEpoch()
+		for _, settlement := range []common.Address{first, second} {
+			_, err = chain.setHeader(settlement, symbiotic.ValidatorSetHeader{Epoch: epoch}, nil, []byte{1}, epoch-1, false)
+			require.NoError(t, err)
+		}
+	}
+
+	// only final headers are seen by default
+	epoch, ok := chain.LastCommittedEpochAt(first)
+	require.True(t, ok)
+	require.Equal(t, symbiotic.Epoch(1), epoch)
+	epoch, ok = chain.lastCommittedEpoch(first, false)
+	require.True(t, ok)
+	require.Equal(t, symbiotic.Epoch(3), epoch)
+	_, ok = chain.header(first, 3, true)
+	require.False(t, ok)
+
+	dropped, err := chain.Reorg(first, 1)
+	require.NoError(t, err)
+	require.Equal(t, []symbiotic.Epoch{3}, dropped)
+	_, ok = chain.header(first, 3, false)
+	require.False(t, ok)
+	_, ok = chain.header(second, 3, false)
+	require.True(t, ok)
+
+	// final headers survive any reorg
+	dropped, err = chain.Reorg(first, 10)
+	require.NoError(t, err)
+	require.Equal(t, []symbiotic.Epoch{2}, dropped)
+	epoch, ok = chain.lastCommittedEpoch(first, false)
+	require.True(t, ok)
+	require.Equal(t, symbiotic.Epoch(1), epoch)
+	epoch, ok = chain.LastCommittedEpoch()
+	require.True(t, ok)
+	require.Equal(t, symbiotic.Epoch(1), epoch)
+
+	_, err = chain.setHeader(first, symbiotic.ValidatorSetHeader{Epoch: 2}, nil, []byte{1}, 1, false)
+	require.NoError(t, err)
+}
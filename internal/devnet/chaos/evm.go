@@ -0,0 +1,216 @@
+package chaos
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/symbioticfi/relay/symbiotic/client/evm"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+type evmClient interface {
+	evm.IEvmClient
+	GetOperators(ctx context.Context, address symbiotic.CrossChainAddress, timestamp symbiotic.Timestamp) ([]common.Address, error)
+}
+
+var _ evm.IEvmClient = (*EvmClient)(nil)
+
+// EvmClient is an evm client with faults of the injector applied to its calls,
+// the operation of a call is the method name.
+type EvmClient struct {
+	client   evmClient
+	injector *Injector
+}
+
+func NewEvmClient(client evmClient, injector *Injector) *EvmClient {
+	return &EvmClient{
+		client:   client,
+		injector: injector,
+	}
+}
+
+func (c *EvmClient) GetChains() []uint64 {
+	return c.client.GetChains()
+}
+
+func (c *EvmClient) GetSubnetwork(ctx context.Context) (common.Hash, error) {
+	return call(ctx, c.injector, "GetSubnetwork", common.Address{}, func() (common.Hash, error) {
+		return c.client.GetSubnetwork(ctx)
+	})
+}
+
+func (c *EvmClient) GetNetworkAddress(ctx context.Context) (common.Address, error) {
+	return call(ctx, c.injector, "GetNetworkAddress", common.Address{}, func() (common.Address, error) {
+		return c.client.GetNetworkAddress(ctx)
+	})
+}
+
+func (c *EvmClient) GetConfig(ctx context.Context, timestamp symbiotic.Timestamp, epoch symbiotic.Epoch) (symbiotic.NetworkConfig, error) {
+	return call(ctx, c.injector, "GetConfig", common.Address{}, func() (symbiotic.NetworkConfig, error) {
+		return c.client.GetConfig(ctx, timestamp, epoch)
+	})
+}
+
+func (c *EvmClient) GetEip712Domain(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.Eip712Domain, error) {
+	return call(ctx, c.injector, "GetEip712Domain", addr.Address, func() (symbiotic.Eip712Domain, error) {
+		return c.client.GetEip712Domain(ctx, addr)
+	})
+}
+
+func (c *EvmClient) GetVotingPowerProviderEip712Domain(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.Eip712Domain, error) {
+	return call(ctx, c.injector, "GetVotingPowerProviderEip712Domain", addr.Address, func() (symbiotic.Eip712Domain, error) {
+		return c.client.GetVotingPowerProviderEip712Domain(ctx, addr)
+	})
+}
+
+func (c *EvmClient) GetOperatorNonce(ctx context.Context, votingPowerProvider symbiotic.CrossChainAddress, operator common.Address) (*big.Int, error) {
+	return call(ctx, c.injector, "GetOperatorNonce", votingPowerProvider.Address, func() (*big.Int, error) {
+		return c.client.GetOperatorNonce(ctx, votingPowerProvider, operator)
+	})
+}
+
+func (c *EvmClient) GetCurrentEpoch(ctx context.Context) (symbiotic.Epoch, error) {
+	return call(ctx, c.injector, "GetCurrentEpoch", common.Address{}, func() (symbiotic.Epoch, error) {
+		return c.client.GetCurrentEpoch(ctx)
+	})
+}
+
+func (c *EvmClient) GetCurrentEpochDuration(ctx context.Context) (uint64, error) {
+	return call(ctx, c.injector, "GetCurrentEpochDuration", common.Address{}, func() (uint64, error) {
+		return c.client.GetCurrentEpochDuration(ctx)
+	})
+}
+
+func (c *EvmClient) GetEpochDuration(ctx context.Context, epoch symbiotic.Epoch) (uint64, error) {
+	return call(ctx, c.injector, "GetEpochDuration", common.Address{}, func() (uint64, error) {
+		return c.client.GetEpochDuration(ctx, epoch)
+	})
+}
+
+func (c *EvmClient) GetEpochStart(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.Timestamp, error) {
+	return call(ctx, c.injector, "GetEpochStart", common.Address{}, func() (symbiotic.Timestamp, error) {
+		return c.client.GetEpochStart(ctx, epoch)
+	})
+}
+
+func (c *EvmClient) IsValsetHeaderCommittedAt(ctx context.Context, addr symbiotic.CrossChainAddress, epoch symbiotic.Epoch, opts ...symbiotic.EVMOption) (bool, error) {
+	return call(ctx, c.injector, "IsValsetHeaderCommittedAt", addr.Address, func() (bool, error) {
+		return c.client.IsValsetHeaderCommittedAt(ctx, addr, epoch, opts...)
+	})
+}
+
+func (c *EvmClient) IsValsetHeaderCommittedAtEpochs(ctx context.Context, addr symbiotic.CrossChainAddress, epochs []symbiotic.Epoch) ([]bool, error) {
+	return call(ctx, c.injector, "IsValsetHeaderCommittedAtEpochs", addr.Address, func() ([]bool, error) {
+		return c.client.IsValsetHeaderCommittedAtEpochs(ctx, addr, epochs)
+	})
+}
+
+func (c *EvmClient) GetHeaderHash(ctx context.Context, addr symbiotic.CrossChainAddress) (common.Hash, error) {
+	return call(ctx, c.injector, "GetHeaderHash", addr.Address, func() (common.Hash, error) {
+		return c.client.GetHeaderHash(ctx, addr)
+	})
+}
+
+func (c *EvmClient) GetHeaderHashAt(ctx context.Context, addr symbiotic.CrossChainAddress, epoch symbiotic.Epoch) (common.Hash, error) {
+	return call(ctx, c.injector, "GetHeaderHashAt", addr.Address, func() (common.Hash, error) {
+		return c.client.GetHeaderHashAt(ctx, addr, epoch)
+	})
+}
+
+func (c *EvmClient) GetLastCommittedHeaderEpoch(ctx context.Context, addr symbiotic.CrossChainAddress, evmOptions ...symbiotic.EVMOption) (symbiotic.Epoch, error) {
+	return call(ctx, c.injector, "GetLastCommittedHeaderEpoch", addr.Address, func() (symbiotic.Epoch, error) {
+		return c.client.GetLastCommittedHeaderEpoch(ctx, addr, evmOptions...)
+	})
+}
+
+func (c *EvmClient) GetCaptureTimestampFromValsetHeaderAt(ctx context.Context, addr symbiotic.CrossChainAddress, epoch symbiotic.Epoch) (uint64, error) {
+	return call(ctx, c.injector, "GetCaptureTimestampFromValsetHeaderAt", addr.Address, func() (uint64, error) {
+		return c.client.GetCaptureTimestampFromValsetHeaderAt(ctx, addr, epoch)
+	})
+}
+
+func (c *EvmClient) GetValSetHeaderAt(ctx context.Context, addr symbiotic.CrossChainAddress, epoch symbiotic.Epoch) (symbiotic.ValidatorSetHeader, error) {
+	return call(ctx, c.injector, "GetValSetHeaderAt", addr.Address, func() (symbiotic.ValidatorSetHeader, error) {
+		return c.client.GetValSetHeaderAt(ctx, addr, epoch)
+	})
+}
+
+func (c *EvmClient) GetValSetHeader(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.ValidatorSetHeader, error) {
+	return call(ctx, c.injector, "GetValSetHeader", addr.Address, func() (symbiotic.ValidatorSetHeader, error) {
+		return c.client.GetValSetHeader(ctx, addr)
+	})
+}
+
+func (c *EvmClient) GetVotingPowers(ctx context.Context, address symbiotic.CrossChainAddress, timestamp symbiotic.Timestamp) ([]symbiotic.OperatorVotingPower, error) {
+	return call(ctx, c.injector, "GetVotingPowers", address.Address, func() ([]symbiotic.OperatorVotingPower, error) {
+		return c.client.GetVotingPowers(ctx, address, timestamp)
+	})
+}
+
+func (c *EvmClient) GetOperators(ctx context.Context, address symbiotic.CrossChainAddress, timestamp symbiotic.Timestamp) ([]common.Address, error) {
+	return call(ctx, c.injector, "GetOperators", address.Address, func() ([]common.Address, error) {
+		return c.client.GetOperators(ctx, address, timestamp)
+	})
+}
+
+func (c *EvmClient) GetKeys(ctx context.Context, address symbiotic.CrossChainAddress, timestamp symbiotic.Timestamp) ([]symbiotic.OperatorWithKeys, error) {
+	return call(ctx, c.injector, "GetKeys", address.Address, func() ([]symbiotic.OperatorWithKeys, error) {
+		return c.client.GetKeys(ctx, address, timestamp)
+	})
+}
+
+func (c *EvmClient) CommitValsetHeader(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte, opts ...symbiotic.EVMOption) (symbiotic.TxResult, error) {
+	return call(ctx, c.injector, "CommitValsetHeader", addr.Address, func() (symbiotic.TxResult, error) {
+		return c.client.CommitValsetHeader(ctx, addr, header, extraData, proof, opts...)
+	})
+}
+
+func (c *EvmClient) SimulateCommitValsetHeader(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte) (symbiotic.TxSimulation, error) {
+	return call(ctx, c.injector, "SimulateCommitValsetHeader", addr.Address, func() (symbiotic.TxSimulation, error) {
+		return c.client.SimulateCommitValsetHeader(ctx, addr, header, extraData, proof)
+	})
+}
+
+func (c *EvmClient) RegisterOperator(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.TxResult, error) {
+	return call(ctx, c.injector, "RegisterOperator", addr.Address, func() (symbiotic.TxResult, error) {
+		return c.client.RegisterOperator(ctx, addr)
+	})
+}
+
+func (c *EvmClient) RegisterKey(ctx context.Context, addr symbiotic.CrossChainAddress, keyTag symbiotic.KeyTag, key symbiotic.CompactPublicKey, signature symbiotic.RawSignature, extraData []byte) (symbiotic.TxResult, error) {
+	return call(ctx, c.injector, "RegisterKey", addr.Address, func() (symbiotic.TxResult, error) {
+		return c.client.RegisterKey(ctx, addr, keyTag, key, signature, extraData)
+	})
+}
+
+func (c *EvmClient) InvalidateOldSignatures(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.TxResult, error) {
+	return call(ctx, c.injector, "InvalidateOldSignatures", addr.Address, func() (symbiotic.TxResult, error) {
+		return c.client.InvalidateOldSignatures(ctx, addr)
+	})
+}
+
+func (c *EvmClient) RegisterOperatorVotingPowerProvider(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.TxResult, error) {
+	return call(ctx, c.injector, "RegisterOperatorVotingPowerProvider", addr.Address, func() (symbiotic.TxResult, error) {
+		return c.client.RegisterOperatorVotingPowerProvider(ctx, addr)
+	})
+}
+
+func (c *EvmClient) UnregisterOperatorVotingPowerProvider(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.TxResult, error) {
+	return call(ctx, c.injector, "UnregisterOperatorVotingPowerProvider", addr.Address, func() (symbiotic.TxResult, error) {
+		return c.client.UnregisterOperatorVotingPowerProvider(ctx, addr)
+	})
+}
+
+func (c *EvmClient) SetGenesis(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData) (symbiotic.TxResult, error) {
+	return call(ctx, c.injector, "SetGenesis", addr.Address, func() (symbiotic.TxResult, error) {
+		return c.client.SetGenesis(ctx, addr, header, extraData)
+	})
+}
+
+func (c *EvmClient) VerifyQuorumSig(ctx context.Context, addr symbiotic.CrossChainAddress, epoch symbiotic.Epoch, message []byte, keyTag symbiotic.KeyTag, threshold *big.Int, proof []byte) (bool, error) {
+	return call(ctx, c.injector, "VerifyQuorumSig", addr.Address, func() (bool, error) {
+		return c.client.VerifyQuorumSig(ctx, addr, epoch, message, keyTag, threshold, proof)
+	})
+}
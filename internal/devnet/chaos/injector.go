@@ -0,0 +1,199 @@
+// Package chaos injects faults into the evm client, the repository and the p2p gossip of a relay,
+// so that recovery of the relay pipeline can be tested on a devnet.
+package chaos
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+)
+
+// ErrCrashed is returned by calls that crashed the relay.
+var ErrCrashed = errors.New("relay crashed by fault injection")
+
+// ErrInjected is the default error of injected faults.
+var ErrInjected = errors.New("injected fault")
+
+// Fault describes a fault of calls of an operation.
+// Operations are the method names of the evm client and the repository, e.g. "CommitValsetHeader" or "SaveProof",
+// and the gossip topics for received p2p messages.
+type Fault struct {
+	Op string
+	// Address limits the fault to evm calls on the contract, any contract if zero
+	Address common.Address
+	// Probability of the fault to happen on a call, always if zero
+	Probability float64
+	// Times is the number of calls the fault happens on, unlimited if zero
+	Times int
+
+	// Latency delays the call
+	Latency time.Duration
+	// Err fails the call, the call is not performed unless AfterCall is set
+	Err error
+	// Crash crashes the relay instead of performing the call
+	Crash bool
+	// AfterCall makes Err and Crash happen after the call is performed,
+	// e.g. a transaction is sent but the relay sees an error or crashes before handling the result
+	AfterCall bool
+
+	// Drop drops received gossip messages
+	Drop bool
+	// Duplicate is the number of extra deliveries of received gossip messages
+	Duplicate int
+}
+
+func (f Fault) Validate() error {
+	if f.Op == "" {
+		return errors.New("fault operation is required")
+	}
+	if f.Probability < 0 || f.Probability > 1 {
+		return errors.Errorf("fault probability %f is not in [0, 1]", f.Probability)
+	}
+	if f.Times < 0 || f.Latency < 0 || f.Duplicate < 0 {
+		return errors.New("fault times, latency and duplicate must not be negative")
+	}
+	return nil
+}
+
+type activeFault struct {
+	Fault
+	happened int
+}
+
+// Injector holds faults of a relay and decides which of them happen on calls.
+// The zero value is not usable, use NewInjector.
+type Injector struct {
+	mu       sync.Mutex
+	rnd      *rand.Rand
+	faults   []*activeFault
+	injected map[string]int
+	onCrash  func()
+}
+
+// NewInjector returns an injector without faults, the seed makes probabilistic faults reproducible.
+func NewInjector(seed uint64) *Injector {
+	return &Injector{
+		rnd:      rand.New(rand.NewPCG(seed, seed)),
+		injected: make(map[string]int),
+	}
+}
+
+// Add adds the fault, faults are checked in the order they are added and the first one that happens is applied.
+// A fault without any effect fails calls with ErrInjected.
+func (i *Injector) Add(fault Fault) error {
+	if err := fault.Validate(); err != nil {
+		return err
+	}
+	if fault.Latency == 0 && fault.Err == nil && !fault.Crash && !fault.Drop && fault.Duplicate == 0 {
+		fault.Err = ErrInjected
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.faults = append(i.faults, &activeFault{Fault: fault})
+	return nil
+}
+
+// Clear removes all faults.
+func (i *Injector) Clear() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.faults = nil
+}
+
+// Injected returns the number of faults that happened on calls of the operation.
+func (i *Injector) Injected(op string) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.injected[op]
+}
+
+// OnCrash sets the function called when a fault crashes the relay.
+// The function must stop the relay, the call that crashed returns ErrCrashed.
+func (i *Injector) OnCrash(f func()) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.onCrash = f
+}
+
+// next returns the fault that happens on the call and false if there is none.
+func (i *Injector) next(op string, address common.Address) (Fault, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, fault := range i.faults {
+		if fault.Op != op || (fault.Address != (common.Address{}) && fault.Address != address) {
+			continue
+		}
+		if fault.Times > 0 && fault.happened >= fault.Times {
+			continue
+		}
+		if fault.Probability > 0 && i.rnd.Float64() >= fault.Probability {
+			continue
+		}
+		fault.happened++
+		i.injected[op]++
+		return fault.Fault, true
+	}
+	return Fault{}, false
+}
+
+func (i *Injector) crash() error {
+	i.mu.Lock()
+	onCrash := i.onCrash
+	i.mu.Unlock()
+	if onCrash != nil {
+		onCrash()
+	}
+	return ErrCrashed
+}
+
+// call performs the call with the fault of the operation applied.
+func call[T any](ctx context.Context, i *Injector, op string, address common.Address, f func() (T, error)) (T, error) {
+	var zero T
+	fault, ok := i.next(op, address)
+	if !ok {
+		return f()
+	}
+
+	if fault.Latency > 0 {
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-time.After(fault.Latency):
+		}
+	}
+
+	if !fault.AfterCall {
+		if fault.Crash {
+			return zero, i.crash()
+		}
+		if fault.Err != nil {
+			return zero, fault.Err
+		}
+		return f()
+	}
+
+	result, err := f()
+	if err != nil {
+		return result, err
+	}
+	if fault.Crash {
+		return zero, i.crash()
+	}
+	if fault.Err != nil {
+		return zero, fault.Err
+	}
+	return result, nil
+}
+
+// exec is call for operations without a result.
+func exec(ctx context.Context, i *Injector, op string, f func() error) error {
+	_, err := call(ctx, i, op, common.Address{}, func() (struct{}, error) {
+		return struct{}{}, f()
+	})
+	return err
+}
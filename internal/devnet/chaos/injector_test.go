@@ -0,0 +1,86 @@
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjector_Times(t *testing.T) {
+	i := NewInjector(1)
+	require.NoError(t, i.Add(Fault{Op: "Op", Times: 2}))
+
+	calls := 0
+	f := func() error {
+		calls++
+		return nil
+	}
+	require.ErrorIs(t, exec(t.Context(), i, "Op", f), ErrInjected)
+	require.ErrorIs(t, exec(t.Context(), i, "Op", f), ErrInjected)
+	require.NoError(t, exec(t.Context(), i, "Op", f))
+	require.NoError(t, exec(t.Context(), i, "Other", f))
+	require.Equal(t, 2, calls)
+	require.Equal(t, 2, i.Injected("Op"))
+}
+
+func TestInjector_Address(t *testing.T) {
+	i := NewInjector(1)
+	faulty := common.HexToAddress("0x1")
+	require.NoError(t, i.Add(Fault{Op: "Op", Address: faulty}))
+
+	f := func() (int, error) { return 1, nil }
+	_, err := call(t.Context(), i, "Op", faulty, f)
+	require.ErrorIs(t, err, ErrInjected)
+	result, err := call(t.Context(), i, "Op", common.HexToAddress("0x2"), f)
+	require.NoError(t, err)
+	require.Equal(t, 1, result)
+}
+
+func TestInjector_Probability(t *testing.T) {
+	i := NewInjector(1)
+	require.NoError(t, i.Add(Fault{Op: "Op", Probability: 0.5}))
+
+	for range 1000 {
+		_ = exec(t.Context(), i, "Op", func() error { return nil })
+	}
+	require.InDelta(t, 500, i.Injected("Op"), 100)
+}
+
+func TestInjector_AfterCallAndCrash(t *testing.T) {
+	i := NewInjector(1)
+	crashed := 0
+	i.OnCrash(func() { crashed++ })
+	require.NoError(t, i.Add(Fault{Op: "Crash", Crash: true, Times: 1}))
+	require.NoError(t, i.Add(Fault{Op: "CrashAfter", Crash: true, AfterCall: true, Times: 1}))
+
+	calls := 0
+	f := func() error {
+		calls++
+		return nil
+	}
+	require.ErrorIs(t, exec(t.Context(), i, "Crash", f), ErrCrashed)
+	require.Equal(t, 0, calls)
+	require.ErrorIs(t, exec(t.Context(), i, "CrashAfter", f), ErrCrashed)
+	require.Equal(t, 1, calls)
+	require.Equal(t, 2, crashed)
+}
+
+func TestInjector_LatencyRespectsContext(t *testing.T) {
+	i := NewInjector(1)
+	require.NoError(t, i.Add(Fault{Op: "Op", Latency: time.Hour}))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	require.ErrorIs(t, exec(ctx, i, "Op", func() error { return nil }), context.Canceled)
+}
+
+func TestFault_Validate(t *testing.T) {
+	require.Error(t, Fault{}.Validate())
+	require.Error(t, Fault{Op: "Op", Probability: 2}.Validate())
+	require.Error(t, Fault{Op: "Op", Times: -1}.Validate())
+	require.NoError(t, Fault{Op: "Op", Err: errors.New("failed")}.Validate())
+}
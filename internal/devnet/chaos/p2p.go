@@ -0,0 +1,30 @@
+package chaos
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+)
+
+// Interceptor returns the p2p interceptor applying faults of the injector to received gossip messages,
+// the operation of a message is its topic. Latency delays handling of all messages of the topic.
+func (i *Injector) Interceptor() func(topic string, msg *pubsub.Message) int {
+	return func(topic string, msg *pubsub.Message) int {
+		fault, ok := i.next(topic, common.Address{})
+		if !ok {
+			return 1
+		}
+		if fault.Latency > 0 {
+			time.Sleep(fault.Latency)
+		}
+		if fault.Crash {
+			_ = i.crash()
+			return 0
+		}
+		if fault.Drop || fault.Err != nil {
+			return 0
+		}
+		return 1 + fault.Duplicate
+	}
+}
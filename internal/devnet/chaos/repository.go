@@ -0,0 +1,116 @@
+package chaos
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/symbioticfi/relay/internal/client/repository/cached"
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// Repository is a repository with faults of the injector applied to its writes,
+// the operation of a write is the method name. Reads are passed through.
+type Repository struct {
+	cached.Repository
+
+	injector *Injector
+}
+
+func NewRepository(repo cached.Repository, injector *Injector) *Repository {
+	return &Repository{
+		Repository: repo,
+		injector:   injector,
+	}
+}
+
+func (r *Repository) SaveSignature(ctx context.Context, signature symbiotic.Signature, validator symbiotic.Validator, activeIndex uint32) error {
+	return exec(ctx, r.injector, "SaveSignature", func() error {
+		return r.Repository.SaveSignature(ctx, signature, validator, activeIndex)
+	})
+}
+
+func (r *Repository) UpdateSignatureMap(ctx context.Context, vm entity.SignatureMap) error {
+	return exec(ctx, r.injector, "UpdateSignatureMap", func() error {
+		return r.Repository.UpdateSignatureMap(ctx, vm)
+	})
+}
+
+func (r *Repository) SaveSignatureRequest(ctx context.Context, requestID common.Hash, req symbiotic.SignatureRequest) error {
+	return exec(ctx, r.injector, "SaveSignatureRequest", func() error {
+		return r.Repository.SaveSignatureRequest(ctx, requestID, req)
+	})
+}
+
+func (r *Repository) RemoveSignaturePending(ctx context.Context, epoch symbiotic.Epoch, requestID common.Hash) error {
+	return exec(ctx, r.injector, "RemoveSignaturePending", func() error {
+		return r.Repository.RemoveSignaturePending(ctx, epoch, requestID)
+	})
+}
+
+func (r *Repository) CancelSignatureRequest(ctx context.Context, requestID common.Hash) error {
+	return exec(ctx, r.injector, "CancelSignatureRequest", func() error {
+		return r.Repository.CancelSignatureRequest(ctx, requestID)
+	})
+}
+
+func (r *Repository) SaveProof(ctx context.Context, aggregationProof symbiotic.AggregationProof) error {
+	return exec(ctx, r.injector, "SaveProof", func() error {
+		return r.Repository.SaveProof(ctx, aggregationProof)
+	})
+}
+
+func (r *Repository) UpgradeProof(ctx context.Context, aggregationProof symbiotic.AggregationProof) error {
+	return exec(ctx, r.injector, "UpgradeProof", func() error {
+		return r.Repository.UpgradeProof(ctx, aggregationProof)
+	})
+}
+
+func (r *Repository) RemoveAggregationProofPending(ctx context.Context, epoch symbiotic.Epoch, requestID common.Hash) error {
+	return exec(ctx, r.injector, "RemoveAggregationProofPending", func() error {
+		return r.Repository.RemoveAggregationProofPending(ctx, epoch, requestID)
+	})
+}
+
+func (r *Repository) UpdateValidatorSetStatus(ctx context.Context, epoch symbiotic.Epoch, status symbiotic.ValidatorSetStatus) error {
+	return exec(ctx, r.injector, "UpdateValidatorSetStatus", func() error {
+		return r.Repository.UpdateValidatorSetStatus(ctx, epoch, status)
+	})
+}
+
+func (r *Repository) UpdateValidatorSetStatusAndRemovePendingProof(ctx context.Context, valset symbiotic.ValidatorSet) error {
+	return exec(ctx, r.injector, "UpdateValidatorSetStatusAndRemovePendingProof", func() error {
+		return r.Repository.UpdateValidatorSetStatusAndRemovePendingProof(ctx, valset)
+	})
+}
+
+func (r *Repository) SaveFirstUncommittedValidatorSetEpoch(ctx context.Context, epoch symbiotic.Epoch) error {
+	return exec(ctx, r.injector, "SaveFirstUncommittedValidatorSetEpoch", func() error {
+		return r.Repository.SaveFirstUncommittedValidatorSetEpoch(ctx, epoch)
+	})
+}
+
+func (r *Repository) SaveConfig(ctx context.Context, config symbiotic.NetworkConfig, epoch symbiotic.Epoch) error {
+	return exec(ctx, r.injector, "SaveConfig", func() error {
+		return r.Repository.SaveConfig(ctx, config, epoch)
+	})
+}
+
+func (r *Repository) SaveSettlementCommitState(ctx context.Context, state symbiotic.SettlementCommitState) error {
+	return exec(ctx, r.injector, "SaveSettlementCommitState", func() error {
+		return r.Repository.SaveSettlementCommitState(ctx, state)
+	})
+}
+
+func (r *Repository) SaveMessageBatch(ctx context.Context, batch symbiotic.MessageBatch) error {
+	return exec(ctx, r.injector, "SaveMessageBatch", func() error {
+		return r.Repository.SaveMessageBatch(ctx, batch)
+	})
+}
+
+func (r *Repository) SaveNextValsetData(ctx context.Context, data entity.NextValsetData) error {
+	return exec(ctx, r.injector, "SaveNextValsetData", func() error {
+		return r.Repository.SaveNextValsetData(ctx, data)
+	})
+}
@@ -0,0 +1,532 @@
+package devnet
+
+import (
+	"context"
+	"maps"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/go-errors/errors"
+
+	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
+	"github.com/symbioticfi/relay/symbiotic/client/evm"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/aggregator"
+	cryptoSym "github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+	valsetDeriver "github.com/symbioticfi/relay/symbiotic/usecase/valset-deriver"
+)
+
+var _ evm.IEvmClient = (*Client)(nil)
+
+type keyProvider interface {
+	GetPrivateKeyByNamespaceTypeId(namespace string, keyType symbiotic.KeyType, id int) (cryptoSym.PrivateKey, error)
+}
+
+// Client is the evm client of a relay on the chain stub,
+// transactions are sent from the evm key of the chain id in the key provider.
+// Header commits and quorum signatures are verified the way the settlement does, with the validator set
+// of the last committed header or of the given epoch.
+type Client struct {
+	chain       *ChainStub
+	keyProvider keyProvider
+}
+
+func NewClient(chain *ChainStub, keyProvider keyProvider) *Client {
+	return &Client{
+		chain:       chain,
+		keyProvider: keyProvider,
+	}
+}
+
+func (c *Client) GetChains() []uint64 {
+	return []uint64{c.chain.cfg.ChainID}
+}
+
+func (c *Client) GetSubnetwork(ctx context.Context) (common.Hash, error) {
+	// subnetwork is the network address followed by the zero subnetwork identifier
+	return common.BytesToHash(append(Deployer.Bytes(), make([]byte, 12)...)), nil
+}
+
+func (c *Client) GetNetworkAddress(ctx context.Context) (common.Address, error) {
+	return Deployer, nil
+}
+
+func (c *Client) GetConfig(ctx context.Context, timestamp symbiotic.Timestamp, epoch symbiotic.Epoch) (symbiotic.NetworkConfig, error) {
+	cfg := c.chain.cfg
+	quorumThresholds := make([]symbiotic.QuorumThreshold, 0, len(cfg.RequiredKeyTags))
+	for _, keyTag := range cfg.RequiredKeyTags {
+		quorumThresholds = append(quorumThresholds, symbiotic.QuorumThreshold{
+			KeyTag:          keyTag,
+			QuorumThreshold: symbiotic.ToQuorumThresholdPct(new(big.Int).Set(cfg.QuorumThreshold)),
+		})
+	}
+
+	settlements := make([]symbiotic.CrossChainAddress, 0, len(c.chain.contracts.Settlements))
+	for _, settlement := range c.chain.contracts.Settlements {
+		settlements = append(settlements, c.address(settlement))
+	}
+
+	return symbiotic.NetworkConfig{
+		VotingPowerProviders:    []symbiotic.CrossChainAddress{c.address(c.chain.contracts.VotingPowerProvider)},
+		KeysProvider:            c.address(c.chain.contracts.KeyRegistry),
+		Settlements:             settlements,
+		VerificationType:        cfg.VerificationType,
+		MaxVotingPower:          symbiotic.ToVotingPower(big.NewInt(0)),
+		MinInclusionVotingPower: symbiotic.ToVotingPower(big.NewInt(0)),
+		MaxValidatorsCount:      symbiotic.ToVotingPower(big.NewInt(0)),
+		RequiredKeyTags:         append([]symbiotic.KeyTag(nil), cfg.RequiredKeyTags...),
+		RequiredHeaderKeyTag:    cfg.RequiredHeaderKeyTag,
+		QuorumThresholds:        quorumThresholds,
+		EpochDuration:           cfg.EpochDuration,
+		NumAggregators:          cfg.NumAggregators,
+		NumCommitters:           cfg.NumCommitters,
+		CommitterSlotDuration:   cfg.CommitterSlotDuration,
+	}, nil
+}
+
+func (c *Client) GetEip712Domain(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.Eip712Domain, error) {
+	if err := c.checkSettlement(addr); err != nil {
+		return symbiotic.Eip712Domain{}, err
+	}
+	return c.eip712Domain("Settlement", addr), nil
+}
+
+func (c *Client) GetVotingPowerProviderEip712Domain(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.Eip712Domain, error) {
+	if err := c.checkContract(addr, c.chain.contracts.VotingPowerProvider); err != nil {
+		return symbiotic.Eip712Domain{}, err
+	}
+	return c.eip712Domain("VotingPowerProvider", addr), nil
+}
+
+func (c *Client) GetOperatorNonce(ctx context.Context, votingPowerProvider symbiotic.CrossChainAddress, operator common.Address) (*big.Int, error) {
+	if err := c.checkContract(votingPowerProvider, c.chain.contracts.VotingPowerProvider); err != nil {
+		return nil, err
+	}
+	return big.NewInt(c.chain.nonce(operator)), nil
+}
+
+func (c *Client) GetCurrentEpoch(ctx context.Context) (symbiotic.Epoch, error) {
+	return c.chain.CurrentEpoch(), nil
+}
+
+func (c *Client) GetCurrentEpochDuration(ctx context.Context) (uint64, error) {
+	return c.chain.cfg.EpochDuration, nil
+}
+
+func (c *Client) GetEpochDuration(ctx context.Context, epoch symbiotic.Epoch) (uint64, error) {
+	return c.chain.cfg.EpochDuration, nil
+}
+
+func (c *Client) GetEpochStart(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.Timestamp, error) {
+	c.chain.mu.RLock()
+	defer c.chain.mu.RUnlock()
+	return c.chain.epochStart(epoch), nil
+}
+
+func (c *Client) IsValsetHeaderCommittedAt(ctx context.Context, addr symbiotic.CrossChainAddress, epoch symbiotic.Epoch, opts ...symbiotic.EVMOption) (bool, error) {
+	if err := c.checkSettlement(addr); err != nil {
+		return false, err
+	}
+	_, ok := c.chain.header(addr.Address, epoch, finalized(opts))
+	return ok, nil
+}
+
+func (c *Client) IsValsetHeaderCommittedAtEpochs(ctx context.Context, addr symbiotic.CrossChainAddress, epochs []symbiotic.Epoch) ([]bool, error) {
+	if err := c.checkSettlement(addr); err != nil {
+		return nil, err
+	}
+	committed := make([]bool, len(epochs))
+	for i, epoch := range epochs {
+		_, committed[i] = c.chain.header(addr.Address, epoch, true)
+	}
+	return committed, nil
+}
+
+func (c *Client) GetHeaderHash(ctx context.Context, addr symbiotic.CrossChainAddress) (common.Hash, error) {
+	header, err := c.GetValSetHeader(ctx, addr)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.Hash()
+}
+
+func (c *Client) GetHeaderHashAt(ctx context.Context, addr symbiotic.CrossChainAddress, epoch symbiotic.Epoch) (common.Hash, error) {
+	header, err := c.GetValSetHeaderAt(ctx, addr, epoch)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.Hash()
+}
+
+func (c *Client) GetLastCommittedHeaderEpoch(ctx context.Context, addr symbiotic.CrossChainAddress, evmOptions ...symbiotic.EVMOption) (symbiotic.Epoch, error) {
+	if err := c.checkSettlement(addr); err != nil {
+		return 0, err
+	}
+	epoch, _ := c.chain.lastCommittedEpoch(addr.Address, finalized(evmOptions))
+	return epoch, nil
+}
+
+func (c *Client) GetCaptureTimestampFromValsetHeaderAt(ctx context.Context, addr symbiotic.CrossChainAddress, epoch symbiotic.Epoch) (uint64, error) {
+	header, err := c.GetValSetHeaderAt(ctx, addr, epoch)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(header.CaptureTimestamp), nil
+}
+
+func (c *Client) GetValSetHeaderAt(ctx context.Context, addr symbiotic.CrossChainAddress, epoch symbiotic.Epoch) (symbiotic.ValidatorSetHeader, error) {
+	if err := c.checkSettlement(addr); err != nil {
+		return symbiotic.ValidatorSetHeader{}, err
+	}
+	// like the settlement contract, an empty header is returned for epochs without a committed header
+	committed, _ := c.chain.header(addr.Address, epoch, true)
+	return committed.header, nil
+}
+
+func (c *Client) GetValSetHeader(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.ValidatorSetHeader, error) {
+	epoch, err := c.GetLastCommittedHeaderEpoch(ctx, addr)
+	if err != nil {
+		return symbiotic.ValidatorSetHeader{}, err
+	}
+	return c.GetValSetHeaderAt(ctx, addr, epoch)
+}
+
+func (c *Client) GetVotingPowers(ctx context.Context, address symbiotic.CrossChainAddress, timestamp symbiotic.Timestamp) ([]symbiotic.OperatorVotingPower, error) {
+	if err := c.checkContract(address, c.chain.contracts.VotingPowerProvider); err != nil {
+		return nil, err
+	}
+	operators, states, err := c.chain.operatorsAt(timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	votingPowers := make([]symbiotic.OperatorVotingPower, 0, len(operators))
+	for _, operator := range operators {
+		if !states[operator].registered {
+			continue
+		}
+		votingPowers = append(votingPowers, symbiotic.OperatorVotingPower{
+			Operator: operator,
+			Vaults: []symbiotic.VaultVotingPower{{
+				Vault:       VaultAddress(operator),
+				VotingPower: symbiotic.ToVotingPower(new(big.Int).Set(states[operator].votingPower)),
+			}},
+		})
+	}
+	return votingPowers, nil
+}
+
+func (c *Client) GetOperators(ctx context.Context, address symbiotic.CrossChainAddress, timestamp symbiotic.Timestamp) ([]common.Address, error) {
+	votingPowers, err := c.GetVotingPowers(ctx, address, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	operators := make([]common.Address, 0, len(votingPowers))
+	for _, vp := range votingPowers {
+		operators = append(operators, vp.Operator)
+	}
+	return operators, nil
+}
+
+func (c *Client) GetKeys(ctx context.Context, address symbiotic.CrossChainAddress, timestamp symbiotic.Timestamp) ([]symbiotic.OperatorWithKeys, error) {
+	if err := c.checkContract(address, c.chain.contracts.KeyRegistry); err != nil {
+		return nil, err
+	}
+	operators, states, err := c.chain.operatorsAt(timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]symbiotic.OperatorWithKeys, 0, len(operators))
+	for _, operator := range operators {
+		state := states[operator]
+		if len(state.keys) == 0 {
+			continue
+		}
+		keys := make([]symbiotic.ValidatorKey, 0, len(state.keys))
+		for _, tag := range slices.Sorted(maps.Keys(state.keys)) {
+			keys = append(keys, symbiotic.ValidatorKey{Tag: tag, Payload: state.keys[tag]})
+		}
+		result = append(result, symbiotic.OperatorWithKeys{Operator: operator, Keys: keys})
+	}
+	return result, nil
+}
+
+func (c *Client) CommitValsetHeader(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte, opts ...symbiotic.EVMOption) (symbiotic.TxResult, error) {
+	if err := c.checkSettlement(addr); err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	if _, err := c.sender(); err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	provenAt, err := c.verifyHeaderProof(ctx, addr, header, extraData, proof)
+	if err != nil {
+		return symbiotic.TxResult{}, errors.Errorf("failed to commit valset header: %w", err)
+	}
+	result, err := c.chain.setHeader(addr.Address, header, extraData, proof, provenAt, false)
+	if err != nil {
+		return symbiotic.TxResult{}, errors.Errorf("failed to commit valset header: %w", err)
+	}
+	if hook := symbiotic.AppliedEVMOptions(opts...).OnTxSent; hook != nil {
+		hook(result.TxHash)
+	}
+	return result, nil
+}
+
+func (c *Client) SimulateCommitValsetHeader(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte) (symbiotic.TxSimulation, error) {
+	if err := c.checkSettlement(addr); err != nil {
+		return symbiotic.TxSimulation{}, err
+	}
+	if _, err := c.sender(); err != nil {
+		return symbiotic.TxSimulation{}, err
+	}
+	provenAt, err := c.verifyHeaderProof(ctx, addr, header, extraData, proof)
+	if err != nil {
+		return symbiotic.TxSimulation{}, errors.Errorf("transaction would revert: %w", err)
+	}
+	simulation, err := c.chain.simulateHeader(addr.Address, header, proof, provenAt)
+	if err != nil {
+		return symbiotic.TxSimulation{}, errors.Errorf("transaction would revert: %w", err)
+	}
+	return simulation, nil
+}
+
+func (c *Client) SetGenesis(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData) (symbiotic.TxResult, error) {
+	if err := c.checkSettlement(addr); err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	if _, err := c.sender(); err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	return c.chain.setHeader(addr.Address, header, extraData, nil, 0, true)
+}
+
+func (c *Client) RegisterOperator(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.TxResult, error) {
+	if err := c.checkContract(addr, c.chain.contracts.OperatorRegistry); err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	operator, err := c.sender()
+	if err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	return c.chain.change(operator, func(state *operatorState) {
+		state.inRegistry = true
+	}), nil
+}
+
+func (c *Client) RegisterKey(ctx context.Context, addr symbiotic.CrossChainAddress, keyTag symbiotic.KeyTag, key symbiotic.CompactPublicKey, signature symbiotic.RawSignature, extraData []byte) (symbiotic.TxResult, error) {
+	if err := c.checkContract(addr, c.chain.contracts.KeyRegistry); err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	operator, err := c.sender()
+	if err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	key = append(symbiotic.CompactPublicKey(nil), key...)
+	return c.chain.change(operator, func(state *operatorState) {
+		state.keys[keyTag] = key
+	}), nil
+}
+
+func (c *Client) InvalidateOldSignatures(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.TxResult, error) {
+	if err := c.checkContract(addr, c.chain.contracts.VotingPowerProvider); err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	operator, err := c.sender()
+	if err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	return c.chain.invalidateNonce(operator), nil
+}
+
+func (c *Client) RegisterOperatorVotingPowerProvider(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.TxResult, error) {
+	if err := c.checkContract(addr, c.chain.contracts.VotingPowerProvider); err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	operator, err := c.sender()
+	if err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	return c.chain.change(operator, func(state *operatorState) {
+		state.registered = true
+	}), nil
+}
+
+func (c *Client) UnregisterOperatorVotingPowerProvider(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.TxResult, error) {
+	if err := c.checkContract(addr, c.chain.contracts.VotingPowerProvider); err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	operator, err := c.sender()
+	if err != nil {
+		return symbiotic.TxResult{}, err
+	}
+	return c.chain.change(operator, func(state *operatorState) {
+		state.registered = false
+	}), nil
+}
+
+// VerifyQuorumSig verifies the proof with the validator set derived at the epoch and the given quorum threshold.
+func (c *Client) VerifyQuorumSig(ctx context.Context, addr symbiotic.CrossChainAddress, epoch symbiotic.Epoch, message []byte, keyTag symbiotic.KeyTag, threshold *big.Int, proof []byte) (bool, error) {
+	if err := c.checkSettlement(addr); err != nil {
+		return false, err
+	}
+	valset, err := c.validatorSetAt(ctx, epoch)
+	if err != nil {
+		return false, err
+	}
+	valset.QuorumThreshold = symbiotic.ToVotingPower(new(big.Int).Set(threshold))
+	return c.verifyProof(ctx, valset, keyTag, message, proof)
+}
+
+// verifyHeaderProof verifies the quorum proof of a header the way the settlement does, with the validator set
+// of the last committed header, and returns the epoch of that validator set.
+func (c *Client) verifyHeaderProof(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte) (symbiotic.Epoch, error) {
+	epoch, ok := c.chain.lastCommittedEpoch(addr.Address, false)
+	// headers the settlement rejects before verifying the proof are left to the checks of the chain
+	if !ok || header.Epoch <= epoch || len(proof) == 0 {
+		return epoch, nil
+	}
+	valset, err := c.validatorSetAt(ctx, epoch)
+	if err != nil {
+		return 0, err
+	}
+
+	message, err := c.headerCommitment(ctx, addr, header, extraData)
+	if err != nil {
+		return 0, err
+	}
+
+	verified, err := c.verifyProof(ctx, valset, valset.RequiredKeyTag, message, proof)
+	if err != nil {
+		return 0, errors.Errorf("quorum proof is not valid for the validator set of epoch %d: %w", epoch, err)
+	}
+	if !verified {
+		return 0, errors.Errorf("quorum proof is not valid for the validator set of epoch %d", epoch)
+	}
+	return epoch, nil
+}
+
+// headerCommitment returns the typed data of the header commitment the quorum signs.
+func (c *Client) headerCommitment(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData) ([]byte, error) {
+	deriver, err := valsetDeriver.NewDeriver(c, nil)
+	if err != nil {
+		return nil, errors.Errorf("failed to create deriver: %w", err)
+	}
+	networkData, err := deriver.GetNetworkData(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	headerHash, err := header.Hash()
+	if err != nil {
+		return nil, errors.Errorf("failed to hash valset header: %w", err)
+	}
+	extraDataHash, err := symbiotic.ExtraDataList(extraData).Hash()
+	if err != nil {
+		return nil, errors.Errorf("failed to hash extra data: %w", err)
+	}
+	_, message, err := apitypes.TypedDataAndHash(
+		symbiotic.ValSetHeaderCommitTypedData(networkData, header.Epoch, headerHash, common.BytesToHash(extraDataHash)),
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to get typed data hash: %w", err)
+	}
+	return []byte(message), nil
+}
+
+// validatorSetAt derives the validator set of the epoch from the chain state.
+func (c *Client) validatorSetAt(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.ValidatorSet, error) {
+	deriver, err := valsetDeriver.NewDeriver(c, nil)
+	if err != nil {
+		return symbiotic.ValidatorSet{}, errors.Errorf("failed to create deriver: %w", err)
+	}
+	captureTimestamp, err := c.GetEpochStart(ctx, epoch)
+	if err != nil {
+		return symbiotic.ValidatorSet{}, err
+	}
+	networkConfig, err := c.GetConfig(ctx, captureTimestamp, epoch)
+	if err != nil {
+		return symbiotic.ValidatorSet{}, err
+	}
+	valset, err := deriver.GetValidatorSet(ctx, epoch, networkConfig)
+	if err != nil {
+		return symbiotic.ValidatorSet{}, errors.Errorf("failed to derive validator set of epoch %d: %w", epoch, err)
+	}
+	return valset, nil
+}
+
+// verifyProof verifies the proof of the message with the go verifier of the chain verification type,
+// an invalid proof is reported as false or as an error depending on the verifier.
+func (c *Client) verifyProof(ctx context.Context, valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag, message []byte, proof []byte) (bool, error) {
+	agg, err := aggregator.NewAggregator(c.chain.cfg.VerificationType, nil)
+	if err != nil {
+		return false, errors.Errorf("failed to create aggregator: %w", err)
+	}
+	messageHash, err := cryptoSym.HashMessage(keyTag.Type(), message)
+	if err != nil {
+		return false, errors.Errorf("failed to hash message: %w", err)
+	}
+	return agg.Verify(ctx, valset, keyTag, symbiotic.AggregationProof{
+		MessageHash: messageHash,
+		KeyTag:      keyTag,
+		Epoch:       valset.Epoch,
+		Proof:       proof,
+	})
+}
+
+// sender returns the address transactions of the client are sent from.
+func (c *Client) sender() (common.Address, error) {
+	pk, err := c.keyProvider.GetPrivateKeyByNamespaceTypeId(
+		keyprovider.EVM_KEY_NAMESPACE,
+		symbiotic.KeyTypeEcdsaSecp256k1,
+		int(c.chain.cfg.ChainID),
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+	ecdsaKey, err := crypto.ToECDSA(pk.Bytes())
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(ecdsaKey.PublicKey), nil
+}
+
+func (c *Client) address(contract common.Address) symbiotic.CrossChainAddress {
+	return symbiotic.CrossChainAddress{ChainId: c.chain.cfg.ChainID, Address: contract}
+}
+
+func (c *Client) checkContract(addr symbiotic.CrossChainAddress, contract common.Address) error {
+	if addr.ChainId != c.chain.cfg.ChainID || addr.Address != contract {
+		return errors.Errorf("no contract at %s on chain %d", addr.Address.Hex(), addr.ChainId)
+	}
+	return nil
+}
+
+func (c *Client) checkSettlement(addr symbiotic.CrossChainAddress) error {
+	if addr.ChainId != c.chain.cfg.ChainID || !slices.Contains(c.chain.contracts.Settlements, addr.Address) {
+		return errors.Errorf("no settlement at %s on chain %d", addr.Address.Hex(), addr.ChainId)
+	}
+	return nil
+}
+
+// finalized reports whether the call reads the finalized view of the chain, the default of the evm client.
+func finalized(opts []symbiotic.EVMOption) bool {
+	return symbiotic.AppliedEVMOptions(opts...).BlockNumber != symbiotic.BlockNumberLatest
+}
+
+func (c *Client) eip712Domain(name string, addr symbiotic.CrossChainAddress) symbiotic.Eip712Domain {
+	return symbiotic.Eip712Domain{
+		Fields:            [1]byte{0x0f},
+		Name:              name,
+		Version:           "1",
+		ChainId:           new(big.Int).SetUint64(addr.ChainId),
+		VerifyingContract: addr.Address,
+	}
+}
+
+// VaultAddress returns the address of the vault the voting power of the operator comes from.
+func VaultAddress(operator common.Address) common.Address {
+	return crypto.CreateAddress(operator, 0)
+}
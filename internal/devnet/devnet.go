@@ -0,0 +1,588 @@
+package devnet
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"github.com/go-playground/validator/v10"
+	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/symbioticfi/relay/internal/client/p2p"
+	bboltrepo "github.com/symbioticfi/relay/internal/client/repository/bbolt"
+	"github.com/symbioticfi/relay/internal/client/repository/cached"
+	"github.com/symbioticfi/relay/internal/devnet/chaos"
+	"github.com/symbioticfi/relay/internal/node"
+	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
+	"github.com/symbioticfi/relay/internal/usecase/metrics"
+	"github.com/symbioticfi/relay/pkg/log"
+	"github.com/symbioticfi/relay/pkg/signals"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/aggregator"
+	valsetDeriver "github.com/symbioticfi/relay/symbiotic/usecase/valset-deriver"
+)
+
+// Config describes a devnet of relays running in one process on a chain stub.
+type Config struct {
+	Nodes int `validate:"gte=1"`
+	Chain ChainStubConfig
+	// Seed is the seed keys of the relays are derived from, the same seed gives the same keys and operators
+	Seed []byte `validate:"required"`
+	// StorageDir holds storage of the relays, one subdirectory per relay
+	StorageDir string `validate:"required"`
+	// APIAddresses are listen addresses of API servers of the relays, free local ports are used if empty
+	APIAddresses []string
+	// VotingPower is the voting power of every relay operator
+	VotingPower *big.Int `validate:"required"`
+	// PollingInterval is the interval of onchain state polling of the relays
+	PollingInterval time.Duration `validate:"gt=0"`
+	// CrashDowntime is the time a relay crashed by an injected fault stays down before it is restarted
+	CrashDowntime time.Duration
+}
+
+// DefaultConfig returns the config of a devnet of three relays storing data in the given directory.
+func DefaultConfig(storageDir string) Config {
+	return Config{
+		Nodes:           3,
+		Chain:           DefaultChainStubConfig(),
+		Seed:            []byte("symbiotic relay devnet"),
+		StorageDir:      storageDir,
+		VotingPower:     big.NewInt(1000),
+		PollingInterval: time.Second,
+		CrashDowntime:   time.Second,
+	}
+}
+
+func (c Config) Validate() error {
+	if err := validator.New().Struct(c); err != nil {
+		return errors.Errorf("invalid devnet config: %w", err)
+	}
+	if len(c.APIAddresses) > 0 && len(c.APIAddresses) != c.Nodes {
+		return errors.Errorf("got %d api addresses for %d nodes", len(c.APIAddresses), c.Nodes)
+	}
+	return nil
+}
+
+// Node is a relay of the devnet.
+type Node struct {
+	Name       string
+	Operator   common.Address
+	APIAddress string
+	// KeyProvider holds the evm, p2p and relay keys of the node
+	KeyProvider *keyprovider.CacheKeyProvider
+	EvmClient   *Client
+	// Faults are injected into the evm client, the repository and the gossip of the relay
+	Faults *chaos.Injector
+
+	peerID   peer.ID
+	hostKey  libp2pCrypto.PrivKey
+	hostAddr multiaddr.Multiaddr
+	restart  chan struct{}
+
+	mu      sync.Mutex
+	host    host.Host
+	cancel  context.CancelFunc
+	stopped bool
+}
+
+// Devnet runs relays over an in-memory libp2p mesh against a chain stub.
+// The relay operators are registered on the chain when the devnet is created and
+// the genesis header is set on start.
+type Devnet struct {
+	cfg   Config
+	chain *ChainStub
+	mesh  mocknet.Mocknet
+	nodes []*Node
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	eg     *errgroup.Group
+}
+
+func New(cfg Config) (*Devnet, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	chain, err := NewChainStub(cfg.Chain)
+	if err != nil {
+		return nil, errors.Errorf("failed to create chain: %w", err)
+	}
+
+	d := &Devnet{
+		cfg:   cfg,
+		chain: chain,
+		mesh:  mocknet.New(),
+	}
+	for i := range cfg.Nodes {
+		n, err := d.newNode(i)
+		if err != nil {
+			return nil, errors.Errorf("failed to create node %d: %w", i, err)
+		}
+		d.nodes = append(d.nodes, n)
+	}
+
+	if err := d.mesh.LinkAll(); err != nil {
+		return nil, errors.Errorf("failed to link nodes: %w", err)
+	}
+	if err := d.mesh.ConnectAllButSelf(); err != nil {
+		return nil, errors.Errorf("failed to connect nodes: %w", err)
+	}
+
+	return d, nil
+}
+
+func (d *Devnet) newNode(i int) (*Node, error) {
+	chainID := d.cfg.Chain.ChainID
+	aliases := make([]string, 0, len(d.cfg.Chain.RequiredKeyTags)+2)
+	evmAlias, err := keyprovider.ToAlias(keyprovider.EVM_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, int(chainID))
+	if err != nil {
+		return nil, err
+	}
+	p2pAlias, err := keyprovider.ToAlias(keyprovider.P2P_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, keyprovider.P2P_HOST_IDENTITY_KEY_ID)
+	if err != nil {
+		return nil, err
+	}
+	aliases = append(aliases, evmAlias, p2pAlias)
+	for _, keyTag := range d.cfg.Chain.RequiredKeyTags {
+		alias, err := keyprovider.KeyTagToAlias(keyTag)
+		if err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, alias)
+	}
+
+	kp, err := keyprovider.NewHDKeyProvider(nodeSeed(d.cfg.Seed, i), aliases)
+	if err != nil {
+		return nil, errors.Errorf("failed to derive keys: %w", err)
+	}
+	keyProvider := keyprovider.NewCacheKeyProvider(kp)
+
+	evmClient := NewClient(d.chain, keyProvider)
+	operator, err := evmClient.sender()
+	if err != nil {
+		return nil, err
+	}
+	d.chain.AddOperator(operator, d.cfg.VotingPower)
+	for _, keyTag := range d.cfg.Chain.RequiredKeyTags {
+		onchainKey, err := keyProvider.GetOnchainKeyFromCache(keyTag)
+		if err != nil {
+			return nil, errors.Errorf("failed to get key %s: %w", keyTag, err)
+		}
+		d.chain.SetKey(operator, keyTag, onchainKey)
+	}
+
+	p2pKey, err := keyProvider.GetPrivateKeyByAlias(p2pAlias)
+	if err != nil {
+		return nil, err
+	}
+	hostKey, err := libp2pCrypto.UnmarshalSecp256k1PrivateKey(p2pKey.Bytes())
+	if err != nil {
+		return nil, errors.Errorf("failed to unmarshal p2p key: %w", err)
+	}
+	addr, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", 4000+i))
+	if err != nil {
+		return nil, err
+	}
+	h, err := d.mesh.AddPeer(hostKey, addr)
+	if err != nil {
+		return nil, errors.Errorf("failed to add p2p host: %w", err)
+	}
+
+	apiAddress := ""
+	if len(d.cfg.APIAddresses) > 0 {
+		apiAddress = d.cfg.APIAddresses[i]
+	} else if apiAddress, err = freeAddress(); err != nil {
+		return nil, err
+	}
+
+	n := &Node{
+		Name:        fmt.Sprintf("relay-%d", i),
+		Operator:    operator,
+		APIAddress:  apiAddress,
+		KeyProvider: keyProvider,
+		EvmClient:   evmClient,
+		Faults:      chaos.NewInjector(uint64(i)),
+		peerID:      h.ID(),
+		hostKey:     hostKey,
+		hostAddr:    addr,
+		restart:     make(chan struct{}, 1),
+		host:        h,
+	}
+	n.Faults.OnCrash(func() {
+		if err := d.CrashNode(n.Name, d.cfg.CrashDowntime); err != nil {
+			slog.Warn("Failed to crash devnet relay", "node", n.Name, "error", err)
+		}
+	})
+	return n, nil
+}
+
+func (d *Devnet) Chain() *ChainStub {
+	return d.chain
+}
+
+func (d *Devnet) Nodes() []*Node {
+	return d.nodes
+}
+
+// AdvanceEpoch starts the next epoch on the chain.
+func (d *Devnet) AdvanceEpoch() symbiotic.Epoch {
+	return d.chain.AdvanceEpoch()
+}
+
+// Start sets the genesis header on the chain unless it is set already and starts the relays.
+// The relays run until Stop is called, the context is canceled or one of them fails.
+func (d *Devnet) Start(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.eg != nil {
+		return errors.New("devnet already started")
+	}
+
+	if _, ok := d.chain.LastCommittedEpoch(); !ok {
+		if err := d.setGenesis(ctx); err != nil {
+			return errors.Errorf("failed to set genesis: %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	eg, egCtx := errgroup.WithContext(ctx)
+	for _, n := range d.nodes {
+		eg.Go(func() error {
+			return d.superviseNode(log.WithAttrs(egCtx, slog.String("node", n.Name)), n)
+		})
+	}
+	d.cancel = cancel
+	d.eg = eg
+	return nil
+}
+
+// Wait blocks until all relays stopped and returns the first failure.
+func (d *Devnet) Wait() error {
+	d.mu.Lock()
+	eg := d.eg
+	d.mu.Unlock()
+	if eg == nil {
+		return errors.New("devnet is not started")
+	}
+	err := eg.Wait()
+	if closeErr := d.mesh.Close(); closeErr != nil && err == nil {
+		err = errors.Errorf("failed to close p2p mesh: %w", closeErr)
+	}
+	return err
+}
+
+// Stop stops the relays and waits for them to finish.
+func (d *Devnet) Stop() error {
+	d.mu.Lock()
+	cancel := d.cancel
+	d.mu.Unlock()
+	if cancel == nil {
+		return errors.New("devnet is not started")
+	}
+	cancel()
+	return d.Wait()
+}
+
+// StopNode stops the relay, it stays stopped until StartNode is called.
+func (d *Devnet) StopNode(name string) error {
+	n, err := d.node(name)
+	if err != nil {
+		return err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.stopped {
+		return errors.Errorf("%s is already stopped", name)
+	}
+	n.stopped = true
+	if n.cancel != nil {
+		n.cancel()
+	}
+	return nil
+}
+
+// StartNode starts the relay stopped by StopNode, the relay continues from its storage.
+func (d *Devnet) StartNode(name string) error {
+	n, err := d.node(name)
+	if err != nil {
+		return err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.stopped {
+		return errors.Errorf("%s is not stopped", name)
+	}
+	n.stopped = false
+	select {
+	case n.restart <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// CrashNode stops the relay and starts it again after the downtime.
+func (d *Devnet) CrashNode(name string, downtime time.Duration) error {
+	if err := d.StopNode(name); err != nil {
+		return err
+	}
+	slog.Info("Crashed devnet relay", "node", name, "downtime", downtime)
+	time.AfterFunc(downtime, func() {
+		if err := d.StartNode(name); err != nil {
+			slog.Warn("Failed to restart crashed devnet relay", "node", name, "error", err)
+		}
+	})
+	return nil
+}
+
+func (d *Devnet) node(name string) (*Node, error) {
+	for _, n := range d.nodes {
+		if n.Name == name {
+			return n, nil
+		}
+	}
+	return nil, errors.Errorf("no relay %s in the devnet", name)
+}
+
+// WaitForCommit blocks until the header of the epoch or a later one is committed to the settlement.
+func (d *Devnet) WaitForCommit(ctx context.Context, epoch symbiotic.Epoch) error {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if committed, ok := d.chain.LastCommittedEpoch(); ok && committed >= epoch {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("header of epoch %d is not committed: %w", epoch, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// setGenesis derives the validator set of the current epoch and sets its header on the settlement,
+// the same way as the generate-genesis command.
+func (d *Devnet) setGenesis(ctx context.Context) error {
+	evmClient := d.nodes[0].EvmClient
+	deriver, err := valsetDeriver.NewDeriver(evmClient, nil)
+	if err != nil {
+		return errors.Errorf("failed to create deriver: %w", err)
+	}
+
+	epoch := d.chain.CurrentEpoch()
+	captureTimestamp, err := evmClient.GetEpochStart(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	networkConfig, err := evmClient.GetConfig(ctx, captureTimestamp, epoch)
+	if err != nil {
+		return err
+	}
+	valset, err := deriver.GetValidatorSet(ctx, epoch, networkConfig)
+	if err != nil {
+		return errors.Errorf("failed to derive validator set: %w", err)
+	}
+	header, err := valset.GetHeader()
+	if err != nil {
+		return errors.Errorf("failed to generate validator set header: %w", err)
+	}
+	agg, err := aggregator.NewAggregator(networkConfig.VerificationType, nil)
+	if err != nil {
+		return errors.Errorf("failed to create aggregator: %w", err)
+	}
+	extraData, err := agg.GenerateExtraData(ctx, valset, networkConfig.RequiredKeyTags)
+	if err != nil {
+		return errors.Errorf("failed to generate extra data: %w", err)
+	}
+
+	for _, settlement := range networkConfig.Settlements {
+		if _, err := evmClient.SetGenesis(ctx, settlement, header, extraData); err != nil {
+			return err
+		}
+	}
+	slog.InfoContext(ctx, "Set devnet genesis", "epoch", epoch, "validators", len(valset.Validators))
+	return nil
+}
+
+// superviseNode runs the relay and restarts it after it is stopped by StopNode or crashed.
+func (d *Devnet) superviseNode(ctx context.Context, n *Node) error {
+	for {
+		runCtx, cancel := context.WithCancel(ctx)
+		n.mu.Lock()
+		n.cancel = cancel
+		stopped := n.stopped
+		n.mu.Unlock()
+
+		var err error
+		if !stopped {
+			err = d.runNode(runCtx, n)
+		}
+		cancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		n.mu.Lock()
+		stopped = n.stopped
+		n.mu.Unlock()
+		if !stopped {
+			if err == nil {
+				err = errors.New("relay exited")
+			}
+			return errors.Errorf("%s failed: %w", n.Name, err)
+		}
+		slog.InfoContext(ctx, "Devnet relay stopped", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-n.restart:
+		}
+		if err := d.resetHost(n); err != nil {
+			return errors.Errorf("%s failed to restart: %w", n.Name, err)
+		}
+		slog.InfoContext(ctx, "Restarting devnet relay")
+	}
+}
+
+// resetHost replaces the p2p host of the stopped relay, the relay closes its host when it stops.
+func (d *Devnet) resetHost(n *Node) error {
+	n.mu.Lock()
+	oldHost := n.host
+	n.mu.Unlock()
+	_ = oldHost.Close()
+
+	for _, other := range d.nodes {
+		if other != n {
+			if err := d.mesh.UnlinkPeers(n.peerID, other.peerID); err != nil {
+				return errors.Errorf("failed to unlink %s: %w", other.Name, err)
+			}
+		}
+	}
+	h, err := d.mesh.AddPeer(n.hostKey, n.hostAddr)
+	if err != nil {
+		return errors.Errorf("failed to add p2p host: %w", err)
+	}
+	for _, other := range d.nodes {
+		if other == n {
+			continue
+		}
+		if _, err := d.mesh.LinkPeers(n.peerID, other.peerID); err != nil {
+			return errors.Errorf("failed to link %s: %w", other.Name, err)
+		}
+		other.mu.Lock()
+		otherStopped := other.stopped
+		other.mu.Unlock()
+		// stopped relays connect to the others when they restart
+		if otherStopped {
+			continue
+		}
+		if _, err := d.mesh.ConnectPeers(n.peerID, other.peerID); err != nil {
+			slog.Warn("Failed to connect devnet relays", "node", n.Name, "peer", other.Name, "error", err)
+		}
+	}
+
+	n.mu.Lock()
+	n.host = h
+	n.mu.Unlock()
+	return nil
+}
+
+func (d *Devnet) runNode(ctx context.Context, n *Node) error {
+	mtr := metrics.New(metrics.Config{Registerer: prometheus.NewRegistry()})
+
+	dir := filepath.Join(d.cfg.StorageDir, n.Name)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.Errorf("failed to create storage dir: %w", err)
+	}
+	repo, err := bboltrepo.New(bboltrepo.Config{
+		Dir:                      dir,
+		Metrics:                  mtr,
+		MutexCleanupInterval:     time.Hour,
+		MutexCleanupStaleTimeout: time.Hour - time.Minute,
+	})
+	if err != nil {
+		return errors.Errorf("failed to create bbolt repository: %w", err)
+	}
+	defer repo.Close()
+
+	discovery := p2p.DefaultDiscoveryConfig()
+	// peers of the in-memory mesh are connected when the devnet is created
+	discovery.DHTMode = "disabled"
+	discovery.EnableMDNS = false
+
+	n.mu.Lock()
+	h := n.host
+	n.mu.Unlock()
+
+	return node.Run(ctx, node.Config{
+		KeyProvider:    n.KeyProvider,
+		EvmClient:      chaos.NewEvmClient(n.EvmClient, n.Faults),
+		Repo:           chaos.NewRepository(repo, n.Faults),
+		Host:           h,
+		Metrics:        mtr,
+		Discovery:      discovery,
+		P2PInterceptor: n.Faults.Interceptor(),
+		SignalCfg:      signals.DefaultConfig(),
+		Cache: cached.Config{
+			NetworkConfigCacheSize: 10,
+			ValidatorSetCacheSize:  10,
+		},
+		MaxUnsigners:    50,
+		PollingInterval: d.cfg.PollingInterval,
+		Sync: node.SyncConfig{
+			Enabled:      true,
+			Period:       5 * time.Second,
+			Timeout:      time.Minute,
+			EpochsToSync: 5,
+		},
+		Committer: node.CommitterConfig{
+			TakeoverTimeout:  30 * time.Second,
+			CatchUpMaxEpochs: 10,
+		},
+		SignatureBatch: node.SignatureBatchConfig{
+			Window:  5 * time.Millisecond,
+			MaxSize: 256,
+		},
+		API: node.APIConfig{
+			ListenAddress:     n.APIAddress,
+			MaxAllowedStreams: 100,
+		},
+	})
+}
+
+// nodeSeed returns the seed keys of the i-th relay are derived from.
+func nodeSeed(seed []byte, i int) []byte {
+	index := binary.BigEndian.AppendUint64(nil, uint64(i))
+	hash := sha512.Sum512(append(append([]byte(nil), seed...), index...))
+	// a BIP-39 seed is 64 bytes long
+	return hash[:]
+}
+
+func freeAddress() (string, error) {
+	listener, err := (&net.ListenConfig{}).Listen(context.Background(), "tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", errors.Errorf("failed to find a free port: %w", err)
+	}
+	addr := listener.Addr().String()
+	if err := listener.Close(); err != nil {
+		return "", err
+	}
+	return addr, nil
+}
@@ -0,0 +1,55 @@
+package devnet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func TestDevnet_CommitsNextEpoch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("devnet test runs relays for several seconds")
+	}
+
+	d, err := New(DefaultConfig(t.TempDir()))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 2*time.Minute)
+	defer cancel()
+
+	require.NoError(t, d.Start(ctx))
+	defer func() {
+		require.NoError(t, d.Stop())
+	}()
+
+	epoch, ok := d.Chain().LastCommittedEpoch()
+	require.True(t, ok)
+	require.Equal(t, symbiotic.Epoch(0), epoch)
+
+	require.Equal(t, symbiotic.Epoch(1), d.AdvanceEpoch())
+	require.NoError(t, d.WaitForCommit(ctx, 1))
+
+	// the committed proof is verified with the validator set of the genesis header
+	settlement := d.Chain().Contracts().Settlements[0]
+	genesis, ok := d.Chain().header(settlement, 0, false)
+	require.True(t, ok)
+	committed, ok := d.Chain().header(settlement, 1, false)
+	require.True(t, ok)
+	client := d.Nodes()[0].EvmClient
+	address := client.address(settlement)
+	message, err := client.headerCommitment(ctx, address, committed.header, committed.extraData)
+	require.NoError(t, err)
+	verified, err := client.VerifyQuorumSig(ctx, address, 0, message, genesis.header.RequiredKeyTag, genesis.header.QuorumThreshold.Int, committed.proof)
+	require.NoError(t, err)
+	require.True(t, verified)
+
+	// a proof of another header is rejected
+	tampered := committed.header
+	tampered.Epoch++
+	_, err = client.CommitValsetHeader(ctx, address, tampered, committed.extraData, committed.proof)
+	require.ErrorContains(t, err, "quorum proof is not valid")
+}
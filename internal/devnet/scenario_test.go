@@ -0,0 +1,189 @@
+package devnet
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	apiv1 "github.com/symbioticfi/relay/api/client/v1"
+	"github.com/symbioticfi/relay/internal/client/p2p"
+	"github.com/symbioticfi/relay/internal/devnet/chaos"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// Scenario tests run a devnet with faults injected into the relays and check liveness of the pipeline:
+// every epoch is eventually committed to all settlements and every signature request eventually gets a proof.
+
+const scenarioTimeout = 3 * time.Minute
+
+func TestScenario_EvmErrorsAndLatency(t *testing.T) {
+	d := startDevnet(t, nil, func(n *Node) {
+		require.NoError(t, n.Faults.Add(chaos.Fault{Op: "CommitValsetHeader", Times: 2}))
+		require.NoError(t, n.Faults.Add(chaos.Fault{Op: "GetKeys", Probability: 0.3}))
+		require.NoError(t, n.Faults.Add(chaos.Fault{Op: "GetVotingPowers", Latency: 200 * time.Millisecond}))
+	})
+
+	for epoch := symbiotic.Epoch(1); epoch <= 2; epoch++ {
+		advanceAndWaitForCommit(t, d, epoch)
+	}
+	requireProofs(t, d, 3)
+}
+
+func TestScenario_PartialSettlementFailures(t *testing.T) {
+	d := startDevnet(t, func(cfg *Config) {
+		cfg.Chain.NumSettlements = 2
+	}, nil)
+	settlements := d.Chain().Contracts().Settlements
+
+	for _, n := range d.Nodes() {
+		// commits to the second settlement fail for a while
+		require.NoError(t, n.Faults.Add(chaos.Fault{Op: "CommitValsetHeader", Address: settlements[1], Times: 3}))
+		// the commit to the first settlement is mined but the relay sees an error
+		require.NoError(t, n.Faults.Add(chaos.Fault{Op: "CommitValsetHeader", Address: settlements[0], Times: 1, AfterCall: true}))
+	}
+
+	advanceAndWaitForCommit(t, d, 1)
+	for _, settlement := range settlements {
+		epoch, ok := d.Chain().LastCommittedEpochAt(settlement)
+		require.True(t, ok)
+		require.Equal(t, symbiotic.Epoch(1), epoch)
+	}
+}
+
+func TestScenario_GossipFaults(t *testing.T) {
+	d := startDevnet(t, nil, func(n *Node) {
+		require.NoError(t, n.Faults.Add(chaos.Fault{Op: p2p.TopicSignatureReady, Probability: 0.5, Drop: true}))
+		require.NoError(t, n.Faults.Add(chaos.Fault{Op: p2p.TopicAggProofReady, Duplicate: 2}))
+		require.NoError(t, n.Faults.Add(chaos.Fault{Op: p2p.TopicCommitIntent, Latency: 100 * time.Millisecond}))
+	})
+
+	requireProofs(t, d, 5)
+	advanceAndWaitForCommit(t, d, 1)
+}
+
+func TestScenario_Reorg(t *testing.T) {
+	d := startDevnet(t, func(cfg *Config) {
+		cfg.Chain.FinalityDelay = 10 * time.Second
+	}, nil)
+	settlement := d.Chain().Contracts().Settlements[0]
+
+	// the commit of epoch 1 is reorganized out before it is final
+	d.AdvanceEpoch()
+	require.Eventually(t, func() bool {
+		epoch, _ := d.Chain().lastCommittedEpoch(settlement, false)
+		return epoch == 1
+	}, scenarioTimeout, 100*time.Millisecond)
+	dropped, err := d.Chain().Reorg(settlement, 1)
+	require.NoError(t, err)
+	require.Equal(t, []symbiotic.Epoch{1}, dropped)
+
+	advanceAndWaitForCommit(t, d, 1)
+	advanceAndWaitForCommit(t, d, 2)
+	requireProofs(t, d, 2)
+}
+
+func TestScenario_CrashAndRestart(t *testing.T) {
+	d := startDevnet(t, nil, func(n *Node) {
+		// relays crash after the commit transaction is sent and after the proof is saved
+		require.NoError(t, n.Faults.Add(chaos.Fault{Op: "CommitValsetHeader", Times: 1, Crash: true, AfterCall: true}))
+		require.NoError(t, n.Faults.Add(chaos.Fault{Op: "SaveProof", Times: 1, Crash: true, AfterCall: true}))
+	})
+
+	advanceAndWaitForCommit(t, d, 1)
+	requireProofs(t, d, 2)
+
+	// a relay is down for a whole epoch
+	name := d.Nodes()[1].Name
+	require.NoError(t, d.StopNode(name))
+	d.AdvanceEpoch()
+	time.Sleep(2 * time.Second)
+	require.NoError(t, d.StartNode(name))
+	advanceAndWaitForCommit(t, d, 3)
+	requireProofs(t, d, 2)
+}
+
+// startDevnet starts a devnet and stops it when the test finishes, faults are added to every relay before start.
+func startDevnet(t *testing.T, configure func(cfg *Config), faults func(n *Node)) *Devnet {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("scenario tests run relays for tens of seconds")
+	}
+	t.Parallel()
+
+	cfg := DefaultConfig(t.TempDir())
+	if configure != nil {
+		configure(&cfg)
+	}
+	d, err := New(cfg)
+	require.NoError(t, err)
+	if faults != nil {
+		for _, n := range d.Nodes() {
+			faults(n)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	require.NoError(t, d.Start(ctx))
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, d.Wait())
+	})
+	return d
+}
+
+func advanceAndWaitForCommit(t *testing.T, d *Devnet, epoch symbiotic.Epoch) {
+	t.Helper()
+	for d.Chain().CurrentEpoch() < epoch {
+		d.AdvanceEpoch()
+	}
+	ctx, cancel := context.WithTimeout(t.Context(), scenarioTimeout)
+	defer cancel()
+	require.NoError(t, d.WaitForCommit(ctx, epoch))
+}
+
+// requireProofs requests signatures of messages on every relay and waits for their proofs on every relay.
+func requireProofs(t *testing.T, d *Devnet, count int) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(t.Context(), scenarioTimeout)
+	defer cancel()
+
+	clients := make([]*apiv1.SymbioticClient, 0, len(d.Nodes()))
+	for _, n := range d.Nodes() {
+		conn, err := grpc.NewClient(n.APIAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			conn.Close()
+		})
+		clients = append(clients, apiv1.NewSymbioticClient(conn))
+	}
+
+	keyTag := uint32(d.cfg.Chain.RequiredHeaderKeyTag)
+	for i := range count {
+		message := fmt.Appendf(nil, "%s message %d at %d", t.Name(), i, time.Now().UnixNano())
+		var (
+			resp *apiv1.SignMessageResponse
+			err  error
+		)
+		// relays may be restarting, so the request is retried
+		require.Eventually(t, func() bool {
+			for _, client := range clients {
+				resp, err = client.SignMessage(ctx, &apiv1.SignMessageRequest{KeyTag: keyTag, Message: message})
+				if err != nil {
+					return false
+				}
+			}
+			return true
+		}, scenarioTimeout, 500*time.Millisecond)
+		require.NoError(t, err)
+
+		for _, client := range clients {
+			_, err := client.WaitForProof(ctx, resp.GetRequestId(), resp.GetEpoch(), 200*time.Millisecond)
+			require.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+var aggregationIntentDomain = []byte("symbiotic-relay/aggregation-intent/v1")
+
+// AggregationIntent is gossiped by an aggregator when it starts aggregating the proof of a request,
+// so that other aggregators of the request defer instead of proving the same request in parallel.
+// It is signed by the aggregator's required key so peers can check that it comes from an aggregator of the epoch.
+type AggregationIntent struct {
+	RequestID common.Hash
+	Epoch     symbiotic.Epoch
+	// Timestamp is the sender's wall-clock time, part of the signed message to limit replays
+	Timestamp symbiotic.Timestamp
+	PublicKey symbiotic.RawPublicKey
+	Signature symbiotic.RawSignature
+}
+
+// SigningMessage returns the message signed by the aggregator.
+func (a AggregationIntent) SigningMessage() []byte {
+	msg := make([]byte, 0, len(aggregationIntentDomain)+common.HashLength+8+8)
+	msg = append(msg, aggregationIntentDomain...)
+	msg = append(msg, a.RequestID.Bytes()...)
+	msg = binary.BigEndian.AppendUint64(msg, uint64(a.Epoch))
+	msg = binary.BigEndian.AppendUint64(msg, uint64(a.Timestamp))
+	return msg
+}
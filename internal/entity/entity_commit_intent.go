@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+var commitIntentDomain = []byte("symbiotic-relay/commit-intent/v1")
+
+// CommitIntent is gossiped by a committer when it starts committing a validator set header
+// to a settlement and again once the commit transaction is sent.
+// It is signed by the committer's required key so peers can check that it comes from a committer of the epoch.
+type CommitIntent struct {
+	Epoch      symbiotic.Epoch
+	Settlement symbiotic.CrossChainAddress
+	// TxHash is zero until the commit transaction is sent
+	TxHash common.Hash
+	// Timestamp is the sender's wall-clock time, part of the signed message to limit replays
+	Timestamp symbiotic.Timestamp
+	PublicKey symbiotic.RawPublicKey
+	Signature symbiotic.RawSignature
+}
+
+// SigningMessage returns the message signed by the committer.
+func (c CommitIntent) SigningMessage() []byte {
+	msg := make([]byte, 0, len(commitIntentDomain)+8+8+common.AddressLength+common.HashLength+8)
+	msg = append(msg, commitIntentDomain...)
+	msg = binary.BigEndian.AppendUint64(msg, uint64(c.Epoch))
+	msg = binary.BigEndian.AppendUint64(msg, c.Settlement.ChainId)
+	msg = append(msg, c.Settlement.Address.Bytes()...)
+	msg = append(msg, c.TxHash.Bytes()...)
+	msg = binary.BigEndian.AppendUint64(msg, uint64(c.Timestamp))
+	return msg
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/go-errors/errors"
+)
+
+// CommitDecision is the outcome of a commit strategy for the current fee of a settlement chain.
+type CommitDecision string
+
+const (
+	// CommitDecisionSend sends the commit with the suggested fee
+	CommitDecisionSend CommitDecision = "send"
+	// CommitDecisionWait defers the commit until the fee drops below the maximum or the wait deadline passes
+	CommitDecisionWait CommitDecision = "wait"
+	// CommitDecisionCapped sends the commit with the fee capped at the maximum
+	CommitDecisionCapped CommitDecision = "capped"
+)
+
+// CommitPolicy configures how validator set headers are committed to the settlement chains.
+type CommitPolicy struct {
+	// Chains are the strategies of settlement chains keyed by chain ID, other chains commit immediately
+	Chains map[uint64]CommitStrategy
+	// Simulate simulates every commit with eth_call before sending it, commits with a fee cap are always simulated
+	Simulate bool
+}
+
+// CommitStrategy is the commit strategy of a settlement chain.
+type CommitStrategy struct {
+	// MaxFeePerGas is the maximum fee per gas in wei, the suggested fee is used if nil
+	MaxFeePerGas *big.Int
+	// WaitForCheaperGas is how long before the next epoch commits above MaxFeePerGas are deferred,
+	// after that they are sent with the fee capped at MaxFeePerGas
+	WaitForCheaperGas time.Duration
+}
+
+func (p CommitPolicy) Validate() error {
+	for chainID, strategy := range p.Chains {
+		if strategy.MaxFeePerGas != nil && strategy.MaxFeePerGas.Sign() <= 0 {
+			return errors.Errorf("max fee per gas of chain %d must be positive", chainID)
+		}
+		if strategy.WaitForCheaperGas < 0 {
+			return errors.Errorf("gas wait deadline of chain %d must not be negative", chainID)
+		}
+		if strategy.WaitForCheaperGas > 0 && strategy.MaxFeePerGas == nil {
+			return errors.Errorf("gas wait deadline of chain %d requires a max fee per gas", chainID)
+		}
+	}
+	return nil
+}
+
+// Strategy returns the commit strategy of the chain, the zero strategy commits immediately.
+func (p CommitPolicy) Strategy(chainID uint64) CommitStrategy {
+	return p.Chains[chainID]
+}
+
+// Decide returns how to commit at the given fee per gas. Commits above the maximum fee are deferred until
+// WaitForCheaperGas before the next epoch starts and capped afterwards, a zero nextEpochStart never defers.
+func (s CommitStrategy) Decide(feePerGas *big.Int, now, nextEpochStart time.Time) CommitDecision {
+	if s.MaxFeePerGas == nil || feePerGas.Cmp(s.MaxFeePerGas) <= 0 {
+		return CommitDecisionSend
+	}
+	if s.WaitForCheaperGas > 0 && !nextEpochStart.IsZero() && now.Before(nextEpochStart.Add(-s.WaitForCheaperGas)) {
+		return CommitDecisionWait
+	}
+	return CommitDecisionCapped
+}
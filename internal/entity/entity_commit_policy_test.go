@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitStrategy_Decide(t *testing.T) {
+	now := time.Unix(1_000, 0)
+	nextEpochStart := now.Add(10 * time.Minute)
+	strategy := CommitStrategy{MaxFeePerGas: big.NewInt(100), WaitForCheaperGas: 5 * time.Minute}
+
+	require.Equal(t, CommitDecisionSend, CommitStrategy{}.Decide(big.NewInt(1_000), now, nextEpochStart))
+	require.Equal(t, CommitDecisionSend, strategy.Decide(big.NewInt(100), now, nextEpochStart))
+	require.Equal(t, CommitDecisionWait, strategy.Decide(big.NewInt(101), now, nextEpochStart))
+	require.Equal(t, CommitDecisionCapped, strategy.Decide(big.NewInt(101), now.Add(5*time.Minute), nextEpochStart))
+	require.Equal(t, CommitDecisionCapped, strategy.Decide(big.NewInt(101), now, time.Time{}))
+	require.Equal(t, CommitDecisionCapped, CommitStrategy{MaxFeePerGas: big.NewInt(100)}.Decide(big.NewInt(101), now, nextEpochStart))
+}
+
+func TestCommitPolicy_Validate(t *testing.T) {
+	require.NoError(t, CommitPolicy{Chains: map[uint64]CommitStrategy{1: {MaxFeePerGas: big.NewInt(1), WaitForCheaperGas: time.Minute}}}.Validate())
+	require.Error(t, CommitPolicy{Chains: map[uint64]CommitStrategy{1: {MaxFeePerGas: big.NewInt(0)}}}.Validate())
+	require.Error(t, CommitPolicy{Chains: map[uint64]CommitStrategy{1: {WaitForCheaperGas: time.Minute}}}.Validate())
+	require.Error(t, CommitPolicy{Chains: map[uint64]CommitStrategy{1: {MaxFeePerGas: big.NewInt(1), WaitForCheaperGas: -time.Minute}}}.Validate())
+}
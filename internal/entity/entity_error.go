@@ -14,4 +14,8 @@ const (
 	ErrNoPeers            = StringError("no peers available")
 	ErrTxConflict         = StringError("transaction conflict")
 	ErrKeyNotFound        = StringError("key not found")
+	ErrNotCancellable     = StringError("signature request can not be cancelled")
+	ErrRequestInactive    = StringError("signature request is cancelled or expired")
+	// ErrClientUnauthenticated is returned for API clients that claim a client ID without its token
+	ErrClientUnauthenticated = StringError("client token is missing or invalid")
 )
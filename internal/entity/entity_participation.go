@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// ParticipationLatencyBuckets are the upper bounds of the signing latency histogram of ValidatorParticipation,
+// latencies above the last bound fall into an extra unbounded bucket.
+var ParticipationLatencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+	time.Minute,
+}
+
+// ValidatorParticipation is the signing record of a validator in an epoch.
+type ValidatorParticipation struct {
+	Epoch    symbiotic.Epoch
+	Operator common.Address
+	// Seen counts the requests of the epoch the validator was expected to sign, it is the sum of Signed and Missed
+	Seen   uint64
+	Signed uint64
+	Missed uint64
+	// LatencyBuckets counts signatures by their delay relative to the first signature of the request,
+	// bucket i holds delays up to ParticipationLatencyBuckets[i], the extra last bucket the longer ones
+	LatencyBuckets []uint64
+}
+
+// ObserveLatency records the delay of a signature relative to the first signature of its request.
+func (p *ValidatorParticipation) ObserveLatency(d time.Duration) {
+	if len(p.LatencyBuckets) != len(ParticipationLatencyBuckets)+1 {
+		buckets := make([]uint64, len(ParticipationLatencyBuckets)+1)
+		copy(buckets, p.LatencyBuckets)
+		p.LatencyBuckets = buckets
+	}
+
+	for i, bound := range ParticipationLatencyBuckets {
+		if d <= bound {
+			p.LatencyBuckets[i]++
+			return
+		}
+	}
+	p.LatencyBuckets[len(ParticipationLatencyBuckets)]++
+}
+
+// Add adds the counts of other to the record, both records must be of the same validator and epoch.
+func (p *ValidatorParticipation) Add(other ValidatorParticipation) {
+	p.Seen += other.Seen
+	p.Signed += other.Signed
+	p.Missed += other.Missed
+
+	if len(other.LatencyBuckets) > len(p.LatencyBuckets) {
+		buckets := make([]uint64, len(other.LatencyBuckets))
+		copy(buckets, p.LatencyBuckets)
+		p.LatencyBuckets = buckets
+	}
+	for i, count := range other.LatencyBuckets {
+		p.LatencyBuckets[i] += count
+	}
+}
+
+// MedianLatency estimates the median signing latency from the histogram by linear interpolation within the
+// bucket holding the median, like Prometheus histogram_quantile. It is zero without observations and the
+// last bound if the median falls into the unbounded bucket.
+func (p ValidatorParticipation) MedianLatency() time.Duration {
+	var total uint64
+	for _, count := range p.LatencyBuckets {
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+
+	rank := float64(total) / 2
+	var cumulative uint64
+	for i, count := range p.LatencyBuckets {
+		if float64(cumulative+count) < rank {
+			cumulative += count
+			continue
+		}
+		if i >= len(ParticipationLatencyBuckets) {
+			return ParticipationLatencyBuckets[len(ParticipationLatencyBuckets)-1]
+		}
+
+		var lower time.Duration
+		if i > 0 {
+			lower = ParticipationLatencyBuckets[i-1]
+		}
+		upper := ParticipationLatencyBuckets[i]
+		return lower + time.Duration(float64(upper-lower)*(rank-float64(cumulative))/float64(count))
+	}
+	return ParticipationLatencyBuckets[len(ParticipationLatencyBuckets)-1]
+}
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorParticipation_MedianLatency(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		latencies []time.Duration
+		expected  time.Duration
+	}{
+		{
+			name:     "no observations",
+			expected: 0,
+		},
+		{
+			name:      "single observation interpolates within its bucket",
+			latencies: []time.Duration{10 * time.Millisecond},
+			expected:  25 * time.Millisecond,
+		},
+		{
+			name:      "median in the middle bucket",
+			latencies: []time.Duration{10 * time.Millisecond, 300 * time.Millisecond, 400 * time.Millisecond, 20 * time.Second},
+			// rank 2 of 4 falls at the first of two observations in the (250ms, 500ms] bucket
+			expected: 375 * time.Millisecond,
+		},
+		{
+			name:      "median above the last bound",
+			latencies: []time.Duration{2 * time.Minute, 3 * time.Minute, time.Second},
+			expected:  time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var participation ValidatorParticipation
+			for _, latency := range tt.latencies {
+				participation.ObserveLatency(latency)
+			}
+			require.Equal(t, tt.expected, participation.MedianLatency())
+		})
+	}
+}
+
+func TestValidatorParticipation_Add(t *testing.T) {
+	t.Parallel()
+
+	participation := ValidatorParticipation{Seen: 1, Signed: 1}
+	participation.ObserveLatency(time.Second)
+
+	other := ValidatorParticipation{Seen: 2, Signed: 1, Missed: 1}
+	other.ObserveLatency(40 * time.Millisecond)
+	participation.Add(other)
+
+	require.Equal(t, uint64(3), participation.Seen)
+	require.Equal(t, uint64(2), participation.Signed)
+	require.Equal(t, uint64(1), participation.Missed)
+	require.Equal(t, []uint64{1, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0}, participation.LatencyBuckets)
+
+	// records without latencies keep the histogram
+	participation.Add(ValidatorParticipation{Seen: 1, Missed: 1})
+	require.Equal(t, []uint64{1, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0}, participation.LatencyBuckets)
+}
@@ -0,0 +1,132 @@
+package entity
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/pkg/fairqueue"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// PriorityPolicy assigns signature requests to scheduling classes.
+// Validator set header requests are always in the strict header class, other requests take the class of the
+// API client that submitted them, else the class of their key tag, else the normal class.
+type PriorityPolicy struct {
+	KeyTags map[symbiotic.KeyTag]symbiotic.PriorityClass
+	// Clients are keyed by client ID, IDs are case-insensitive since config keys are lower cased
+	Clients map[string]symbiotic.PriorityClass
+	// ClientTokens are the secret tokens clients authenticate their client ID with, keyed by client ID.
+	// Every client with a class needs one, otherwise any caller could claim its class.
+	ClientTokens map[string]string
+	// Weights are the shares of the weighted classes, unset classes use DefaultPriorityWeights
+	Weights map[symbiotic.PriorityClass]int
+}
+
+func DefaultPriorityWeights() map[symbiotic.PriorityClass]int {
+	return map[symbiotic.PriorityClass]int{
+		symbiotic.PriorityHigh:   4,
+		symbiotic.PriorityNormal: 2,
+		symbiotic.PriorityLow:    1,
+	}
+}
+
+func (p PriorityPolicy) Validate() error {
+	for keyTag, class := range p.KeyTags {
+		if !configurableClass(class) {
+			return errors.Errorf("invalid priority class %s for key tag %s", class, keyTag)
+		}
+	}
+	for client, class := range p.Clients {
+		if !configurableClass(class) {
+			return errors.Errorf("invalid priority class %s for client %q", class, client)
+		}
+		if _, ok := lookupClient(p.ClientTokens, client); !ok {
+			return errors.Errorf("no token for client %q", client)
+		}
+	}
+	for client, token := range p.ClientTokens {
+		if _, ok := lookupClient(p.Clients, client); !ok {
+			return errors.Errorf("token for client %q without a priority class", client)
+		}
+		if token == "" {
+			return errors.Errorf("empty token for client %q", client)
+		}
+	}
+	for class, weight := range p.Weights {
+		if !configurableClass(class) {
+			return errors.Errorf("weight can not be set for priority class %s", class)
+		}
+		if weight <= 0 {
+			return errors.Errorf("weight of priority class %s must be positive", class)
+		}
+	}
+	return nil
+}
+
+func configurableClass(class symbiotic.PriorityClass) bool {
+	return class == symbiotic.PriorityLow || class == symbiotic.PriorityNormal || class == symbiotic.PriorityHigh
+}
+
+// ClientClass returns the class of requests submitted by the API client, unspecified for unknown clients.
+// A known client must present its token, ErrClientUnauthenticated is returned otherwise.
+func (p PriorityPolicy) ClientClass(clientID, token string) (symbiotic.PriorityClass, error) {
+	if clientID == "" {
+		return symbiotic.PriorityUnspecified, nil
+	}
+	class, ok := lookupClient(p.Clients, clientID)
+	if !ok {
+		return symbiotic.PriorityUnspecified, nil
+	}
+	expected, ok := lookupClient(p.ClientTokens, clientID)
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		return symbiotic.PriorityUnspecified, errors.Errorf("client %q: %w", clientID, ErrClientUnauthenticated)
+	}
+	return class, nil
+}
+
+// lookupClient returns the value of the client ID, IDs are matched case-insensitively.
+func lookupClient[V any](values map[string]V, clientID string) (V, bool) {
+	if value, ok := values[clientID]; ok {
+		return value, true
+	}
+	for id, value := range values {
+		if strings.EqualFold(id, clientID) {
+			return value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Class returns the scheduling class of the request.
+func (p PriorityPolicy) Class(req symbiotic.SignatureRequest) symbiotic.PriorityClass {
+	if req.Priority != symbiotic.PriorityUnspecified {
+		return req.Priority
+	}
+	if class, ok := p.KeyTags[req.KeyTag]; ok {
+		return class
+	}
+	return symbiotic.PriorityNormal
+}
+
+// QueueConfig returns the configuration of a fair queue scheduling the classes of the policy.
+func (p PriorityPolicy) QueueConfig(name string, observer fairqueue.Observer) fairqueue.Config {
+	weights := DefaultPriorityWeights()
+	for class, weight := range p.Weights {
+		weights[class] = weight
+	}
+
+	return fairqueue.Config{
+		Name: name,
+		Classes: []fairqueue.Class{
+			{Name: symbiotic.PriorityHeader.String(), Strict: true},
+			{Name: symbiotic.PriorityHigh.String(), Weight: weights[symbiotic.PriorityHigh]},
+			{Name: symbiotic.PriorityNormal.String(), Weight: weights[symbiotic.PriorityNormal]},
+			{Name: symbiotic.PriorityLow.String(), Weight: weights[symbiotic.PriorityLow]},
+		},
+		DefaultClass: symbiotic.PriorityNormal.String(),
+		Observer:     observer,
+	}
+}
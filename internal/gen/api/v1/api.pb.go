@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,6 +24,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Signature request lifecycle state enumeration
+type SignatureRequestStatus int32
+
+const (
+	// Default/unknown status
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED SignatureRequestStatus = 0
+	// No signatures are collected yet
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_PENDING SignatureRequestStatus = 1
+	// Signatures are being collected, final for non aggregation key tags
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_SIGNED SignatureRequestStatus = 2
+	// Aggregation proof is available
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_AGGREGATED SignatureRequestStatus = 3
+	// Deadline passed before the request was aggregated
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_EXPIRED SignatureRequestStatus = 4
+	// Request was cancelled on this relay
+	SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_CANCELLED SignatureRequestStatus = 5
+)
+
+// Enum value maps for SignatureRequestStatus.
+var (
+	SignatureRequestStatus_name = map[int32]string{
+		0: "SIGNATURE_REQUEST_STATUS_UNSPECIFIED",
+		1: "SIGNATURE_REQUEST_STATUS_PENDING",
+		2: "SIGNATURE_REQUEST_STATUS_SIGNED",
+		3: "SIGNATURE_REQUEST_STATUS_AGGREGATED",
+		4: "SIGNATURE_REQUEST_STATUS_EXPIRED",
+		5: "SIGNATURE_REQUEST_STATUS_CANCELLED",
+	}
+	SignatureRequestStatus_value = map[string]int32{
+		"SIGNATURE_REQUEST_STATUS_UNSPECIFIED": 0,
+		"SIGNATURE_REQUEST_STATUS_PENDING":     1,
+		"SIGNATURE_REQUEST_STATUS_SIGNED":      2,
+		"SIGNATURE_REQUEST_STATUS_AGGREGATED":  3,
+		"SIGNATURE_REQUEST_STATUS_EXPIRED":     4,
+		"SIGNATURE_REQUEST_STATUS_CANCELLED":   5,
+	}
+)
+
+func (x SignatureRequestStatus) Enum() *SignatureRequestStatus {
+	p := new(SignatureRequestStatus)
+	*p = x
+	return p
+}
+
+func (x SignatureRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignatureRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (SignatureRequestStatus) Type() protoreflect.EnumType {
+	return &file_v1_api_proto_enumTypes[0]
+}
+
+func (x SignatureRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignatureRequestStatus.Descriptor instead.
+func (SignatureRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{0}
+}
+
 // Validator set status enumeration
 type ValidatorSetStatus int32
 
@@ -64,11 +130,11 @@ func (x ValidatorSetStatus) String() string {
 }
 
 func (ValidatorSetStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_api_proto_enumTypes[0].Descriptor()
+	return file_v1_api_proto_enumTypes[1].Descriptor()
 }
 
 func (ValidatorSetStatus) Type() protoreflect.EnumType {
-	return &file_v1_api_proto_enumTypes[0]
+	return &file_v1_api_proto_enumTypes[1]
 }
 
 func (x ValidatorSetStatus) Number() protoreflect.EnumNumber {
@@ -77,7 +143,7 @@ func (x ValidatorSetStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ValidatorSetStatus.Descriptor instead.
 func (ValidatorSetStatus) EnumDescriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{0}
+	return file_v1_api_proto_rawDescGZIP(), []int{1}
 }
 
 // Signing process status enumeration
@@ -125,11 +191,11 @@ func (x SigningStatus) String() string {
 }
 
 func (SigningStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_api_proto_enumTypes[1].Descriptor()
+	return file_v1_api_proto_enumTypes[2].Descriptor()
 }
 
 func (SigningStatus) Type() protoreflect.EnumType {
-	return &file_v1_api_proto_enumTypes[1]
+	return &file_v1_api_proto_enumTypes[2]
 }
 
 func (x SigningStatus) Number() protoreflect.EnumNumber {
@@ -138,7 +204,7 @@ func (x SigningStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SigningStatus.Descriptor instead.
 func (SigningStatus) EnumDescriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{1}
+	return file_v1_api_proto_rawDescGZIP(), []int{2}
 }
 
 // Error code enumeration
@@ -182,11 +248,11 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_api_proto_enumTypes[2].Descriptor()
+	return file_v1_api_proto_enumTypes[3].Descriptor()
 }
 
 func (ErrorCode) Type() protoreflect.EnumType {
-	return &file_v1_api_proto_enumTypes[2]
+	return &file_v1_api_proto_enumTypes[3]
 }
 
 func (x ErrorCode) Number() protoreflect.EnumNumber {
@@ -195,7 +261,68 @@ func (x ErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ErrorCode.Descriptor instead.
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{2}
+	return file_v1_api_proto_rawDescGZIP(), []int{3}
+}
+
+// Commit status of a validator set header on a settlement
+type CommitStatus int32
+
+const (
+	// Not tracked by this node
+	CommitStatus_COMMIT_STATUS_UNSPECIFIED CommitStatus = 0
+	// Header is waiting to be committed
+	CommitStatus_COMMIT_STATUS_PENDING CommitStatus = 1
+	// Commit transaction was sent and is not confirmed yet
+	CommitStatus_COMMIT_STATUS_SUBMITTED CommitStatus = 2
+	// Header is committed
+	CommitStatus_COMMIT_STATUS_CONFIRMED CommitStatus = 3
+	// Last commit attempt failed
+	CommitStatus_COMMIT_STATUS_FAILED CommitStatus = 4
+)
+
+// Enum value maps for CommitStatus.
+var (
+	CommitStatus_name = map[int32]string{
+		0: "COMMIT_STATUS_UNSPECIFIED",
+		1: "COMMIT_STATUS_PENDING",
+		2: "COMMIT_STATUS_SUBMITTED",
+		3: "COMMIT_STATUS_CONFIRMED",
+		4: "COMMIT_STATUS_FAILED",
+	}
+	CommitStatus_value = map[string]int32{
+		"COMMIT_STATUS_UNSPECIFIED": 0,
+		"COMMIT_STATUS_PENDING":     1,
+		"COMMIT_STATUS_SUBMITTED":   2,
+		"COMMIT_STATUS_CONFIRMED":   3,
+		"COMMIT_STATUS_FAILED":      4,
+	}
+)
+
+func (x CommitStatus) Enum() *CommitStatus {
+	p := new(CommitStatus)
+	*p = x
+	return p
+}
+
+func (x CommitStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommitStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_api_proto_enumTypes[4].Descriptor()
+}
+
+func (CommitStatus) Type() protoreflect.EnumType {
+	return &file_v1_api_proto_enumTypes[4]
+}
+
+func (x CommitStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommitStatus.Descriptor instead.
+func (CommitStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{4}
 }
 
 // Request to check if the current node should be active in a custom schedule.
@@ -364,6 +491,12 @@ type SignMessageRequest struct {
 	Message []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	// Required epoch (optional, if not provided latest committed epoch will be used)
 	RequiredEpoch *uint64 `protobuf:"varint,3,opt,name=required_epoch,json=requiredEpoch,proto3,oneof" json:"required_epoch,omitempty"`
+	// EIP-712 typed data to be signed instead of the raw message (optional, message must be empty or equal to the typed data encoding)
+	TypedData *TypedData `protobuf:"bytes,4,opt,name=typed_data,json=typedData,proto3,oneof" json:"typed_data,omitempty"`
+	// Time to live of the request (optional), relays stop working on the request once it passes. Exclusive with deadline
+	Ttl *durationpb.Duration `protobuf:"bytes,5,opt,name=ttl,proto3,oneof" json:"ttl,omitempty"`
+	// Time the request expires at (optional), relays stop working on the request once it passes. Exclusive with ttl
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3,oneof" json:"deadline,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -419,6 +552,27 @@ func (x *SignMessageRequest) GetRequiredEpoch() uint64 {
 	return 0
 }
 
+func (x *SignMessageRequest) GetTypedData() *TypedData {
+	if x != nil {
+		return x.TypedData
+	}
+	return nil
+}
+
+func (x *SignMessageRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *SignMessageRequest) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
 // Response message for sign message request
 type SignMessageResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -474,30 +628,33 @@ func (x *SignMessageResponse) GetEpoch() uint64 {
 	return 0
 }
 
-// Request message for listening to signatures stream
-type ListenSignaturesRequest struct {
+// Request message for signing a batch of messages
+type SignMessageBatchRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional: start epoch. If provided, stream will first send all historical signatures starting from this epoch, then continue with real-time updates
-	// If not provided, only signatures generated after stream creation will be sent
-	StartEpoch    *uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3,oneof" json:"start_epoch,omitempty"`
+	// Key tag identifier (0-127), must be an aggregation key
+	KeyTag uint32 `protobuf:"varint,1,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
+	// Messages to be signed
+	Messages [][]byte `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// Required epoch (optional, if not provided latest committed epoch will be used)
+	RequiredEpoch *uint64 `protobuf:"varint,3,opt,name=required_epoch,json=requiredEpoch,proto3,oneof" json:"required_epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListenSignaturesRequest) Reset() {
-	*x = ListenSignaturesRequest{}
+func (x *SignMessageBatchRequest) Reset() {
+	*x = SignMessageBatchRequest{}
 	mi := &file_v1_api_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListenSignaturesRequest) String() string {
+func (x *SignMessageBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListenSignaturesRequest) ProtoMessage() {}
+func (*SignMessageBatchRequest) ProtoMessage() {}
 
-func (x *ListenSignaturesRequest) ProtoReflect() protoreflect.Message {
+func (x *SignMessageBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -509,45 +666,59 @@ func (x *ListenSignaturesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListenSignaturesRequest.ProtoReflect.Descriptor instead.
-func (*ListenSignaturesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignMessageBatchRequest.ProtoReflect.Descriptor instead.
+func (*SignMessageBatchRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListenSignaturesRequest) GetStartEpoch() uint64 {
-	if x != nil && x.StartEpoch != nil {
-		return *x.StartEpoch
+func (x *SignMessageBatchRequest) GetKeyTag() uint32 {
+	if x != nil {
+		return x.KeyTag
 	}
 	return 0
 }
 
-// Response message for signatures stream
-type ListenSignaturesResponse struct {
+func (x *SignMessageBatchRequest) GetMessages() [][]byte {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *SignMessageBatchRequest) GetRequiredEpoch() uint64 {
+	if x != nil && x.RequiredEpoch != nil {
+		return *x.RequiredEpoch
+	}
+	return 0
+}
+
+// Response message for sign message batch request
+type SignMessageBatchResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Id of the signature request
+	// Hash of the signature request over the batch root
 	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	// Epoch number
 	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	// Signature data
-	Signature     *Signature `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Merkle root of the batch, this is the message that gets signed
+	MerkleRoot    []byte `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListenSignaturesResponse) Reset() {
-	*x = ListenSignaturesResponse{}
+func (x *SignMessageBatchResponse) Reset() {
+	*x = SignMessageBatchResponse{}
 	mi := &file_v1_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListenSignaturesResponse) String() string {
+func (x *SignMessageBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListenSignaturesResponse) ProtoMessage() {}
+func (*SignMessageBatchResponse) ProtoMessage() {}
 
-func (x *ListenSignaturesResponse) ProtoReflect() protoreflect.Message {
+func (x *SignMessageBatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -559,56 +730,57 @@ func (x *ListenSignaturesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListenSignaturesResponse.ProtoReflect.Descriptor instead.
-func (*ListenSignaturesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignMessageBatchResponse.ProtoReflect.Descriptor instead.
+func (*SignMessageBatchResponse) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListenSignaturesResponse) GetRequestId() string {
+func (x *SignMessageBatchResponse) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ListenSignaturesResponse) GetEpoch() uint64 {
+func (x *SignMessageBatchResponse) GetEpoch() uint64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return 0
 }
 
-func (x *ListenSignaturesResponse) GetSignature() *Signature {
+func (x *SignMessageBatchResponse) GetMerkleRoot() []byte {
 	if x != nil {
-		return x.Signature
+		return x.MerkleRoot
 	}
 	return nil
 }
 
-// Request message for listening to aggregation proofs stream
-type ListenProofsRequest struct {
+// Request message for getting inclusion proof of a batched message
+type GetBatchedMessageProofRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional: start epoch. If provided, stream will first send all historical proofs starting from this epoch, then continue with real-time updates
-	// If not provided, only proofs generated after stream creation will be sent
-	StartEpoch    *uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3,oneof" json:"start_epoch,omitempty"`
+	// Hash of the signature request over the batch root
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Index of the message in the batch
+	Index         uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListenProofsRequest) Reset() {
-	*x = ListenProofsRequest{}
+func (x *GetBatchedMessageProofRequest) Reset() {
+	*x = GetBatchedMessageProofRequest{}
 	mi := &file_v1_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListenProofsRequest) String() string {
+func (x *GetBatchedMessageProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListenProofsRequest) ProtoMessage() {}
+func (*GetBatchedMessageProofRequest) ProtoMessage() {}
 
-func (x *ListenProofsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBatchedMessageProofRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -620,45 +792,58 @@ func (x *ListenProofsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListenProofsRequest.ProtoReflect.Descriptor instead.
-func (*ListenProofsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBatchedMessageProofRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchedMessageProofRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListenProofsRequest) GetStartEpoch() uint64 {
-	if x != nil && x.StartEpoch != nil {
-		return *x.StartEpoch
+func (x *GetBatchedMessageProofRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetBatchedMessageProofRequest) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
 	}
 	return 0
 }
 
-// Response message for aggregation proofs stream
-type ListenProofsResponse struct {
+// Response message for getting inclusion proof of a batched message
+type GetBatchedMessageProofResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Id of the request
-	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// Epoch number
-	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	// Final aggregation proof
-	AggregationProof *AggregationProof `protobuf:"bytes,3,opt,name=aggregation_proof,json=aggregationProof,proto3" json:"aggregation_proof,omitempty"`
+	// Merkle root of the batch
+	MerkleRoot []byte `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// Leaf of the message, keccak256(keccak256(message))
+	Leaf []byte `protobuf:"bytes,2,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// Index of the message in the batch
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// Number of messages in the batch
+	MessageCount uint32 `protobuf:"varint,4,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
+	// Sibling hashes from the leaf to the root, nodes are hashed as sorted pairs
+	InclusionProof [][]byte `protobuf:"bytes,5,rep,name=inclusion_proof,json=inclusionProof,proto3" json:"inclusion_proof,omitempty"`
+	// Aggregation proof of the batch root, absent until the batch is aggregated
+	AggregationProof *AggregationProof `protobuf:"bytes,6,opt,name=aggregation_proof,json=aggregationProof,proto3" json:"aggregation_proof,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListenProofsResponse) Reset() {
-	*x = ListenProofsResponse{}
+func (x *GetBatchedMessageProofResponse) Reset() {
+	*x = GetBatchedMessageProofResponse{}
 	mi := &file_v1_api_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListenProofsResponse) String() string {
+func (x *GetBatchedMessageProofResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListenProofsResponse) ProtoMessage() {}
+func (*GetBatchedMessageProofResponse) ProtoMessage() {}
 
-func (x *ListenProofsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBatchedMessageProofResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -670,56 +855,77 @@ func (x *ListenProofsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListenProofsResponse.ProtoReflect.Descriptor instead.
-func (*ListenProofsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBatchedMessageProofResponse.ProtoReflect.Descriptor instead.
+func (*GetBatchedMessageProofResponse) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListenProofsResponse) GetRequestId() string {
+func (x *GetBatchedMessageProofResponse) GetMerkleRoot() []byte {
 	if x != nil {
-		return x.RequestId
+		return x.MerkleRoot
 	}
-	return ""
+	return nil
 }
 
-func (x *ListenProofsResponse) GetEpoch() uint64 {
+func (x *GetBatchedMessageProofResponse) GetLeaf() []byte {
 	if x != nil {
-		return x.Epoch
+		return x.Leaf
+	}
+	return nil
+}
+
+func (x *GetBatchedMessageProofResponse) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
 	}
 	return 0
 }
 
-func (x *ListenProofsResponse) GetAggregationProof() *AggregationProof {
+func (x *GetBatchedMessageProofResponse) GetMessageCount() uint32 {
+	if x != nil {
+		return x.MessageCount
+	}
+	return 0
+}
+
+func (x *GetBatchedMessageProofResponse) GetInclusionProof() [][]byte {
+	if x != nil {
+		return x.InclusionProof
+	}
+	return nil
+}
+
+func (x *GetBatchedMessageProofResponse) GetAggregationProof() *AggregationProof {
 	if x != nil {
 		return x.AggregationProof
 	}
 	return nil
 }
 
-// Request message for listening to validator set changes stream
-type ListenValidatorSetRequest struct {
+// Request message for listening to signatures stream
+type ListenSignaturesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional: start epoch. If provided, stream will first send all historical validator sets starting from this epoch, then continue with real-time updates
-	// If not provided, only validator sets generated after stream creation will be sent
+	// Optional: start epoch. If provided, stream will first send all historical signatures starting from this epoch, then continue with real-time updates
+	// If not provided, only signatures generated after stream creation will be sent
 	StartEpoch    *uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3,oneof" json:"start_epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListenValidatorSetRequest) Reset() {
-	*x = ListenValidatorSetRequest{}
+func (x *ListenSignaturesRequest) Reset() {
+	*x = ListenSignaturesRequest{}
 	mi := &file_v1_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListenValidatorSetRequest) String() string {
+func (x *ListenSignaturesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListenValidatorSetRequest) ProtoMessage() {}
+func (*ListenSignaturesRequest) ProtoMessage() {}
 
-func (x *ListenValidatorSetRequest) ProtoReflect() protoreflect.Message {
+func (x *ListenSignaturesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -731,41 +937,47 @@ func (x *ListenValidatorSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListenValidatorSetRequest.ProtoReflect.Descriptor instead.
-func (*ListenValidatorSetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListenSignaturesRequest.ProtoReflect.Descriptor instead.
+func (*ListenSignaturesRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListenValidatorSetRequest) GetStartEpoch() uint64 {
+func (x *ListenSignaturesRequest) GetStartEpoch() uint64 {
 	if x != nil && x.StartEpoch != nil {
 		return *x.StartEpoch
 	}
 	return 0
 }
 
-// Response message for validator set changes stream
-type ListenValidatorSetResponse struct {
+// Response message for signatures stream
+type ListenSignaturesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The validator set
-	ValidatorSet  *ValidatorSet `protobuf:"bytes,1,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+	// Id of the signature request
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Epoch number
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Signature data
+	Signature *Signature `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Lifecycle state of the signature request on this relay, unspecified if the request is not known to it
+	Status        SignatureRequestStatus `protobuf:"varint,4,opt,name=status,proto3,enum=api.proto.v1.SignatureRequestStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListenValidatorSetResponse) Reset() {
-	*x = ListenValidatorSetResponse{}
+func (x *ListenSignaturesResponse) Reset() {
+	*x = ListenSignaturesResponse{}
 	mi := &file_v1_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListenValidatorSetResponse) String() string {
+func (x *ListenSignaturesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListenValidatorSetResponse) ProtoMessage() {}
+func (*ListenSignaturesResponse) ProtoMessage() {}
 
-func (x *ListenValidatorSetResponse) ProtoReflect() protoreflect.Message {
+func (x *ListenSignaturesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -777,40 +989,63 @@ func (x *ListenValidatorSetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListenValidatorSetResponse.ProtoReflect.Descriptor instead.
-func (*ListenValidatorSetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListenSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*ListenSignaturesResponse) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListenValidatorSetResponse) GetValidatorSet() *ValidatorSet {
+func (x *ListenSignaturesResponse) GetRequestId() string {
 	if x != nil {
-		return x.ValidatorSet
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-// Request message for getting aggregation proof
-type GetAggregationProofRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListenSignaturesResponse) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
 }
 
-func (x *GetAggregationProofRequest) Reset() {
-	*x = GetAggregationProofRequest{}
+func (x *ListenSignaturesResponse) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *ListenSignaturesResponse) GetStatus() SignatureRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED
+}
+
+// Request message for listening to aggregation proofs stream
+type ListenProofsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: start epoch. If provided, stream will first send all historical proofs starting from this epoch, then continue with real-time updates
+	// If not provided, only proofs generated after stream creation will be sent
+	StartEpoch    *uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3,oneof" json:"start_epoch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListenProofsRequest) Reset() {
+	*x = ListenProofsRequest{}
 	mi := &file_v1_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAggregationProofRequest) String() string {
+func (x *ListenProofsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAggregationProofRequest) ProtoMessage() {}
+func (*ListenProofsRequest) ProtoMessage() {}
 
-func (x *GetAggregationProofRequest) ProtoReflect() protoreflect.Message {
+func (x *ListenProofsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -822,41 +1057,49 @@ func (x *GetAggregationProofRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAggregationProofRequest.ProtoReflect.Descriptor instead.
-func (*GetAggregationProofRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListenProofsRequest.ProtoReflect.Descriptor instead.
+func (*ListenProofsRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetAggregationProofRequest) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
+func (x *ListenProofsRequest) GetStartEpoch() uint64 {
+	if x != nil && x.StartEpoch != nil {
+		return *x.StartEpoch
 	}
-	return ""
+	return 0
 }
 
-// Request message for getting aggregation proof
-type GetAggregationProofsByEpochRequest struct {
+// Response message for aggregation proofs stream
+type ListenProofsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id of the request
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	// Epoch number
-	Epoch         uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Final aggregation proof
+	AggregationProof *AggregationProof `protobuf:"bytes,3,opt,name=aggregation_proof,json=aggregationProof,proto3" json:"aggregation_proof,omitempty"`
+	// Lifecycle state of the signature request on this relay, unspecified if the request is not known to it
+	Status SignatureRequestStatus `protobuf:"varint,4,opt,name=status,proto3,enum=api.proto.v1.SignatureRequestStatus" json:"status,omitempty"`
+	// True if the proof replaces a previously streamed proof of the request with fewer non-signers
+	Upgraded      bool `protobuf:"varint,5,opt,name=upgraded,proto3" json:"upgraded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAggregationProofsByEpochRequest) Reset() {
-	*x = GetAggregationProofsByEpochRequest{}
+func (x *ListenProofsResponse) Reset() {
+	*x = ListenProofsResponse{}
 	mi := &file_v1_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAggregationProofsByEpochRequest) String() string {
+func (x *ListenProofsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAggregationProofsByEpochRequest) ProtoMessage() {}
+func (*ListenProofsResponse) ProtoMessage() {}
 
-func (x *GetAggregationProofsByEpochRequest) ProtoReflect() protoreflect.Message {
+func (x *ListenProofsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -868,39 +1111,70 @@ func (x *GetAggregationProofsByEpochRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAggregationProofsByEpochRequest.ProtoReflect.Descriptor instead.
-func (*GetAggregationProofsByEpochRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListenProofsResponse.ProtoReflect.Descriptor instead.
+func (*ListenProofsResponse) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetAggregationProofsByEpochRequest) GetEpoch() uint64 {
+func (x *ListenProofsResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ListenProofsResponse) GetEpoch() uint64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return 0
 }
 
-// Request message for getting current epoch
-type GetCurrentEpochRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *ListenProofsResponse) GetAggregationProof() *AggregationProof {
+	if x != nil {
+		return x.AggregationProof
+	}
+	return nil
+}
+
+func (x *ListenProofsResponse) GetStatus() SignatureRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListenProofsResponse) GetUpgraded() bool {
+	if x != nil {
+		return x.Upgraded
+	}
+	return false
+}
+
+// Request message for listening to validator set changes stream
+type ListenValidatorSetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: start epoch. If provided, stream will first send all historical validator sets starting from this epoch, then continue with real-time updates
+	// If not provided, only validator sets generated after stream creation will be sent
+	StartEpoch    *uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3,oneof" json:"start_epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentEpochRequest) Reset() {
-	*x = GetCurrentEpochRequest{}
+func (x *ListenValidatorSetRequest) Reset() {
+	*x = ListenValidatorSetRequest{}
 	mi := &file_v1_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentEpochRequest) String() string {
+func (x *ListenValidatorSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentEpochRequest) ProtoMessage() {}
+func (*ListenValidatorSetRequest) ProtoMessage() {}
 
-func (x *GetCurrentEpochRequest) ProtoReflect() protoreflect.Message {
+func (x *ListenValidatorSetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -912,33 +1186,41 @@ func (x *GetCurrentEpochRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentEpochRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrentEpochRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListenValidatorSetRequest.ProtoReflect.Descriptor instead.
+func (*ListenValidatorSetRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{12}
 }
 
-// Request message for getting signatures
-type GetSignaturesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+func (x *ListenValidatorSetRequest) GetStartEpoch() uint64 {
+	if x != nil && x.StartEpoch != nil {
+		return *x.StartEpoch
+	}
+	return 0
+}
+
+// Response message for validator set changes stream
+type ListenValidatorSetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The validator set
+	ValidatorSet  *ValidatorSet `protobuf:"bytes,1,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignaturesRequest) Reset() {
-	*x = GetSignaturesRequest{}
+func (x *ListenValidatorSetResponse) Reset() {
+	*x = ListenValidatorSetResponse{}
 	mi := &file_v1_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignaturesRequest) String() string {
+func (x *ListenValidatorSetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignaturesRequest) ProtoMessage() {}
+func (*ListenValidatorSetResponse) ProtoMessage() {}
 
-func (x *GetSignaturesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListenValidatorSetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -950,41 +1232,40 @@ func (x *GetSignaturesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignaturesRequest.ProtoReflect.Descriptor instead.
-func (*GetSignaturesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListenValidatorSetResponse.ProtoReflect.Descriptor instead.
+func (*ListenValidatorSetResponse) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetSignaturesRequest) GetRequestId() string {
+func (x *ListenValidatorSetResponse) GetValidatorSet() *ValidatorSet {
 	if x != nil {
-		return x.RequestId
+		return x.ValidatorSet
 	}
-	return ""
+	return nil
 }
 
-// Request message for getting signatures by epoch
-type GetSignaturesByEpochRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Epoch number
-	Epoch         uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+// Request message for getting aggregation proof
+type GetAggregationProofRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignaturesByEpochRequest) Reset() {
-	*x = GetSignaturesByEpochRequest{}
+func (x *GetAggregationProofRequest) Reset() {
+	*x = GetAggregationProofRequest{}
 	mi := &file_v1_api_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignaturesByEpochRequest) String() string {
+func (x *GetAggregationProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignaturesByEpochRequest) ProtoMessage() {}
+func (*GetAggregationProofRequest) ProtoMessage() {}
 
-func (x *GetSignaturesByEpochRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAggregationProofRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -996,41 +1277,41 @@ func (x *GetSignaturesByEpochRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignaturesByEpochRequest.ProtoReflect.Descriptor instead.
-func (*GetSignaturesByEpochRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAggregationProofRequest.ProtoReflect.Descriptor instead.
+func (*GetAggregationProofRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetSignaturesByEpochRequest) GetEpoch() uint64 {
+func (x *GetAggregationProofRequest) GetRequestId() string {
 	if x != nil {
-		return x.Epoch
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-// Response message for getting signatures
-type GetSignaturesResponse struct {
+// Request message for getting aggregation proof
+type GetAggregationProofsByEpochRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of signatures
-	Signatures    []*Signature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// Epoch number
+	Epoch         uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignaturesResponse) Reset() {
-	*x = GetSignaturesResponse{}
+func (x *GetAggregationProofsByEpochRequest) Reset() {
+	*x = GetAggregationProofsByEpochRequest{}
 	mi := &file_v1_api_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignaturesResponse) String() string {
+func (x *GetAggregationProofsByEpochRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignaturesResponse) ProtoMessage() {}
+func (*GetAggregationProofsByEpochRequest) ProtoMessage() {}
 
-func (x *GetSignaturesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAggregationProofsByEpochRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1042,41 +1323,39 @@ func (x *GetSignaturesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignaturesResponse.ProtoReflect.Descriptor instead.
-func (*GetSignaturesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAggregationProofsByEpochRequest.ProtoReflect.Descriptor instead.
+func (*GetAggregationProofsByEpochRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetSignaturesResponse) GetSignatures() []*Signature {
+func (x *GetAggregationProofsByEpochRequest) GetEpoch() uint64 {
 	if x != nil {
-		return x.Signatures
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-// Response message for getting signatures by epoch
-type GetSignaturesByEpochResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of signatures
-	Signatures    []*Signature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+// Request message for getting current epoch
+type GetCurrentEpochRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignaturesByEpochResponse) Reset() {
-	*x = GetSignaturesByEpochResponse{}
+func (x *GetCurrentEpochRequest) Reset() {
+	*x = GetCurrentEpochRequest{}
 	mi := &file_v1_api_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignaturesByEpochResponse) String() string {
+func (x *GetCurrentEpochRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignaturesByEpochResponse) ProtoMessage() {}
+func (*GetCurrentEpochRequest) ProtoMessage() {}
 
-func (x *GetSignaturesByEpochResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCurrentEpochRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1088,41 +1367,33 @@ func (x *GetSignaturesByEpochResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignaturesByEpochResponse.ProtoReflect.Descriptor instead.
-func (*GetSignaturesByEpochResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCurrentEpochRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentEpochRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetSignaturesByEpochResponse) GetSignatures() []*Signature {
-	if x != nil {
-		return x.Signatures
-	}
-	return nil
-}
-
-// Request message for getting all signature request IDs by epoch
-type GetSignatureRequestIDsByEpochRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Epoch number
-	Epoch         uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+// Request message for getting signatures
+type GetSignaturesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignatureRequestIDsByEpochRequest) Reset() {
-	*x = GetSignatureRequestIDsByEpochRequest{}
+func (x *GetSignaturesRequest) Reset() {
+	*x = GetSignaturesRequest{}
 	mi := &file_v1_api_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignatureRequestIDsByEpochRequest) String() string {
+func (x *GetSignaturesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignatureRequestIDsByEpochRequest) ProtoMessage() {}
+func (*GetSignaturesRequest) ProtoMessage() {}
 
-func (x *GetSignatureRequestIDsByEpochRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSignaturesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1134,41 +1405,41 @@ func (x *GetSignatureRequestIDsByEpochRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignatureRequestIDsByEpochRequest.ProtoReflect.Descriptor instead.
-func (*GetSignatureRequestIDsByEpochRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSignaturesRequest.ProtoReflect.Descriptor instead.
+func (*GetSignaturesRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetSignatureRequestIDsByEpochRequest) GetEpoch() uint64 {
+func (x *GetSignaturesRequest) GetRequestId() string {
 	if x != nil {
-		return x.Epoch
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-// Response message for getting all signature request IDs by epoch
-type GetSignatureRequestIDsByEpochResponse struct {
+// Request message for getting signatures by epoch
+type GetSignaturesByEpochRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of all signature request IDs for the epoch
-	RequestIds    []string `protobuf:"bytes,1,rep,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
+	// Epoch number
+	Epoch         uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignatureRequestIDsByEpochResponse) Reset() {
-	*x = GetSignatureRequestIDsByEpochResponse{}
+func (x *GetSignaturesByEpochRequest) Reset() {
+	*x = GetSignaturesByEpochRequest{}
 	mi := &file_v1_api_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignatureRequestIDsByEpochResponse) String() string {
+func (x *GetSignaturesByEpochRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignatureRequestIDsByEpochResponse) ProtoMessage() {}
+func (*GetSignaturesByEpochRequest) ProtoMessage() {}
 
-func (x *GetSignatureRequestIDsByEpochResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSignaturesByEpochRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1180,41 +1451,41 @@ func (x *GetSignatureRequestIDsByEpochResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignatureRequestIDsByEpochResponse.ProtoReflect.Descriptor instead.
-func (*GetSignatureRequestIDsByEpochResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSignaturesByEpochRequest.ProtoReflect.Descriptor instead.
+func (*GetSignaturesByEpochRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetSignatureRequestIDsByEpochResponse) GetRequestIds() []string {
+func (x *GetSignaturesByEpochRequest) GetEpoch() uint64 {
 	if x != nil {
-		return x.RequestIds
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-// Request message for getting all signature requests by epoch
-type GetSignatureRequestsByEpochRequest struct {
+// Response message for getting signatures
+type GetSignaturesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Epoch number
-	Epoch         uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// List of signatures
+	Signatures    []*Signature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignatureRequestsByEpochRequest) Reset() {
-	*x = GetSignatureRequestsByEpochRequest{}
+func (x *GetSignaturesResponse) Reset() {
+	*x = GetSignaturesResponse{}
 	mi := &file_v1_api_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignatureRequestsByEpochRequest) String() string {
+func (x *GetSignaturesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignatureRequestsByEpochRequest) ProtoMessage() {}
+func (*GetSignaturesResponse) ProtoMessage() {}
 
-func (x *GetSignatureRequestsByEpochRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSignaturesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1226,41 +1497,41 @@ func (x *GetSignatureRequestsByEpochRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignatureRequestsByEpochRequest.ProtoReflect.Descriptor instead.
-func (*GetSignatureRequestsByEpochRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*GetSignaturesResponse) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetSignatureRequestsByEpochRequest) GetEpoch() uint64 {
+func (x *GetSignaturesResponse) GetSignatures() []*Signature {
 	if x != nil {
-		return x.Epoch
+		return x.Signatures
 	}
-	return 0
+	return nil
 }
 
-// Response message for getting all signature requests by epoch
-type GetSignatureRequestsByEpochResponse struct {
+// Response message for getting signatures by epoch
+type GetSignaturesByEpochResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of all signature requests for the epoch
-	SignatureRequests []*SignatureRequest `protobuf:"bytes,1,rep,name=signature_requests,json=signatureRequests,proto3" json:"signature_requests,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// List of signatures
+	Signatures    []*Signature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignatureRequestsByEpochResponse) Reset() {
-	*x = GetSignatureRequestsByEpochResponse{}
+func (x *GetSignaturesByEpochResponse) Reset() {
+	*x = GetSignaturesByEpochResponse{}
 	mi := &file_v1_api_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignatureRequestsByEpochResponse) String() string {
+func (x *GetSignaturesByEpochResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignatureRequestsByEpochResponse) ProtoMessage() {}
+func (*GetSignaturesByEpochResponse) ProtoMessage() {}
 
-func (x *GetSignatureRequestsByEpochResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSignaturesByEpochResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1272,40 +1543,41 @@ func (x *GetSignatureRequestsByEpochResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignatureRequestsByEpochResponse.ProtoReflect.Descriptor instead.
-func (*GetSignatureRequestsByEpochResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSignaturesByEpochResponse.ProtoReflect.Descriptor instead.
+func (*GetSignaturesByEpochResponse) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetSignatureRequestsByEpochResponse) GetSignatureRequests() []*SignatureRequest {
+func (x *GetSignaturesByEpochResponse) GetSignatures() []*Signature {
 	if x != nil {
-		return x.SignatureRequests
+		return x.Signatures
 	}
 	return nil
 }
 
-// Request message for getting signature request
-type GetSignatureRequestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+// Request message for getting all signature request IDs by epoch
+type GetSignatureRequestIDsByEpochRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Epoch number
+	Epoch         uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignatureRequestRequest) Reset() {
-	*x = GetSignatureRequestRequest{}
+func (x *GetSignatureRequestIDsByEpochRequest) Reset() {
+	*x = GetSignatureRequestIDsByEpochRequest{}
 	mi := &file_v1_api_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignatureRequestRequest) String() string {
+func (x *GetSignatureRequestIDsByEpochRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignatureRequestRequest) ProtoMessage() {}
+func (*GetSignatureRequestIDsByEpochRequest) ProtoMessage() {}
 
-func (x *GetSignatureRequestRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSignatureRequestIDsByEpochRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1317,40 +1589,41 @@ func (x *GetSignatureRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignatureRequestRequest.ProtoReflect.Descriptor instead.
-func (*GetSignatureRequestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSignatureRequestIDsByEpochRequest.ProtoReflect.Descriptor instead.
+func (*GetSignatureRequestIDsByEpochRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetSignatureRequestRequest) GetRequestId() string {
+func (x *GetSignatureRequestIDsByEpochRequest) GetEpoch() uint64 {
 	if x != nil {
-		return x.RequestId
+		return x.Epoch
 	}
-	return ""
+	return 0
 }
 
-// Request message for getting aggregation status
-type GetAggregationStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+// Response message for getting all signature request IDs by epoch
+type GetSignatureRequestIDsByEpochResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of all signature request IDs for the epoch
+	RequestIds    []string `protobuf:"bytes,1,rep,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAggregationStatusRequest) Reset() {
-	*x = GetAggregationStatusRequest{}
+func (x *GetSignatureRequestIDsByEpochResponse) Reset() {
+	*x = GetSignatureRequestIDsByEpochResponse{}
 	mi := &file_v1_api_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAggregationStatusRequest) String() string {
+func (x *GetSignatureRequestIDsByEpochResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAggregationStatusRequest) ProtoMessage() {}
+func (*GetSignatureRequestIDsByEpochResponse) ProtoMessage() {}
 
-func (x *GetAggregationStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSignatureRequestIDsByEpochResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1362,41 +1635,41 @@ func (x *GetAggregationStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAggregationStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetAggregationStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSignatureRequestIDsByEpochResponse.ProtoReflect.Descriptor instead.
+func (*GetSignatureRequestIDsByEpochResponse) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetAggregationStatusRequest) GetRequestId() string {
+func (x *GetSignatureRequestIDsByEpochResponse) GetRequestIds() []string {
 	if x != nil {
-		return x.RequestId
+		return x.RequestIds
 	}
-	return ""
+	return nil
 }
 
-// Request message for getting validator set
-type GetValidatorSetRequest struct {
+// Request message for getting all signature requests by epoch
+type GetSignatureRequestsByEpochRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Epoch number (optional, if not provided current epoch will be used)
-	Epoch         *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
+	// Epoch number
+	Epoch         uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetValidatorSetRequest) Reset() {
-	*x = GetValidatorSetRequest{}
+func (x *GetSignatureRequestsByEpochRequest) Reset() {
+	*x = GetSignatureRequestsByEpochRequest{}
 	mi := &file_v1_api_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetValidatorSetRequest) String() string {
+func (x *GetSignatureRequestsByEpochRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetValidatorSetRequest) ProtoMessage() {}
+func (*GetSignatureRequestsByEpochRequest) ProtoMessage() {}
 
-func (x *GetValidatorSetRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSignatureRequestsByEpochRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1408,43 +1681,41 @@ func (x *GetValidatorSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetValidatorSetRequest.ProtoReflect.Descriptor instead.
-func (*GetValidatorSetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSignatureRequestsByEpochRequest.ProtoReflect.Descriptor instead.
+func (*GetSignatureRequestsByEpochRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetValidatorSetRequest) GetEpoch() uint64 {
-	if x != nil && x.Epoch != nil {
-		return *x.Epoch
+func (x *GetSignatureRequestsByEpochRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
 	}
 	return 0
 }
 
-// Request message for getting validator by address
-type GetValidatorByAddressRequest struct {
+// Response message for getting all signature requests by epoch
+type GetSignatureRequestsByEpochResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Epoch number (optional, if not provided current epoch will be used)
-	Epoch *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
-	// Validator address (required)
-	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// List of all signature requests for the epoch
+	SignatureRequests []*SignatureRequest `protobuf:"bytes,1,rep,name=signature_requests,json=signatureRequests,proto3" json:"signature_requests,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetValidatorByAddressRequest) Reset() {
-	*x = GetValidatorByAddressRequest{}
+func (x *GetSignatureRequestsByEpochResponse) Reset() {
+	*x = GetSignatureRequestsByEpochResponse{}
 	mi := &file_v1_api_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetValidatorByAddressRequest) String() string {
+func (x *GetSignatureRequestsByEpochResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetValidatorByAddressRequest) ProtoMessage() {}
+func (*GetSignatureRequestsByEpochResponse) ProtoMessage() {}
 
-func (x *GetValidatorByAddressRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSignatureRequestsByEpochResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1456,52 +1727,40 @@ func (x *GetValidatorByAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetValidatorByAddressRequest.ProtoReflect.Descriptor instead.
-func (*GetValidatorByAddressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSignatureRequestsByEpochResponse.ProtoReflect.Descriptor instead.
+func (*GetSignatureRequestsByEpochResponse) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetValidatorByAddressRequest) GetEpoch() uint64 {
-	if x != nil && x.Epoch != nil {
-		return *x.Epoch
-	}
-	return 0
-}
-
-func (x *GetValidatorByAddressRequest) GetAddress() string {
+func (x *GetSignatureRequestsByEpochResponse) GetSignatureRequests() []*SignatureRequest {
 	if x != nil {
-		return x.Address
+		return x.SignatureRequests
 	}
-	return ""
+	return nil
 }
 
-// Request message for getting validator by key
-type GetValidatorByKeyRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Epoch number (optional, if not provided current epoch will be used)
-	Epoch *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
-	// Validator key tag (required)
-	KeyTag uint32 `protobuf:"varint,2,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
-	// Validator on chain (public) key (required)
-	OnChainKey    []byte `protobuf:"bytes,3,opt,name=on_chain_key,json=onChainKey,proto3" json:"on_chain_key,omitempty"`
+// Request message for getting signature request
+type GetSignatureRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetValidatorByKeyRequest) Reset() {
-	*x = GetValidatorByKeyRequest{}
+func (x *GetSignatureRequestRequest) Reset() {
+	*x = GetSignatureRequestRequest{}
 	mi := &file_v1_api_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetValidatorByKeyRequest) String() string {
+func (x *GetSignatureRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetValidatorByKeyRequest) ProtoMessage() {}
+func (*GetSignatureRequestRequest) ProtoMessage() {}
 
-func (x *GetValidatorByKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSignatureRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1513,55 +1772,40 @@ func (x *GetValidatorByKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetValidatorByKeyRequest.ProtoReflect.Descriptor instead.
-func (*GetValidatorByKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSignatureRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetSignatureRequestRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetValidatorByKeyRequest) GetEpoch() uint64 {
-	if x != nil && x.Epoch != nil {
-		return *x.Epoch
-	}
-	return 0
-}
-
-func (x *GetValidatorByKeyRequest) GetKeyTag() uint32 {
-	if x != nil {
-		return x.KeyTag
-	}
-	return 0
-}
-
-func (x *GetValidatorByKeyRequest) GetOnChainKey() []byte {
+func (x *GetSignatureRequestRequest) GetRequestId() string {
 	if x != nil {
-		return x.OnChainKey
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-// Request message for getting local validator
-type GetLocalValidatorRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Epoch number (optional, if not provided current epoch will be used)
-	Epoch         *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
+// Request message for cancelling a signature request
+type CancelSignatureRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLocalValidatorRequest) Reset() {
-	*x = GetLocalValidatorRequest{}
+func (x *CancelSignatureRequestRequest) Reset() {
+	*x = CancelSignatureRequestRequest{}
 	mi := &file_v1_api_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLocalValidatorRequest) String() string {
+func (x *CancelSignatureRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLocalValidatorRequest) ProtoMessage() {}
+func (*CancelSignatureRequestRequest) ProtoMessage() {}
 
-func (x *GetLocalValidatorRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelSignatureRequestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1573,41 +1817,41 @@ func (x *GetLocalValidatorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLocalValidatorRequest.ProtoReflect.Descriptor instead.
-func (*GetLocalValidatorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelSignatureRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelSignatureRequestRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetLocalValidatorRequest) GetEpoch() uint64 {
-	if x != nil && x.Epoch != nil {
-		return *x.Epoch
+func (x *CancelSignatureRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-// Request message for getting validator set header
-type GetValidatorSetHeaderRequest struct {
+// Response message for cancelling a signature request
+type CancelSignatureRequestResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Epoch number (optional, if not provided current epoch will be used)
-	Epoch         *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The cancelled signature request
+	SignatureRequest *SignatureRequest `protobuf:"bytes,1,opt,name=signature_request,json=signatureRequest,proto3" json:"signature_request,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetValidatorSetHeaderRequest) Reset() {
-	*x = GetValidatorSetHeaderRequest{}
+func (x *CancelSignatureRequestResponse) Reset() {
+	*x = CancelSignatureRequestResponse{}
 	mi := &file_v1_api_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetValidatorSetHeaderRequest) String() string {
+func (x *CancelSignatureRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetValidatorSetHeaderRequest) ProtoMessage() {}
+func (*CancelSignatureRequestResponse) ProtoMessage() {}
 
-func (x *GetValidatorSetHeaderRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelSignatureRequestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1619,41 +1863,40 @@ func (x *GetValidatorSetHeaderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetValidatorSetHeaderRequest.ProtoReflect.Descriptor instead.
-func (*GetValidatorSetHeaderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelSignatureRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelSignatureRequestResponse) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetValidatorSetHeaderRequest) GetEpoch() uint64 {
-	if x != nil && x.Epoch != nil {
-		return *x.Epoch
+func (x *CancelSignatureRequestResponse) GetSignatureRequest() *SignatureRequest {
+	if x != nil {
+		return x.SignatureRequest
 	}
-	return 0
+	return nil
 }
 
-// Request message for getting validator set metadata
-type GetValidatorSetMetadataRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Epoch number (optional, if not provided current epoch will be used)
-	Epoch         *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
+// Request message for getting aggregation status
+type GetAggregationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetValidatorSetMetadataRequest) Reset() {
-	*x = GetValidatorSetMetadataRequest{}
+func (x *GetAggregationStatusRequest) Reset() {
+	*x = GetAggregationStatusRequest{}
 	mi := &file_v1_api_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetValidatorSetMetadataRequest) String() string {
+func (x *GetAggregationStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetValidatorSetMetadataRequest) ProtoMessage() {}
+func (*GetAggregationStatusRequest) ProtoMessage() {}
 
-func (x *GetValidatorSetMetadataRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAggregationStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1665,43 +1908,41 @@ func (x *GetValidatorSetMetadataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetValidatorSetMetadataRequest.ProtoReflect.Descriptor instead.
-func (*GetValidatorSetMetadataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAggregationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetAggregationStatusRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetValidatorSetMetadataRequest) GetEpoch() uint64 {
-	if x != nil && x.Epoch != nil {
-		return *x.Epoch
+func (x *GetAggregationStatusRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-// Response message for getting current epoch
-type GetCurrentEpochResponse struct {
+// Request message for getting validator set
+type GetValidatorSetRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Epoch number
-	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	// Epoch start time
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Epoch number (optional, if not provided current epoch will be used)
+	Epoch         *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentEpochResponse) Reset() {
-	*x = GetCurrentEpochResponse{}
+func (x *GetValidatorSetRequest) Reset() {
+	*x = GetValidatorSetRequest{}
 	mi := &file_v1_api_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentEpochResponse) String() string {
+func (x *GetValidatorSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentEpochResponse) ProtoMessage() {}
+func (*GetValidatorSetRequest) ProtoMessage() {}
 
-func (x *GetCurrentEpochResponse) ProtoReflect() protoreflect.Message {
+func (x *GetValidatorSetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1713,54 +1954,43 @@ func (x *GetCurrentEpochResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentEpochResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrentEpochResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetValidatorSetRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorSetRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetCurrentEpochResponse) GetEpoch() uint64 {
-	if x != nil {
-		return x.Epoch
+func (x *GetValidatorSetRequest) GetEpoch() uint64 {
+	if x != nil && x.Epoch != nil {
+		return *x.Epoch
 	}
 	return 0
 }
 
-func (x *GetCurrentEpochResponse) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
-}
-
-// SignatureRequest represents a signature request
-type SignatureRequest struct {
+// Request message for getting validator by address
+type GetValidatorByAddressRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Request ID
-	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// Key tag identifier (0-127)
-	KeyTag uint32 `protobuf:"varint,2,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
-	// Message to be signed
-	Message []byte `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	// Required epoch
-	RequiredEpoch uint64 `protobuf:"varint,4,opt,name=required_epoch,json=requiredEpoch,proto3" json:"required_epoch,omitempty"`
+	// Epoch number (optional, if not provided current epoch will be used)
+	Epoch *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
+	// Validator address (required)
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SignatureRequest) Reset() {
-	*x = SignatureRequest{}
+func (x *GetValidatorByAddressRequest) Reset() {
+	*x = GetValidatorByAddressRequest{}
 	mi := &file_v1_api_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SignatureRequest) String() string {
+func (x *GetValidatorByAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignatureRequest) ProtoMessage() {}
+func (*GetValidatorByAddressRequest) ProtoMessage() {}
 
-func (x *SignatureRequest) ProtoReflect() protoreflect.Message {
+func (x *GetValidatorByAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1772,61 +2002,52 @@ func (x *SignatureRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignatureRequest.ProtoReflect.Descriptor instead.
-func (*SignatureRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetValidatorByAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorByAddressRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SignatureRequest) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
-}
-
-func (x *SignatureRequest) GetKeyTag() uint32 {
-	if x != nil {
-		return x.KeyTag
+func (x *GetValidatorByAddressRequest) GetEpoch() uint64 {
+	if x != nil && x.Epoch != nil {
+		return *x.Epoch
 	}
 	return 0
 }
 
-func (x *SignatureRequest) GetMessage() []byte {
-	if x != nil {
-		return x.Message
-	}
-	return nil
-}
-
-func (x *SignatureRequest) GetRequiredEpoch() uint64 {
+func (x *GetValidatorByAddressRequest) GetAddress() string {
 	if x != nil {
-		return x.RequiredEpoch
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-// Response message for getting signature request
-type GetSignatureRequestResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SignatureRequest *SignatureRequest      `protobuf:"bytes,1,opt,name=signature_request,json=signatureRequest,proto3" json:"signature_request,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Request message for getting validator by key
+type GetValidatorByKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Epoch number (optional, if not provided current epoch will be used)
+	Epoch *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
+	// Validator key tag (required)
+	KeyTag uint32 `protobuf:"varint,2,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
+	// Validator on chain (public) key (required)
+	OnChainKey    []byte `protobuf:"bytes,3,opt,name=on_chain_key,json=onChainKey,proto3" json:"on_chain_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignatureRequestResponse) Reset() {
-	*x = GetSignatureRequestResponse{}
+func (x *GetValidatorByKeyRequest) Reset() {
+	*x = GetValidatorByKeyRequest{}
 	mi := &file_v1_api_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignatureRequestResponse) String() string {
+func (x *GetValidatorByKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignatureRequestResponse) ProtoMessage() {}
+func (*GetValidatorByKeyRequest) ProtoMessage() {}
 
-func (x *GetSignatureRequestResponse) ProtoReflect() protoreflect.Message {
+func (x *GetValidatorByKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1838,40 +2059,55 @@ func (x *GetSignatureRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignatureRequestResponse.ProtoReflect.Descriptor instead.
-func (*GetSignatureRequestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetValidatorByKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorByKeyRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetSignatureRequestResponse) GetSignatureRequest() *SignatureRequest {
+func (x *GetValidatorByKeyRequest) GetEpoch() uint64 {
+	if x != nil && x.Epoch != nil {
+		return *x.Epoch
+	}
+	return 0
+}
+
+func (x *GetValidatorByKeyRequest) GetKeyTag() uint32 {
 	if x != nil {
-		return x.SignatureRequest
+		return x.KeyTag
+	}
+	return 0
+}
+
+func (x *GetValidatorByKeyRequest) GetOnChainKey() []byte {
+	if x != nil {
+		return x.OnChainKey
 	}
 	return nil
 }
 
-// Response message for getting aggregation proof
-type GetAggregationProofResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AggregationProof *AggregationProof      `protobuf:"bytes,1,opt,name=aggregation_proof,json=aggregationProof,proto3" json:"aggregation_proof,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Request message for getting local validator
+type GetLocalValidatorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Epoch number (optional, if not provided current epoch will be used)
+	Epoch         *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAggregationProofResponse) Reset() {
-	*x = GetAggregationProofResponse{}
+func (x *GetLocalValidatorRequest) Reset() {
+	*x = GetLocalValidatorRequest{}
 	mi := &file_v1_api_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAggregationProofResponse) String() string {
+func (x *GetLocalValidatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAggregationProofResponse) ProtoMessage() {}
+func (*GetLocalValidatorRequest) ProtoMessage() {}
 
-func (x *GetAggregationProofResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLocalValidatorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1883,40 +2119,41 @@ func (x *GetAggregationProofResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAggregationProofResponse.ProtoReflect.Descriptor instead.
-func (*GetAggregationProofResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLocalValidatorRequest.ProtoReflect.Descriptor instead.
+func (*GetLocalValidatorRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetAggregationProofResponse) GetAggregationProof() *AggregationProof {
-	if x != nil {
-		return x.AggregationProof
+func (x *GetLocalValidatorRequest) GetEpoch() uint64 {
+	if x != nil && x.Epoch != nil {
+		return *x.Epoch
 	}
-	return nil
+	return 0
 }
 
-// Response message for getting aggregation proof
-type GetAggregationProofsByEpochResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	AggregationProofs []*AggregationProof    `protobuf:"bytes,1,rep,name=aggregation_proofs,json=aggregationProofs,proto3" json:"aggregation_proofs,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// Request message for getting validator set header
+type GetValidatorSetHeaderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Epoch number (optional, if not provided current epoch will be used)
+	Epoch         *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAggregationProofsByEpochResponse) Reset() {
-	*x = GetAggregationProofsByEpochResponse{}
+func (x *GetValidatorSetHeaderRequest) Reset() {
+	*x = GetValidatorSetHeaderRequest{}
 	mi := &file_v1_api_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAggregationProofsByEpochResponse) String() string {
+func (x *GetValidatorSetHeaderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAggregationProofsByEpochResponse) ProtoMessage() {}
+func (*GetValidatorSetHeaderRequest) ProtoMessage() {}
 
-func (x *GetAggregationProofsByEpochResponse) ProtoReflect() protoreflect.Message {
+func (x *GetValidatorSetHeaderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1928,45 +2165,41 @@ func (x *GetAggregationProofsByEpochResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAggregationProofsByEpochResponse.ProtoReflect.Descriptor instead.
-func (*GetAggregationProofsByEpochResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetValidatorSetHeaderRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorSetHeaderRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetAggregationProofsByEpochResponse) GetAggregationProofs() []*AggregationProof {
-	if x != nil {
-		return x.AggregationProofs
+func (x *GetValidatorSetHeaderRequest) GetEpoch() uint64 {
+	if x != nil && x.Epoch != nil {
+		return *x.Epoch
 	}
-	return nil
+	return 0
 }
 
-// Response message for getting aggregation proof
-type AggregationProof struct {
+// Request message for getting validator set metadata
+type GetValidatorSetMetadataRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Message hash
-	MessageHash []byte `protobuf:"bytes,2,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
-	// Proof data
-	Proof []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
-	// Request ID
-	RequestId     string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Epoch number (optional, if not provided current epoch will be used)
+	Epoch         *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AggregationProof) Reset() {
-	*x = AggregationProof{}
+func (x *GetValidatorSetMetadataRequest) Reset() {
+	*x = GetValidatorSetMetadataRequest{}
 	mi := &file_v1_api_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AggregationProof) String() string {
+func (x *GetValidatorSetMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AggregationProof) ProtoMessage() {}
+func (*GetValidatorSetMetadataRequest) ProtoMessage() {}
 
-func (x *AggregationProof) ProtoReflect() protoreflect.Message {
+func (x *GetValidatorSetMetadataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1978,57 +2211,43 @@ func (x *AggregationProof) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AggregationProof.ProtoReflect.Descriptor instead.
-func (*AggregationProof) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetValidatorSetMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorSetMetadataRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *AggregationProof) GetMessageHash() []byte {
-	if x != nil {
-		return x.MessageHash
-	}
-	return nil
-}
-
-func (x *AggregationProof) GetProof() []byte {
-	if x != nil {
-		return x.Proof
-	}
-	return nil
-}
-
-func (x *AggregationProof) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
+func (x *GetValidatorSetMetadataRequest) GetEpoch() uint64 {
+	if x != nil && x.Epoch != nil {
+		return *x.Epoch
 	}
-	return ""
+	return 0
 }
 
-// Response message for getting aggregation status
-type GetAggregationStatusResponse struct {
+// Response message for getting current epoch
+type GetCurrentEpochResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Current voting power of the aggregator (big integer as string)
-	CurrentVotingPower string `protobuf:"bytes,1,opt,name=current_voting_power,json=currentVotingPower,proto3" json:"current_voting_power,omitempty"`
-	// List of operator addresses that signed the request
-	SignerOperators []string `protobuf:"bytes,2,rep,name=signer_operators,json=signerOperators,proto3" json:"signer_operators,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Epoch number
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Epoch start time
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAggregationStatusResponse) Reset() {
-	*x = GetAggregationStatusResponse{}
+func (x *GetCurrentEpochResponse) Reset() {
+	*x = GetCurrentEpochResponse{}
 	mi := &file_v1_api_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAggregationStatusResponse) String() string {
+func (x *GetCurrentEpochResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAggregationStatusResponse) ProtoMessage() {}
+func (*GetCurrentEpochResponse) ProtoMessage() {}
 
-func (x *GetAggregationStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCurrentEpochResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2040,54 +2259,60 @@ func (x *GetAggregationStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAggregationStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetAggregationStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCurrentEpochResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentEpochResponse) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetAggregationStatusResponse) GetCurrentVotingPower() string {
+func (x *GetCurrentEpochResponse) GetEpoch() uint64 {
 	if x != nil {
-		return x.CurrentVotingPower
+		return x.Epoch
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAggregationStatusResponse) GetSignerOperators() []string {
+func (x *GetCurrentEpochResponse) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SignerOperators
+		return x.StartTime
 	}
 	return nil
 }
 
-// Digital signature
-type Signature struct {
+// SignatureRequest represents a signature request
+type SignatureRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Signature data
-	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	// Message hash
-	MessageHash []byte `protobuf:"bytes,2,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
-	// Public key
-	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	// Request ID
-	RequestId     string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Key tag identifier (0-127)
+	KeyTag uint32 `protobuf:"varint,2,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
+	// Message to be signed
+	Message []byte `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Required epoch
+	RequiredEpoch uint64 `protobuf:"varint,4,opt,name=required_epoch,json=requiredEpoch,proto3" json:"required_epoch,omitempty"`
+	// EIP-712 typed data the message was encoded from, absent for raw messages
+	TypedData *TypedData `protobuf:"bytes,5,opt,name=typed_data,json=typedData,proto3,oneof" json:"typed_data,omitempty"`
+	// Time the request expires at, absent if it never expires
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3,oneof" json:"deadline,omitempty"`
+	// Lifecycle state of the request on this relay
+	Status        SignatureRequestStatus `protobuf:"varint,7,opt,name=status,proto3,enum=api.proto.v1.SignatureRequestStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Signature) Reset() {
-	*x = Signature{}
+func (x *SignatureRequest) Reset() {
+	*x = SignatureRequest{}
 	mi := &file_v1_api_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Signature) String() string {
+func (x *SignatureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Signature) ProtoMessage() {}
+func (*SignatureRequest) ProtoMessage() {}
 
-func (x *Signature) ProtoReflect() protoreflect.Message {
+func (x *SignatureRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2099,62 +2324,89 @@ func (x *Signature) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Signature.ProtoReflect.Descriptor instead.
-func (*Signature) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignatureRequest.ProtoReflect.Descriptor instead.
+func (*SignatureRequest) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Signature) GetSignature() []byte {
+func (x *SignatureRequest) GetRequestId() string {
 	if x != nil {
-		return x.Signature
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SignatureRequest) GetKeyTag() uint32 {
+	if x != nil {
+		return x.KeyTag
+	}
+	return 0
+}
+
+func (x *SignatureRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
 	}
 	return nil
 }
 
-func (x *Signature) GetMessageHash() []byte {
+func (x *SignatureRequest) GetRequiredEpoch() uint64 {
 	if x != nil {
-		return x.MessageHash
+		return x.RequiredEpoch
+	}
+	return 0
+}
+
+func (x *SignatureRequest) GetTypedData() *TypedData {
+	if x != nil {
+		return x.TypedData
 	}
 	return nil
 }
 
-func (x *Signature) GetPublicKey() []byte {
+func (x *SignatureRequest) GetDeadline() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PublicKey
+		return x.Deadline
 	}
 	return nil
 }
 
-func (x *Signature) GetRequestId() string {
+func (x *SignatureRequest) GetStatus() SignatureRequestStatus {
 	if x != nil {
-		return x.RequestId
+		return x.Status
 	}
-	return ""
+	return SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED
 }
 
-// Response message for getting validator set
-type GetValidatorSetResponse struct {
+// EIP-712 typed data, the signed message is "\x19\x01" || domainSeparator || hashStruct(message)
+type TypedData struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The validator set
-	ValidatorSet  *ValidatorSet `protobuf:"bytes,1,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+	// Signing domain
+	Domain *Eip712Domain `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Struct type definitions, must include EIP712Domain and the primary type
+	Types []*TypedDataStruct `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	// Name of the struct type of the message
+	PrimaryType string `protobuf:"bytes,3,opt,name=primary_type,json=primaryType,proto3" json:"primary_type,omitempty"`
+	// JSON encoded message, integers may be passed as decimal or hex strings to avoid precision loss
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetValidatorSetResponse) Reset() {
-	*x = GetValidatorSetResponse{}
+func (x *TypedData) Reset() {
+	*x = TypedData{}
 	mi := &file_v1_api_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetValidatorSetResponse) String() string {
+func (x *TypedData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetValidatorSetResponse) ProtoMessage() {}
+func (*TypedData) ProtoMessage() {}
 
-func (x *GetValidatorSetResponse) ProtoReflect() protoreflect.Message {
+func (x *TypedData) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2166,41 +2418,70 @@ func (x *GetValidatorSetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetValidatorSetResponse.ProtoReflect.Descriptor instead.
-func (*GetValidatorSetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TypedData.ProtoReflect.Descriptor instead.
+func (*TypedData) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetValidatorSetResponse) GetValidatorSet() *ValidatorSet {
+func (x *TypedData) GetDomain() *Eip712Domain {
 	if x != nil {
-		return x.ValidatorSet
+		return x.Domain
 	}
 	return nil
 }
 
-// Response message for getting validator by address
-type GetValidatorByAddressResponse struct {
+func (x *TypedData) GetTypes() []*TypedDataStruct {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *TypedData) GetPrimaryType() string {
+	if x != nil {
+		return x.PrimaryType
+	}
+	return ""
+}
+
+func (x *TypedData) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// EIP-712 signing domain, empty fields are omitted from the domain separator
+type Eip712Domain struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The validator
-	Validator     *Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Domain name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Domain version
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Chain ID
+	ChainId uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Verifying contract address
+	VerifyingContract string `protobuf:"bytes,4,opt,name=verifying_contract,json=verifyingContract,proto3" json:"verifying_contract,omitempty"`
+	// Domain salt as hex string
+	Salt          string `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetValidatorByAddressResponse) Reset() {
-	*x = GetValidatorByAddressResponse{}
+func (x *Eip712Domain) Reset() {
+	*x = Eip712Domain{}
 	mi := &file_v1_api_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetValidatorByAddressResponse) String() string {
+func (x *Eip712Domain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetValidatorByAddressResponse) ProtoMessage() {}
+func (*Eip712Domain) ProtoMessage() {}
 
-func (x *GetValidatorByAddressResponse) ProtoReflect() protoreflect.Message {
+func (x *Eip712Domain) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2212,41 +2493,71 @@ func (x *GetValidatorByAddressResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetValidatorByAddressResponse.ProtoReflect.Descriptor instead.
-func (*GetValidatorByAddressResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Eip712Domain.ProtoReflect.Descriptor instead.
+func (*Eip712Domain) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetValidatorByAddressResponse) GetValidator() *Validator {
+func (x *Eip712Domain) GetName() string {
 	if x != nil {
-		return x.Validator
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// Response message for getting validator by key
-type GetValidatorByKeyResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The validator
-	Validator     *Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Eip712Domain) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
 }
 
-func (x *GetValidatorByKeyResponse) Reset() {
-	*x = GetValidatorByKeyResponse{}
+func (x *Eip712Domain) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *Eip712Domain) GetVerifyingContract() string {
+	if x != nil {
+		return x.VerifyingContract
+	}
+	return ""
+}
+
+func (x *Eip712Domain) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+// EIP-712 struct type definition
+type TypedDataStruct struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Struct name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Struct fields in encoding order
+	Fields        []*TypedDataField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypedDataStruct) Reset() {
+	*x = TypedDataStruct{}
 	mi := &file_v1_api_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetValidatorByKeyResponse) String() string {
+func (x *TypedDataStruct) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetValidatorByKeyResponse) ProtoMessage() {}
+func (*TypedDataStruct) ProtoMessage() {}
 
-func (x *GetValidatorByKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *TypedDataStruct) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_api_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2258,87 +2569,1658 @@ func (x *GetValidatorByKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetValidatorByKeyResponse.ProtoReflect.Descriptor instead.
-func (*GetValidatorByKeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TypedDataStruct.ProtoReflect.Descriptor instead.
+func (*TypedDataStruct) Descriptor() ([]byte, []int) {
 	return file_v1_api_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetValidatorByKeyResponse) GetValidator() *Validator {
+func (x *TypedDataStruct) GetName() string {
 	if x != nil {
-		return x.Validator
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TypedDataStruct) GetFields() []*TypedDataField {
+	if x != nil {
+		return x.Fields
 	}
 	return nil
 }
 
-// Response message for getting local validator
-type GetLocalValidatorResponse struct {
+// EIP-712 struct field
+type TypedDataField struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The validator
-	Validator     *Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Field name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Field type, e.g. address, uint256, bytes32[] or another struct name
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLocalValidatorResponse) Reset() {
-	*x = GetLocalValidatorResponse{}
+func (x *TypedDataField) Reset() {
+	*x = TypedDataField{}
 	mi := &file_v1_api_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLocalValidatorResponse) String() string {
+func (x *TypedDataField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLocalValidatorResponse) ProtoMessage() {}
+func (*TypedDataField) ProtoMessage() {}
+
+func (x *TypedDataField) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypedDataField.ProtoReflect.Descriptor instead.
+func (*TypedDataField) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TypedDataField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TypedDataField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// Response message for getting signature request
+type GetSignatureRequestResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SignatureRequest *SignatureRequest      `protobuf:"bytes,1,opt,name=signature_request,json=signatureRequest,proto3" json:"signature_request,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetSignatureRequestResponse) Reset() {
+	*x = GetSignatureRequestResponse{}
+	mi := &file_v1_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignatureRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignatureRequestResponse) ProtoMessage() {}
+
+func (x *GetSignatureRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignatureRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetSignatureRequestResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetSignatureRequestResponse) GetSignatureRequest() *SignatureRequest {
+	if x != nil {
+		return x.SignatureRequest
+	}
+	return nil
+}
+
+// Response message for getting aggregation proof
+type GetAggregationProofResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AggregationProof *AggregationProof      `protobuf:"bytes,1,opt,name=aggregation_proof,json=aggregationProof,proto3" json:"aggregation_proof,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetAggregationProofResponse) Reset() {
+	*x = GetAggregationProofResponse{}
+	mi := &file_v1_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAggregationProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAggregationProofResponse) ProtoMessage() {}
+
+func (x *GetAggregationProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAggregationProofResponse.ProtoReflect.Descriptor instead.
+func (*GetAggregationProofResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetAggregationProofResponse) GetAggregationProof() *AggregationProof {
+	if x != nil {
+		return x.AggregationProof
+	}
+	return nil
+}
+
+// Response message for getting aggregation proof
+type GetAggregationProofsByEpochResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AggregationProofs []*AggregationProof    `protobuf:"bytes,1,rep,name=aggregation_proofs,json=aggregationProofs,proto3" json:"aggregation_proofs,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetAggregationProofsByEpochResponse) Reset() {
+	*x = GetAggregationProofsByEpochResponse{}
+	mi := &file_v1_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAggregationProofsByEpochResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAggregationProofsByEpochResponse) ProtoMessage() {}
+
+func (x *GetAggregationProofsByEpochResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAggregationProofsByEpochResponse.ProtoReflect.Descriptor instead.
+func (*GetAggregationProofsByEpochResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetAggregationProofsByEpochResponse) GetAggregationProofs() []*AggregationProof {
+	if x != nil {
+		return x.AggregationProofs
+	}
+	return nil
+}
+
+// Response message for getting aggregation proof
+type AggregationProof struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Message hash
+	MessageHash []byte `protobuf:"bytes,2,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
+	// Proof data
+	Proof []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// Request ID
+	RequestId     string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregationProof) Reset() {
+	*x = AggregationProof{}
+	mi := &file_v1_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregationProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregationProof) ProtoMessage() {}
+
+func (x *AggregationProof) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregationProof.ProtoReflect.Descriptor instead.
+func (*AggregationProof) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AggregationProof) GetMessageHash() []byte {
+	if x != nil {
+		return x.MessageHash
+	}
+	return nil
+}
+
+func (x *AggregationProof) GetProof() []byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *AggregationProof) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// Response message for getting aggregation status
+type GetAggregationStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current voting power of the aggregator (big integer as string)
+	CurrentVotingPower string `protobuf:"bytes,1,opt,name=current_voting_power,json=currentVotingPower,proto3" json:"current_voting_power,omitempty"`
+	// List of operator addresses that signed the request
+	SignerOperators []string `protobuf:"bytes,2,rep,name=signer_operators,json=signerOperators,proto3" json:"signer_operators,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAggregationStatusResponse) Reset() {
+	*x = GetAggregationStatusResponse{}
+	mi := &file_v1_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAggregationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAggregationStatusResponse) ProtoMessage() {}
+
+func (x *GetAggregationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAggregationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetAggregationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetAggregationStatusResponse) GetCurrentVotingPower() string {
+	if x != nil {
+		return x.CurrentVotingPower
+	}
+	return ""
+}
+
+func (x *GetAggregationStatusResponse) GetSignerOperators() []string {
+	if x != nil {
+		return x.SignerOperators
+	}
+	return nil
+}
+
+// Digital signature
+type Signature struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Signature data
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Message hash
+	MessageHash []byte `protobuf:"bytes,2,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
+	// Public key
+	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Request ID
+	RequestId     string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Signature) Reset() {
+	*x = Signature{}
+	mi := &file_v1_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Signature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signature) ProtoMessage() {}
+
+func (x *Signature) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signature.ProtoReflect.Descriptor instead.
+func (*Signature) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Signature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Signature) GetMessageHash() []byte {
+	if x != nil {
+		return x.MessageHash
+	}
+	return nil
+}
+
+func (x *Signature) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *Signature) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// Response message for getting validator set
+type GetValidatorSetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The validator set
+	ValidatorSet  *ValidatorSet `protobuf:"bytes,1,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorSetResponse) Reset() {
+	*x = GetValidatorSetResponse{}
+	mi := &file_v1_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorSetResponse) ProtoMessage() {}
+
+func (x *GetValidatorSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorSetResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetValidatorSetResponse) GetValidatorSet() *ValidatorSet {
+	if x != nil {
+		return x.ValidatorSet
+	}
+	return nil
+}
+
+// Response message for getting validator by address
+type GetValidatorByAddressResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The validator
+	Validator     *Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorByAddressResponse) Reset() {
+	*x = GetValidatorByAddressResponse{}
+	mi := &file_v1_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorByAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorByAddressResponse) ProtoMessage() {}
+
+func (x *GetValidatorByAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorByAddressResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorByAddressResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetValidatorByAddressResponse) GetValidator() *Validator {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+// Response message for getting validator by key
+type GetValidatorByKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The validator
+	Validator     *Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorByKeyResponse) Reset() {
+	*x = GetValidatorByKeyResponse{}
+	mi := &file_v1_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorByKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorByKeyResponse) ProtoMessage() {}
+
+func (x *GetValidatorByKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorByKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorByKeyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetValidatorByKeyResponse) GetValidator() *Validator {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+// Response message for getting local validator
+type GetLocalValidatorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The validator
+	Validator     *Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocalValidatorResponse) Reset() {
+	*x = GetLocalValidatorResponse{}
+	mi := &file_v1_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocalValidatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocalValidatorResponse) ProtoMessage() {}
+
+func (x *GetLocalValidatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocalValidatorResponse.ProtoReflect.Descriptor instead.
+func (*GetLocalValidatorResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetLocalValidatorResponse) GetValidator() *Validator {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+type ExtraData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtraData) Reset() {
+	*x = ExtraData{}
+	mi := &file_v1_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtraData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtraData) ProtoMessage() {}
+
+func (x *ExtraData) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtraData.ProtoReflect.Descriptor instead.
+func (*ExtraData) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExtraData) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ExtraData) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Response message for getting validator set header
+type GetValidatorSetMetadataResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExtraData      []*ExtraData           `protobuf:"bytes,1,rep,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
+	CommitmentData []byte                 `protobuf:"bytes,2,opt,name=commitment_data,json=commitmentData,proto3" json:"commitment_data,omitempty"`
+	RequestId      string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetValidatorSetMetadataResponse) Reset() {
+	*x = GetValidatorSetMetadataResponse{}
+	mi := &file_v1_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorSetMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorSetMetadataResponse) ProtoMessage() {}
+
+func (x *GetValidatorSetMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorSetMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorSetMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetValidatorSetMetadataResponse) GetExtraData() []*ExtraData {
+	if x != nil {
+		return x.ExtraData
+	}
+	return nil
+}
+
+func (x *GetValidatorSetMetadataResponse) GetCommitmentData() []byte {
+	if x != nil {
+		return x.CommitmentData
+	}
+	return nil
+}
+
+func (x *GetValidatorSetMetadataResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// Response message for getting validator set header
+type GetValidatorSetHeaderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the validator set
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Key tag required to commit next validator set
+	RequiredKeyTag uint32 `protobuf:"varint,2,opt,name=required_key_tag,json=requiredKeyTag,proto3" json:"required_key_tag,omitempty"`
+	// Validator set epoch
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Epoch capture timestamp
+	CaptureTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=capture_timestamp,json=captureTimestamp,proto3" json:"capture_timestamp,omitempty"`
+	// Quorum threshold (big integer as string)
+	QuorumThreshold string `protobuf:"bytes,5,opt,name=quorum_threshold,json=quorumThreshold,proto3" json:"quorum_threshold,omitempty"`
+	// Total voting power (big integer as string)
+	TotalVotingPower string `protobuf:"bytes,6,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// Validators SSZ Merkle root (hex string)
+	ValidatorsSszMroot string `protobuf:"bytes,7,opt,name=validators_ssz_mroot,json=validatorsSszMroot,proto3" json:"validators_ssz_mroot,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetValidatorSetHeaderResponse) Reset() {
+	*x = GetValidatorSetHeaderResponse{}
+	mi := &file_v1_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorSetHeaderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorSetHeaderResponse) ProtoMessage() {}
+
+func (x *GetValidatorSetHeaderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorSetHeaderResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorSetHeaderResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetValidatorSetHeaderResponse) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetValidatorSetHeaderResponse) GetRequiredKeyTag() uint32 {
+	if x != nil {
+		return x.RequiredKeyTag
+	}
+	return 0
+}
+
+func (x *GetValidatorSetHeaderResponse) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetValidatorSetHeaderResponse) GetCaptureTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CaptureTimestamp
+	}
+	return nil
+}
+
+func (x *GetValidatorSetHeaderResponse) GetQuorumThreshold() string {
+	if x != nil {
+		return x.QuorumThreshold
+	}
+	return ""
+}
+
+func (x *GetValidatorSetHeaderResponse) GetTotalVotingPower() string {
+	if x != nil {
+		return x.TotalVotingPower
+	}
+	return ""
+}
+
+func (x *GetValidatorSetHeaderResponse) GetValidatorsSszMroot() string {
+	if x != nil {
+		return x.ValidatorsSszMroot
+	}
+	return ""
+}
+
+// Validator information
+type Validator struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Operator address (hex string)
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Voting power of the validator (big integer as string)
+	VotingPower string `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// Indicates if the validator is active
+	IsActive bool `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// List of cryptographic keys
+	Keys []*Key `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
+	// List of validator vaults
+	Vaults        []*ValidatorVault `protobuf:"bytes,5,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Validator) Reset() {
+	*x = Validator{}
+	mi := &file_v1_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validator) ProtoMessage() {}
+
+func (x *Validator) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
+func (*Validator) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Validator) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Validator) GetVotingPower() string {
+	if x != nil {
+		return x.VotingPower
+	}
+	return ""
+}
+
+func (x *Validator) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Validator) GetKeys() []*Key {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *Validator) GetVaults() []*ValidatorVault {
+	if x != nil {
+		return x.Vaults
+	}
+	return nil
+}
+
+// Cryptographic key
+type Key struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Key tag identifier (0-127)
+	Tag uint32 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Key payload
+	Payload       []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Key) Reset() {
+	*x = Key{}
+	mi := &file_v1_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Key) ProtoMessage() {}
+
+func (x *Key) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Key.ProtoReflect.Descriptor instead.
+func (*Key) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Key) GetTag() uint32 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *Key) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// Validator vault information
+type ValidatorVault struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Chain identifier
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Vault address
+	Vault string `protobuf:"bytes,2,opt,name=vault,proto3" json:"vault,omitempty"`
+	// Voting power for this vault (big integer as string)
+	VotingPower   string `protobuf:"bytes,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatorVault) Reset() {
+	*x = ValidatorVault{}
+	mi := &file_v1_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorVault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorVault) ProtoMessage() {}
+
+func (x *ValidatorVault) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorVault.ProtoReflect.Descriptor instead.
+func (*ValidatorVault) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ValidatorVault) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *ValidatorVault) GetVault() string {
+	if x != nil {
+		return x.Vault
+	}
+	return ""
+}
+
+func (x *ValidatorVault) GetVotingPower() string {
+	if x != nil {
+		return x.VotingPower
+	}
+	return ""
+}
+
+// Request message for getting last committed epoch for a specific settlement chain
+type GetLastCommittedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Settlement chain ID
+	SettlementChainId uint64 `protobuf:"varint,1,opt,name=settlement_chain_id,json=settlementChainId,proto3" json:"settlement_chain_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetLastCommittedRequest) Reset() {
+	*x = GetLastCommittedRequest{}
+	mi := &file_v1_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastCommittedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastCommittedRequest) ProtoMessage() {}
+
+func (x *GetLastCommittedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastCommittedRequest.ProtoReflect.Descriptor instead.
+func (*GetLastCommittedRequest) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetLastCommittedRequest) GetSettlementChainId() uint64 {
+	if x != nil {
+		return x.SettlementChainId
+	}
+	return 0
+}
+
+// Response message for getting last committed epoch
+type GetLastCommittedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Settlement chain ID
+	SettlementChainId uint64          `protobuf:"varint,1,opt,name=settlement_chain_id,json=settlementChainId,proto3" json:"settlement_chain_id,omitempty"`
+	EpochInfo         *ChainEpochInfo `protobuf:"bytes,2,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetLastCommittedResponse) Reset() {
+	*x = GetLastCommittedResponse{}
+	mi := &file_v1_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastCommittedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastCommittedResponse) ProtoMessage() {}
+
+func (x *GetLastCommittedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastCommittedResponse.ProtoReflect.Descriptor instead.
+func (*GetLastCommittedResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetLastCommittedResponse) GetSettlementChainId() uint64 {
+	if x != nil {
+		return x.SettlementChainId
+	}
+	return 0
+}
+
+func (x *GetLastCommittedResponse) GetEpochInfo() *ChainEpochInfo {
+	if x != nil {
+		return x.EpochInfo
+	}
+	return nil
+}
+
+// Request message for getting last committed epochs for all chains
+type GetLastAllCommittedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLastAllCommittedRequest) Reset() {
+	*x = GetLastAllCommittedRequest{}
+	mi := &file_v1_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastAllCommittedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastAllCommittedRequest) ProtoMessage() {}
+
+func (x *GetLastAllCommittedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastAllCommittedRequest.ProtoReflect.Descriptor instead.
+func (*GetLastAllCommittedRequest) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{59}
+}
+
+// Response message for getting all last committed epochs
+type GetLastAllCommittedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of settlement chains with their last committed epochs
+	EpochInfos map[uint64]*ChainEpochInfo `protobuf:"bytes,1,rep,name=epoch_infos,json=epochInfos,proto3" json:"epoch_infos,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Suggested epoch info for signatures, it is the minimum commited epoch among all chains
+	SuggestedEpochInfo *ChainEpochInfo `protobuf:"bytes,2,opt,name=suggested_epoch_info,json=suggestedEpochInfo,proto3" json:"suggested_epoch_info,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetLastAllCommittedResponse) Reset() {
+	*x = GetLastAllCommittedResponse{}
+	mi := &file_v1_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastAllCommittedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastAllCommittedResponse) ProtoMessage() {}
+
+func (x *GetLastAllCommittedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastAllCommittedResponse.ProtoReflect.Descriptor instead.
+func (*GetLastAllCommittedResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetLastAllCommittedResponse) GetEpochInfos() map[uint64]*ChainEpochInfo {
+	if x != nil {
+		return x.EpochInfos
+	}
+	return nil
+}
+
+func (x *GetLastAllCommittedResponse) GetSuggestedEpochInfo() *ChainEpochInfo {
+	if x != nil {
+		return x.SuggestedEpochInfo
+	}
+	return nil
+}
+
+// Settlement chain with its last committed epoch
+type ChainEpochInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Last committed epoch for this chain
+	LastCommittedEpoch uint64 `protobuf:"varint,1,opt,name=last_committed_epoch,json=lastCommittedEpoch,proto3" json:"last_committed_epoch,omitempty"`
+	// Epoch start time
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainEpochInfo) Reset() {
+	*x = ChainEpochInfo{}
+	mi := &file_v1_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainEpochInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainEpochInfo) ProtoMessage() {}
+
+func (x *ChainEpochInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainEpochInfo.ProtoReflect.Descriptor instead.
+func (*ChainEpochInfo) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ChainEpochInfo) GetLastCommittedEpoch() uint64 {
+	if x != nil {
+		return x.LastCommittedEpoch
+	}
+	return 0
+}
+
+func (x *ChainEpochInfo) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+type ValidatorSet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the validator set
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Key tag required to commit next validator set
+	RequiredKeyTag uint32 `protobuf:"varint,2,opt,name=required_key_tag,json=requiredKeyTag,proto3" json:"required_key_tag,omitempty"`
+	// Validator set epoch
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Epoch capture timestamp
+	CaptureTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=capture_timestamp,json=captureTimestamp,proto3" json:"capture_timestamp,omitempty"`
+	// Quorum threshold (big integer as string)
+	QuorumThreshold string `protobuf:"bytes,5,opt,name=quorum_threshold,json=quorumThreshold,proto3" json:"quorum_threshold,omitempty"`
+	// Status of validator set header
+	Status ValidatorSetStatus `protobuf:"varint,6,opt,name=status,proto3,enum=api.proto.v1.ValidatorSetStatus" json:"status,omitempty"`
+	// List of validators
+	Validators    []*Validator `protobuf:"bytes,7,rep,name=validators,proto3" json:"validators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatorSet) Reset() {
+	*x = ValidatorSet{}
+	mi := &file_v1_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSet) ProtoMessage() {}
+
+func (x *ValidatorSet) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorSet.ProtoReflect.Descriptor instead.
+func (*ValidatorSet) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ValidatorSet) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ValidatorSet) GetRequiredKeyTag() uint32 {
+	if x != nil {
+		return x.RequiredKeyTag
+	}
+	return 0
+}
+
+func (x *ValidatorSet) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorSet) GetCaptureTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CaptureTimestamp
+	}
+	return nil
+}
+
+func (x *ValidatorSet) GetQuorumThreshold() string {
+	if x != nil {
+		return x.QuorumThreshold
+	}
+	return ""
+}
+
+func (x *ValidatorSet) GetStatus() ValidatorSetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ValidatorSetStatus_VALIDATOR_SET_STATUS_UNSPECIFIED
+}
+
+func (x *ValidatorSet) GetValidators() []*Validator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+// Request message for getting signal queue status
+type GetSignalQueueStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Signal id to return status for (optional, defaults to all signals)
+	SignalId *string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3,oneof" json:"signal_id,omitempty"`
+	// Maximum number of dead letters returned per signal (0 returns none)
+	DeadLetterLimit uint32 `protobuf:"varint,2,opt,name=dead_letter_limit,json=deadLetterLimit,proto3" json:"dead_letter_limit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetSignalQueueStatusRequest) Reset() {
+	*x = GetSignalQueueStatusRequest{}
+	mi := &file_v1_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignalQueueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignalQueueStatusRequest) ProtoMessage() {}
+
+func (x *GetSignalQueueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignalQueueStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSignalQueueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetSignalQueueStatusRequest) GetSignalId() string {
+	if x != nil && x.SignalId != nil {
+		return *x.SignalId
+	}
+	return ""
+}
+
+func (x *GetSignalQueueStatusRequest) GetDeadLetterLimit() uint32 {
+	if x != nil {
+		return x.DeadLetterLimit
+	}
+	return 0
+}
+
+// Response message for getting signal queue status
+type GetSignalQueueStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Status of the requested signal queues
+	Queues        []*SignalQueueStatus `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignalQueueStatusResponse) Reset() {
+	*x = GetSignalQueueStatusResponse{}
+	mi := &file_v1_api_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignalQueueStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignalQueueStatusResponse) ProtoMessage() {}
+
+func (x *GetSignalQueueStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignalQueueStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSignalQueueStatusResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetSignalQueueStatusResponse) GetQueues() []*SignalQueueStatus {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+// State of a single signal queue
+type SignalQueueStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Signal id
+	SignalId string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	// Whether events of the signal are persisted
+	Durable bool `protobuf:"varint,2,opt,name=durable,proto3" json:"durable,omitempty"`
+	// Number of events buffered in memory waiting for a worker
+	Queued uint32 `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	// Number of events currently being handled
+	Processing uint32 `protobuf:"varint,4,opt,name=processing,proto3" json:"processing,omitempty"`
+	// Number of persisted events not yet delivered successfully
+	Pending uint32 `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Number of persisted events that exhausted their delivery attempts
+	DeadLetterCount uint32 `protobuf:"varint,6,opt,name=dead_letter_count,json=deadLetterCount,proto3" json:"dead_letter_count,omitempty"`
+	// Oldest dead-lettered events, up to the requested limit
+	DeadLetters   []*SignalDeadLetter `protobuf:"bytes,7,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignalQueueStatus) Reset() {
+	*x = SignalQueueStatus{}
+	mi := &file_v1_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignalQueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalQueueStatus) ProtoMessage() {}
+
+func (x *SignalQueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalQueueStatus.ProtoReflect.Descriptor instead.
+func (*SignalQueueStatus) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SignalQueueStatus) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *SignalQueueStatus) GetDurable() bool {
+	if x != nil {
+		return x.Durable
+	}
+	return false
+}
+
+func (x *SignalQueueStatus) GetQueued() uint32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *SignalQueueStatus) GetProcessing() uint32 {
+	if x != nil {
+		return x.Processing
+	}
+	return 0
+}
+
+func (x *SignalQueueStatus) GetPending() uint32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *SignalQueueStatus) GetDeadLetterCount() uint32 {
+	if x != nil {
+		return x.DeadLetterCount
+	}
+	return 0
+}
+
+func (x *SignalQueueStatus) GetDeadLetters() []*SignalDeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+// Signal event that exhausted its delivery attempts
+type SignalDeadLetter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sequence number of the event within its signal
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// Number of failed delivery attempts
+	Attempts uint32 `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Error returned on the last delivery attempt
+	LastError string `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Time the event was emitted
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Encoded event payload
+	Payload       []byte `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignalDeadLetter) Reset() {
+	*x = SignalDeadLetter{}
+	mi := &file_v1_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignalDeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalDeadLetter) ProtoMessage() {}
+
+func (x *SignalDeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalDeadLetter.ProtoReflect.Descriptor instead.
+func (*SignalDeadLetter) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SignalDeadLetter) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SignalDeadLetter) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
 
-func (x *GetLocalValidatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[40]
+func (x *SignalDeadLetter) GetLastError() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastError
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetLocalValidatorResponse.ProtoReflect.Descriptor instead.
-func (*GetLocalValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{40}
+func (x *SignalDeadLetter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *GetLocalValidatorResponse) GetValidator() *Validator {
+func (x *SignalDeadLetter) GetPayload() []byte {
 	if x != nil {
-		return x.Validator
+		return x.Payload
 	}
 	return nil
 }
 
-type ExtraData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+// Request message for getting commit status
+type GetCommitStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Epoch number (optional, defaults to the latest known validator set epoch)
+	Epoch         *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExtraData) Reset() {
-	*x = ExtraData{}
-	mi := &file_v1_api_proto_msgTypes[41]
+func (x *GetCommitStatusRequest) Reset() {
+	*x = GetCommitStatusRequest{}
+	mi := &file_v1_api_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExtraData) String() string {
+func (x *GetCommitStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExtraData) ProtoMessage() {}
+func (*GetCommitStatusRequest) ProtoMessage() {}
 
-func (x *ExtraData) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[41]
+func (x *GetCommitStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2349,50 +4231,44 @@ func (x *ExtraData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExtraData.ProtoReflect.Descriptor instead.
-func (*ExtraData) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *ExtraData) GetKey() []byte {
-	if x != nil {
-		return x.Key
-	}
-	return nil
+// Deprecated: Use GetCommitStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetCommitStatusRequest) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ExtraData) GetValue() []byte {
-	if x != nil {
-		return x.Value
+func (x *GetCommitStatusRequest) GetEpoch() uint64 {
+	if x != nil && x.Epoch != nil {
+		return *x.Epoch
 	}
-	return nil
+	return 0
 }
 
-// Response message for getting validator set header
-type GetValidatorSetMetadataResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ExtraData      []*ExtraData           `protobuf:"bytes,1,rep,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
-	CommitmentData []byte                 `protobuf:"bytes,2,opt,name=commitment_data,json=commitmentData,proto3" json:"commitment_data,omitempty"`
-	RequestId      string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Response message for getting commit status
+type GetCommitStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Epoch of the validator set header
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Commit status for each settlement of the epoch
+	Settlements   []*SettlementCommitStatus `protobuf:"bytes,2,rep,name=settlements,proto3" json:"settlements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetValidatorSetMetadataResponse) Reset() {
-	*x = GetValidatorSetMetadataResponse{}
-	mi := &file_v1_api_proto_msgTypes[42]
+func (x *GetCommitStatusResponse) Reset() {
+	*x = GetCommitStatusResponse{}
+	mi := &file_v1_api_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetValidatorSetMetadataResponse) String() string {
+func (x *GetCommitStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetValidatorSetMetadataResponse) ProtoMessage() {}
+func (*GetCommitStatusResponse) ProtoMessage() {}
 
-func (x *GetValidatorSetMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[42]
+func (x *GetCommitStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2403,68 +4279,63 @@ func (x *GetValidatorSetMetadataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetValidatorSetMetadataResponse.ProtoReflect.Descriptor instead.
-func (*GetValidatorSetMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GetCommitStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCommitStatusResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetValidatorSetMetadataResponse) GetExtraData() []*ExtraData {
+func (x *GetCommitStatusResponse) GetEpoch() uint64 {
 	if x != nil {
-		return x.ExtraData
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (x *GetValidatorSetMetadataResponse) GetCommitmentData() []byte {
+func (x *GetCommitStatusResponse) GetSettlements() []*SettlementCommitStatus {
 	if x != nil {
-		return x.CommitmentData
+		return x.Settlements
 	}
 	return nil
 }
 
-func (x *GetValidatorSetMetadataResponse) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
-}
-
-// Response message for getting validator set header
-type GetValidatorSetHeaderResponse struct {
+// Commit status of a validator set header on a single settlement
+type SettlementCommitStatus struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Version of the validator set
-	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// Key tag required to commit next validator set
-	RequiredKeyTag uint32 `protobuf:"varint,2,opt,name=required_key_tag,json=requiredKeyTag,proto3" json:"required_key_tag,omitempty"`
-	// Validator set epoch
-	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	// Epoch capture timestamp
-	CaptureTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=capture_timestamp,json=captureTimestamp,proto3" json:"capture_timestamp,omitempty"`
-	// Quorum threshold (big integer as string)
-	QuorumThreshold string `protobuf:"bytes,5,opt,name=quorum_threshold,json=quorumThreshold,proto3" json:"quorum_threshold,omitempty"`
-	// Total voting power (big integer as string)
-	TotalVotingPower string `protobuf:"bytes,6,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
-	// Validators SSZ Merkle root (hex string)
-	ValidatorsSszMroot string `protobuf:"bytes,7,opt,name=validators_ssz_mroot,json=validatorsSszMroot,proto3" json:"validators_ssz_mroot,omitempty"`
+	// Settlement chain id
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Settlement contract address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Commit status tracked by this node
+	Status CommitStatus `protobuf:"varint,3,opt,name=status,proto3,enum=api.proto.v1.CommitStatus" json:"status,omitempty"`
+	// Hash of the latest known commit transaction (empty if none was sent)
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Number of commit transactions sent by this node
+	Attempts uint32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Error of the latest failed attempt
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Time the status was last updated (empty if not tracked)
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Last epoch committed on the settlement chain
+	LastCommittedEpoch uint64 `protobuf:"varint,8,opt,name=last_committed_epoch,json=lastCommittedEpoch,proto3" json:"last_committed_epoch,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetValidatorSetHeaderResponse) Reset() {
-	*x = GetValidatorSetHeaderResponse{}
-	mi := &file_v1_api_proto_msgTypes[43]
+func (x *SettlementCommitStatus) Reset() {
+	*x = SettlementCommitStatus{}
+	mi := &file_v1_api_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetValidatorSetHeaderResponse) String() string {
+func (x *SettlementCommitStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetValidatorSetHeaderResponse) ProtoMessage() {}
+func (*SettlementCommitStatus) ProtoMessage() {}
 
-func (x *GetValidatorSetHeaderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[43]
+func (x *SettlementCommitStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2475,92 +4346,93 @@ func (x *GetValidatorSetHeaderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetValidatorSetHeaderResponse.ProtoReflect.Descriptor instead.
-func (*GetValidatorSetHeaderResponse) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use SettlementCommitStatus.ProtoReflect.Descriptor instead.
+func (*SettlementCommitStatus) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetValidatorSetHeaderResponse) GetVersion() uint32 {
+func (x *SettlementCommitStatus) GetChainId() uint64 {
 	if x != nil {
-		return x.Version
+		return x.ChainId
 	}
 	return 0
 }
 
-func (x *GetValidatorSetHeaderResponse) GetRequiredKeyTag() uint32 {
+func (x *SettlementCommitStatus) GetAddress() string {
 	if x != nil {
-		return x.RequiredKeyTag
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *GetValidatorSetHeaderResponse) GetEpoch() uint64 {
+func (x *SettlementCommitStatus) GetStatus() CommitStatus {
 	if x != nil {
-		return x.Epoch
+		return x.Status
 	}
-	return 0
+	return CommitStatus_COMMIT_STATUS_UNSPECIFIED
 }
 
-func (x *GetValidatorSetHeaderResponse) GetCaptureTimestamp() *timestamppb.Timestamp {
+func (x *SettlementCommitStatus) GetTxHash() string {
 	if x != nil {
-		return x.CaptureTimestamp
+		return x.TxHash
 	}
-	return nil
+	return ""
 }
 
-func (x *GetValidatorSetHeaderResponse) GetQuorumThreshold() string {
+func (x *SettlementCommitStatus) GetAttempts() uint32 {
 	if x != nil {
-		return x.QuorumThreshold
+		return x.Attempts
 	}
-	return ""
+	return 0
 }
 
-func (x *GetValidatorSetHeaderResponse) GetTotalVotingPower() string {
+func (x *SettlementCommitStatus) GetLastError() string {
 	if x != nil {
-		return x.TotalVotingPower
+		return x.LastError
 	}
 	return ""
 }
 
-func (x *GetValidatorSetHeaderResponse) GetValidatorsSszMroot() string {
+func (x *SettlementCommitStatus) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ValidatorsSszMroot
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-// Validator information
-type Validator struct {
+func (x *SettlementCommitStatus) GetLastCommittedEpoch() uint64 {
+	if x != nil {
+		return x.LastCommittedEpoch
+	}
+	return 0
+}
+
+// Request message for getting validator participation
+type GetValidatorParticipationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Operator address (hex string)
-	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
-	// Voting power of the validator (big integer as string)
-	VotingPower string `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
-	// Indicates if the validator is active
-	IsActive bool `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	// List of cryptographic keys
-	Keys []*Key `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
-	// List of validator vaults
-	Vaults        []*ValidatorVault `protobuf:"bytes,5,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	// Epoch number (optional, defaults to the latest known validator set epoch)
+	Epoch *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
+	// Operator address (optional, returns all validators if empty)
+	Operator      *string `protobuf:"bytes,2,opt,name=operator,proto3,oneof" json:"operator,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Validator) Reset() {
-	*x = Validator{}
-	mi := &file_v1_api_proto_msgTypes[44]
+func (x *GetValidatorParticipationRequest) Reset() {
+	*x = GetValidatorParticipationRequest{}
+	mi := &file_v1_api_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Validator) String() string {
+func (x *GetValidatorParticipationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Validator) ProtoMessage() {}
+func (*GetValidatorParticipationRequest) ProtoMessage() {}
 
-func (x *Validator) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[44]
+func (x *GetValidatorParticipationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2571,72 +4443,51 @@ func (x *Validator) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
-func (*Validator) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetValidatorParticipationRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorParticipationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *Validator) GetOperator() string {
-	if x != nil {
-		return x.Operator
+func (x *GetValidatorParticipationRequest) GetEpoch() uint64 {
+	if x != nil && x.Epoch != nil {
+		return *x.Epoch
 	}
-	return ""
+	return 0
 }
 
-func (x *Validator) GetVotingPower() string {
-	if x != nil {
-		return x.VotingPower
+func (x *GetValidatorParticipationRequest) GetOperator() string {
+	if x != nil && x.Operator != nil {
+		return *x.Operator
 	}
 	return ""
 }
 
-func (x *Validator) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
-}
-
-func (x *Validator) GetKeys() []*Key {
-	if x != nil {
-		return x.Keys
-	}
-	return nil
-}
-
-func (x *Validator) GetVaults() []*ValidatorVault {
-	if x != nil {
-		return x.Vaults
-	}
-	return nil
-}
-
-// Cryptographic key
-type Key struct {
+// Response message for getting validator participation
+type GetValidatorParticipationResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Key tag identifier (0-127)
-	Tag uint32 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	// Key payload
-	Payload       []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Epoch of the participation records
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Participation of each validator with accounted requests, ordered by operator address
+	Participations []*ValidatorParticipation `protobuf:"bytes,2,rep,name=participations,proto3" json:"participations,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Key) Reset() {
-	*x = Key{}
-	mi := &file_v1_api_proto_msgTypes[45]
+func (x *GetValidatorParticipationResponse) Reset() {
+	*x = GetValidatorParticipationResponse{}
+	mi := &file_v1_api_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Key) String() string {
+func (x *GetValidatorParticipationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Key) ProtoMessage() {}
+func (*GetValidatorParticipationResponse) ProtoMessage() {}
 
-func (x *Key) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[45]
+func (x *GetValidatorParticipationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2647,53 +4498,57 @@ func (x *Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Key.ProtoReflect.Descriptor instead.
-func (*Key) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetValidatorParticipationResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorParticipationResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *Key) GetTag() uint32 {
+func (x *GetValidatorParticipationResponse) GetEpoch() uint64 {
 	if x != nil {
-		return x.Tag
+		return x.Epoch
 	}
 	return 0
 }
 
-func (x *Key) GetPayload() []byte {
+func (x *GetValidatorParticipationResponse) GetParticipations() []*ValidatorParticipation {
 	if x != nil {
-		return x.Payload
+		return x.Participations
 	}
 	return nil
 }
 
-// Validator vault information
-type ValidatorVault struct {
+// Signing participation of a validator in an epoch
+type ValidatorParticipation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Chain identifier
-	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Vault address
-	Vault string `protobuf:"bytes,2,opt,name=vault,proto3" json:"vault,omitempty"`
-	// Voting power for this vault (big integer as string)
-	VotingPower   string `protobuf:"bytes,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// Operator address
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Number of requests the validator was expected to sign
+	Seen uint64 `protobuf:"varint,2,opt,name=seen,proto3" json:"seen,omitempty"`
+	// Number of requests the validator signed
+	Signed uint64 `protobuf:"varint,3,opt,name=signed,proto3" json:"signed,omitempty"`
+	// Number of requests the validator did not sign
+	Missed uint64 `protobuf:"varint,4,opt,name=missed,proto3" json:"missed,omitempty"`
+	// Estimated median signing latency relative to the first signature of a request
+	MedianLatency *durationpb.Duration `protobuf:"bytes,5,opt,name=median_latency,json=medianLatency,proto3" json:"median_latency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidatorVault) Reset() {
-	*x = ValidatorVault{}
-	mi := &file_v1_api_proto_msgTypes[46]
+func (x *ValidatorParticipation) Reset() {
+	*x = ValidatorParticipation{}
+	mi := &file_v1_api_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidatorVault) String() string {
+func (x *ValidatorParticipation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorVault) ProtoMessage() {}
+func (*ValidatorParticipation) ProtoMessage() {}
 
-func (x *ValidatorVault) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[46]
+func (x *ValidatorParticipation) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2704,56 +4559,70 @@ func (x *ValidatorVault) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorVault.ProtoReflect.Descriptor instead.
-func (*ValidatorVault) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ValidatorParticipation.ProtoReflect.Descriptor instead.
+func (*ValidatorParticipation) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ValidatorVault) GetChainId() uint64 {
+func (x *ValidatorParticipation) GetOperator() string {
 	if x != nil {
-		return x.ChainId
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ValidatorParticipation) GetSeen() uint64 {
+	if x != nil {
+		return x.Seen
 	}
 	return 0
 }
 
-func (x *ValidatorVault) GetVault() string {
+func (x *ValidatorParticipation) GetSigned() uint64 {
+	if x != nil {
+		return x.Signed
+	}
+	return 0
+}
+
+func (x *ValidatorParticipation) GetMissed() uint64 {
 	if x != nil {
-		return x.Vault
+		return x.Missed
 	}
-	return ""
+	return 0
 }
 
-func (x *ValidatorVault) GetVotingPower() string {
+func (x *ValidatorParticipation) GetMedianLatency() *durationpb.Duration {
 	if x != nil {
-		return x.VotingPower
+		return x.MedianLatency
 	}
-	return ""
+	return nil
 }
 
-// Request message for getting last committed epoch for a specific settlement chain
-type GetLastCommittedRequest struct {
+// Request message for getting equivocation evidence
+type GetEquivocationEvidenceRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Settlement chain ID
-	SettlementChainId uint64 `protobuf:"varint,1,opt,name=settlement_chain_id,json=settlementChainId,proto3" json:"settlement_chain_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Epoch of the validator set header (optional, defaults to the latest known validator set epoch)
+	Epoch         *uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof" json:"epoch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLastCommittedRequest) Reset() {
-	*x = GetLastCommittedRequest{}
-	mi := &file_v1_api_proto_msgTypes[47]
+func (x *GetEquivocationEvidenceRequest) Reset() {
+	*x = GetEquivocationEvidenceRequest{}
+	mi := &file_v1_api_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLastCommittedRequest) String() string {
+func (x *GetEquivocationEvidenceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastCommittedRequest) ProtoMessage() {}
+func (*GetEquivocationEvidenceRequest) ProtoMessage() {}
 
-func (x *GetLastCommittedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[47]
+func (x *GetEquivocationEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2764,43 +4633,44 @@ func (x *GetLastCommittedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastCommittedRequest.ProtoReflect.Descriptor instead.
-func (*GetLastCommittedRequest) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use GetEquivocationEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*GetEquivocationEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetLastCommittedRequest) GetSettlementChainId() uint64 {
-	if x != nil {
-		return x.SettlementChainId
+func (x *GetEquivocationEvidenceRequest) GetEpoch() uint64 {
+	if x != nil && x.Epoch != nil {
+		return *x.Epoch
 	}
 	return 0
 }
 
-// Response message for getting last committed epoch
-type GetLastCommittedResponse struct {
+// Response message for getting equivocation evidence
+type GetEquivocationEvidenceResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Settlement chain ID
-	SettlementChainId uint64          `protobuf:"varint,1,opt,name=settlement_chain_id,json=settlementChainId,proto3" json:"settlement_chain_id,omitempty"`
-	EpochInfo         *ChainEpochInfo `protobuf:"bytes,2,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Epoch of the validator set header
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Evidence recorded for the epoch, ordered by operator address
+	Evidence      []*EquivocationEvidence `protobuf:"bytes,2,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLastCommittedResponse) Reset() {
-	*x = GetLastCommittedResponse{}
-	mi := &file_v1_api_proto_msgTypes[48]
+func (x *GetEquivocationEvidenceResponse) Reset() {
+	*x = GetEquivocationEvidenceResponse{}
+	mi := &file_v1_api_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLastCommittedResponse) String() string {
+func (x *GetEquivocationEvidenceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastCommittedResponse) ProtoMessage() {}
+func (*GetEquivocationEvidenceResponse) ProtoMessage() {}
 
-func (x *GetLastCommittedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[48]
+func (x *GetEquivocationEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2811,47 +4681,50 @@ func (x *GetLastCommittedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastCommittedResponse.ProtoReflect.Descriptor instead.
-func (*GetLastCommittedResponse) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use GetEquivocationEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*GetEquivocationEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetLastCommittedResponse) GetSettlementChainId() uint64 {
+func (x *GetEquivocationEvidenceResponse) GetEpoch() uint64 {
 	if x != nil {
-		return x.SettlementChainId
+		return x.Epoch
 	}
 	return 0
 }
 
-func (x *GetLastCommittedResponse) GetEpochInfo() *ChainEpochInfo {
+func (x *GetEquivocationEvidenceResponse) GetEvidence() []*EquivocationEvidence {
 	if x != nil {
-		return x.EpochInfo
+		return x.Evidence
 	}
 	return nil
 }
 
-// Request message for getting last committed epochs for all chains
-type GetLastAllCommittedRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// Request message for listening to equivocation evidence
+type ListenEquivocationEvidenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: start epoch. If provided, stream will first send all recorded evidence starting from this epoch, then continue with real-time updates
+	// If not provided, only evidence detected after stream creation will be sent
+	StartEpoch    *uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3,oneof" json:"start_epoch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLastAllCommittedRequest) Reset() {
-	*x = GetLastAllCommittedRequest{}
-	mi := &file_v1_api_proto_msgTypes[49]
+func (x *ListenEquivocationEvidenceRequest) Reset() {
+	*x = ListenEquivocationEvidenceRequest{}
+	mi := &file_v1_api_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLastAllCommittedRequest) String() string {
+func (x *ListenEquivocationEvidenceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastAllCommittedRequest) ProtoMessage() {}
+func (*ListenEquivocationEvidenceRequest) ProtoMessage() {}
 
-func (x *GetLastAllCommittedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[49]
+func (x *ListenEquivocationEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2862,37 +4735,42 @@ func (x *GetLastAllCommittedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastAllCommittedRequest.ProtoReflect.Descriptor instead.
-func (*GetLastAllCommittedRequest) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ListenEquivocationEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*ListenEquivocationEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{75}
 }
 
-// Response message for getting all last committed epochs
-type GetLastAllCommittedResponse struct {
+func (x *ListenEquivocationEvidenceRequest) GetStartEpoch() uint64 {
+	if x != nil && x.StartEpoch != nil {
+		return *x.StartEpoch
+	}
+	return 0
+}
+
+// Response message for equivocation evidence stream
+type ListenEquivocationEvidenceResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of settlement chains with their last committed epochs
-	EpochInfos map[uint64]*ChainEpochInfo `protobuf:"bytes,1,rep,name=epoch_infos,json=epochInfos,proto3" json:"epoch_infos,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Suggested epoch info for signatures, it is the minimum commited epoch among all chains
-	SuggestedEpochInfo *ChainEpochInfo `protobuf:"bytes,2,opt,name=suggested_epoch_info,json=suggestedEpochInfo,proto3" json:"suggested_epoch_info,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// The detected evidence
+	Evidence      *EquivocationEvidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLastAllCommittedResponse) Reset() {
-	*x = GetLastAllCommittedResponse{}
-	mi := &file_v1_api_proto_msgTypes[50]
+func (x *ListenEquivocationEvidenceResponse) Reset() {
+	*x = ListenEquivocationEvidenceResponse{}
+	mi := &file_v1_api_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLastAllCommittedResponse) String() string {
+func (x *ListenEquivocationEvidenceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastAllCommittedResponse) ProtoMessage() {}
+func (*ListenEquivocationEvidenceResponse) ProtoMessage() {}
 
-func (x *GetLastAllCommittedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[50]
+func (x *ListenEquivocationEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2903,51 +4781,63 @@ func (x *GetLastAllCommittedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastAllCommittedResponse.ProtoReflect.Descriptor instead.
-func (*GetLastAllCommittedResponse) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *GetLastAllCommittedResponse) GetEpochInfos() map[uint64]*ChainEpochInfo {
-	if x != nil {
-		return x.EpochInfos
-	}
-	return nil
+// Deprecated: Use ListenEquivocationEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*ListenEquivocationEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetLastAllCommittedResponse) GetSuggestedEpochInfo() *ChainEpochInfo {
+func (x *ListenEquivocationEvidenceResponse) GetEvidence() *EquivocationEvidence {
 	if x != nil {
-		return x.SuggestedEpochInfo
+		return x.Evidence
 	}
 	return nil
 }
 
-// Settlement chain with its last committed epoch
-type ChainEpochInfo struct {
+// Proof that a validator key signed two conflicting validator set header commitments of the same epoch.
+// Both signed messages are EIP-712 ValSetHeaderCommit messages rebuilt from the domain separator, subnetwork,
+// epoch and the respective header and extra data hashes
+type EquivocationEvidence struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Last committed epoch for this chain
-	LastCommittedEpoch uint64 `protobuf:"varint,1,opt,name=last_committed_epoch,json=lastCommittedEpoch,proto3" json:"last_committed_epoch,omitempty"`
-	// Epoch start time
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Operator address
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Key tag of the signing key
+	KeyTag uint32 `protobuf:"varint,2,opt,name=key_tag,json=keyTag,proto3" json:"key_tag,omitempty"`
+	// Public key of the signing key
+	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Epoch of the validator set header
+	Epoch uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// EIP-712 domain separator of the signed messages
+	DomainSeparator []byte `protobuf:"bytes,5,opt,name=domain_separator,json=domainSeparator,proto3" json:"domain_separator,omitempty"`
+	// Subnetwork of the signed messages
+	Subnetwork []byte `protobuf:"bytes,6,opt,name=subnetwork,proto3" json:"subnetwork,omitempty"`
+	// Commitment signature seen first by this node
+	First *SignedHeaderCommitment `protobuf:"bytes,7,opt,name=first,proto3" json:"first,omitempty"`
+	// Conflicting commitment signature
+	Second *SignedHeaderCommitment `protobuf:"bytes,8,opt,name=second,proto3" json:"second,omitempty"`
+	// Time the equivocation was detected by this node
+	DetectedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	// ABI encoded evidence: (address operator, uint8 keyTag, bytes publicKey, bytes32 domainSeparator, bytes32 subnetwork,
+	// uint48 epoch, (bytes32 headerHash, bytes32 extraDataHash, bytes signature) first, (...) second)
+	AbiEncoded    []byte `protobuf:"bytes,10,opt,name=abi_encoded,json=abiEncoded,proto3" json:"abi_encoded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChainEpochInfo) Reset() {
-	*x = ChainEpochInfo{}
-	mi := &file_v1_api_proto_msgTypes[51]
+func (x *EquivocationEvidence) Reset() {
+	*x = EquivocationEvidence{}
+	mi := &file_v1_api_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChainEpochInfo) String() string {
+func (x *EquivocationEvidence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChainEpochInfo) ProtoMessage() {}
+func (*EquivocationEvidence) ProtoMessage() {}
 
-func (x *ChainEpochInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[51]
+func (x *EquivocationEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2958,120 +4848,141 @@ func (x *ChainEpochInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChainEpochInfo.ProtoReflect.Descriptor instead.
-func (*ChainEpochInfo) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use EquivocationEvidence.ProtoReflect.Descriptor instead.
+func (*EquivocationEvidence) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ChainEpochInfo) GetLastCommittedEpoch() uint64 {
+func (x *EquivocationEvidence) GetOperator() string {
 	if x != nil {
-		return x.LastCommittedEpoch
+		return x.Operator
 	}
-	return 0
+	return ""
 }
 
-func (x *ChainEpochInfo) GetStartTime() *timestamppb.Timestamp {
+func (x *EquivocationEvidence) GetKeyTag() uint32 {
 	if x != nil {
-		return x.StartTime
+		return x.KeyTag
 	}
-	return nil
+	return 0
 }
 
-type ValidatorSet struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Version of the validator set
-	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// Key tag required to commit next validator set
-	RequiredKeyTag uint32 `protobuf:"varint,2,opt,name=required_key_tag,json=requiredKeyTag,proto3" json:"required_key_tag,omitempty"`
-	// Validator set epoch
-	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	// Epoch capture timestamp
-	CaptureTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=capture_timestamp,json=captureTimestamp,proto3" json:"capture_timestamp,omitempty"`
-	// Quorum threshold (big integer as string)
-	QuorumThreshold string `protobuf:"bytes,5,opt,name=quorum_threshold,json=quorumThreshold,proto3" json:"quorum_threshold,omitempty"`
-	// Status of validator set header
-	Status ValidatorSetStatus `protobuf:"varint,6,opt,name=status,proto3,enum=api.proto.v1.ValidatorSetStatus" json:"status,omitempty"`
-	// List of validators
-	Validators    []*Validator `protobuf:"bytes,7,rep,name=validators,proto3" json:"validators,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EquivocationEvidence) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
 }
 
-func (x *ValidatorSet) Reset() {
-	*x = ValidatorSet{}
-	mi := &file_v1_api_proto_msgTypes[52]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EquivocationEvidence) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
 }
 
-func (x *ValidatorSet) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EquivocationEvidence) GetDomainSeparator() []byte {
+	if x != nil {
+		return x.DomainSeparator
+	}
+	return nil
 }
 
-func (*ValidatorSet) ProtoMessage() {}
-
-func (x *ValidatorSet) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_api_proto_msgTypes[52]
+func (x *EquivocationEvidence) GetSubnetwork() []byte {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Subnetwork
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ValidatorSet.ProtoReflect.Descriptor instead.
-func (*ValidatorSet) Descriptor() ([]byte, []int) {
-	return file_v1_api_proto_rawDescGZIP(), []int{52}
+func (x *EquivocationEvidence) GetFirst() *SignedHeaderCommitment {
+	if x != nil {
+		return x.First
+	}
+	return nil
 }
 
-func (x *ValidatorSet) GetVersion() uint32 {
+func (x *EquivocationEvidence) GetSecond() *SignedHeaderCommitment {
 	if x != nil {
-		return x.Version
+		return x.Second
 	}
-	return 0
+	return nil
 }
 
-func (x *ValidatorSet) GetRequiredKeyTag() uint32 {
+func (x *EquivocationEvidence) GetDetectedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RequiredKeyTag
+		return x.DetectedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *ValidatorSet) GetEpoch() uint64 {
+func (x *EquivocationEvidence) GetAbiEncoded() []byte {
 	if x != nil {
-		return x.Epoch
+		return x.AbiEncoded
 	}
-	return 0
+	return nil
 }
 
-func (x *ValidatorSet) GetCaptureTimestamp() *timestamppb.Timestamp {
+// Validator set header commitment with the signature over it
+type SignedHeaderCommitment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Hash of the validator set header
+	HeaderHash []byte `protobuf:"bytes,1,opt,name=header_hash,json=headerHash,proto3" json:"header_hash,omitempty"`
+	// Hash of the extra data of the validator set header
+	ExtraDataHash []byte `protobuf:"bytes,2,opt,name=extra_data_hash,json=extraDataHash,proto3" json:"extra_data_hash,omitempty"`
+	// Signature over the commitment message
+	Signature     []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignedHeaderCommitment) Reset() {
+	*x = SignedHeaderCommitment{}
+	mi := &file_v1_api_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedHeaderCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedHeaderCommitment) ProtoMessage() {}
+
+func (x *SignedHeaderCommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_proto_msgTypes[78]
 	if x != nil {
-		return x.CaptureTimestamp
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ValidatorSet) GetQuorumThreshold() string {
+// Deprecated: Use SignedHeaderCommitment.ProtoReflect.Descriptor instead.
+func (*SignedHeaderCommitment) Descriptor() ([]byte, []int) {
+	return file_v1_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SignedHeaderCommitment) GetHeaderHash() []byte {
 	if x != nil {
-		return x.QuorumThreshold
+		return x.HeaderHash
 	}
-	return ""
+	return nil
 }
 
-func (x *ValidatorSet) GetStatus() ValidatorSetStatus {
+func (x *SignedHeaderCommitment) GetExtraDataHash() []byte {
 	if x != nil {
-		return x.Status
+		return x.ExtraDataHash
 	}
-	return ValidatorSetStatus_VALIDATOR_SET_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *ValidatorSet) GetValidators() []*Validator {
+func (x *SignedHeaderCommitment) GetSignature() []byte {
 	if x != nil {
-		return x.Validators
+		return x.Signature
 	}
 	return nil
 }
@@ -3080,7 +4991,7 @@ var File_v1_api_proto protoreflect.FileDescriptor
 
 const file_v1_api_proto_rawDesc = "" +
 	"\n" +
-	"\fv1/api.proto\x12\fapi.proto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\x95\x02\n" +
+	"\fv1/api.proto\x12\fapi.proto.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\x95\x02\n" +
 	"\"GetCustomScheduleNodeStatusRequest\x12\x19\n" +
 	"\x05epoch\x18\x01 \x01(\x04H\x00R\x05epoch\x88\x01\x01\x12\x17\n" +
 	"\x04seed\x18\x02 \x01(\fH\x01R\x04seed\x88\x01\x01\x122\n" +
@@ -3092,34 +5003,67 @@ const file_v1_api_proto_rawDesc = "" +
 	"#GetCustomScheduleNodeStatusResponse\x12\x1b\n" +
 	"\tis_active\x18\x01 \x01(\bR\bisActive\x12Q\n" +
 	"\x17current_slot_start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x14currentSlotStartTime\x12M\n" +
-	"\x15current_slot_end_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x12currentSlotEndTime\"\x86\x01\n" +
+	"\x15current_slot_end_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x12currentSlotEndTime\"\xd6\x02\n" +
 	"\x12SignMessageRequest\x12\x17\n" +
 	"\akey_tag\x18\x01 \x01(\rR\x06keyTag\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\fR\amessage\x12*\n" +
-	"\x0erequired_epoch\x18\x03 \x01(\x04H\x00R\rrequiredEpoch\x88\x01\x01B\x11\n" +
-	"\x0f_required_epoch\"J\n" +
+	"\x0erequired_epoch\x18\x03 \x01(\x04H\x00R\rrequiredEpoch\x88\x01\x01\x12;\n" +
+	"\n" +
+	"typed_data\x18\x04 \x01(\v2\x17.api.proto.v1.TypedDataH\x01R\ttypedData\x88\x01\x01\x120\n" +
+	"\x03ttl\x18\x05 \x01(\v2\x19.google.protobuf.DurationH\x02R\x03ttl\x88\x01\x01\x12;\n" +
+	"\bdeadline\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\bdeadline\x88\x01\x01B\x11\n" +
+	"\x0f_required_epochB\r\n" +
+	"\v_typed_dataB\x06\n" +
+	"\x04_ttlB\v\n" +
+	"\t_deadline\"J\n" +
 	"\x13SignMessageResponse\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x14\n" +
-	"\x05epoch\x18\x02 \x01(\x04R\x05epoch\"O\n" +
+	"\x05epoch\x18\x02 \x01(\x04R\x05epoch\"\x8d\x01\n" +
+	"\x17SignMessageBatchRequest\x12\x17\n" +
+	"\akey_tag\x18\x01 \x01(\rR\x06keyTag\x12\x1a\n" +
+	"\bmessages\x18\x02 \x03(\fR\bmessages\x12*\n" +
+	"\x0erequired_epoch\x18\x03 \x01(\x04H\x00R\rrequiredEpoch\x88\x01\x01B\x11\n" +
+	"\x0f_required_epoch\"p\n" +
+	"\x18SignMessageBatchResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x14\n" +
+	"\x05epoch\x18\x02 \x01(\x04R\x05epoch\x12\x1f\n" +
+	"\vmerkle_root\x18\x03 \x01(\fR\n" +
+	"merkleRoot\"T\n" +
+	"\x1dGetBatchedMessageProofRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\rR\x05index\"\x86\x02\n" +
+	"\x1eGetBatchedMessageProofResponse\x12\x1f\n" +
+	"\vmerkle_root\x18\x01 \x01(\fR\n" +
+	"merkleRoot\x12\x12\n" +
+	"\x04leaf\x18\x02 \x01(\fR\x04leaf\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\rR\x05index\x12#\n" +
+	"\rmessage_count\x18\x04 \x01(\rR\fmessageCount\x12'\n" +
+	"\x0finclusion_proof\x18\x05 \x03(\fR\x0einclusionProof\x12K\n" +
+	"\x11aggregation_proof\x18\x06 \x01(\v2\x1e.api.proto.v1.AggregationProofR\x10aggregationProof\"O\n" +
 	"\x17ListenSignaturesRequest\x12$\n" +
 	"\vstart_epoch\x18\x01 \x01(\x04H\x00R\n" +
 	"startEpoch\x88\x01\x01B\x0e\n" +
-	"\f_start_epoch\"\x86\x01\n" +
+	"\f_start_epoch\"\xc4\x01\n" +
 	"\x18ListenSignaturesResponse\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x14\n" +
 	"\x05epoch\x18\x02 \x01(\x04R\x05epoch\x125\n" +
-	"\tsignature\x18\x03 \x01(\v2\x17.api.proto.v1.SignatureR\tsignature\"K\n" +
+	"\tsignature\x18\x03 \x01(\v2\x17.api.proto.v1.SignatureR\tsignature\x12<\n" +
+	"\x06status\x18\x04 \x01(\x0e2$.api.proto.v1.SignatureRequestStatusR\x06status\"K\n" +
 	"\x13ListenProofsRequest\x12$\n" +
 	"\vstart_epoch\x18\x01 \x01(\x04H\x00R\n" +
 	"startEpoch\x88\x01\x01B\x0e\n" +
-	"\f_start_epoch\"\x98\x01\n" +
+	"\f_start_epoch\"\xf2\x01\n" +
 	"\x14ListenProofsResponse\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x14\n" +
 	"\x05epoch\x18\x02 \x01(\x04R\x05epoch\x12K\n" +
-	"\x11aggregation_proof\x18\x03 \x01(\v2\x1e.api.proto.v1.AggregationProofR\x10aggregationProof\"Q\n" +
+	"\x11aggregation_proof\x18\x03 \x01(\v2\x1e.api.proto.v1.AggregationProofR\x10aggregationProof\x12<\n" +
+	"\x06status\x18\x04 \x01(\x0e2$.api.proto.v1.SignatureRequestStatusR\x06status\x12\x1a\n" +
+	"\bupgraded\x18\x05 \x01(\bR\bupgraded\"Q\n" +
 	"\x19ListenValidatorSetRequest\x12$\n" +
 	"\vstart_epoch\x18\x01 \x01(\x04H\x00R\n" +
 	"startEpoch\x88\x01\x01B\x0e\n" +
@@ -3156,7 +5100,12 @@ const file_v1_api_proto_rawDesc = "" +
 	"\x12signature_requests\x18\x01 \x03(\v2\x1e.api.proto.v1.SignatureRequestR\x11signatureRequests\";\n" +
 	"\x1aGetSignatureRequestRequest\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\"<\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\">\n" +
+	"\x1dCancelSignatureRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"m\n" +
+	"\x1eCancelSignatureRequestResponse\x12K\n" +
+	"\x11signature_request\x18\x01 \x01(\v2\x1e.api.proto.v1.SignatureRequestR\x10signatureRequest\"<\n" +
 	"\x1bGetAggregationStatusRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\"=\n" +
@@ -3185,13 +5134,36 @@ const file_v1_api_proto_rawDesc = "" +
 	"\x17GetCurrentEpochResponse\x12\x14\n" +
 	"\x05epoch\x18\x01 \x01(\x04R\x05epoch\x129\n" +
 	"\n" +
-	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\"\x8b\x01\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\"\xdf\x02\n" +
 	"\x10SignatureRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
 	"\akey_tag\x18\x02 \x01(\rR\x06keyTag\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\fR\amessage\x12%\n" +
-	"\x0erequired_epoch\x18\x04 \x01(\x04R\rrequiredEpoch\"j\n" +
+	"\x0erequired_epoch\x18\x04 \x01(\x04R\rrequiredEpoch\x12;\n" +
+	"\n" +
+	"typed_data\x18\x05 \x01(\v2\x17.api.proto.v1.TypedDataH\x00R\ttypedData\x88\x01\x01\x12;\n" +
+	"\bdeadline\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\bdeadline\x88\x01\x01\x12<\n" +
+	"\x06status\x18\a \x01(\x0e2$.api.proto.v1.SignatureRequestStatusR\x06statusB\r\n" +
+	"\v_typed_dataB\v\n" +
+	"\t_deadline\"\xb1\x01\n" +
+	"\tTypedData\x122\n" +
+	"\x06domain\x18\x01 \x01(\v2\x1a.api.proto.v1.Eip712DomainR\x06domain\x123\n" +
+	"\x05types\x18\x02 \x03(\v2\x1d.api.proto.v1.TypedDataStructR\x05types\x12!\n" +
+	"\fprimary_type\x18\x03 \x01(\tR\vprimaryType\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x9a\x01\n" +
+	"\fEip712Domain\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\x04R\achainId\x12-\n" +
+	"\x12verifying_contract\x18\x04 \x01(\tR\x11verifyingContract\x12\x12\n" +
+	"\x04salt\x18\x05 \x01(\tR\x04salt\"[\n" +
+	"\x0fTypedDataStruct\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
+	"\x06fields\x18\x02 \x03(\v2\x1c.api.proto.v1.TypedDataFieldR\x06fields\"8\n" +
+	"\x0eTypedDataField\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"j\n" +
 	"\x1bGetSignatureRequestResponse\x12K\n" +
 	"\x11signature_request\x18\x01 \x01(\v2\x1e.api.proto.v1.SignatureRequestR\x10signatureRequest\"j\n" +
 	"\x1bGetAggregationProofResponse\x12K\n" +
@@ -3278,7 +5250,104 @@ const file_v1_api_proto_rawDesc = "" +
 	"\x06status\x18\x06 \x01(\x0e2 .api.proto.v1.ValidatorSetStatusR\x06status\x127\n" +
 	"\n" +
 	"validators\x18\a \x03(\v2\x17.api.proto.v1.ValidatorR\n" +
-	"validators*\xa5\x01\n" +
+	"validators\"y\n" +
+	"\x1bGetSignalQueueStatusRequest\x12 \n" +
+	"\tsignal_id\x18\x01 \x01(\tH\x00R\bsignalId\x88\x01\x01\x12*\n" +
+	"\x11dead_letter_limit\x18\x02 \x01(\rR\x0fdeadLetterLimitB\f\n" +
+	"\n" +
+	"_signal_id\"W\n" +
+	"\x1cGetSignalQueueStatusResponse\x127\n" +
+	"\x06queues\x18\x01 \x03(\v2\x1f.api.proto.v1.SignalQueueStatusR\x06queues\"\x8b\x02\n" +
+	"\x11SignalQueueStatus\x12\x1b\n" +
+	"\tsignal_id\x18\x01 \x01(\tR\bsignalId\x12\x18\n" +
+	"\adurable\x18\x02 \x01(\bR\adurable\x12\x16\n" +
+	"\x06queued\x18\x03 \x01(\rR\x06queued\x12\x1e\n" +
+	"\n" +
+	"processing\x18\x04 \x01(\rR\n" +
+	"processing\x12\x18\n" +
+	"\apending\x18\x05 \x01(\rR\apending\x12*\n" +
+	"\x11dead_letter_count\x18\x06 \x01(\rR\x0fdeadLetterCount\x12A\n" +
+	"\fdead_letters\x18\a \x03(\v2\x1e.api.proto.v1.SignalDeadLetterR\vdeadLetters\"\xb4\x01\n" +
+	"\x10SignalDeadLetter\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x04R\x03seq\x12\x1a\n" +
+	"\battempts\x18\x02 \x01(\rR\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x03 \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\fR\apayload\"=\n" +
+	"\x16GetCommitStatusRequest\x12\x19\n" +
+	"\x05epoch\x18\x01 \x01(\x04H\x00R\x05epoch\x88\x01\x01B\b\n" +
+	"\x06_epoch\"w\n" +
+	"\x17GetCommitStatusResponse\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\x04R\x05epoch\x12F\n" +
+	"\vsettlements\x18\x02 \x03(\v2$.api.proto.v1.SettlementCommitStatusR\vsettlements\"\xc2\x02\n" +
+	"\x16SettlementCommitStatus\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\x04R\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x122\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1a.api.proto.v1.CommitStatusR\x06status\x12\x17\n" +
+	"\atx_hash\x18\x04 \x01(\tR\x06txHash\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\rR\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\x14last_committed_epoch\x18\b \x01(\x04R\x12lastCommittedEpoch\"u\n" +
+	" GetValidatorParticipationRequest\x12\x19\n" +
+	"\x05epoch\x18\x01 \x01(\x04H\x00R\x05epoch\x88\x01\x01\x12\x1f\n" +
+	"\boperator\x18\x02 \x01(\tH\x01R\boperator\x88\x01\x01B\b\n" +
+	"\x06_epochB\v\n" +
+	"\t_operator\"\x87\x01\n" +
+	"!GetValidatorParticipationResponse\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\x04R\x05epoch\x12L\n" +
+	"\x0eparticipations\x18\x02 \x03(\v2$.api.proto.v1.ValidatorParticipationR\x0eparticipations\"\xba\x01\n" +
+	"\x16ValidatorParticipation\x12\x1a\n" +
+	"\boperator\x18\x01 \x01(\tR\boperator\x12\x12\n" +
+	"\x04seen\x18\x02 \x01(\x04R\x04seen\x12\x16\n" +
+	"\x06signed\x18\x03 \x01(\x04R\x06signed\x12\x16\n" +
+	"\x06missed\x18\x04 \x01(\x04R\x06missed\x12@\n" +
+	"\x0emedian_latency\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\rmedianLatency\"E\n" +
+	"\x1eGetEquivocationEvidenceRequest\x12\x19\n" +
+	"\x05epoch\x18\x01 \x01(\x04H\x00R\x05epoch\x88\x01\x01B\b\n" +
+	"\x06_epoch\"w\n" +
+	"\x1fGetEquivocationEvidenceResponse\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\x04R\x05epoch\x12>\n" +
+	"\bevidence\x18\x02 \x03(\v2\".api.proto.v1.EquivocationEvidenceR\bevidence\"Y\n" +
+	"!ListenEquivocationEvidenceRequest\x12$\n" +
+	"\vstart_epoch\x18\x01 \x01(\x04H\x00R\n" +
+	"startEpoch\x88\x01\x01B\x0e\n" +
+	"\f_start_epoch\"d\n" +
+	"\"ListenEquivocationEvidenceResponse\x12>\n" +
+	"\bevidence\x18\x01 \x01(\v2\".api.proto.v1.EquivocationEvidenceR\bevidence\"\xa3\x03\n" +
+	"\x14EquivocationEvidence\x12\x1a\n" +
+	"\boperator\x18\x01 \x01(\tR\boperator\x12\x17\n" +
+	"\akey_tag\x18\x02 \x01(\rR\x06keyTag\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\fR\tpublicKey\x12\x14\n" +
+	"\x05epoch\x18\x04 \x01(\x04R\x05epoch\x12)\n" +
+	"\x10domain_separator\x18\x05 \x01(\fR\x0fdomainSeparator\x12\x1e\n" +
+	"\n" +
+	"subnetwork\x18\x06 \x01(\fR\n" +
+	"subnetwork\x12:\n" +
+	"\x05first\x18\a \x01(\v2$.api.proto.v1.SignedHeaderCommitmentR\x05first\x12<\n" +
+	"\x06second\x18\b \x01(\v2$.api.proto.v1.SignedHeaderCommitmentR\x06second\x12;\n" +
+	"\vdetected_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAt\x12\x1f\n" +
+	"\vabi_encoded\x18\n" +
+	" \x01(\fR\n" +
+	"abiEncoded\"\x7f\n" +
+	"\x16SignedHeaderCommitment\x12\x1f\n" +
+	"\vheader_hash\x18\x01 \x01(\fR\n" +
+	"headerHash\x12&\n" +
+	"\x0fextra_data_hash\x18\x02 \x01(\fR\rextraDataHash\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature*\x84\x02\n" +
+	"\x16SignatureRequestStatus\x12(\n" +
+	"$SIGNATURE_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12$\n" +
+	" SIGNATURE_REQUEST_STATUS_PENDING\x10\x01\x12#\n" +
+	"\x1fSIGNATURE_REQUEST_STATUS_SIGNED\x10\x02\x12'\n" +
+	"#SIGNATURE_REQUEST_STATUS_AGGREGATED\x10\x03\x12$\n" +
+	" SIGNATURE_REQUEST_STATUS_EXPIRED\x10\x04\x12&\n" +
+	"\"SIGNATURE_REQUEST_STATUS_CANCELLED\x10\x05*\xa5\x01\n" +
 	"\x12ValidatorSetStatus\x12$\n" +
 	" VALIDATOR_SET_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cVALIDATOR_SET_STATUS_DERIVED\x10\x01\x12#\n" +
@@ -3294,9 +5363,17 @@ const file_v1_api_proto_rawDesc = "" +
 	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12ERROR_CODE_NO_DATA\x10\x01\x12\x17\n" +
 	"\x13ERROR_CODE_INTERNAL\x10\x02\x12\x1d\n" +
-	"\x19ERROR_CODE_NOT_AGGREGATOR\x10\x032\xc9\x19\n" +
+	"\x19ERROR_CODE_NOT_AGGREGATOR\x10\x03*\x9c\x01\n" +
+	"\fCommitStatus\x12\x1d\n" +
+	"\x19COMMIT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15COMMIT_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17COMMIT_STATUS_SUBMITTED\x10\x02\x12\x1b\n" +
+	"\x17COMMIT_STATUS_CONFIRMED\x10\x03\x12\x18\n" +
+	"\x14COMMIT_STATUS_FAILED\x10\x042\x90#\n" +
 	"\x13SymbioticAPIService\x12g\n" +
-	"\vSignMessage\x12 .api.proto.v1.SignMessageRequest\x1a!.api.proto.v1.SignMessageResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/sign\x12\x96\x01\n" +
+	"\vSignMessage\x12 .api.proto.v1.SignMessageRequest\x1a!.api.proto.v1.SignMessageResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/sign\x12|\n" +
+	"\x10SignMessageBatch\x12%.api.proto.v1.SignMessageBatchRequest\x1a&.api.proto.v1.SignMessageBatchResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/sign/batch\x12\xa6\x01\n" +
+	"\x16GetBatchedMessageProof\x12+.api.proto.v1.GetBatchedMessageProofRequest\x1a,.api.proto.v1.GetBatchedMessageProofResponse\"1\x82\xd3\xe4\x93\x02+\x12)/v1/sign/batch/{request_id}/proof/{index}\x12\x96\x01\n" +
 	"\x13GetAggregationProof\x12(.api.proto.v1.GetAggregationProofRequest\x1a).api.proto.v1.GetAggregationProofResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/aggregation/proof/{request_id}\x12\xb0\x01\n" +
 	"\x1bGetAggregationProofsByEpoch\x120.api.proto.v1.GetAggregationProofsByEpochRequest\x1a1.api.proto.v1.GetAggregationProofsByEpochResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/aggregation/proofs/epoch/{epoch}\x12y\n" +
 	"\x0fGetCurrentEpoch\x12$.api.proto.v1.GetCurrentEpochRequest\x1a%.api.proto.v1.GetCurrentEpochResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/epoch/current\x12}\n" +
@@ -3304,7 +5381,8 @@ const file_v1_api_proto_rawDesc = "" +
 	"\x14GetSignaturesByEpoch\x12).api.proto.v1.GetSignaturesByEpochRequest\x1a*.api.proto.v1.GetSignaturesByEpochResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/signatures/epoch/{epoch}\x12\xb9\x01\n" +
 	"\x1dGetSignatureRequestIDsByEpoch\x122.api.proto.v1.GetSignatureRequestIDsByEpochRequest\x1a3.api.proto.v1.GetSignatureRequestIDsByEpochResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v1/signature-request-ids/epoch/{epoch}\x12\xb0\x01\n" +
 	"\x1bGetSignatureRequestsByEpoch\x120.api.proto.v1.GetSignatureRequestsByEpochRequest\x1a1.api.proto.v1.GetSignatureRequestsByEpochResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/signature-requests/epoch/{epoch}\x12\x96\x01\n" +
-	"\x13GetSignatureRequest\x12(.api.proto.v1.GetSignatureRequestRequest\x1a).api.proto.v1.GetSignatureRequestResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/signature-request/{request_id}\x12\x9a\x01\n" +
+	"\x13GetSignatureRequest\x12(.api.proto.v1.GetSignatureRequestRequest\x1a).api.proto.v1.GetSignatureRequestResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/signature-request/{request_id}\x12\xa9\x01\n" +
+	"\x16CancelSignatureRequest\x12+.api.proto.v1.CancelSignatureRequestRequest\x1a,.api.proto.v1.CancelSignatureRequestResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/signature-request/{request_id}/cancel\x12\x9a\x01\n" +
 	"\x14GetAggregationStatus\x12).api.proto.v1.GetAggregationStatusRequest\x1a*.api.proto.v1.GetAggregationStatusResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/aggregation/status/{request_id}\x12y\n" +
 	"\x0fGetValidatorSet\x12$.api.proto.v1.GetValidatorSetRequest\x1a%.api.proto.v1.GetValidatorSetResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/validator-set\x12\x99\x01\n" +
 	"\x15GetValidatorByAddress\x12*.api.proto.v1.GetValidatorByAddressRequest\x1a+.api.proto.v1.GetValidatorByAddressResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/validator/address/{address}\x12\x98\x01\n" +
@@ -3314,10 +5392,15 @@ const file_v1_api_proto_rawDesc = "" +
 	"\x10GetLastCommitted\x12%.api.proto.v1.GetLastCommittedRequest\x1a&.api.proto.v1.GetLastCommittedResponse\"1\x82\xd3\xe4\x93\x02+\x12)/v1/committed/chain/{settlement_chain_id}\x12\x85\x01\n" +
 	"\x13GetLastAllCommitted\x12(.api.proto.v1.GetLastAllCommittedRequest\x1a).api.proto.v1.GetLastAllCommittedResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/committed/all\x12\x9a\x01\n" +
 	"\x17GetValidatorSetMetadata\x12,.api.proto.v1.GetValidatorSetMetadataRequest\x1a-.api.proto.v1.GetValidatorSetMetadataResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/validator-set/metadata\x12\xb9\x01\n" +
-	"\x1bGetCustomScheduleNodeStatus\x120.api.proto.v1.GetCustomScheduleNodeStatusRequest\x1a1.api.proto.v1.GetCustomScheduleNodeStatusResponse\"5\x82\xd3\xe4\x93\x02/\x12-/v1/validator-set/custom-schedule/node-status\x12\x82\x01\n" +
+	"\x1bGetCustomScheduleNodeStatus\x120.api.proto.v1.GetCustomScheduleNodeStatusRequest\x1a1.api.proto.v1.GetCustomScheduleNodeStatusResponse\"5\x82\xd3\xe4\x93\x02/\x12-/v1/validator-set/custom-schedule/node-status\x12\x88\x01\n" +
+	"\x14GetSignalQueueStatus\x12).api.proto.v1.GetSignalQueueStatusRequest\x1a*.api.proto.v1.GetSignalQueueStatusResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/signal-queues\x12y\n" +
+	"\x0fGetCommitStatus\x12$.api.proto.v1.GetCommitStatusRequest\x1a%.api.proto.v1.GetCommitStatusResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/commit-status\x12\xa1\x01\n" +
+	"\x19GetValidatorParticipation\x12..api.proto.v1.GetValidatorParticipationRequest\x1a/.api.proto.v1.GetValidatorParticipationResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/validator-participation\x12\x99\x01\n" +
+	"\x17GetEquivocationEvidence\x12,.api.proto.v1.GetEquivocationEvidenceRequest\x1a-.api.proto.v1.GetEquivocationEvidenceResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/equivocation-evidence\x12\x82\x01\n" +
 	"\x10ListenSignatures\x12%.api.proto.v1.ListenSignaturesRequest\x1a&.api.proto.v1.ListenSignaturesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/stream/signatures0\x01\x12r\n" +
 	"\fListenProofs\x12!.api.proto.v1.ListenProofsRequest\x1a\".api.proto.v1.ListenProofsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/stream/proofs0\x01\x12\x8b\x01\n" +
-	"\x12ListenValidatorSet\x12'.api.proto.v1.ListenValidatorSetRequest\x1a(.api.proto.v1.ListenValidatorSetResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/stream/validator-set0\x01B\x99\x01\n" +
+	"\x12ListenValidatorSet\x12'.api.proto.v1.ListenValidatorSetRequest\x1a(.api.proto.v1.ListenValidatorSetResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/stream/validator-set0\x01\x12\xab\x01\n" +
+	"\x1aListenEquivocationEvidence\x12/.api.proto.v1.ListenEquivocationEvidenceRequest\x1a0.api.proto.v1.ListenEquivocationEvidenceResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/stream/equivocation-evidence0\x01B\x99\x01\n" +
 	"\x10com.api.proto.v1B\bApiProtoP\x01Z)github.com/symbioticfi/relay/api/proto/v1\xa2\x02\x03APX\xaa\x02\fApi.Proto.V1\xca\x02\fApi\\Proto\\V1\xe2\x02\x18Api\\Proto\\V1\\GPBMetadata\xea\x02\x0eApi::Proto::V1b\x06proto3"
 
 var (
@@ -3332,146 +5415,217 @@ func file_v1_api_proto_rawDescGZIP() []byte {
 	return file_v1_api_proto_rawDescData
 }
 
-var file_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
+var file_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
 var file_v1_api_proto_goTypes = []any{
-	(ValidatorSetStatus)(0),                       // 0: api.proto.v1.ValidatorSetStatus
-	(SigningStatus)(0),                            // 1: api.proto.v1.SigningStatus
-	(ErrorCode)(0),                                // 2: api.proto.v1.ErrorCode
-	(*GetCustomScheduleNodeStatusRequest)(nil),    // 3: api.proto.v1.GetCustomScheduleNodeStatusRequest
-	(*GetCustomScheduleNodeStatusResponse)(nil),   // 4: api.proto.v1.GetCustomScheduleNodeStatusResponse
-	(*SignMessageRequest)(nil),                    // 5: api.proto.v1.SignMessageRequest
-	(*SignMessageResponse)(nil),                   // 6: api.proto.v1.SignMessageResponse
-	(*ListenSignaturesRequest)(nil),               // 7: api.proto.v1.ListenSignaturesRequest
-	(*ListenSignaturesResponse)(nil),              // 8: api.proto.v1.ListenSignaturesResponse
-	(*ListenProofsRequest)(nil),                   // 9: api.proto.v1.ListenProofsRequest
-	(*ListenProofsResponse)(nil),                  // 10: api.proto.v1.ListenProofsResponse
-	(*ListenValidatorSetRequest)(nil),             // 11: api.proto.v1.ListenValidatorSetRequest
-	(*ListenValidatorSetResponse)(nil),            // 12: api.proto.v1.ListenValidatorSetResponse
-	(*GetAggregationProofRequest)(nil),            // 13: api.proto.v1.GetAggregationProofRequest
-	(*GetAggregationProofsByEpochRequest)(nil),    // 14: api.proto.v1.GetAggregationProofsByEpochRequest
-	(*GetCurrentEpochRequest)(nil),                // 15: api.proto.v1.GetCurrentEpochRequest
-	(*GetSignaturesRequest)(nil),                  // 16: api.proto.v1.GetSignaturesRequest
-	(*GetSignaturesByEpochRequest)(nil),           // 17: api.proto.v1.GetSignaturesByEpochRequest
-	(*GetSignaturesResponse)(nil),                 // 18: api.proto.v1.GetSignaturesResponse
-	(*GetSignaturesByEpochResponse)(nil),          // 19: api.proto.v1.GetSignaturesByEpochResponse
-	(*GetSignatureRequestIDsByEpochRequest)(nil),  // 20: api.proto.v1.GetSignatureRequestIDsByEpochRequest
-	(*GetSignatureRequestIDsByEpochResponse)(nil), // 21: api.proto.v1.GetSignatureRequestIDsByEpochResponse
-	(*GetSignatureRequestsByEpochRequest)(nil),    // 22: api.proto.v1.GetSignatureRequestsByEpochRequest
-	(*GetSignatureRequestsByEpochResponse)(nil),   // 23: api.proto.v1.GetSignatureRequestsByEpochResponse
-	(*GetSignatureRequestRequest)(nil),            // 24: api.proto.v1.GetSignatureRequestRequest
-	(*GetAggregationStatusRequest)(nil),           // 25: api.proto.v1.GetAggregationStatusRequest
-	(*GetValidatorSetRequest)(nil),                // 26: api.proto.v1.GetValidatorSetRequest
-	(*GetValidatorByAddressRequest)(nil),          // 27: api.proto.v1.GetValidatorByAddressRequest
-	(*GetValidatorByKeyRequest)(nil),              // 28: api.proto.v1.GetValidatorByKeyRequest
-	(*GetLocalValidatorRequest)(nil),              // 29: api.proto.v1.GetLocalValidatorRequest
-	(*GetValidatorSetHeaderRequest)(nil),          // 30: api.proto.v1.GetValidatorSetHeaderRequest
-	(*GetValidatorSetMetadataRequest)(nil),        // 31: api.proto.v1.GetValidatorSetMetadataRequest
-	(*GetCurrentEpochResponse)(nil),               // 32: api.proto.v1.GetCurrentEpochResponse
-	(*SignatureRequest)(nil),                      // 33: api.proto.v1.SignatureRequest
-	(*GetSignatureRequestResponse)(nil),           // 34: api.proto.v1.GetSignatureRequestResponse
-	(*GetAggregationProofResponse)(nil),           // 35: api.proto.v1.GetAggregationProofResponse
-	(*GetAggregationProofsByEpochResponse)(nil),   // 36: api.proto.v1.GetAggregationProofsByEpochResponse
-	(*AggregationProof)(nil),                      // 37: api.proto.v1.AggregationProof
-	(*GetAggregationStatusResponse)(nil),          // 38: api.proto.v1.GetAggregationStatusResponse
-	(*Signature)(nil),                             // 39: api.proto.v1.Signature
-	(*GetValidatorSetResponse)(nil),               // 40: api.proto.v1.GetValidatorSetResponse
-	(*GetValidatorByAddressResponse)(nil),         // 41: api.proto.v1.GetValidatorByAddressResponse
-	(*GetValidatorByKeyResponse)(nil),             // 42: api.proto.v1.GetValidatorByKeyResponse
-	(*GetLocalValidatorResponse)(nil),             // 43: api.proto.v1.GetLocalValidatorResponse
-	(*ExtraData)(nil),                             // 44: api.proto.v1.ExtraData
-	(*GetValidatorSetMetadataResponse)(nil),       // 45: api.proto.v1.GetValidatorSetMetadataResponse
-	(*GetValidatorSetHeaderResponse)(nil),         // 46: api.proto.v1.GetValidatorSetHeaderResponse
-	(*Validator)(nil),                             // 47: api.proto.v1.Validator
-	(*Key)(nil),                                   // 48: api.proto.v1.Key
-	(*ValidatorVault)(nil),                        // 49: api.proto.v1.ValidatorVault
-	(*GetLastCommittedRequest)(nil),               // 50: api.proto.v1.GetLastCommittedRequest
-	(*GetLastCommittedResponse)(nil),              // 51: api.proto.v1.GetLastCommittedResponse
-	(*GetLastAllCommittedRequest)(nil),            // 52: api.proto.v1.GetLastAllCommittedRequest
-	(*GetLastAllCommittedResponse)(nil),           // 53: api.proto.v1.GetLastAllCommittedResponse
-	(*ChainEpochInfo)(nil),                        // 54: api.proto.v1.ChainEpochInfo
-	(*ValidatorSet)(nil),                          // 55: api.proto.v1.ValidatorSet
-	nil,                                           // 56: api.proto.v1.GetLastAllCommittedResponse.EpochInfosEntry
-	(*timestamppb.Timestamp)(nil),                 // 57: google.protobuf.Timestamp
+	(SignatureRequestStatus)(0),                   // 0: api.proto.v1.SignatureRequestStatus
+	(ValidatorSetStatus)(0),                       // 1: api.proto.v1.ValidatorSetStatus
+	(SigningStatus)(0),                            // 2: api.proto.v1.SigningStatus
+	(ErrorCode)(0),                                // 3: api.proto.v1.ErrorCode
+	(CommitStatus)(0),                             // 4: api.proto.v1.CommitStatus
+	(*GetCustomScheduleNodeStatusRequest)(nil),    // 5: api.proto.v1.GetCustomScheduleNodeStatusRequest
+	(*GetCustomScheduleNodeStatusResponse)(nil),   // 6: api.proto.v1.GetCustomScheduleNodeStatusResponse
+	(*SignMessageRequest)(nil),                    // 7: api.proto.v1.SignMessageRequest
+	(*SignMessageResponse)(nil),                   // 8: api.proto.v1.SignMessageResponse
+	(*SignMessageBatchRequest)(nil),               // 9: api.proto.v1.SignMessageBatchRequest
+	(*SignMessageBatchResponse)(nil),              // 10: api.proto.v1.SignMessageBatchResponse
+	(*GetBatchedMessageProofRequest)(nil),         // 11: api.proto.v1.GetBatchedMessageProofRequest
+	(*GetBatchedMessageProofResponse)(nil),        // 12: api.proto.v1.GetBatchedMessageProofResponse
+	(*ListenSignaturesRequest)(nil),               // 13: api.proto.v1.ListenSignaturesRequest
+	(*ListenSignaturesResponse)(nil),              // 14: api.proto.v1.ListenSignaturesResponse
+	(*ListenProofsRequest)(nil),                   // 15: api.proto.v1.ListenProofsRequest
+	(*ListenProofsResponse)(nil),                  // 16: api.proto.v1.ListenProofsResponse
+	(*ListenValidatorSetRequest)(nil),             // 17: api.proto.v1.ListenValidatorSetRequest
+	(*ListenValidatorSetResponse)(nil),            // 18: api.proto.v1.ListenValidatorSetResponse
+	(*GetAggregationProofRequest)(nil),            // 19: api.proto.v1.GetAggregationProofRequest
+	(*GetAggregationProofsByEpochRequest)(nil),    // 20: api.proto.v1.GetAggregationProofsByEpochRequest
+	(*GetCurrentEpochRequest)(nil),                // 21: api.proto.v1.GetCurrentEpochRequest
+	(*GetSignaturesRequest)(nil),                  // 22: api.proto.v1.GetSignaturesRequest
+	(*GetSignaturesByEpochRequest)(nil),           // 23: api.proto.v1.GetSignaturesByEpochRequest
+	(*GetSignaturesResponse)(nil),                 // 24: api.proto.v1.GetSignaturesResponse
+	(*GetSignaturesByEpochResponse)(nil),          // 25: api.proto.v1.GetSignaturesByEpochResponse
+	(*GetSignatureRequestIDsByEpochRequest)(nil),  // 26: api.proto.v1.GetSignatureRequestIDsByEpochRequest
+	(*GetSignatureRequestIDsByEpochResponse)(nil), // 27: api.proto.v1.GetSignatureRequestIDsByEpochResponse
+	(*GetSignatureRequestsByEpochRequest)(nil),    // 28: api.proto.v1.GetSignatureRequestsByEpochRequest
+	(*GetSignatureRequestsByEpochResponse)(nil),   // 29: api.proto.v1.GetSignatureRequestsByEpochResponse
+	(*GetSignatureRequestRequest)(nil),            // 30: api.proto.v1.GetSignatureRequestRequest
+	(*CancelSignatureRequestRequest)(nil),         // 31: api.proto.v1.CancelSignatureRequestRequest
+	(*CancelSignatureRequestResponse)(nil),        // 32: api.proto.v1.CancelSignatureRequestResponse
+	(*GetAggregationStatusRequest)(nil),           // 33: api.proto.v1.GetAggregationStatusRequest
+	(*GetValidatorSetRequest)(nil),                // 34: api.proto.v1.GetValidatorSetRequest
+	(*GetValidatorByAddressRequest)(nil),          // 35: api.proto.v1.GetValidatorByAddressRequest
+	(*GetValidatorByKeyRequest)(nil),              // 36: api.proto.v1.GetValidatorByKeyRequest
+	(*GetLocalValidatorRequest)(nil),              // 37: api.proto.v1.GetLocalValidatorRequest
+	(*GetValidatorSetHeaderRequest)(nil),          // 38: api.proto.v1.GetValidatorSetHeaderRequest
+	(*GetValidatorSetMetadataRequest)(nil),        // 39: api.proto.v1.GetValidatorSetMetadataRequest
+	(*GetCurrentEpochResponse)(nil),               // 40: api.proto.v1.GetCurrentEpochResponse
+	(*SignatureRequest)(nil),                      // 41: api.proto.v1.SignatureRequest
+	(*TypedData)(nil),                             // 42: api.proto.v1.TypedData
+	(*Eip712Domain)(nil),                          // 43: api.proto.v1.Eip712Domain
+	(*TypedDataStruct)(nil),                       // 44: api.proto.v1.TypedDataStruct
+	(*TypedDataField)(nil),                        // 45: api.proto.v1.TypedDataField
+	(*GetSignatureRequestResponse)(nil),           // 46: api.proto.v1.GetSignatureRequestResponse
+	(*GetAggregationProofResponse)(nil),           // 47: api.proto.v1.GetAggregationProofResponse
+	(*GetAggregationProofsByEpochResponse)(nil),   // 48: api.proto.v1.GetAggregationProofsByEpochResponse
+	(*AggregationProof)(nil),                      // 49: api.proto.v1.AggregationProof
+	(*GetAggregationStatusResponse)(nil),          // 50: api.proto.v1.GetAggregationStatusResponse
+	(*Signature)(nil),                             // 51: api.proto.v1.Signature
+	(*GetValidatorSetResponse)(nil),               // 52: api.proto.v1.GetValidatorSetResponse
+	(*GetValidatorByAddressResponse)(nil),         // 53: api.proto.v1.GetValidatorByAddressResponse
+	(*GetValidatorByKeyResponse)(nil),             // 54: api.proto.v1.GetValidatorByKeyResponse
+	(*GetLocalValidatorResponse)(nil),             // 55: api.proto.v1.GetLocalValidatorResponse
+	(*ExtraData)(nil),                             // 56: api.proto.v1.ExtraData
+	(*GetValidatorSetMetadataResponse)(nil),       // 57: api.proto.v1.GetValidatorSetMetadataResponse
+	(*GetValidatorSetHeaderResponse)(nil),         // 58: api.proto.v1.GetValidatorSetHeaderResponse
+	(*Validator)(nil),                             // 59: api.proto.v1.Validator
+	(*Key)(nil),                                   // 60: api.proto.v1.Key
+	(*ValidatorVault)(nil),                        // 61: api.proto.v1.ValidatorVault
+	(*GetLastCommittedRequest)(nil),               // 62: api.proto.v1.GetLastCommittedRequest
+	(*GetLastCommittedResponse)(nil),              // 63: api.proto.v1.GetLastCommittedResponse
+	(*GetLastAllCommittedRequest)(nil),            // 64: api.proto.v1.GetLastAllCommittedRequest
+	(*GetLastAllCommittedResponse)(nil),           // 65: api.proto.v1.GetLastAllCommittedResponse
+	(*ChainEpochInfo)(nil),                        // 66: api.proto.v1.ChainEpochInfo
+	(*ValidatorSet)(nil),                          // 67: api.proto.v1.ValidatorSet
+	(*GetSignalQueueStatusRequest)(nil),           // 68: api.proto.v1.GetSignalQueueStatusRequest
+	(*GetSignalQueueStatusResponse)(nil),          // 69: api.proto.v1.GetSignalQueueStatusResponse
+	(*SignalQueueStatus)(nil),                     // 70: api.proto.v1.SignalQueueStatus
+	(*SignalDeadLetter)(nil),                      // 71: api.proto.v1.SignalDeadLetter
+	(*GetCommitStatusRequest)(nil),                // 72: api.proto.v1.GetCommitStatusRequest
+	(*GetCommitStatusResponse)(nil),               // 73: api.proto.v1.GetCommitStatusResponse
+	(*SettlementCommitStatus)(nil),                // 74: api.proto.v1.SettlementCommitStatus
+	(*GetValidatorParticipationRequest)(nil),      // 75: api.proto.v1.GetValidatorParticipationRequest
+	(*GetValidatorParticipationResponse)(nil),     // 76: api.proto.v1.GetValidatorParticipationResponse
+	(*ValidatorParticipation)(nil),                // 77: api.proto.v1.ValidatorParticipation
+	(*GetEquivocationEvidenceRequest)(nil),        // 78: api.proto.v1.GetEquivocationEvidenceRequest
+	(*GetEquivocationEvidenceResponse)(nil),       // 79: api.proto.v1.GetEquivocationEvidenceResponse
+	(*ListenEquivocationEvidenceRequest)(nil),     // 80: api.proto.v1.ListenEquivocationEvidenceRequest
+	(*ListenEquivocationEvidenceResponse)(nil),    // 81: api.proto.v1.ListenEquivocationEvidenceResponse
+	(*EquivocationEvidence)(nil),                  // 82: api.proto.v1.EquivocationEvidence
+	(*SignedHeaderCommitment)(nil),                // 83: api.proto.v1.SignedHeaderCommitment
+	nil,                                           // 84: api.proto.v1.GetLastAllCommittedResponse.EpochInfosEntry
+	(*timestamppb.Timestamp)(nil),                 // 85: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                   // 86: google.protobuf.Duration
 }
 var file_v1_api_proto_depIdxs = []int32{
-	57, // 0: api.proto.v1.GetCustomScheduleNodeStatusResponse.current_slot_start_time:type_name -> google.protobuf.Timestamp
-	57, // 1: api.proto.v1.GetCustomScheduleNodeStatusResponse.current_slot_end_time:type_name -> google.protobuf.Timestamp
-	39, // 2: api.proto.v1.ListenSignaturesResponse.signature:type_name -> api.proto.v1.Signature
-	37, // 3: api.proto.v1.ListenProofsResponse.aggregation_proof:type_name -> api.proto.v1.AggregationProof
-	55, // 4: api.proto.v1.ListenValidatorSetResponse.validator_set:type_name -> api.proto.v1.ValidatorSet
-	39, // 5: api.proto.v1.GetSignaturesResponse.signatures:type_name -> api.proto.v1.Signature
-	39, // 6: api.proto.v1.GetSignaturesByEpochResponse.signatures:type_name -> api.proto.v1.Signature
-	33, // 7: api.proto.v1.GetSignatureRequestsByEpochResponse.signature_requests:type_name -> api.proto.v1.SignatureRequest
-	57, // 8: api.proto.v1.GetCurrentEpochResponse.start_time:type_name -> google.protobuf.Timestamp
-	33, // 9: api.proto.v1.GetSignatureRequestResponse.signature_request:type_name -> api.proto.v1.SignatureRequest
-	37, // 10: api.proto.v1.GetAggregationProofResponse.aggregation_proof:type_name -> api.proto.v1.AggregationProof
-	37, // 11: api.proto.v1.GetAggregationProofsByEpochResponse.aggregation_proofs:type_name -> api.proto.v1.AggregationProof
-	55, // 12: api.proto.v1.GetValidatorSetResponse.validator_set:type_name -> api.proto.v1.ValidatorSet
-	47, // 13: api.proto.v1.GetValidatorByAddressResponse.validator:type_name -> api.proto.v1.Validator
-	47, // 14: api.proto.v1.GetValidatorByKeyResponse.validator:type_name -> api.proto.v1.Validator
-	47, // 15: api.proto.v1.GetLocalValidatorResponse.validator:type_name -> api.proto.v1.Validator
-	44, // 16: api.proto.v1.GetValidatorSetMetadataResponse.extra_data:type_name -> api.proto.v1.ExtraData
-	57, // 17: api.proto.v1.GetValidatorSetHeaderResponse.capture_timestamp:type_name -> google.protobuf.Timestamp
-	48, // 18: api.proto.v1.Validator.keys:type_name -> api.proto.v1.Key
-	49, // 19: api.proto.v1.Validator.vaults:type_name -> api.proto.v1.ValidatorVault
-	54, // 20: api.proto.v1.GetLastCommittedResponse.epoch_info:type_name -> api.proto.v1.ChainEpochInfo
-	56, // 21: api.proto.v1.GetLastAllCommittedResponse.epoch_infos:type_name -> api.proto.v1.GetLastAllCommittedResponse.EpochInfosEntry
-	54, // 22: api.proto.v1.GetLastAllCommittedResponse.suggested_epoch_info:type_name -> api.proto.v1.ChainEpochInfo
-	57, // 23: api.proto.v1.ChainEpochInfo.start_time:type_name -> google.protobuf.Timestamp
-	57, // 24: api.proto.v1.ValidatorSet.capture_timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 25: api.proto.v1.ValidatorSet.status:type_name -> api.proto.v1.ValidatorSetStatus
-	47, // 26: api.proto.v1.ValidatorSet.validators:type_name -> api.proto.v1.Validator
-	54, // 27: api.proto.v1.GetLastAllCommittedResponse.EpochInfosEntry.value:type_name -> api.proto.v1.ChainEpochInfo
-	5,  // 28: api.proto.v1.SymbioticAPIService.SignMessage:input_type -> api.proto.v1.SignMessageRequest
-	13, // 29: api.proto.v1.SymbioticAPIService.GetAggregationProof:input_type -> api.proto.v1.GetAggregationProofRequest
-	14, // 30: api.proto.v1.SymbioticAPIService.GetAggregationProofsByEpoch:input_type -> api.proto.v1.GetAggregationProofsByEpochRequest
-	15, // 31: api.proto.v1.SymbioticAPIService.GetCurrentEpoch:input_type -> api.proto.v1.GetCurrentEpochRequest
-	16, // 32: api.proto.v1.SymbioticAPIService.GetSignatures:input_type -> api.proto.v1.GetSignaturesRequest
-	17, // 33: api.proto.v1.SymbioticAPIService.GetSignaturesByEpoch:input_type -> api.proto.v1.GetSignaturesByEpochRequest
-	20, // 34: api.proto.v1.SymbioticAPIService.GetSignatureRequestIDsByEpoch:input_type -> api.proto.v1.GetSignatureRequestIDsByEpochRequest
-	22, // 35: api.proto.v1.SymbioticAPIService.GetSignatureRequestsByEpoch:input_type -> api.proto.v1.GetSignatureRequestsByEpochRequest
-	24, // 36: api.proto.v1.SymbioticAPIService.GetSignatureRequest:input_type -> api.proto.v1.GetSignatureRequestRequest
-	25, // 37: api.proto.v1.SymbioticAPIService.GetAggregationStatus:input_type -> api.proto.v1.GetAggregationStatusRequest
-	26, // 38: api.proto.v1.SymbioticAPIService.GetValidatorSet:input_type -> api.proto.v1.GetValidatorSetRequest
-	27, // 39: api.proto.v1.SymbioticAPIService.GetValidatorByAddress:input_type -> api.proto.v1.GetValidatorByAddressRequest
-	28, // 40: api.proto.v1.SymbioticAPIService.GetValidatorByKey:input_type -> api.proto.v1.GetValidatorByKeyRequest
-	29, // 41: api.proto.v1.SymbioticAPIService.GetLocalValidator:input_type -> api.proto.v1.GetLocalValidatorRequest
-	30, // 42: api.proto.v1.SymbioticAPIService.GetValidatorSetHeader:input_type -> api.proto.v1.GetValidatorSetHeaderRequest
-	50, // 43: api.proto.v1.SymbioticAPIService.GetLastCommitted:input_type -> api.proto.v1.GetLastCommittedRequest
-	52, // 44: api.proto.v1.SymbioticAPIService.GetLastAllCommitted:input_type -> api.proto.v1.GetLastAllCommittedRequest
-	31, // 45: api.proto.v1.SymbioticAPIService.GetValidatorSetMetadata:input_type -> api.proto.v1.GetValidatorSetMetadataRequest
-	3,  // 46: api.proto.v1.SymbioticAPIService.GetCustomScheduleNodeStatus:input_type -> api.proto.v1.GetCustomScheduleNodeStatusRequest
-	7,  // 47: api.proto.v1.SymbioticAPIService.ListenSignatures:input_type -> api.proto.v1.ListenSignaturesRequest
-	9,  // 48: api.proto.v1.SymbioticAPIService.ListenProofs:input_type -> api.proto.v1.ListenProofsRequest
-	11, // 49: api.proto.v1.SymbioticAPIService.ListenValidatorSet:input_type -> api.proto.v1.ListenValidatorSetRequest
-	6,  // 50: api.proto.v1.SymbioticAPIService.SignMessage:output_type -> api.proto.v1.SignMessageResponse
-	35, // 51: api.proto.v1.SymbioticAPIService.GetAggregationProof:output_type -> api.proto.v1.GetAggregationProofResponse
-	36, // 52: api.proto.v1.SymbioticAPIService.GetAggregationProofsByEpoch:output_type -> api.proto.v1.GetAggregationProofsByEpochResponse
-	32, // 53: api.proto.v1.SymbioticAPIService.GetCurrentEpoch:output_type -> api.proto.v1.GetCurrentEpochResponse
-	18, // 54: api.proto.v1.SymbioticAPIService.GetSignatures:output_type -> api.proto.v1.GetSignaturesResponse
-	19, // 55: api.proto.v1.SymbioticAPIService.GetSignaturesByEpoch:output_type -> api.proto.v1.GetSignaturesByEpochResponse
-	21, // 56: api.proto.v1.SymbioticAPIService.GetSignatureRequestIDsByEpoch:output_type -> api.proto.v1.GetSignatureRequestIDsByEpochResponse
-	23, // 57: api.proto.v1.SymbioticAPIService.GetSignatureRequestsByEpoch:output_type -> api.proto.v1.GetSignatureRequestsByEpochResponse
-	34, // 58: api.proto.v1.SymbioticAPIService.GetSignatureRequest:output_type -> api.proto.v1.GetSignatureRequestResponse
-	38, // 59: api.proto.v1.SymbioticAPIService.GetAggregationStatus:output_type -> api.proto.v1.GetAggregationStatusResponse
-	40, // 60: api.proto.v1.SymbioticAPIService.GetValidatorSet:output_type -> api.proto.v1.GetValidatorSetResponse
-	41, // 61: api.proto.v1.SymbioticAPIService.GetValidatorByAddress:output_type -> api.proto.v1.GetValidatorByAddressResponse
-	42, // 62: api.proto.v1.SymbioticAPIService.GetValidatorByKey:output_type -> api.proto.v1.GetValidatorByKeyResponse
-	43, // 63: api.proto.v1.SymbioticAPIService.GetLocalValidator:output_type -> api.proto.v1.GetLocalValidatorResponse
-	46, // 64: api.proto.v1.SymbioticAPIService.GetValidatorSetHeader:output_type -> api.proto.v1.GetValidatorSetHeaderResponse
-	51, // 65: api.proto.v1.SymbioticAPIService.GetLastCommitted:output_type -> api.proto.v1.GetLastCommittedResponse
-	53, // 66: api.proto.v1.SymbioticAPIService.GetLastAllCommitted:output_type -> api.proto.v1.GetLastAllCommittedResponse
-	45, // 67: api.proto.v1.SymbioticAPIService.GetValidatorSetMetadata:output_type -> api.proto.v1.GetValidatorSetMetadataResponse
-	4,  // 68: api.proto.v1.SymbioticAPIService.GetCustomScheduleNodeStatus:output_type -> api.proto.v1.GetCustomScheduleNodeStatusResponse
-	8,  // 69: api.proto.v1.SymbioticAPIService.ListenSignatures:output_type -> api.proto.v1.ListenSignaturesResponse
-	10, // 70: api.proto.v1.SymbioticAPIService.ListenProofs:output_type -> api.proto.v1.ListenProofsResponse
-	12, // 71: api.proto.v1.SymbioticAPIService.ListenValidatorSet:output_type -> api.proto.v1.ListenValidatorSetResponse
-	50, // [50:72] is the sub-list for method output_type
-	28, // [28:50] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	85, // 0: api.proto.v1.GetCustomScheduleNodeStatusResponse.current_slot_start_time:type_name -> google.protobuf.Timestamp
+	85, // 1: api.proto.v1.GetCustomScheduleNodeStatusResponse.current_slot_end_time:type_name -> google.protobuf.Timestamp
+	42, // 2: api.proto.v1.SignMessageRequest.typed_data:type_name -> api.proto.v1.TypedData
+	86, // 3: api.proto.v1.SignMessageRequest.ttl:type_name -> google.protobuf.Duration
+	85, // 4: api.proto.v1.SignMessageRequest.deadline:type_name -> google.protobuf.Timestamp
+	49, // 5: api.proto.v1.GetBatchedMessageProofResponse.aggregation_proof:type_name -> api.proto.v1.AggregationProof
+	51, // 6: api.proto.v1.ListenSignaturesResponse.signature:type_name -> api.proto.v1.Signature
+	0,  // 7: api.proto.v1.ListenSignaturesResponse.status:type_name -> api.proto.v1.SignatureRequestStatus
+	49, // 8: api.proto.v1.ListenProofsResponse.aggregation_proof:type_name -> api.proto.v1.AggregationProof
+	0,  // 9: api.proto.v1.ListenProofsResponse.status:type_name -> api.proto.v1.SignatureRequestStatus
+	67, // 10: api.proto.v1.ListenValidatorSetResponse.validator_set:type_name -> api.proto.v1.ValidatorSet
+	51, // 11: api.proto.v1.GetSignaturesResponse.signatures:type_name -> api.proto.v1.Signature
+	51, // 12: api.proto.v1.GetSignaturesByEpochResponse.signatures:type_name -> api.proto.v1.Signature
+	41, // 13: api.proto.v1.GetSignatureRequestsByEpochResponse.signature_requests:type_name -> api.proto.v1.SignatureRequest
+	41, // 14: api.proto.v1.CancelSignatureRequestResponse.signature_request:type_name -> api.proto.v1.SignatureRequest
+	85, // 15: api.proto.v1.GetCurrentEpochResponse.start_time:type_name -> google.protobuf.Timestamp
+	42, // 16: api.proto.v1.SignatureRequest.typed_data:type_name -> api.proto.v1.TypedData
+	85, // 17: api.proto.v1.SignatureRequest.deadline:type_name -> google.protobuf.Timestamp
+	0,  // 18: api.proto.v1.SignatureRequest.status:type_name -> api.proto.v1.SignatureRequestStatus
+	43, // 19: api.proto.v1.TypedData.domain:type_name -> api.proto.v1.Eip712Domain
+	44, // 20: api.proto.v1.TypedData.types:type_name -> api.proto.v1.TypedDataStruct
+	45, // 21: api.proto.v1.TypedDataStruct.fields:type_name -> api.proto.v1.TypedDataField
+	41, // 22: api.proto.v1.GetSignatureRequestResponse.signature_request:type_name -> api.proto.v1.SignatureRequest
+	49, // 23: api.proto.v1.GetAggregationProofResponse.aggregation_proof:type_name -> api.proto.v1.AggregationProof
+	49, // 24: api.proto.v1.GetAggregationProofsByEpochResponse.aggregation_proofs:type_name -> api.proto.v1.AggregationProof
+	67, // 25: api.proto.v1.GetValidatorSetResponse.validator_set:type_name -> api.proto.v1.ValidatorSet
+	59, // 26: api.proto.v1.GetValidatorByAddressResponse.validator:type_name -> api.proto.v1.Validator
+	59, // 27: api.proto.v1.GetValidatorByKeyResponse.validator:type_name -> api.proto.v1.Validator
+	59, // 28: api.proto.v1.GetLocalValidatorResponse.validator:type_name -> api.proto.v1.Validator
+	56, // 29: api.proto.v1.GetValidatorSetMetadataResponse.extra_data:type_name -> api.proto.v1.ExtraData
+	85, // 30: api.proto.v1.GetValidatorSetHeaderResponse.capture_timestamp:type_name -> google.protobuf.Timestamp
+	60, // 31: api.proto.v1.Validator.keys:type_name -> api.proto.v1.Key
+	61, // 32: api.proto.v1.Validator.vaults:type_name -> api.proto.v1.ValidatorVault
+	66, // 33: api.proto.v1.GetLastCommittedResponse.epoch_info:type_name -> api.proto.v1.ChainEpochInfo
+	84, // 34: api.proto.v1.GetLastAllCommittedResponse.epoch_infos:type_name -> api.proto.v1.GetLastAllCommittedResponse.EpochInfosEntry
+	66, // 35: api.proto.v1.GetLastAllCommittedResponse.suggested_epoch_info:type_name -> api.proto.v1.ChainEpochInfo
+	85, // 36: api.proto.v1.ChainEpochInfo.start_time:type_name -> google.protobuf.Timestamp
+	85, // 37: api.proto.v1.ValidatorSet.capture_timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 38: api.proto.v1.ValidatorSet.status:type_name -> api.proto.v1.ValidatorSetStatus
+	59, // 39: api.proto.v1.ValidatorSet.validators:type_name -> api.proto.v1.Validator
+	70, // 40: api.proto.v1.GetSignalQueueStatusResponse.queues:type_name -> api.proto.v1.SignalQueueStatus
+	71, // 41: api.proto.v1.SignalQueueStatus.dead_letters:type_name -> api.proto.v1.SignalDeadLetter
+	85, // 42: api.proto.v1.SignalDeadLetter.created_at:type_name -> google.protobuf.Timestamp
+	74, // 43: api.proto.v1.GetCommitStatusResponse.settlements:type_name -> api.proto.v1.SettlementCommitStatus
+	4,  // 44: api.proto.v1.SettlementCommitStatus.status:type_name -> api.proto.v1.CommitStatus
+	85, // 45: api.proto.v1.SettlementCommitStatus.updated_at:type_name -> google.protobuf.Timestamp
+	77, // 46: api.proto.v1.GetValidatorParticipationResponse.participations:type_name -> api.proto.v1.ValidatorParticipation
+	86, // 47: api.proto.v1.ValidatorParticipation.median_latency:type_name -> google.protobuf.Duration
+	82, // 48: api.proto.v1.GetEquivocationEvidenceResponse.evidence:type_name -> api.proto.v1.EquivocationEvidence
+	82, // 49: api.proto.v1.ListenEquivocationEvidenceResponse.evidence:type_name -> api.proto.v1.EquivocationEvidence
+	83, // 50: api.proto.v1.EquivocationEvidence.first:type_name -> api.proto.v1.SignedHeaderCommitment
+	83, // 51: api.proto.v1.EquivocationEvidence.second:type_name -> api.proto.v1.SignedHeaderCommitment
+	85, // 52: api.proto.v1.EquivocationEvidence.detected_at:type_name -> google.protobuf.Timestamp
+	66, // 53: api.proto.v1.GetLastAllCommittedResponse.EpochInfosEntry.value:type_name -> api.proto.v1.ChainEpochInfo
+	7,  // 54: api.proto.v1.SymbioticAPIService.SignMessage:input_type -> api.proto.v1.SignMessageRequest
+	9,  // 55: api.proto.v1.SymbioticAPIService.SignMessageBatch:input_type -> api.proto.v1.SignMessageBatchRequest
+	11, // 56: api.proto.v1.SymbioticAPIService.GetBatchedMessageProof:input_type -> api.proto.v1.GetBatchedMessageProofRequest
+	19, // 57: api.proto.v1.SymbioticAPIService.GetAggregationProof:input_type -> api.proto.v1.GetAggregationProofRequest
+	20, // 58: api.proto.v1.SymbioticAPIService.GetAggregationProofsByEpoch:input_type -> api.proto.v1.GetAggregationProofsByEpochRequest
+	21, // 59: api.proto.v1.SymbioticAPIService.GetCurrentEpoch:input_type -> api.proto.v1.GetCurrentEpochRequest
+	22, // 60: api.proto.v1.SymbioticAPIService.GetSignatures:input_type -> api.proto.v1.GetSignaturesRequest
+	23, // 61: api.proto.v1.SymbioticAPIService.GetSignaturesByEpoch:input_type -> api.proto.v1.GetSignaturesByEpochRequest
+	26, // 62: api.proto.v1.SymbioticAPIService.GetSignatureRequestIDsByEpoch:input_type -> api.proto.v1.GetSignatureRequestIDsByEpochRequest
+	28, // 63: api.proto.v1.SymbioticAPIService.GetSignatureRequestsByEpoch:input_type -> api.proto.v1.GetSignatureRequestsByEpochRequest
+	30, // 64: api.proto.v1.SymbioticAPIService.GetSignatureRequest:input_type -> api.proto.v1.GetSignatureRequestRequest
+	31, // 65: api.proto.v1.SymbioticAPIService.CancelSignatureRequest:input_type -> api.proto.v1.CancelSignatureRequestRequest
+	33, // 66: api.proto.v1.SymbioticAPIService.GetAggregationStatus:input_type -> api.proto.v1.GetAggregationStatusRequest
+	34, // 67: api.proto.v1.SymbioticAPIService.GetValidatorSet:input_type -> api.proto.v1.GetValidatorSetRequest
+	35, // 68: api.proto.v1.SymbioticAPIService.GetValidatorByAddress:input_type -> api.proto.v1.GetValidatorByAddressRequest
+	36, // 69: api.proto.v1.SymbioticAPIService.GetValidatorByKey:input_type -> api.proto.v1.GetValidatorByKeyRequest
+	37, // 70: api.proto.v1.SymbioticAPIService.GetLocalValidator:input_type -> api.proto.v1.GetLocalValidatorRequest
+	38, // 71: api.proto.v1.SymbioticAPIService.GetValidatorSetHeader:input_type -> api.proto.v1.GetValidatorSetHeaderRequest
+	62, // 72: api.proto.v1.SymbioticAPIService.GetLastCommitted:input_type -> api.proto.v1.GetLastCommittedRequest
+	64, // 73: api.proto.v1.SymbioticAPIService.GetLastAllCommitted:input_type -> api.proto.v1.GetLastAllCommittedRequest
+	39, // 74: api.proto.v1.SymbioticAPIService.GetValidatorSetMetadata:input_type -> api.proto.v1.GetValidatorSetMetadataRequest
+	5,  // 75: api.proto.v1.SymbioticAPIService.GetCustomScheduleNodeStatus:input_type -> api.proto.v1.GetCustomScheduleNodeStatusRequest
+	68, // 76: api.proto.v1.SymbioticAPIService.GetSignalQueueStatus:input_type -> api.proto.v1.GetSignalQueueStatusRequest
+	72, // 77: api.proto.v1.SymbioticAPIService.GetCommitStatus:input_type -> api.proto.v1.GetCommitStatusRequest
+	75, // 78: api.proto.v1.SymbioticAPIService.GetValidatorParticipation:input_type -> api.proto.v1.GetValidatorParticipationRequest
+	78, // 79: api.proto.v1.SymbioticAPIService.GetEquivocationEvidence:input_type -> api.proto.v1.GetEquivocationEvidenceRequest
+	13, // 80: api.proto.v1.SymbioticAPIService.ListenSignatures:input_type -> api.proto.v1.ListenSignaturesRequest
+	15, // 81: api.proto.v1.SymbioticAPIService.ListenProofs:input_type -> api.proto.v1.ListenProofsRequest
+	17, // 82: api.proto.v1.SymbioticAPIService.ListenValidatorSet:input_type -> api.proto.v1.ListenValidatorSetRequest
+	80, // 83: api.proto.v1.SymbioticAPIService.ListenEquivocationEvidence:input_type -> api.proto.v1.ListenEquivocationEvidenceRequest
+	8,  // 84: api.proto.v1.SymbioticAPIService.SignMessage:output_type -> api.proto.v1.SignMessageResponse
+	10, // 85: api.proto.v1.SymbioticAPIService.SignMessageBatch:output_type -> api.proto.v1.SignMessageBatchResponse
+	12, // 86: api.proto.v1.SymbioticAPIService.GetBatchedMessageProof:output_type -> api.proto.v1.GetBatchedMessageProofResponse
+	47, // 87: api.proto.v1.SymbioticAPIService.GetAggregationProof:output_type -> api.proto.v1.GetAggregationProofResponse
+	48, // 88: api.proto.v1.SymbioticAPIService.GetAggregationProofsByEpoch:output_type -> api.proto.v1.GetAggregationProofsByEpochResponse
+	40, // 89: api.proto.v1.SymbioticAPIService.GetCurrentEpoch:output_type -> api.proto.v1.GetCurrentEpochResponse
+	24, // 90: api.proto.v1.SymbioticAPIService.GetSignatures:output_type -> api.proto.v1.GetSignaturesResponse
+	25, // 91: api.proto.v1.SymbioticAPIService.GetSignaturesByEpoch:output_type -> api.proto.v1.GetSignaturesByEpochResponse
+	27, // 92: api.proto.v1.SymbioticAPIService.GetSignatureRequestIDsByEpoch:output_type -> api.proto.v1.GetSignatureRequestIDsByEpochResponse
+	29, // 93: api.proto.v1.SymbioticAPIService.GetSignatureRequestsByEpoch:output_type -> api.proto.v1.GetSignatureRequestsByEpochResponse
+	46, // 94: api.proto.v1.SymbioticAPIService.GetSignatureRequest:output_type -> api.proto.v1.GetSignatureRequestResponse
+	32, // 95: api.proto.v1.SymbioticAPIService.CancelSignatureRequest:output_type -> api.proto.v1.CancelSignatureRequestResponse
+	50, // 96: api.proto.v1.SymbioticAPIService.GetAggregationStatus:output_type -> api.proto.v1.GetAggregationStatusResponse
+	52, // 97: api.proto.v1.SymbioticAPIService.GetValidatorSet:output_type -> api.proto.v1.GetValidatorSetResponse
+	53, // 98: api.proto.v1.SymbioticAPIService.GetValidatorByAddress:output_type -> api.proto.v1.GetValidatorByAddressResponse
+	54, // 99: api.proto.v1.SymbioticAPIService.GetValidatorByKey:output_type -> api.proto.v1.GetValidatorByKeyResponse
+	55, // 100: api.proto.v1.SymbioticAPIService.GetLocalValidator:output_type -> api.proto.v1.GetLocalValidatorResponse
+	58, // 101: api.proto.v1.SymbioticAPIService.GetValidatorSetHeader:output_type -> api.proto.v1.GetValidatorSetHeaderResponse
+	63, // 102: api.proto.v1.SymbioticAPIService.GetLastCommitted:output_type -> api.proto.v1.GetLastCommittedResponse
+	65, // 103: api.proto.v1.SymbioticAPIService.GetLastAllCommitted:output_type -> api.proto.v1.GetLastAllCommittedResponse
+	57, // 104: api.proto.v1.SymbioticAPIService.GetValidatorSetMetadata:output_type -> api.proto.v1.GetValidatorSetMetadataResponse
+	6,  // 105: api.proto.v1.SymbioticAPIService.GetCustomScheduleNodeStatus:output_type -> api.proto.v1.GetCustomScheduleNodeStatusResponse
+	69, // 106: api.proto.v1.SymbioticAPIService.GetSignalQueueStatus:output_type -> api.proto.v1.GetSignalQueueStatusResponse
+	73, // 107: api.proto.v1.SymbioticAPIService.GetCommitStatus:output_type -> api.proto.v1.GetCommitStatusResponse
+	76, // 108: api.proto.v1.SymbioticAPIService.GetValidatorParticipation:output_type -> api.proto.v1.GetValidatorParticipationResponse
+	79, // 109: api.proto.v1.SymbioticAPIService.GetEquivocationEvidence:output_type -> api.proto.v1.GetEquivocationEvidenceResponse
+	14, // 110: api.proto.v1.SymbioticAPIService.ListenSignatures:output_type -> api.proto.v1.ListenSignaturesResponse
+	16, // 111: api.proto.v1.SymbioticAPIService.ListenProofs:output_type -> api.proto.v1.ListenProofsResponse
+	18, // 112: api.proto.v1.SymbioticAPIService.ListenValidatorSet:output_type -> api.proto.v1.ListenValidatorSetResponse
+	81, // 113: api.proto.v1.SymbioticAPIService.ListenEquivocationEvidence:output_type -> api.proto.v1.ListenEquivocationEvidenceResponse
+	84, // [84:114] is the sub-list for method output_type
+	54, // [54:84] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_v1_api_proto_init() }
@@ -3482,21 +5636,28 @@ func file_v1_api_proto_init() {
 	file_v1_api_proto_msgTypes[0].OneofWrappers = []any{}
 	file_v1_api_proto_msgTypes[2].OneofWrappers = []any{}
 	file_v1_api_proto_msgTypes[4].OneofWrappers = []any{}
-	file_v1_api_proto_msgTypes[6].OneofWrappers = []any{}
 	file_v1_api_proto_msgTypes[8].OneofWrappers = []any{}
-	file_v1_api_proto_msgTypes[23].OneofWrappers = []any{}
-	file_v1_api_proto_msgTypes[24].OneofWrappers = []any{}
-	file_v1_api_proto_msgTypes[25].OneofWrappers = []any{}
-	file_v1_api_proto_msgTypes[26].OneofWrappers = []any{}
-	file_v1_api_proto_msgTypes[27].OneofWrappers = []any{}
-	file_v1_api_proto_msgTypes[28].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[10].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[12].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[29].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[30].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[31].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[32].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[33].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[34].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[36].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[63].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[67].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[70].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[73].OneofWrappers = []any{}
+	file_v1_api_proto_msgTypes[75].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_api_proto_rawDesc), len(file_v1_api_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   54,
+			NumEnums:      5,
+			NumMessages:   80,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
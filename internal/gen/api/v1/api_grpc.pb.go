@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	SymbioticAPIService_SignMessage_FullMethodName                   = "/api.proto.v1.SymbioticAPIService/SignMessage"
+	SymbioticAPIService_SignMessageBatch_FullMethodName              = "/api.proto.v1.SymbioticAPIService/SignMessageBatch"
+	SymbioticAPIService_GetBatchedMessageProof_FullMethodName        = "/api.proto.v1.SymbioticAPIService/GetBatchedMessageProof"
 	SymbioticAPIService_GetAggregationProof_FullMethodName           = "/api.proto.v1.SymbioticAPIService/GetAggregationProof"
 	SymbioticAPIService_GetAggregationProofsByEpoch_FullMethodName   = "/api.proto.v1.SymbioticAPIService/GetAggregationProofsByEpoch"
 	SymbioticAPIService_GetCurrentEpoch_FullMethodName               = "/api.proto.v1.SymbioticAPIService/GetCurrentEpoch"
@@ -28,6 +30,7 @@ const (
 	SymbioticAPIService_GetSignatureRequestIDsByEpoch_FullMethodName = "/api.proto.v1.SymbioticAPIService/GetSignatureRequestIDsByEpoch"
 	SymbioticAPIService_GetSignatureRequestsByEpoch_FullMethodName   = "/api.proto.v1.SymbioticAPIService/GetSignatureRequestsByEpoch"
 	SymbioticAPIService_GetSignatureRequest_FullMethodName           = "/api.proto.v1.SymbioticAPIService/GetSignatureRequest"
+	SymbioticAPIService_CancelSignatureRequest_FullMethodName        = "/api.proto.v1.SymbioticAPIService/CancelSignatureRequest"
 	SymbioticAPIService_GetAggregationStatus_FullMethodName          = "/api.proto.v1.SymbioticAPIService/GetAggregationStatus"
 	SymbioticAPIService_GetValidatorSet_FullMethodName               = "/api.proto.v1.SymbioticAPIService/GetValidatorSet"
 	SymbioticAPIService_GetValidatorByAddress_FullMethodName         = "/api.proto.v1.SymbioticAPIService/GetValidatorByAddress"
@@ -38,9 +41,14 @@ const (
 	SymbioticAPIService_GetLastAllCommitted_FullMethodName           = "/api.proto.v1.SymbioticAPIService/GetLastAllCommitted"
 	SymbioticAPIService_GetValidatorSetMetadata_FullMethodName       = "/api.proto.v1.SymbioticAPIService/GetValidatorSetMetadata"
 	SymbioticAPIService_GetCustomScheduleNodeStatus_FullMethodName   = "/api.proto.v1.SymbioticAPIService/GetCustomScheduleNodeStatus"
+	SymbioticAPIService_GetSignalQueueStatus_FullMethodName          = "/api.proto.v1.SymbioticAPIService/GetSignalQueueStatus"
+	SymbioticAPIService_GetCommitStatus_FullMethodName               = "/api.proto.v1.SymbioticAPIService/GetCommitStatus"
+	SymbioticAPIService_GetValidatorParticipation_FullMethodName     = "/api.proto.v1.SymbioticAPIService/GetValidatorParticipation"
+	SymbioticAPIService_GetEquivocationEvidence_FullMethodName       = "/api.proto.v1.SymbioticAPIService/GetEquivocationEvidence"
 	SymbioticAPIService_ListenSignatures_FullMethodName              = "/api.proto.v1.SymbioticAPIService/ListenSignatures"
 	SymbioticAPIService_ListenProofs_FullMethodName                  = "/api.proto.v1.SymbioticAPIService/ListenProofs"
 	SymbioticAPIService_ListenValidatorSet_FullMethodName            = "/api.proto.v1.SymbioticAPIService/ListenValidatorSet"
+	SymbioticAPIService_ListenEquivocationEvidence_FullMethodName    = "/api.proto.v1.SymbioticAPIService/ListenEquivocationEvidence"
 )
 
 // SymbioticAPIServiceClient is the client API for SymbioticAPIService service.
@@ -51,6 +59,10 @@ const (
 type SymbioticAPIServiceClient interface {
 	// Sign a message
 	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
+	// Sign a batch of messages with a single signature over their Merkle root
+	SignMessageBatch(ctx context.Context, in *SignMessageBatchRequest, opts ...grpc.CallOption) (*SignMessageBatchResponse, error)
+	// Get inclusion proof of a batched message together with the aggregation proof of the batch root
+	GetBatchedMessageProof(ctx context.Context, in *GetBatchedMessageProofRequest, opts ...grpc.CallOption) (*GetBatchedMessageProofResponse, error)
 	// Get aggregation proof
 	GetAggregationProof(ctx context.Context, in *GetAggregationProofRequest, opts ...grpc.CallOption) (*GetAggregationProofResponse, error)
 	// Get aggregation proofs by epoch
@@ -67,6 +79,10 @@ type SymbioticAPIServiceClient interface {
 	GetSignatureRequestsByEpoch(ctx context.Context, in *GetSignatureRequestsByEpochRequest, opts ...grpc.CallOption) (*GetSignatureRequestsByEpochResponse, error)
 	// Get signature request by request id
 	GetSignatureRequest(ctx context.Context, in *GetSignatureRequestRequest, opts ...grpc.CallOption) (*GetSignatureRequestResponse, error)
+	// Cancel a signature request submitted to this relay, the relay stops signing, syncing and aggregating it.
+	// The request is cancelled only on this relay, requests created by the relay itself can not be cancelled.
+	// Cancellation is final, signing the same message for the same epoch again fails with FAILED_PRECONDITION
+	CancelSignatureRequest(ctx context.Context, in *CancelSignatureRequestRequest, opts ...grpc.CallOption) (*CancelSignatureRequestResponse, error)
 	// Get aggregation status, can be sent only to aggregator nodes
 	GetAggregationStatus(ctx context.Context, in *GetAggregationStatusRequest, opts ...grpc.CallOption) (*GetAggregationStatusResponse, error)
 	// Get current validator set
@@ -90,12 +106,26 @@ type SymbioticAPIServiceClient interface {
 	// such as deciding which application instances should commit data on-chain or perform other coordinated actions.
 	// The schedule ensures deterministic but randomized selection of active nodes at any given time.
 	GetCustomScheduleNodeStatus(ctx context.Context, in *GetCustomScheduleNodeStatusRequest, opts ...grpc.CallOption) (*GetCustomScheduleNodeStatusResponse, error)
+	// Get state of the internal signal queues. For durable queues it includes persisted pending events
+	// and the events that exhausted their delivery attempts (dead letters)
+	GetSignalQueueStatus(ctx context.Context, in *GetSignalQueueStatusRequest, opts ...grpc.CallOption) (*GetSignalQueueStatusResponse, error)
+	// Get commit progress of a validator set header for every settlement chain, including the state
+	// tracked by the local committer and the last epoch committed on chain
+	GetCommitStatus(ctx context.Context, in *GetCommitStatusRequest, opts ...grpc.CallOption) (*GetCommitStatusResponse, error)
+	// Get signing participation of validators in an epoch: requests seen, signed and missed and the median
+	// signing latency relative to the first signature of a request, as accounted by this node
+	GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*GetValidatorParticipationResponse, error)
+	// Get evidence of validators that signed conflicting validator set header commitments for the same epoch.
+	// Only header signatures gossiped to this node with their commitment are checked, synced signatures are not
+	GetEquivocationEvidence(ctx context.Context, in *GetEquivocationEvidenceRequest, opts ...grpc.CallOption) (*GetEquivocationEvidenceResponse, error)
 	// Stream signatures in real-time. If start_epoch is provided, sends historical data first
 	ListenSignatures(ctx context.Context, in *ListenSignaturesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListenSignaturesResponse], error)
 	// Stream aggregation proofs in real-time. If start_epoch is provided, sends historical data first
 	ListenProofs(ctx context.Context, in *ListenProofsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListenProofsResponse], error)
 	// Stream validator set changes in real-time. If start_epoch is provided, sends historical data first
 	ListenValidatorSet(ctx context.Context, in *ListenValidatorSetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListenValidatorSetResponse], error)
+	// Stream equivocation evidence in real-time. If start_epoch is provided, sends historical data first
+	ListenEquivocationEvidence(ctx context.Context, in *ListenEquivocationEvidenceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListenEquivocationEvidenceResponse], error)
 }
 
 type symbioticAPIServiceClient struct {
@@ -116,6 +146,26 @@ func (c *symbioticAPIServiceClient) SignMessage(ctx context.Context, in *SignMes
 	return out, nil
 }
 
+func (c *symbioticAPIServiceClient) SignMessageBatch(ctx context.Context, in *SignMessageBatchRequest, opts ...grpc.CallOption) (*SignMessageBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignMessageBatchResponse)
+	err := c.cc.Invoke(ctx, SymbioticAPIService_SignMessageBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbioticAPIServiceClient) GetBatchedMessageProof(ctx context.Context, in *GetBatchedMessageProofRequest, opts ...grpc.CallOption) (*GetBatchedMessageProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBatchedMessageProofResponse)
+	err := c.cc.Invoke(ctx, SymbioticAPIService_GetBatchedMessageProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *symbioticAPIServiceClient) GetAggregationProof(ctx context.Context, in *GetAggregationProofRequest, opts ...grpc.CallOption) (*GetAggregationProofResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAggregationProofResponse)
@@ -196,6 +246,16 @@ func (c *symbioticAPIServiceClient) GetSignatureRequest(ctx context.Context, in
 	return out, nil
 }
 
+func (c *symbioticAPIServiceClient) CancelSignatureRequest(ctx context.Context, in *CancelSignatureRequestRequest, opts ...grpc.CallOption) (*CancelSignatureRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelSignatureRequestResponse)
+	err := c.cc.Invoke(ctx, SymbioticAPIService_CancelSignatureRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *symbioticAPIServiceClient) GetAggregationStatus(ctx context.Context, in *GetAggregationStatusRequest, opts ...grpc.CallOption) (*GetAggregationStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAggregationStatusResponse)
@@ -296,6 +356,46 @@ func (c *symbioticAPIServiceClient) GetCustomScheduleNodeStatus(ctx context.Cont
 	return out, nil
 }
 
+func (c *symbioticAPIServiceClient) GetSignalQueueStatus(ctx context.Context, in *GetSignalQueueStatusRequest, opts ...grpc.CallOption) (*GetSignalQueueStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSignalQueueStatusResponse)
+	err := c.cc.Invoke(ctx, SymbioticAPIService_GetSignalQueueStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbioticAPIServiceClient) GetCommitStatus(ctx context.Context, in *GetCommitStatusRequest, opts ...grpc.CallOption) (*GetCommitStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommitStatusResponse)
+	err := c.cc.Invoke(ctx, SymbioticAPIService_GetCommitStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbioticAPIServiceClient) GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*GetValidatorParticipationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetValidatorParticipationResponse)
+	err := c.cc.Invoke(ctx, SymbioticAPIService_GetValidatorParticipation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbioticAPIServiceClient) GetEquivocationEvidence(ctx context.Context, in *GetEquivocationEvidenceRequest, opts ...grpc.CallOption) (*GetEquivocationEvidenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEquivocationEvidenceResponse)
+	err := c.cc.Invoke(ctx, SymbioticAPIService_GetEquivocationEvidence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *symbioticAPIServiceClient) ListenSignatures(ctx context.Context, in *ListenSignaturesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListenSignaturesResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &SymbioticAPIService_ServiceDesc.Streams[0], SymbioticAPIService_ListenSignatures_FullMethodName, cOpts...)
@@ -353,6 +453,25 @@ func (c *symbioticAPIServiceClient) ListenValidatorSet(ctx context.Context, in *
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type SymbioticAPIService_ListenValidatorSetClient = grpc.ServerStreamingClient[ListenValidatorSetResponse]
 
+func (c *symbioticAPIServiceClient) ListenEquivocationEvidence(ctx context.Context, in *ListenEquivocationEvidenceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListenEquivocationEvidenceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SymbioticAPIService_ServiceDesc.Streams[3], SymbioticAPIService_ListenEquivocationEvidence_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListenEquivocationEvidenceRequest, ListenEquivocationEvidenceResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SymbioticAPIService_ListenEquivocationEvidenceClient = grpc.ServerStreamingClient[ListenEquivocationEvidenceResponse]
+
 // SymbioticAPIServiceServer is the server API for SymbioticAPIService service.
 // All implementations must embed UnimplementedSymbioticAPIServiceServer
 // for forward compatibility.
@@ -361,6 +480,10 @@ type SymbioticAPIService_ListenValidatorSetClient = grpc.ServerStreamingClient[L
 type SymbioticAPIServiceServer interface {
 	// Sign a message
 	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
+	// Sign a batch of messages with a single signature over their Merkle root
+	SignMessageBatch(context.Context, *SignMessageBatchRequest) (*SignMessageBatchResponse, error)
+	// Get inclusion proof of a batched message together with the aggregation proof of the batch root
+	GetBatchedMessageProof(context.Context, *GetBatchedMessageProofRequest) (*GetBatchedMessageProofResponse, error)
 	// Get aggregation proof
 	GetAggregationProof(context.Context, *GetAggregationProofRequest) (*GetAggregationProofResponse, error)
 	// Get aggregation proofs by epoch
@@ -377,6 +500,10 @@ type SymbioticAPIServiceServer interface {
 	GetSignatureRequestsByEpoch(context.Context, *GetSignatureRequestsByEpochRequest) (*GetSignatureRequestsByEpochResponse, error)
 	// Get signature request by request id
 	GetSignatureRequest(context.Context, *GetSignatureRequestRequest) (*GetSignatureRequestResponse, error)
+	// Cancel a signature request submitted to this relay, the relay stops signing, syncing and aggregating it.
+	// The request is cancelled only on this relay, requests created by the relay itself can not be cancelled.
+	// Cancellation is final, signing the same message for the same epoch again fails with FAILED_PRECONDITION
+	CancelSignatureRequest(context.Context, *CancelSignatureRequestRequest) (*CancelSignatureRequestResponse, error)
 	// Get aggregation status, can be sent only to aggregator nodes
 	GetAggregationStatus(context.Context, *GetAggregationStatusRequest) (*GetAggregationStatusResponse, error)
 	// Get current validator set
@@ -400,12 +527,26 @@ type SymbioticAPIServiceServer interface {
 	// such as deciding which application instances should commit data on-chain or perform other coordinated actions.
 	// The schedule ensures deterministic but randomized selection of active nodes at any given time.
 	GetCustomScheduleNodeStatus(context.Context, *GetCustomScheduleNodeStatusRequest) (*GetCustomScheduleNodeStatusResponse, error)
+	// Get state of the internal signal queues. For durable queues it includes persisted pending events
+	// and the events that exhausted their delivery attempts (dead letters)
+	GetSignalQueueStatus(context.Context, *GetSignalQueueStatusRequest) (*GetSignalQueueStatusResponse, error)
+	// Get commit progress of a validator set header for every settlement chain, including the state
+	// tracked by the local committer and the last epoch committed on chain
+	GetCommitStatus(context.Context, *GetCommitStatusRequest) (*GetCommitStatusResponse, error)
+	// Get signing participation of validators in an epoch: requests seen, signed and missed and the median
+	// signing latency relative to the first signature of a request, as accounted by this node
+	GetValidatorParticipation(context.Context, *GetValidatorParticipationRequest) (*GetValidatorParticipationResponse, error)
+	// Get evidence of validators that signed conflicting validator set header commitments for the same epoch.
+	// Only header signatures gossiped to this node with their commitment are checked, synced signatures are not
+	GetEquivocationEvidence(context.Context, *GetEquivocationEvidenceRequest) (*GetEquivocationEvidenceResponse, error)
 	// Stream signatures in real-time. If start_epoch is provided, sends historical data first
 	ListenSignatures(*ListenSignaturesRequest, grpc.ServerStreamingServer[ListenSignaturesResponse]) error
 	// Stream aggregation proofs in real-time. If start_epoch is provided, sends historical data first
 	ListenProofs(*ListenProofsRequest, grpc.ServerStreamingServer[ListenProofsResponse]) error
 	// Stream validator set changes in real-time. If start_epoch is provided, sends historical data first
 	ListenValidatorSet(*ListenValidatorSetRequest, grpc.ServerStreamingServer[ListenValidatorSetResponse]) error
+	// Stream equivocation evidence in real-time. If start_epoch is provided, sends historical data first
+	ListenEquivocationEvidence(*ListenEquivocationEvidenceRequest, grpc.ServerStreamingServer[ListenEquivocationEvidenceResponse]) error
 	mustEmbedUnimplementedSymbioticAPIServiceServer()
 }
 
@@ -419,6 +560,12 @@ type UnimplementedSymbioticAPIServiceServer struct{}
 func (UnimplementedSymbioticAPIServiceServer) SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignMessage not implemented")
 }
+func (UnimplementedSymbioticAPIServiceServer) SignMessageBatch(context.Context, *SignMessageBatchRequest) (*SignMessageBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignMessageBatch not implemented")
+}
+func (UnimplementedSymbioticAPIServiceServer) GetBatchedMessageProof(context.Context, *GetBatchedMessageProofRequest) (*GetBatchedMessageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchedMessageProof not implemented")
+}
 func (UnimplementedSymbioticAPIServiceServer) GetAggregationProof(context.Context, *GetAggregationProofRequest) (*GetAggregationProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAggregationProof not implemented")
 }
@@ -443,6 +590,9 @@ func (UnimplementedSymbioticAPIServiceServer) GetSignatureRequestsByEpoch(contex
 func (UnimplementedSymbioticAPIServiceServer) GetSignatureRequest(context.Context, *GetSignatureRequestRequest) (*GetSignatureRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSignatureRequest not implemented")
 }
+func (UnimplementedSymbioticAPIServiceServer) CancelSignatureRequest(context.Context, *CancelSignatureRequestRequest) (*CancelSignatureRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSignatureRequest not implemented")
+}
 func (UnimplementedSymbioticAPIServiceServer) GetAggregationStatus(context.Context, *GetAggregationStatusRequest) (*GetAggregationStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAggregationStatus not implemented")
 }
@@ -473,6 +623,18 @@ func (UnimplementedSymbioticAPIServiceServer) GetValidatorSetMetadata(context.Co
 func (UnimplementedSymbioticAPIServiceServer) GetCustomScheduleNodeStatus(context.Context, *GetCustomScheduleNodeStatusRequest) (*GetCustomScheduleNodeStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCustomScheduleNodeStatus not implemented")
 }
+func (UnimplementedSymbioticAPIServiceServer) GetSignalQueueStatus(context.Context, *GetSignalQueueStatusRequest) (*GetSignalQueueStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignalQueueStatus not implemented")
+}
+func (UnimplementedSymbioticAPIServiceServer) GetCommitStatus(context.Context, *GetCommitStatusRequest) (*GetCommitStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitStatus not implemented")
+}
+func (UnimplementedSymbioticAPIServiceServer) GetValidatorParticipation(context.Context, *GetValidatorParticipationRequest) (*GetValidatorParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorParticipation not implemented")
+}
+func (UnimplementedSymbioticAPIServiceServer) GetEquivocationEvidence(context.Context, *GetEquivocationEvidenceRequest) (*GetEquivocationEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEquivocationEvidence not implemented")
+}
 func (UnimplementedSymbioticAPIServiceServer) ListenSignatures(*ListenSignaturesRequest, grpc.ServerStreamingServer[ListenSignaturesResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method ListenSignatures not implemented")
 }
@@ -482,6 +644,9 @@ func (UnimplementedSymbioticAPIServiceServer) ListenProofs(*ListenProofsRequest,
 func (UnimplementedSymbioticAPIServiceServer) ListenValidatorSet(*ListenValidatorSetRequest, grpc.ServerStreamingServer[ListenValidatorSetResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method ListenValidatorSet not implemented")
 }
+func (UnimplementedSymbioticAPIServiceServer) ListenEquivocationEvidence(*ListenEquivocationEvidenceRequest, grpc.ServerStreamingServer[ListenEquivocationEvidenceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListenEquivocationEvidence not implemented")
+}
 func (UnimplementedSymbioticAPIServiceServer) mustEmbedUnimplementedSymbioticAPIServiceServer() {}
 func (UnimplementedSymbioticAPIServiceServer) testEmbeddedByValue()                             {}
 
@@ -521,6 +686,42 @@ func _SymbioticAPIService_SignMessage_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SymbioticAPIService_SignMessageBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbioticAPIServiceServer).SignMessageBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbioticAPIService_SignMessageBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbioticAPIServiceServer).SignMessageBatch(ctx, req.(*SignMessageBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbioticAPIService_GetBatchedMessageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchedMessageProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbioticAPIServiceServer).GetBatchedMessageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbioticAPIService_GetBatchedMessageProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbioticAPIServiceServer).GetBatchedMessageProof(ctx, req.(*GetBatchedMessageProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SymbioticAPIService_GetAggregationProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAggregationProofRequest)
 	if err := dec(in); err != nil {
@@ -665,6 +866,24 @@ func _SymbioticAPIService_GetSignatureRequest_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SymbioticAPIService_CancelSignatureRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSignatureRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbioticAPIServiceServer).CancelSignatureRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbioticAPIService_CancelSignatureRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbioticAPIServiceServer).CancelSignatureRequest(ctx, req.(*CancelSignatureRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SymbioticAPIService_GetAggregationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAggregationStatusRequest)
 	if err := dec(in); err != nil {
@@ -845,6 +1064,78 @@ func _SymbioticAPIService_GetCustomScheduleNodeStatus_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SymbioticAPIService_GetSignalQueueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignalQueueStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbioticAPIServiceServer).GetSignalQueueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbioticAPIService_GetSignalQueueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbioticAPIServiceServer).GetSignalQueueStatus(ctx, req.(*GetSignalQueueStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbioticAPIService_GetCommitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbioticAPIServiceServer).GetCommitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbioticAPIService_GetCommitStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbioticAPIServiceServer).GetCommitStatus(ctx, req.(*GetCommitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbioticAPIService_GetValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbioticAPIServiceServer).GetValidatorParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbioticAPIService_GetValidatorParticipation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbioticAPIServiceServer).GetValidatorParticipation(ctx, req.(*GetValidatorParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbioticAPIService_GetEquivocationEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEquivocationEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbioticAPIServiceServer).GetEquivocationEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbioticAPIService_GetEquivocationEvidence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbioticAPIServiceServer).GetEquivocationEvidence(ctx, req.(*GetEquivocationEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SymbioticAPIService_ListenSignatures_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ListenSignaturesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -878,6 +1169,17 @@ func _SymbioticAPIService_ListenValidatorSet_Handler(srv interface{}, stream grp
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type SymbioticAPIService_ListenValidatorSetServer = grpc.ServerStreamingServer[ListenValidatorSetResponse]
 
+func _SymbioticAPIService_ListenEquivocationEvidence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListenEquivocationEvidenceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SymbioticAPIServiceServer).ListenEquivocationEvidence(m, &grpc.GenericServerStream[ListenEquivocationEvidenceRequest, ListenEquivocationEvidenceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SymbioticAPIService_ListenEquivocationEvidenceServer = grpc.ServerStreamingServer[ListenEquivocationEvidenceResponse]
+
 // SymbioticAPIService_ServiceDesc is the grpc.ServiceDesc for SymbioticAPIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -889,6 +1191,14 @@ var SymbioticAPIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignMessage",
 			Handler:    _SymbioticAPIService_SignMessage_Handler,
 		},
+		{
+			MethodName: "SignMessageBatch",
+			Handler:    _SymbioticAPIService_SignMessageBatch_Handler,
+		},
+		{
+			MethodName: "GetBatchedMessageProof",
+			Handler:    _SymbioticAPIService_GetBatchedMessageProof_Handler,
+		},
 		{
 			MethodName: "GetAggregationProof",
 			Handler:    _SymbioticAPIService_GetAggregationProof_Handler,
@@ -921,6 +1231,10 @@ var SymbioticAPIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSignatureRequest",
 			Handler:    _SymbioticAPIService_GetSignatureRequest_Handler,
 		},
+		{
+			MethodName: "CancelSignatureRequest",
+			Handler:    _SymbioticAPIService_CancelSignatureRequest_Handler,
+		},
 		{
 			MethodName: "GetAggregationStatus",
 			Handler:    _SymbioticAPIService_GetAggregationStatus_Handler,
@@ -961,6 +1275,22 @@ var SymbioticAPIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCustomScheduleNodeStatus",
 			Handler:    _SymbioticAPIService_GetCustomScheduleNodeStatus_Handler,
 		},
+		{
+			MethodName: "GetSignalQueueStatus",
+			Handler:    _SymbioticAPIService_GetSignalQueueStatus_Handler,
+		},
+		{
+			MethodName: "GetCommitStatus",
+			Handler:    _SymbioticAPIService_GetCommitStatus_Handler,
+		},
+		{
+			MethodName: "GetValidatorParticipation",
+			Handler:    _SymbioticAPIService_GetValidatorParticipation_Handler,
+		},
+		{
+			MethodName: "GetEquivocationEvidence",
+			Handler:    _SymbioticAPIService_GetEquivocationEvidence_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -978,6 +1308,11 @@ var SymbioticAPIService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SymbioticAPIService_ListenValidatorSet_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListenEquivocationEvidence",
+			Handler:       _SymbioticAPIService_ListenEquivocationEvidence_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "v1/api.proto",
 }
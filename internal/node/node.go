@@ -0,0 +1,704 @@
+package node
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"github.com/go-playground/validator/v10"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/host"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/symbioticfi/relay/internal/client/p2p"
+	"github.com/symbioticfi/relay/internal/client/repository/cached"
+	"github.com/symbioticfi/relay/internal/client/repository/codec"
+	"github.com/symbioticfi/relay/internal/entity"
+	aggregationPolicy "github.com/symbioticfi/relay/internal/usecase/aggregation-policy"
+	aggregatorApp "github.com/symbioticfi/relay/internal/usecase/aggregator-app"
+	api_server "github.com/symbioticfi/relay/internal/usecase/api-server"
+	entity_processor "github.com/symbioticfi/relay/internal/usecase/entity-processor"
+	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
+	"github.com/symbioticfi/relay/internal/usecase/metrics"
+	participationTracker "github.com/symbioticfi/relay/internal/usecase/participation-tracker"
+	"github.com/symbioticfi/relay/internal/usecase/pruner"
+	signatureListener "github.com/symbioticfi/relay/internal/usecase/signature-listener"
+	signerApp "github.com/symbioticfi/relay/internal/usecase/signer-app"
+	sync_provider "github.com/symbioticfi/relay/internal/usecase/sync-provider"
+	sync_runner "github.com/symbioticfi/relay/internal/usecase/sync-runner"
+	valsetListener "github.com/symbioticfi/relay/internal/usecase/valset-listener"
+	valsetStatusTracker "github.com/symbioticfi/relay/internal/usecase/valset-status-tracker"
+	"github.com/symbioticfi/relay/pkg/proof"
+	"github.com/symbioticfi/relay/pkg/signals"
+	"github.com/symbioticfi/relay/pkg/tracing"
+	"github.com/symbioticfi/relay/symbiotic/client/evm"
+	"github.com/symbioticfi/relay/symbiotic/client/votingpower"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/aggregator"
+	valsetDeriver "github.com/symbioticfi/relay/symbiotic/usecase/valset-deriver"
+)
+
+const (
+	defaultPollingInterval       = time.Second * 5
+	defaultParticipationInterval = time.Second * 10
+)
+
+type evmClient interface {
+	evm.IEvmClient
+	GetOperators(ctx context.Context, address symbiotic.CrossChainAddress, timestamp symbiotic.Timestamp) ([]common.Address, error)
+}
+
+// Config holds the dependencies and settings of a relay node.
+// Clients, storage and the libp2p host are created by the caller, so the same node can run
+// against real chains and networks or against a chain stub and an in-memory mesh.
+type Config struct {
+	KeyProvider      *keyprovider.CacheKeyProvider `validate:"required"`
+	EvmClient        evmClient                     `validate:"required"`
+	ExternalVPClient *votingpower.Client
+	Repo             cached.Repository `validate:"required"`
+	Host             host.Host         `validate:"required"`
+	Metrics          *metrics.Metrics  `validate:"required"`
+	Discovery        p2p.DiscoveryConfig
+	// P2PInterceptor, if set, intercepts received gossip messages, see p2p.Config
+	P2PInterceptor func(topic string, msg *pubsub.Message) int
+
+	SignalCfg    signals.Config
+	Cache        cached.Config
+	CircuitsDir  string
+	MaxUnsigners uint64
+	// PollingInterval is the interval of onchain state polling, 5 seconds if zero
+	PollingInterval time.Duration
+	Sync            SyncConfig
+	ForceRole       ForceRole
+	Committer       CommitterConfig
+	Retention       RetentionConfig
+	Pruner          PrunerConfig
+	SignatureBatch  SignatureBatchConfig
+	Participation   ParticipationConfig
+	Aggregator      AggregatorConfig
+	Tracing         tracing.Config
+	API             APIConfig
+	MetricsAPI      MetricsConfig
+	// Priority assigns signature requests to the scheduling classes of signing and aggregation
+	Priority entity.PriorityPolicy
+}
+
+type SyncConfig struct {
+	Enabled      bool
+	Period       time.Duration
+	Timeout      time.Duration
+	EpochsToSync uint64
+}
+
+type ForceRole struct {
+	Aggregator bool
+	Committer  bool
+}
+
+type CommitterConfig struct {
+	TakeoverTimeout  time.Duration
+	CatchUpMaxEpochs uint64
+	Policy           entity.CommitPolicy
+}
+
+type RetentionConfig struct {
+	ValSetEpochs    uint64
+	ProofEpochs     uint64
+	SignatureEpochs uint64
+}
+
+type PrunerConfig struct {
+	Enabled  bool
+	Interval time.Duration
+}
+
+// SignatureBatchConfig configures the micro-batching of received BLS signature verification
+type SignatureBatchConfig struct {
+	Window  time.Duration
+	MaxSize int
+}
+
+// ParticipationConfig configures the accounting of validator participation
+type ParticipationConfig struct {
+	// FlushInterval is how often finished requests are accounted, 10 seconds if zero
+	FlushInterval time.Duration
+}
+
+// AggregatorConfig configures the coordination of the aggregators of a request and the upgrades of its proof
+type AggregatorConfig struct {
+	// BackupDelay is how long each backup aggregator waits after the one before it, zero disables coordination
+	BackupDelay time.Duration
+	// IntentTTL is how long an aggregation intent of another aggregator defers a request at most, zero ignores intents
+	IntentTTL time.Duration
+	// UpgradeWindow is how long after a proof exists later signatures are aggregated into a cheaper one, zero disables upgrades
+	UpgradeWindow time.Duration
+}
+
+type APIConfig struct {
+	ListenAddress     string `validate:"required"`
+	MaxAllowedStreams uint64
+	VerboseLogging    bool
+	HTTPGateway       bool
+}
+
+type MetricsConfig struct {
+	ListenAddress string
+	PprofEnabled  bool
+}
+
+func (c Config) Validate() error {
+	if err := validator.New().Struct(c); err != nil {
+		return errors.Errorf("invalid node config: %w", err)
+	}
+	return nil
+}
+
+// Run starts all services of the relay node and blocks until the context is canceled or a service fails.
+func Run(ctx context.Context, cfg Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pollingInterval := cfg.PollingInterval
+	if pollingInterval == 0 {
+		pollingInterval = defaultPollingInterval
+	}
+	keyProvider := cfg.KeyProvider
+	evmClient := cfg.EvmClient
+	mtr := cfg.Metrics
+
+	deriver, err := valsetDeriver.NewDeriver(evmClient, cfg.ExternalVPClient)
+	if err != nil {
+		return errors.Errorf("failed to create valset deriver: %w", err)
+	}
+
+	repo, err := cached.NewCached(cfg.Repo, cfg.Cache)
+	if err != nil {
+		return errors.Errorf("failed to create cached repository: %w", err)
+	}
+
+	currentOnchainEpoch, err := evmClient.GetCurrentEpoch(ctx)
+	if err != nil {
+		return errors.Errorf("failed to get current epoch: %w", err)
+	}
+
+	captureTimestamp, err := evmClient.GetEpochStart(ctx, currentOnchainEpoch)
+	if err != nil {
+		return errors.Errorf("failed to get capture timestamp: %w", err)
+	}
+
+	config, err := evmClient.GetConfig(ctx, captureTimestamp, currentOnchainEpoch)
+	if err != nil {
+		return errors.Errorf("failed to get config: %w", err)
+	}
+
+	var prover *proof.ZkProver
+	if config.VerificationType == symbiotic.VerificationTypeBlsBn254ZK {
+		prover = proof.NewZkProver(cfg.CircuitsDir)
+	}
+	agg, err := aggregator.NewAggregator(config.VerificationType, prover)
+	if err != nil {
+		return errors.Errorf("failed to create aggregator: %w", err)
+	}
+
+	signatureProcessedSignal := signals.New[symbiotic.Signature](cfg.SignalCfg, "signatureProcessed", nil)
+	aggProofReadySignal := signals.New[symbiotic.AggregationProof](cfg.SignalCfg, "aggProofReady", nil)
+	aggProofUpgradedSignal := signals.New[symbiotic.AggregationProof](cfg.SignalCfg, "aggProofUpgraded", nil)
+	validatorSetSignal := signals.New[symbiotic.ValidatorSet](cfg.SignalCfg, "validatorSet", nil)
+	equivocationSignal := signals.New[symbiotic.EquivocationEvidence](cfg.SignalCfg, "equivocation", nil)
+	if cfg.SignalCfg.Durable {
+		if err := enableDurableSignals(repo, signatureProcessedSignal, aggProofReadySignal, validatorSetSignal); err != nil {
+			return err
+		}
+	}
+
+	entityProcessor, err := entity_processor.NewEntityProcessor(entity_processor.Config{
+		Repo:                     repo,
+		Aggregator:               agg,
+		AggProofSignal:           aggProofReadySignal,
+		AggProofUpgradedSignal:   aggProofUpgradedSignal,
+		SignatureProcessedSignal: signatureProcessedSignal,
+		Metrics:                  mtr,
+		EquivocationSignal:       equivocationSignal,
+		BatchWindow:              cfg.SignatureBatch.Window,
+		MaxBatchSize:             cfg.SignatureBatch.MaxSize,
+		MaxConcurrentSignatures:  cfg.SignalCfg.WorkerCount,
+	})
+	if err != nil {
+		return errors.Errorf("failed to create entity processor: %w", err)
+	}
+	syncProvider, err := sync_provider.New(sync_provider.Config{
+		Repo:                        repo,
+		EntityProcessor:             entityProcessor,
+		EpochsToSync:                cfg.Sync.EpochsToSync,
+		MaxSignatureRequestsPerSync: 1000,
+		MaxResponseSignatureCount:   1000,
+		MaxAggProofRequestsPerSync:  500,
+		MaxResponseAggProofCount:    500,
+	})
+	if err != nil {
+		return errors.Errorf("failed to create syncer: %w", err)
+	}
+
+	signer, err := signerApp.NewSignerApp(signerApp.Config{
+		KeyProvider:     keyProvider,
+		Repo:            repo,
+		EntityProcessor: entityProcessor,
+		Metrics:         mtr,
+		Priority:        cfg.Priority,
+	})
+	if err != nil {
+		return errors.Errorf("failed to create signer app: %w", err)
+	}
+
+	listener, err := valsetListener.New(valsetListener.Config{
+		EvmClient:                evmClient,
+		Repo:                     repo,
+		Deriver:                  deriver,
+		PollingInterval:          pollingInterval,
+		ValidatorSet:             validatorSetSignal,
+		Signer:                   signer,
+		Aggregator:               agg,
+		KeyProvider:              keyProvider,
+		Metrics:                  mtr,
+		ForceCommitter:           cfg.ForceRole.Committer,
+		EpochRetentionCount:      cfg.Retention.ValSetEpochs,
+		CommitterTakeoverTimeout: cfg.Committer.TakeoverTimeout,
+		CatchUpMaxEpochs:         cfg.Committer.CatchUpMaxEpochs,
+		CommitPolicy:             cfg.Committer.Policy,
+	})
+	if err != nil {
+		return errors.Errorf("failed to create epoch listener: %w", err)
+	}
+
+	// Load all missing epochs before starting services
+	if err := listener.LoadAllMissingEpochs(ctx); err != nil {
+		return errors.Errorf("failed to load missing epochs: %w", err)
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	// also start monitoring for new epochs immediately so that we don't miss any epochs while starting other services
+	eg.Go(func() error {
+		err := listener.Start(egCtx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.ErrorContext(ctx, "Valset listener failed", "error", err)
+			return errors.Errorf("failed to start valset listener: %w", err)
+		}
+		slog.InfoContext(ctx, "Valset listener stopped")
+		return nil
+	})
+
+	p2pCfg := p2p.Config{
+		Host:        cfg.Host,
+		Metrics:     mtr,
+		Discovery:   cfg.Discovery,
+		Handler:     p2p.NewP2PHandler(syncProvider),
+		Interceptor: cfg.P2PInterceptor,
+	}
+	p2pService, err := p2p.NewService(ctx, p2pCfg, cfg.SignalCfg)
+	if err != nil {
+		return errors.Errorf("failed to create p2p service: %w", err)
+	}
+	defer p2pService.Close()
+	slog.InfoContext(ctx, "Created p2p service", "listenAddr", cfg.Host.Addrs(), "id", cfg.Host.ID().String())
+
+	discoveryService, err := p2p.NewDiscoveryService(p2pCfg)
+	if err != nil {
+		return errors.Errorf("failed to create discovery service: %w", err)
+	}
+
+	// Initialize tracing with instance ID from P2P service
+	if cfg.Tracing.Enabled {
+		tracingCfg := cfg.Tracing
+		tracingCfg.InstanceID = p2pService.ID() // Use P2P ID as unique instance identifier
+		tracer, err := tracing.New(ctx, tracingCfg)
+		if err != nil {
+			return errors.Errorf("failed to create tracer: %w", err)
+		}
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(ctx, 5*time.Second)
+			defer shutdownCancel()
+			if err := tracer.Shutdown(shutdownCtx); err != nil {
+				slog.ErrorContext(ctx, "Failed to shutdown tracer", "error", err)
+			}
+		}()
+		slog.InfoContext(ctx, "Tracing enabled",
+			"endpoint", tracingCfg.Endpoint,
+			"service", tracing.ServiceName,
+			"instanceId", tracingCfg.InstanceID,
+			"sampleRate", tracingCfg.SampleRate,
+		)
+	}
+
+	eg.Go(func() error {
+		err := signer.HandleSignatureRequests(egCtx, cfg.SignalCfg.WorkerCount, p2pService)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.ErrorContext(ctx, "Signature requests handler failed", "error", err)
+			return errors.Errorf("failed to handle missing self signatures: %w", err)
+		}
+		slog.InfoContext(ctx, "Signature requests handler stopped")
+		return nil
+	})
+
+	syncRunner, err := sync_runner.New(sync_runner.Config{
+		Enabled:     cfg.Sync.Enabled,
+		P2PService:  p2pService,
+		Provider:    syncProvider,
+		SyncPeriod:  cfg.Sync.Period,
+		SyncTimeout: cfg.Sync.Timeout,
+		Metrics:     mtr,
+	})
+	if err != nil {
+		return errors.Errorf("failed to create sync runner: %w", err)
+	}
+
+	prunerService, err := pruner.New(pruner.Config{
+		Repo:                     repo,
+		Metrics:                  mtr,
+		Enabled:                  cfg.Pruner.Enabled,
+		Interval:                 cfg.Pruner.Interval,
+		ValsetRetentionEpochs:    cfg.Retention.ValSetEpochs,
+		ProofRetentionEpochs:     cfg.Retention.ProofEpochs,
+		SignatureRetentionEpochs: cfg.Retention.SignatureEpochs,
+	})
+	if err != nil {
+		return errors.Errorf("failed to create pruner: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Created discovery service", "listenAddr", cfg.Host.Addrs())
+	if err := discoveryService.Start(ctx); err != nil {
+		return errors.Errorf("failed to start discovery service: %w", err)
+	}
+	defer discoveryService.Close(ctx)
+
+	slog.InfoContext(ctx, "Started discovery service", "listenAddr", cfg.Host.Addrs())
+
+	if err := p2pService.StartSignaturesAggregatedMessageListener(signer.HandleSignaturesAggregatedMessage); err != nil {
+		return errors.Errorf("failed to start signatures aggregated message listener: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Created signer app, starting")
+
+	statusTracker, err := valsetStatusTracker.New(valsetStatusTracker.Config{
+		EvmClient:            evmClient,
+		Repo:                 repo,
+		PollingInterval:      pollingInterval,
+		EpochPollingInterval: time.Minute,
+		Metrics:              mtr,
+	})
+	if err != nil {
+		return errors.Errorf("failed to create valset status tracker: %w", err)
+	}
+
+	if err := statusTracker.TrackMissingEpochsStatuses(ctx); err != nil {
+		return errors.Errorf("failed to track missing epochs statuses: %w", err)
+	}
+
+	signListener, err := signatureListener.New(signatureListener.Config{
+		Repo:            repo,
+		EntityProcessor: entityProcessor,
+		SignalCfg:       cfg.SignalCfg,
+		SelfP2PID:       p2pService.ID(),
+	})
+	if err != nil {
+		return errors.Errorf("failed to create signature listener: %w", err)
+	}
+
+	if err := p2pService.StartSignatureMessageListener(signListener.HandleSignatureReceivedMessage); err != nil {
+		return errors.Errorf("failed to start signature message listener: %w", err)
+	}
+
+	if err := p2pService.StartCommitIntentMessageListener(listener.HandleCommitIntentMessage); err != nil {
+		return errors.Errorf("failed to start commit intent message listener: %w", err)
+	}
+
+	eg.Go(func() error {
+		err := statusTracker.Start(egCtx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.ErrorContext(ctx, "Valset status tracker failed", "error", err)
+			return errors.Errorf("failed to start valset status tracker: %w", err)
+		}
+		slog.InfoContext(ctx, "Valset status tracker stopped")
+		return nil
+	})
+
+	eg.Go(func() error {
+		err := listener.StartCommitterLoop(egCtx, p2pService, syncRunner)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.ErrorContext(ctx, "Valset listener committer loop failed", "error", err)
+			return errors.Errorf("failed to start committer loop: %w", err)
+		}
+		slog.InfoContext(ctx, "Valset listener committer loop stopped")
+		return nil
+	})
+
+	aggPolicyType := symbiotic.AggregationPolicyLowLatency
+	if config.VerificationType == symbiotic.VerificationTypeBlsBn254Simple {
+		aggPolicyType = symbiotic.AggregationPolicyLowCost
+	}
+	aggPolicy, err := aggregationPolicy.NewAggregationPolicy(aggPolicyType, cfg.MaxUnsigners)
+	if err != nil {
+		return errors.Errorf("failed to create aggregator policy: %w", err)
+	}
+
+	var aggApp *aggregatorApp.AggregatorApp
+	aggApp, err = aggregatorApp.NewAggregatorApp(aggregatorApp.Config{
+		Repo:              repo,
+		P2PClient:         p2pService,
+		Aggregator:        agg,
+		Metrics:           mtr,
+		AggregationPolicy: aggPolicy,
+		KeyProvider:       keyProvider,
+		ForceAggregator:   cfg.ForceRole.Aggregator,
+		Priority:          cfg.Priority,
+		BackupDelay:       cfg.Aggregator.BackupDelay,
+		IntentTTL:         cfg.Aggregator.IntentTTL,
+		UpgradeWindow:     cfg.Aggregator.UpgradeWindow,
+	})
+	if err != nil {
+		return errors.Errorf("failed to create aggregator app: %w", err)
+	}
+
+	if err := p2pService.StartAggregationIntentMessageListener(aggApp.HandleAggregationIntentMessage); err != nil {
+		return errors.Errorf("failed to start aggregation intent message listener: %w", err)
+	}
+
+	participationInterval := cfg.Participation.FlushInterval
+	if participationInterval == 0 {
+		participationInterval = defaultParticipationInterval
+	}
+	participation, err := participationTracker.New(participationTracker.Config{
+		Repo:          repo,
+		KeyProvider:   keyProvider,
+		Metrics:       mtr,
+		FlushInterval: participationInterval,
+	})
+	if err != nil {
+		return errors.Errorf("failed to create participation tracker: %w", err)
+	}
+
+	serveMetricsOnAPIAddress := cfg.API.ListenAddress == cfg.MetricsAPI.ListenAddress || cfg.MetricsAPI.ListenAddress == ""
+
+	api, err := api_server.NewSymbioticServer(ctx, api_server.Config{
+		Address:                cfg.API.ListenAddress,
+		ShutdownTimeout:        time.Second * 5,
+		ReadHeaderTimeout:      time.Second,
+		Signer:                 signer,
+		Repo:                   repo,
+		EvmClient:              evmClient,
+		KeyProvider:            keyProvider,
+		Aggregator:             aggApp,
+		Deriver:                deriver,
+		Metrics:                mtr,
+		ServeMetrics:           serveMetricsOnAPIAddress,
+		ServePprof:             cfg.MetricsAPI.PprofEnabled,
+		ServeHTTPGateway:       cfg.API.HTTPGateway,
+		VerboseLogging:         cfg.API.VerboseLogging,
+		MaxAllowedStreamsCount: int(cfg.API.MaxAllowedStreams),
+		Priority:               cfg.Priority,
+		SignalQueues: []api_server.SignalQueue{
+			signatureProcessedSignal,
+			aggProofReadySignal,
+			aggProofUpgradedSignal,
+			validatorSetSignal,
+			equivocationSignal,
+		},
+	})
+	if err != nil {
+		return errors.Errorf("failed to create api app: %w", err)
+	}
+
+	if err := validatorSetSignal.SetHandlers(api.HandleValidatorSet()); err != nil {
+		return errors.Errorf("failed to set validator set set message handler: %w", err)
+	}
+	if err := validatorSetSignal.StartWorkers(ctx); err != nil {
+		return errors.Errorf("failed to start validator set set signal workers: %w", err)
+	}
+
+	if err := signatureProcessedSignal.SetHandlers(
+		aggApp.HandleSignatureProcessedMessage,
+		api.HandleSignatureProcessed(),
+		participation.HandleSignatureProcessed,
+	); err != nil {
+		return errors.Errorf("failed to set signature received message handler: %w", err)
+	}
+	if err := signatureProcessedSignal.StartWorkers(ctx); err != nil {
+		return errors.Errorf("failed to start signature received signal workers: %w", err)
+	}
+
+	err = aggProofReadySignal.SetHandlers(
+		api.HandleProofAggregated(),
+		participation.HandleProofAggregated,
+	)
+	if err != nil {
+		return errors.Errorf("failed to set agg proof ready signal handler: %w", err)
+	}
+	if err := aggProofReadySignal.StartWorkers(ctx); err != nil {
+		return errors.Errorf("failed to start agg proof ready signal workers: %w", err)
+	}
+
+	if err := aggProofUpgradedSignal.SetHandlers(api.HandleProofUpgraded()); err != nil {
+		return errors.Errorf("failed to set agg proof upgraded signal handler: %w", err)
+	}
+	if err := aggProofUpgradedSignal.StartWorkers(ctx); err != nil {
+		return errors.Errorf("failed to start agg proof upgraded signal workers: %w", err)
+	}
+
+	if err := equivocationSignal.SetHandlers(api.HandleEquivocationEvidence()); err != nil {
+		return errors.Errorf("failed to set equivocation signal handler: %w", err)
+	}
+	if err := equivocationSignal.StartWorkers(ctx); err != nil {
+		return errors.Errorf("failed to start equivocation signal workers: %w", err)
+	}
+
+	slog.DebugContext(ctx, "Created aggregator app, starting")
+
+	eg.Go(func() error {
+		err := api.Start(egCtx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.ErrorContext(ctx, "API server failed", "error", err)
+			return errors.Errorf("failed to start api server: %w", err)
+		}
+		slog.InfoContext(ctx, "API server stopped")
+		return nil
+	})
+
+	eg.Go(func() error {
+		err := syncRunner.Start(egCtx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.ErrorContext(ctx, "Sync runner failed", "error", err)
+			return errors.Errorf("failed to start sync runner: %w", err)
+		}
+		slog.InfoContext(ctx, "Sync finished stopped")
+		return nil
+	})
+
+	eg.Go(func() error {
+		err := statusTracker.RunEpochTracker(egCtx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.ErrorContext(ctx, "Epoch tracker failed", "error", err)
+			return errors.Errorf("failed to start epoch tracker: %w", err)
+		}
+		slog.InfoContext(ctx, "Epoch tracker stopped")
+		return nil
+	})
+
+	eg.Go(func() error {
+		return aggApp.TryAggregateRequestsWithoutProof(ctx)
+	})
+
+	eg.Go(func() error {
+		err := aggApp.Start(egCtx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.ErrorContext(ctx, "Aggregator failed", "error", err)
+			return errors.Errorf("failed to start aggregator: %w", err)
+		}
+		slog.InfoContext(ctx, "Aggregator stopped")
+		return nil
+	})
+
+	eg.Go(func() error {
+		err := participation.Start(egCtx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.ErrorContext(ctx, "Participation tracker failed", "error", err)
+			return errors.Errorf("failed to start participation tracker: %w", err)
+		}
+		slog.InfoContext(ctx, "Participation tracker stopped")
+		return nil
+	})
+
+	eg.Go(func() error {
+		prunerService.Start(egCtx)
+		slog.InfoContext(ctx, "Pruner stopped")
+		return nil
+	})
+
+	eg.Go(func() error {
+		keyProvider.WatchKeys(egCtx, pollingInterval)
+		return nil
+	})
+
+	eg.Go(func() error {
+		err := p2pService.StartGRPCServer(egCtx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.ErrorContext(ctx, "P2P grpc server failed", "error", err)
+			return errors.Errorf("failed to start p2p grpc server: %w", err)
+		}
+		slog.InfoContext(ctx, "P2P grpc server stopped")
+		return nil
+	})
+
+	if !serveMetricsOnAPIAddress {
+		mtrApp, err := metrics.NewApp(metrics.AppConfig{
+			Address:           cfg.MetricsAPI.ListenAddress,
+			ReadHeaderTimeout: time.Second * 5,
+		})
+		if err != nil {
+			return errors.Errorf("failed to create metrics app: %w", err)
+		}
+
+		slog.DebugContext(ctx, "Created metrics app, starting")
+		eg.Go(func() error {
+			err := mtrApp.Start(egCtx)
+			if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, http.ErrServerClosed) {
+				slog.ErrorContext(ctx, "Metrics server failed", "error", err)
+				return errors.Errorf("failed to start metrics server: %w", err)
+			}
+			slog.InfoContext(ctx, "Metrics server stopped")
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+// enableDurableSignals persists events of the signal pipeline in the repository so that
+// events queued at shutdown or crash time are redelivered after restart.
+func enableDurableSignals(
+	repo *cached.CachedRepository,
+	signatureProcessed *signals.Signal[symbiotic.Signature],
+	aggProofReady *signals.Signal[symbiotic.AggregationProof],
+	validatorSet *signals.Signal[symbiotic.ValidatorSet],
+) error {
+	if err := signatureProcessed.SetStore(repo, signals.Codec[symbiotic.Signature]{
+		Marshal: codec.SignatureToBytes,
+		Unmarshal: func(_ context.Context, data []byte) (symbiotic.Signature, error) {
+			return codec.BytesToSignature(data)
+		},
+	}); err != nil {
+		return errors.Errorf("failed to set signature processed signal store: %w", err)
+	}
+
+	if err := aggProofReady.SetStore(repo, signals.Codec[symbiotic.AggregationProof]{
+		Marshal: codec.AggregationProofToBytes,
+		Unmarshal: func(_ context.Context, data []byte) (symbiotic.AggregationProof, error) {
+			return codec.BytesToAggregationProof(data)
+		},
+	}); err != nil {
+		return errors.Errorf("failed to set agg proof ready signal store: %w", err)
+	}
+
+	// validator sets are already persisted by the listener, so only the epoch is stored
+	if err := validatorSet.SetStore(repo, signals.Codec[symbiotic.ValidatorSet]{
+		Marshal: func(valset symbiotic.ValidatorSet) ([]byte, error) {
+			return valset.Epoch.Bytes(), nil
+		},
+		Unmarshal: func(ctx context.Context, data []byte) (symbiotic.ValidatorSet, error) {
+			epoch, err := symbiotic.EpochFromBytes(data)
+			if err != nil {
+				return symbiotic.ValidatorSet{}, errors.Errorf("failed to decode epoch: %w", err)
+			}
+			return repo.GetValidatorSetByEpoch(ctx, epoch)
+		},
+	}); err != nil {
+		return errors.Errorf("failed to set validator set signal store: %w", err)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/symbioticfi/relay/internal/entity"
 	aggregationPolicyTypes "github.com/symbioticfi/relay/internal/usecase/aggregation-policy/types"
+	"github.com/symbioticfi/relay/pkg/fairqueue"
 	"github.com/symbioticfi/relay/pkg/log"
 	"github.com/symbioticfi/relay/pkg/tracing"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
@@ -21,6 +22,7 @@ import (
 type repository interface {
 	GetValidatorSetByEpoch(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.ValidatorSet, error)
 	GetAggregationProof(ctx context.Context, requestID common.Hash) (symbiotic.AggregationProof, error)
+	GetAggregationProofCreatedAt(ctx context.Context, requestID common.Hash) (time.Time, error)
 	GetSignatureRequest(_ context.Context, requestID common.Hash) (symbiotic.SignatureRequest, error)
 	GetAllSignatures(ctx context.Context, requestID common.Hash) ([]symbiotic.Signature, error)
 	GetConfigByEpoch(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.NetworkConfig, error)
@@ -32,11 +34,14 @@ type repository interface {
 
 type p2pClient interface {
 	BroadcastSignatureAggregatedMessage(ctx context.Context, proof symbiotic.AggregationProof) error
+	BroadcastAggregationIntentMessage(ctx context.Context, msg entity.AggregationIntent) error
 }
 
 type metrics interface {
 	ObserveOnlyAggregateDuration(d time.Duration)
 	ObserveAppAggregateDuration(d time.Duration)
+	SetQueueDepth(queue, class string, depth int)
+	ObserveQueueWait(queue, class string, d time.Duration)
 }
 
 type aggregator interface {
@@ -44,8 +49,8 @@ type aggregator interface {
 }
 
 type keyProvider interface {
-	GetPrivateKey(keyTag symbiotic.KeyTag) (crypto.PrivateKey, error)
-	GetOnchainKeyFromCache(keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, error)
+	GetOnchainKeyForValset(valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, error)
+	GetPrivateKeyForValset(valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag) (crypto.PrivateKey, error)
 }
 
 type aggregatorPolicy = aggregationPolicyTypes.AggregationPolicy
@@ -58,18 +63,34 @@ type Config struct {
 	AggregationPolicy aggregatorPolicy `validate:"required"`
 	KeyProvider       keyProvider      `validate:"required"`
 	ForceAggregator   bool
+	Priority          entity.PriorityPolicy
+	// BackupDelay is how long each backup aggregator of a request waits after the one before it in the aggregator
+	// order before aggregating itself, zero disables coordination and every aggregator aggregates immediately
+	BackupDelay time.Duration `validate:"gte=0"`
+	// IntentTTL is how long an aggregation intent of another aggregator defers a request at most, it covers the time
+	// the announcing aggregator takes to aggregate. Aggregators after the announcing one in the order stop deferring
+	// once their backup delay behind it passed, zero ignores intents.
+	IntentTTL time.Duration `validate:"gte=0"`
+	// UpgradeWindow is how long after the first proof of a request was stored signatures that arrive later are
+	// aggregated into a cheaper proof of it, zero disables upgrades. Only blsBn254Simple proofs shrink with more signers.
+	UpgradeWindow time.Duration `validate:"gte=0"`
 }
 
 func (c Config) Validate() error {
 	if err := validate.New().Struct(c); err != nil {
 		return errors.Errorf("failed to validate config: %w", err)
 	}
+	if err := c.Priority.Validate(); err != nil {
+		return errors.Errorf("failed to validate priority policy: %w", err)
+	}
 
 	return nil
 }
 
 type AggregatorApp struct {
-	cfg Config
+	cfg         Config
+	coordinator *aggregationCoordinator
+	upgrades    *proofUpgrades
 }
 
 func NewAggregatorApp(cfg Config) (*AggregatorApp, error) {
@@ -78,7 +99,9 @@ func NewAggregatorApp(cfg Config) (*AggregatorApp, error) {
 	}
 
 	app := &AggregatorApp{
-		cfg: cfg,
+		cfg:         cfg,
+		coordinator: newAggregationCoordinator(),
+		upgrades:    newProofUpgrades(),
 	}
 
 	return app, nil
@@ -122,14 +145,36 @@ func (s *AggregatorApp) TryAggregateProofForRequestID(ctx context.Context, reque
 		slog.String("requestId", requestID.Hex()),
 	)
 
-	_, err := s.cfg.Repo.GetAggregationProof(ctx, requestID)
+	existingProof, err := s.cfg.Repo.GetAggregationProof(ctx, requestID)
 	if err != nil && !errors.Is(err, entity.ErrEntityNotFound) {
 		tracing.RecordError(span, err)
 		return errors.Errorf("failed to get aggregation proof: %w", err)
 	}
-	if err == nil {
-		tracing.AddEvent(span, "proof_already_exists")
-		slog.DebugContext(ctx, "Skipped aggregation, proof already exists")
+	upgrade := err == nil
+	if upgrade {
+		s.coordinator.forget(requestID)
+		open, err := s.upgradeOpen(ctx, requestID)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		if !open {
+			tracing.AddEvent(span, "proof_already_exists")
+			slog.DebugContext(ctx, "Skipped aggregation, proof already exists")
+			return nil
+		}
+	}
+
+	// requests are local to relays, signatures of requests unknown to this relay are still aggregated
+	signatureRequest, err := s.cfg.Repo.GetSignatureRequest(ctx, requestID)
+	if err != nil && !errors.Is(err, entity.ErrEntityNotFound) {
+		tracing.RecordError(span, err)
+		return errors.Errorf("failed to get signature request: %w", err)
+	}
+	if err == nil && !signatureRequest.Active(time.Now()) {
+		s.coordinator.forget(requestID)
+		tracing.AddEvent(span, "request_inactive")
+		slog.DebugContext(ctx, "Skipped aggregation, request is cancelled or expired", "cancelled", signatureRequest.Cancelled, "deadline", signatureRequest.Deadline)
 		return nil
 	}
 
@@ -151,10 +196,11 @@ func (s *AggregatorApp) TryAggregateProofForRequestID(ctx context.Context, reque
 
 	tracing.SetAttributes(span, tracing.AttrValidatorCount.Int(len(validatorSet.Validators)))
 
+	var onchainKey symbiotic.CompactPublicKey
 	if s.cfg.ForceAggregator {
 		slog.DebugContext(ctx, "Force aggregator mode enabled")
 	} else {
-		onchainKey, err := s.cfg.KeyProvider.GetOnchainKeyFromCache(validatorSet.RequiredKeyTag)
+		onchainKey, err = s.cfg.KeyProvider.GetOnchainKeyForValset(validatorSet, validatorSet.RequiredKeyTag)
 		if err != nil {
 			if errors.Is(err, entity.ErrKeyNotFound) {
 				tracing.AddEvent(span, "skipped_not_key_not_found")
@@ -193,6 +239,12 @@ func (s *AggregatorApp) TryAggregateProofForRequestID(ctx context.Context, reque
 		return nil
 	}
 
+	if upgrade && !s.upgrades.attempt(requestID, signatureMap.CurrentVotingPower) {
+		tracing.AddEvent(span, "proof_already_exists")
+		slog.DebugContext(ctx, "Skipped proof upgrade, no signatures since the last aggregation")
+		return nil
+	}
+
 	tracing.AddEvent(span, "quorum_reached")
 	tracing.SetAttributes(span,
 		tracing.AttrQuorumThreshold.Int(int(validatorSet.QuorumThreshold.Uint64())),
@@ -203,15 +255,18 @@ func (s *AggregatorApp) TryAggregateProofForRequestID(ctx context.Context, reque
 		"totalActiveVotingPower", totalActiveVotingPower.String(),
 	)
 
-	appAggregationStart := time.Now()
+	// upgrades are not coordinated, aggregators that upgrade to the same proof only duplicate gossip
+	if !upgrade {
+		if wait := s.coordinator.wait(requestID, s.backupWait(requestID, validatorSet, onchainKey), time.Now()); wait > 0 {
+			tracing.AddEvent(span, "deferred_to_other_aggregator")
+			slog.DebugContext(ctx, "Deferred aggregation to other aggregators of the request", "wait", wait.String())
+			return nil
+		}
 
-	sigs, err := s.cfg.Repo.GetAllSignatures(ctx, requestID)
-	if err != nil {
-		tracing.RecordError(span, err)
-		return errors.Errorf("failed to get signature aggregated message: %w", err)
+		s.broadcastAggregationIntent(ctx, requestID, validatorSet)
 	}
-	tracing.SetAttributes(span, tracing.AttrSignatureCount.Int(len(sigs)))
-	slog.DebugContext(ctx, "Loaded signatures for aggregation", "count", len(sigs))
+
+	appAggregationStart := time.Now()
 
 	networkConfig, err := s.cfg.Repo.GetConfigByEpoch(ctx, signatureMap.Epoch)
 	if err != nil {
@@ -221,6 +276,22 @@ func (s *AggregatorApp) TryAggregateProofForRequestID(ctx context.Context, reque
 
 	slog.DebugContext(ctx, "Loaded network config", "networkConfig", networkConfig)
 
+	if upgrade && networkConfig.VerificationType != symbiotic.VerificationTypeBlsBn254Simple {
+		tracing.AddEvent(span, "proof_already_exists")
+		slog.DebugContext(ctx, "Skipped proof upgrade, proofs of the verification type have a constant size",
+			"verificationType", networkConfig.VerificationType,
+		)
+		return nil
+	}
+
+	sigs, err := s.cfg.Repo.GetAllSignatures(ctx, requestID)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return errors.Errorf("failed to get signature aggregated message: %w", err)
+	}
+	tracing.SetAttributes(span, tracing.AttrSignatureCount.Int(len(sigs)))
+	slog.DebugContext(ctx, "Loaded signatures for aggregation", "count", len(sigs))
+
 	onlyAggregateStart := time.Now()
 	proofData, err := s.cfg.Aggregator.Aggregate(ctx, validatorSet, sigs)
 	if err != nil {
@@ -229,10 +300,20 @@ func (s *AggregatorApp) TryAggregateProofForRequestID(ctx context.Context, reque
 	}
 	s.cfg.Metrics.ObserveOnlyAggregateDuration(time.Since(onlyAggregateStart))
 
+	if upgrade && !proofData.Improves(existingProof) {
+		tracing.AddEvent(span, "proof_not_improved")
+		slog.DebugContext(ctx, "Skipped proof upgrade, aggregated proof is not cheaper than the existing one",
+			"proofSize", len(proofData.Proof),
+			"existingProofSize", len(existingProof.Proof),
+		)
+		return nil
+	}
+
 	tracing.AddEvent(span, "proof_created")
 	tracing.SetAttributes(span, tracing.AttrProofSize.Int(len(proofData.Proof)))
 	slog.InfoContext(ctx, "Aggregation proof created",
 		"duration", time.Since(appAggregationStart).String(),
+		"upgrade", upgrade,
 	)
 
 	err = s.cfg.P2PClient.BroadcastSignatureAggregatedMessage(ctx, proofData)
@@ -241,6 +322,7 @@ func (s *AggregatorApp) TryAggregateProofForRequestID(ctx context.Context, reque
 		return errors.Errorf("failed to broadcast signature aggregated message: %w", err)
 	}
 	s.cfg.Metrics.ObserveAppAggregateDuration(time.Since(appAggregationStart))
+	s.coordinator.forget(requestID)
 
 	tracing.AddEvent(span, "aggregation_completed")
 	slog.InfoContext(ctx, "Aggregation completed, proof broadcast via p2p",
@@ -270,6 +352,13 @@ func (s *AggregatorApp) TryAggregateRequestsWithoutProof(ctx context.Context) er
 		return nil
 	}
 
+	// requests of all epochs are collected first so that header and high priority requests are
+	// aggregated before the backlog of application requests instead of in repository order
+	queue, err := fairqueue.New[common.Hash](s.cfg.Priority.QueueConfig("aggregator", s.cfg.Metrics))
+	if err != nil {
+		return errors.Errorf("failed to create aggregation queue: %w", err)
+	}
+
 	for epoch := latestEpoch; ; epoch-- {
 		var lastHash common.Hash
 		for {
@@ -286,11 +375,7 @@ func (s *AggregatorApp) TryAggregateRequestsWithoutProof(ctx context.Context) er
 				if !req.KeyTag.Type().AggregationKey() {
 					continue // Skip non-aggregation requests
 				}
-
-				err := s.TryAggregateProofForRequestID(ctx, req.RequestID)
-				if err != nil {
-					return errors.Errorf("failed to try aggregate proof for request ID %s: %w", req.RequestID.Hex(), err)
-				}
+				queue.Add(req.RequestID, s.cfg.Priority.Class(req.SignatureRequest).String())
 			}
 
 			lastHash = requests[len(requests)-1].RequestID
@@ -301,7 +386,17 @@ func (s *AggregatorApp) TryAggregateRequestsWithoutProof(ctx context.Context) er
 		}
 	}
 
-	return nil
+	for {
+		requestID, ok := queue.TryGet()
+		if !ok {
+			return nil
+		}
+		err := s.TryAggregateProofForRequestID(ctx, requestID)
+		queue.Done(requestID)
+		if err != nil {
+			return errors.Errorf("failed to try aggregate proof for request ID %s: %w", requestID.Hex(), err)
+		}
+	}
 }
 
 func (s *AggregatorApp) GetAggregationStatus(ctx context.Context, requestID common.Hash) (symbiotic.AggregationStatus, error) {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/samber/lo"
@@ -56,6 +57,7 @@ func newTestSetup(t *testing.T, policyType symbiotic.AggregationPolicyType, maxU
 		Metrics:           mockMetrics,
 		AggregationPolicy: aggPolicy,
 		KeyProvider:       keyprovider.NewCacheKeyProvider(kp),
+		IntentTTL:         5 * time.Minute,
 	}
 
 	app, err := NewAggregatorApp(cfg)
@@ -171,6 +173,7 @@ func setupSuccessfulAggregationMocks(setup *testSetup, msg symbiotic.Signature,
 	setup.mockRepo.EXPECT().GetAllSignatures(gomock.Any(), msg.RequestID()).Return(signatures, nil)
 	setup.mockRepo.EXPECT().GetConfigByEpoch(gomock.Any(), msg.Epoch).Return(networkConfig, nil)
 	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), msg.RequestID()).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	setup.mockRepo.EXPECT().GetSignatureRequest(gomock.Any(), msg.RequestID()).Return(symbiotic.SignatureRequest{KeyTag: msg.KeyTag, RequiredEpoch: msg.Epoch}, nil)
 
 	setup.mockAggregator.EXPECT().Aggregate(gomock.Any(), gomock.Any(), gomock.Any()).Return(proofData, nil)
 
@@ -192,6 +195,7 @@ func TestHandleSignatureGeneratedMessage_LowLatencyPolicy_QuorumNotReached(t *te
 	setup.mockRepo.EXPECT().GetSignatureMap(gomock.Any(), msg.RequestID()).Return(testingData.SignatureMap, nil)
 	setup.mockRepo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), msg.Epoch).Return(testingData.ValidatorSet, nil)
 	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), msg.RequestID()).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	setup.mockRepo.EXPECT().GetSignatureRequest(gomock.Any(), msg.RequestID()).Return(symbiotic.SignatureRequest{KeyTag: msg.KeyTag, RequiredEpoch: msg.Epoch}, nil)
 
 	// Execute
 	err := setup.app.HandleSignatureProcessedMessage(t.Context(), msg)
@@ -217,6 +221,24 @@ func TestHandleSignatureGeneratedMessage_LowLatencyPolicy_QuorumReached(t *testi
 	require.NoError(t, err)
 }
 
+func TestHandleSignatureGeneratedMessage_InactiveRequest_SkipsAggregation(t *testing.T) {
+	for name, req := range map[string]symbiotic.SignatureRequest{
+		"cancelled": {Cancelled: true},
+		"expired":   {Deadline: time.Now().Add(-time.Second)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			setup := newTestSetup(t, symbiotic.AggregationPolicyLowLatency, 0)
+			msg := createTestSignatureExtended(t, setup.privateKey)
+
+			// quorum is not checked and nothing is aggregated or broadcast
+			setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), msg.RequestID()).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+			setup.mockRepo.EXPECT().GetSignatureRequest(gomock.Any(), msg.RequestID()).Return(req, nil)
+
+			require.NoError(t, setup.app.HandleSignatureProcessedMessage(t.Context(), msg))
+		})
+	}
+}
+
 // LOW COST POLICY TESTS
 
 func TestHandleSignatureGeneratedMessage_LowCostPolicy_QuorumNotReached(t *testing.T) {
@@ -230,6 +252,7 @@ func TestHandleSignatureGeneratedMessage_LowCostPolicy_QuorumNotReached(t *testi
 	setup.mockRepo.EXPECT().GetSignatureMap(gomock.Any(), msg.RequestID()).Return(testingData.SignatureMap, nil)
 	setup.mockRepo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), msg.Epoch).Return(testingData.ValidatorSet, nil)
 	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), msg.RequestID()).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	setup.mockRepo.EXPECT().GetSignatureRequest(gomock.Any(), msg.RequestID()).Return(symbiotic.SignatureRequest{KeyTag: msg.KeyTag, RequiredEpoch: msg.Epoch}, nil)
 
 	// Execute
 	err := setup.app.HandleSignatureProcessedMessage(ctx, msg)
@@ -249,6 +272,7 @@ func TestHandleSignatureGeneratedMessage_LowCostPolicy_QuorumReached_TooManyUnsi
 	setup.mockRepo.EXPECT().GetSignatureMap(gomock.Any(), msg.RequestID()).Return(testingData.SignatureMap, nil)
 	setup.mockRepo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), msg.Epoch).Return(testingData.ValidatorSet, nil)
 	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), msg.RequestID()).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	setup.mockRepo.EXPECT().GetSignatureRequest(gomock.Any(), msg.RequestID()).Return(symbiotic.SignatureRequest{KeyTag: msg.KeyTag, RequiredEpoch: msg.Epoch}, nil)
 
 	// Execute
 	err := setup.app.HandleSignatureProcessedMessage(ctx, msg)
@@ -321,6 +345,7 @@ func TestHandleSignatureGeneratedMessage_LowCostPolicy_ZeroMaxUnsigners(t *testi
 	setup.mockRepo.EXPECT().GetSignatureMap(gomock.Any(), msg.RequestID()).Return(testingData.SignatureMap, nil)
 	setup.mockRepo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), msg.Epoch).Return(testingData.ValidatorSet, nil)
 	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), msg.RequestID()).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	setup.mockRepo.EXPECT().GetSignatureRequest(gomock.Any(), msg.RequestID()).Return(symbiotic.SignatureRequest{KeyTag: msg.KeyTag, RequiredEpoch: msg.Epoch}, nil)
 
 	// Execute
 	err := setup.app.HandleSignatureProcessedMessage(ctx, msg)
@@ -387,3 +412,38 @@ func TestSignatureMapFunctionality(t *testing.T) {
 	require.Equal(t, int64(5), validatorSet.GetTotalActiveValidators())
 	require.Equal(t, validatorSet.QuorumThreshold, symbiotic.ToVotingPower(big.NewInt(670)))
 }
+
+func TestTryAggregateRequestsWithoutProof_SchedulesByPriority(t *testing.T) {
+	setup := newTestSetup(t, symbiotic.AggregationPolicyLowLatency, 0)
+	setup.mockMetrics.EXPECT().SetQueueDepth("aggregator", gomock.Any(), gomock.Any()).AnyTimes()
+	setup.mockMetrics.EXPECT().ObserveQueueWait("aggregator", gomock.Any(), gomock.Any()).AnyTimes()
+
+	application := symbiotic.SignatureRequestWithID{
+		SignatureRequest: symbiotic.SignatureRequest{KeyTag: 15, RequiredEpoch: 1},
+		RequestID:        common.HexToHash("0x01"),
+	}
+	header := symbiotic.SignatureRequestWithID{
+		SignatureRequest: symbiotic.SignatureRequest{KeyTag: 15, RequiredEpoch: 0, Priority: symbiotic.PriorityHeader},
+		RequestID:        common.HexToHash("0x02"),
+	}
+	nonAggregation := symbiotic.SignatureRequestWithID{
+		SignatureRequest: symbiotic.SignatureRequest{KeyTag: 0x10, RequiredEpoch: 1},
+		RequestID:        common.HexToHash("0x03"),
+	}
+
+	setup.mockRepo.EXPECT().GetLatestValidatorSetEpoch(gomock.Any()).Return(symbiotic.Epoch(1), nil)
+	setup.mockRepo.EXPECT().GetSignatureRequestsWithoutAggregationProof(gomock.Any(), symbiotic.Epoch(1), 10, common.Hash{}).Return([]symbiotic.SignatureRequestWithID{application, nonAggregation}, nil)
+	setup.mockRepo.EXPECT().GetSignatureRequestsWithoutAggregationProof(gomock.Any(), symbiotic.Epoch(1), 10, nonAggregation.RequestID).Return(nil, nil)
+	setup.mockRepo.EXPECT().GetSignatureRequestsWithoutAggregationProof(gomock.Any(), symbiotic.Epoch(0), 10, common.Hash{}).Return([]symbiotic.SignatureRequestWithID{header}, nil)
+	setup.mockRepo.EXPECT().GetSignatureRequestsWithoutAggregationProof(gomock.Any(), symbiotic.Epoch(0), 10, header.RequestID).Return(nil, nil)
+
+	// proofs already exist, the order of the proof lookups is the order requests are aggregated in
+	var order []common.Hash
+	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, requestID common.Hash) (symbiotic.AggregationProof, error) {
+		order = append(order, requestID)
+		return symbiotic.AggregationProof{}, nil
+	}).Times(2)
+
+	require.NoError(t, setup.app.TryAggregateRequestsWithoutProof(t.Context()))
+	require.Equal(t, []common.Hash{header.RequestID, application.RequestID}, order)
+}
@@ -0,0 +1,252 @@
+package aggregator_app
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	"github.com/symbioticfi/relay/pkg/log"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+const (
+	// coordinationRetention is how long the state of a request that was never aggregated is kept
+	coordinationRetention = time.Hour
+	// deferredCheckInterval is how often deferred requests are checked for being due
+	deferredCheckInterval = time.Second
+)
+
+// aggregationCoordinator keeps the time each request was first ready for aggregation on this node,
+// the aggregation intents gossiped by other aggregators and the requests deferred to other aggregators.
+type aggregationCoordinator struct {
+	mutex   sync.Mutex
+	readyAt map[common.Hash]time.Time
+	// intents maps requests to the time the last observed intent of another aggregator stops deferring them
+	intents  map[common.Hash]time.Time
+	deferred map[common.Hash]time.Time
+}
+
+func newAggregationCoordinator() *aggregationCoordinator {
+	return &aggregationCoordinator{
+		readyAt:  make(map[common.Hash]time.Time),
+		intents:  make(map[common.Hash]time.Time),
+		deferred: make(map[common.Hash]time.Time),
+	}
+}
+
+// wait returns how long the aggregation of a ready request is deferred: until backupWait passed since the request
+// was first ready and no intent of another aggregator is active. A deferred request is remembered until it is due.
+func (c *aggregationCoordinator) wait(requestID common.Hash, backupWait time.Duration, now time.Time) time.Duration {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	readyAt, ok := c.readyAt[requestID]
+	if !ok {
+		readyAt = now
+		c.readyAt[requestID] = now
+	}
+
+	due := readyAt.Add(backupWait)
+	if expiresAt, ok := c.intents[requestID]; ok && expiresAt.After(due) {
+		due = expiresAt
+	}
+
+	if !due.After(now) {
+		delete(c.deferred, requestID)
+		return 0
+	}
+	c.deferred[requestID] = due
+	return due.Sub(now)
+}
+
+// observe records an intent of another aggregator received at the given time that defers the request for hold.
+func (c *aggregationCoordinator) observe(intent entity.AggregationIntent, hold time.Duration, now time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.intents[intent.RequestID] = now.Add(hold)
+}
+
+// due returns the deferred requests that are due and forgets them as deferred.
+func (c *aggregationCoordinator) due(now time.Time) []common.Hash {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var requestIDs []common.Hash
+	for requestID, due := range c.deferred {
+		if !due.After(now) {
+			requestIDs = append(requestIDs, requestID)
+			delete(c.deferred, requestID)
+		}
+	}
+	return requestIDs
+}
+
+// forget drops the state of a request that is aggregated or no longer to be aggregated.
+func (c *aggregationCoordinator) forget(requestID common.Hash) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.readyAt, requestID)
+	delete(c.intents, requestID)
+	delete(c.deferred, requestID)
+}
+
+// prune drops the state of requests that were never aggregated by this node, e.g. because aggregation failed.
+func (c *aggregationCoordinator) prune(now time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for requestID, readyAt := range c.readyAt {
+		if _, ok := c.deferred[requestID]; !ok && now.Sub(readyAt) >= coordinationRetention {
+			delete(c.readyAt, requestID)
+		}
+	}
+	for requestID, expiresAt := range c.intents {
+		if now.Sub(expiresAt) >= coordinationRetention {
+			delete(c.intents, requestID)
+		}
+	}
+}
+
+// Start retries the aggregation of requests deferred to other aggregators once they are due
+// and drops the requests whose proof upgrade window has passed.
+func (s *AggregatorApp) Start(ctx context.Context) error {
+	ctx = log.WithComponent(ctx, "aggregator")
+
+	ticker := time.NewTicker(deferredCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			now := time.Now()
+			for _, requestID := range s.coordinator.due(now) {
+				if err := s.TryAggregateProofForRequestID(ctx, requestID); err != nil {
+					slog.WarnContext(ctx, "Failed to aggregate deferred request", "requestId", requestID.Hex(), "error", err)
+				}
+			}
+			s.coordinator.prune(now)
+			s.upgrades.prune(s.cfg.UpgradeWindow, now)
+		}
+	}
+}
+
+// backupWait returns how long a backup aggregator waits for the aggregators before it in the order of the request.
+// Coordination is disabled with a zero backup delay, forced aggregators are not in the order and aggregate immediately.
+func (s *AggregatorApp) backupWait(requestID common.Hash, validatorSet symbiotic.ValidatorSet, onchainKey symbiotic.CompactPublicKey) time.Duration {
+	if s.cfg.BackupDelay == 0 || s.cfg.ForceAggregator {
+		return 0
+	}
+	rank, ok := validatorSet.AggregatorFailoverRank(requestID, onchainKey)
+	if !ok {
+		return 0
+	}
+	return time.Duration(rank) * s.cfg.BackupDelay
+}
+
+// intentHold returns how long an intent of another aggregator defers the request on this node: one backup delay
+// for each rank this node is behind the announcing aggregator in the order of the request and at least one,
+// capped by the intent TTL. An announcing aggregator that crashed so only stalls the request until the next
+// aggregator after it takes over, not for the whole TTL.
+func (s *AggregatorApp) intentHold(requestID common.Hash, validatorSet symbiotic.ValidatorSet, onchainKey, announcerKey symbiotic.CompactPublicKey) time.Duration {
+	ranksBehind := uint64(1)
+	rank, ok := validatorSet.AggregatorFailoverRank(requestID, onchainKey)
+	announcerRank, announcerOk := validatorSet.AggregatorFailoverRank(requestID, announcerKey)
+	if ok && announcerOk && rank > announcerRank+1 {
+		ranksBehind = rank - announcerRank
+	}
+	return min(s.cfg.IntentTTL, time.Duration(ranksBehind)*s.cfg.BackupDelay)
+}
+
+// HandleAggregationIntentMessage records an aggregation intent gossiped by another aggregator.
+// Intents are only accepted if they are signed by an aggregator of the epoch and are not older than the intent TTL,
+// a zero intent TTL ignores all intents.
+func (s *AggregatorApp) HandleAggregationIntentMessage(ctx context.Context, msg entity.P2PMessage[entity.AggregationIntent]) error {
+	intent := msg.Message
+	ctx = log.WithComponent(ctx, "aggregator")
+	ctx = log.WithAttrs(ctx,
+		slog.String("requestId", intent.RequestID.Hex()),
+		slog.Uint64("epoch", uint64(intent.Epoch)),
+		slog.String("sender", msg.SenderInfo.Sender),
+	)
+
+	if s.cfg.IntentTTL == 0 {
+		return nil
+	}
+	now := time.Now()
+	sentAt := time.Unix(int64(intent.Timestamp), 0)
+	if now.Sub(sentAt) > s.cfg.IntentTTL || sentAt.Sub(now) > s.cfg.IntentTTL {
+		slog.DebugContext(ctx, "Ignored stale aggregation intent", "sentAt", sentAt)
+		return nil
+	}
+
+	valset, err := s.cfg.Repo.GetValidatorSetByEpoch(ctx, intent.Epoch)
+	if err != nil {
+		return errors.Errorf("failed to get validator set for aggregation intent epoch %d: %w", intent.Epoch, err)
+	}
+
+	publicKey, err := crypto.NewPublicKey(valset.RequiredKeyTag.Type(), intent.PublicKey)
+	if err != nil {
+		return errors.Errorf("failed to parse aggregation intent public key: %w", err)
+	}
+	if err := publicKey.Verify(intent.SigningMessage(), intent.Signature); err != nil {
+		return errors.Errorf("invalid aggregation intent signature: %w", err)
+	}
+	if !valset.IsAggregator(publicKey.OnChain()) {
+		return errors.Errorf("aggregation intent is not signed by an aggregator of epoch %d", intent.Epoch)
+	}
+
+	ownKey, err := s.cfg.KeyProvider.GetOnchainKeyForValset(valset, valset.RequiredKeyTag)
+	if err == nil && bytes.Equal(ownKey, publicKey.OnChain()) {
+		return nil
+	}
+
+	hold := s.intentHold(intent.RequestID, valset, ownKey, publicKey.OnChain())
+	s.coordinator.observe(intent, hold, now)
+	slog.DebugContext(ctx, "Observed aggregation intent", "hold", hold)
+	return nil
+}
+
+// broadcastAggregationIntent signs and gossips an aggregation intent, failures are only logged
+// since intents are advisory and aggregation proceeds without them.
+func (s *AggregatorApp) broadcastAggregationIntent(ctx context.Context, requestID common.Hash, validatorSet symbiotic.ValidatorSet) {
+	if s.cfg.BackupDelay == 0 {
+		return
+	}
+
+	privateKey, err := s.cfg.KeyProvider.GetPrivateKeyForValset(validatorSet, validatorSet.RequiredKeyTag)
+	if err != nil {
+		if errors.Is(err, entity.ErrKeyNotFound) {
+			// forced aggregators without a key of the validator set can't announce
+			return
+		}
+		slog.WarnContext(ctx, "Failed to get key to sign aggregation intent", "error", err)
+		return
+	}
+
+	intent := entity.AggregationIntent{
+		RequestID: requestID,
+		Epoch:     validatorSet.Epoch,
+		Timestamp: symbiotic.Timestamp(uint64(time.Now().Unix())),
+		PublicKey: privateKey.PublicKey().Raw(),
+	}
+	intent.Signature, _, err = privateKey.Sign(intent.SigningMessage())
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to sign aggregation intent", "error", err)
+		return
+	}
+
+	if err := s.cfg.P2PClient.BroadcastAggregationIntentMessage(ctx, intent); err != nil {
+		slog.WarnContext(ctx, "Failed to broadcast aggregation intent", "error", err)
+	}
+}
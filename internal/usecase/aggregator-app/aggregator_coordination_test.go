@@ -0,0 +1,176 @@
+package aggregator_app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+// createCoordinatedTestData returns test data with distinct validator keys where the local key has the given rank
+// in the aggregator order of the request, together with the keys of all validators.
+func createCoordinatedTestData(t *testing.T, requestID common.Hash, epoch symbiotic.Epoch, own crypto.PrivateKey, rank int) (testData, []crypto.PrivateKey) {
+	t.Helper()
+
+	data := createTestDataWithQuorum(requestID, epoch, true, own)
+	keys := make([]crypto.PrivateKey, len(data.ValidatorSet.Validators))
+	for i := range keys {
+		key, err := crypto.GeneratePrivateKey(symbiotic.KeyTypeBlsBn254)
+		require.NoError(t, err)
+		keys[i] = key
+	}
+	keys[data.ValidatorSet.AggregatorOrder(requestID)[rank]] = own
+
+	for i := range data.ValidatorSet.Validators {
+		data.ValidatorSet.Validators[i].Keys = []symbiotic.ValidatorKey{{Tag: 15, Payload: keys[i].PublicKey().OnChain()}}
+	}
+	return data, keys
+}
+
+func expectReadyForAggregation(setup *testSetup, msg symbiotic.Signature, data testData) {
+	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), msg.RequestID()).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	setup.mockRepo.EXPECT().GetSignatureRequest(gomock.Any(), msg.RequestID()).Return(symbiotic.SignatureRequest{KeyTag: msg.KeyTag, RequiredEpoch: msg.Epoch}, nil)
+	setup.mockRepo.EXPECT().GetSignatureMap(gomock.Any(), msg.RequestID()).Return(data.SignatureMap, nil)
+	setup.mockRepo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), msg.Epoch).Return(data.ValidatorSet, nil)
+}
+
+func signedAggregationIntent(t *testing.T, key crypto.PrivateKey, requestID common.Hash, epoch symbiotic.Epoch) entity.AggregationIntent {
+	t.Helper()
+
+	intent := entity.AggregationIntent{
+		RequestID: requestID,
+		Epoch:     epoch,
+		Timestamp: symbiotic.Timestamp(uint64(time.Now().Unix())),
+		PublicKey: key.PublicKey().Raw(),
+	}
+	var err error
+	intent.Signature, _, err = key.Sign(intent.SigningMessage())
+	require.NoError(t, err)
+	return intent
+}
+
+func TestTryAggregateProofForRequestID_BackupAggregatesWhenDue(t *testing.T) {
+	setup := newTestSetup(t, symbiotic.AggregationPolicyLowLatency, 0)
+	setup.app.cfg.BackupDelay = time.Minute
+	msg := createTestSignatureExtended(t, setup.privateKey)
+	requestID := msg.RequestID()
+	data, _ := createCoordinatedTestData(t, requestID, msg.Epoch, setup.privateKey, 1)
+
+	// the first backup waits one backup delay for the primary
+	expectReadyForAggregation(setup, msg, data)
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), requestID))
+	require.Contains(t, setup.app.coordinator.deferred, requestID)
+	require.Empty(t, setup.app.coordinator.due(time.Now()))
+
+	// no proof arrived within the delay, the backup announces and aggregates
+	setup.app.coordinator.readyAt[requestID] = time.Now().Add(-time.Minute)
+
+	setupSuccessfulAggregationMocks(setup, msg, data)
+	setup.mockP2PClient.EXPECT().BroadcastAggregationIntentMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, intent entity.AggregationIntent) error {
+			require.Equal(t, requestID, intent.RequestID)
+			require.NoError(t, setup.privateKey.PublicKey().Verify(intent.SigningMessage(), intent.Signature))
+			return nil
+		})
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), requestID))
+	require.NotContains(t, setup.app.coordinator.readyAt, requestID)
+
+	// a proof received over gossip ends the coordination of a request
+	setup.app.coordinator.readyAt[requestID] = time.Now()
+	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), requestID).Return(symbiotic.AggregationProof{}, nil)
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), requestID))
+	require.NotContains(t, setup.app.coordinator.readyAt, requestID)
+}
+
+func TestTryAggregateProofForRequestID_PrimaryDefersToAnnouncedAggregation(t *testing.T) {
+	setup := newTestSetup(t, symbiotic.AggregationPolicyLowLatency, 0)
+	setup.app.cfg.BackupDelay = time.Minute
+	msg := createTestSignatureExtended(t, setup.privateKey)
+	requestID := msg.RequestID()
+	data, keys := createCoordinatedTestData(t, requestID, msg.Epoch, setup.privateKey, 0)
+	other := keys[data.ValidatorSet.AggregatorOrder(requestID)[1]]
+
+	setup.mockRepo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), msg.Epoch).Return(data.ValidatorSet, nil)
+	require.NoError(t, setup.app.HandleAggregationIntentMessage(t.Context(), entity.P2PMessage[entity.AggregationIntent]{
+		Message: signedAggregationIntent(t, other, requestID, msg.Epoch),
+	}))
+
+	expectReadyForAggregation(setup, msg, data)
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), requestID))
+	require.Contains(t, setup.app.coordinator.deferred, requestID)
+	// the primary is one rank ahead of the announcing backup and defers for one backup delay
+	require.WithinDuration(t, time.Now().Add(time.Minute), setup.app.coordinator.deferred[requestID], time.Second)
+}
+
+func TestHandleAggregationIntentMessage_BackupOverridesIntentAfterItsDelay(t *testing.T) {
+	setup := newTestSetup(t, symbiotic.AggregationPolicyLowLatency, 0)
+	setup.app.cfg.BackupDelay = time.Minute
+	msg := createTestSignatureExtended(t, setup.privateKey)
+	requestID := msg.RequestID()
+	data, keys := createCoordinatedTestData(t, requestID, msg.Epoch, setup.privateKey, 3)
+	primary := keys[data.ValidatorSet.AggregatorOrder(requestID)[0]]
+	setup.mockRepo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), msg.Epoch).Return(data.ValidatorSet, nil).AnyTimes()
+
+	observe := func() time.Duration {
+		require.NoError(t, setup.app.HandleAggregationIntentMessage(t.Context(), entity.P2PMessage[entity.AggregationIntent]{
+			Message: signedAggregationIntent(t, primary, requestID, msg.Epoch),
+		}))
+		return time.Until(setup.app.coordinator.intents[requestID])
+	}
+
+	// the third backup defers to an intent of the primary for its own three backup delays
+	require.InDelta(t, 3*time.Minute, observe(), float64(time.Second))
+
+	// and never longer than the intent TTL
+	setup.app.cfg.IntentTTL = 2 * time.Minute
+	require.InDelta(t, 2*time.Minute, observe(), float64(time.Second))
+
+	// a zero TTL ignores intents
+	setup.app.coordinator.forget(requestID)
+	setup.app.cfg.IntentTTL = 0
+	observe()
+	require.Empty(t, setup.app.coordinator.intents)
+}
+
+func TestHandleAggregationIntentMessage_RejectsInvalidIntents(t *testing.T) {
+	setup := newTestSetup(t, symbiotic.AggregationPolicyLowLatency, 0)
+	msg := createTestSignatureExtended(t, setup.privateKey)
+	requestID := msg.RequestID()
+	data, keys := createCoordinatedTestData(t, requestID, msg.Epoch, setup.privateKey, 0)
+	other := keys[data.ValidatorSet.AggregatorOrder(requestID)[1]]
+
+	setup.mockRepo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), msg.Epoch).Return(data.ValidatorSet, nil).AnyTimes()
+
+	t.Run("not an aggregator", func(t *testing.T) {
+		stranger, err := crypto.GeneratePrivateKey(symbiotic.KeyTypeBlsBn254)
+		require.NoError(t, err)
+
+		err = setup.app.HandleAggregationIntentMessage(t.Context(), entity.P2PMessage[entity.AggregationIntent]{
+			Message: signedAggregationIntent(t, stranger, requestID, msg.Epoch),
+		})
+		require.ErrorContains(t, err, "not signed by an aggregator")
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		intent := signedAggregationIntent(t, other, requestID, msg.Epoch)
+		intent.RequestID = common.HexToHash("0x01")
+
+		err := setup.app.HandleAggregationIntentMessage(t.Context(), entity.P2PMessage[entity.AggregationIntent]{Message: intent})
+		require.ErrorContains(t, err, "invalid aggregation intent signature")
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		intent := signedAggregationIntent(t, other, requestID, msg.Epoch)
+		intent.Timestamp -= symbiotic.Timestamp(uint64((2 * setup.app.cfg.IntentTTL).Seconds()))
+
+		require.NoError(t, setup.app.HandleAggregationIntentMessage(t.Context(), entity.P2PMessage[entity.AggregationIntent]{Message: intent}))
+	})
+
+	require.Empty(t, setup.app.coordinator.intents)
+}
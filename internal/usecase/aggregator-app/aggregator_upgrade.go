@@ -0,0 +1,102 @@
+package aggregator_app
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// proofUpgrades keeps the requests whose proofs may still be upgraded with signatures that arrived after aggregation.
+// The window of a request is anchored to the stored creation time of its first proof, so a request stays closed
+// once its window passed, also for late signatures or syncs after a restart.
+type proofUpgrades struct {
+	mutex sync.Mutex
+	// since maps requests with an open window to the creation time of their first proof
+	since map[common.Hash]time.Time
+	// votingPower maps requests to the signed voting power last aggregated into an upgrade
+	votingPower map[common.Hash]*big.Int
+}
+
+func newProofUpgrades() *proofUpgrades {
+	return &proofUpgrades{
+		since:       make(map[common.Hash]time.Time),
+		votingPower: make(map[common.Hash]*big.Int),
+	}
+}
+
+// createdAt returns the proof creation time of a request whose window is still tracked.
+func (u *proofUpgrades) createdAt(requestID common.Hash) (time.Time, bool) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	since, ok := u.since[requestID]
+	return since, ok
+}
+
+// open reports whether the proof of a request created at the given time may still be upgraded
+// and tracks the request until its window passes.
+func (u *proofUpgrades) open(requestID common.Hash, createdAt time.Time, window time.Duration, now time.Time) bool {
+	if now.Sub(createdAt) >= window {
+		return false
+	}
+
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	u.since[requestID] = createdAt
+	return true
+}
+
+// attempt reports whether the signed voting power exceeds the one last aggregated into an upgrade of the request
+// and records it as aggregated if so.
+func (u *proofUpgrades) attempt(requestID common.Hash, votingPower symbiotic.VotingPower) bool {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	if last, ok := u.votingPower[requestID]; ok && votingPower.Cmp(last) <= 0 {
+		return false
+	}
+	u.votingPower[requestID] = new(big.Int).Set(votingPower.Int)
+	return true
+}
+
+// prune drops the requests whose upgrade window has passed.
+func (u *proofUpgrades) prune(window time.Duration, now time.Time) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	for requestID, since := range u.since {
+		if now.Sub(since) >= window {
+			delete(u.since, requestID)
+			delete(u.votingPower, requestID)
+		}
+	}
+}
+
+// upgradeOpen reports whether the existing proof of a request may still be upgraded. A zero window disables
+// upgrades, proofs saved without a creation time are not upgraded.
+func (s *AggregatorApp) upgradeOpen(ctx context.Context, requestID common.Hash) (bool, error) {
+	if s.cfg.UpgradeWindow == 0 {
+		return false, nil
+	}
+
+	createdAt, ok := s.upgrades.createdAt(requestID)
+	if !ok {
+		var err error
+		createdAt, err = s.cfg.Repo.GetAggregationProofCreatedAt(ctx, requestID)
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.Errorf("failed to get aggregation proof creation time: %w", err)
+		}
+	}
+	return s.upgrades.open(requestID, createdAt, s.cfg.UpgradeWindow, time.Now()), nil
+}
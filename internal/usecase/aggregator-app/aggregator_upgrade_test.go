@@ -0,0 +1,109 @@
+package aggregator_app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func expectUpgradeAttempt(setup *testSetup, msg symbiotic.Signature, data testData, existing symbiotic.AggregationProof, verificationType symbiotic.VerificationType) {
+	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), msg.RequestID()).Return(existing, nil)
+	setup.mockRepo.EXPECT().GetAggregationProofCreatedAt(gomock.Any(), msg.RequestID()).Return(time.Now(), nil)
+	setup.mockRepo.EXPECT().GetSignatureRequest(gomock.Any(), msg.RequestID()).Return(symbiotic.SignatureRequest{KeyTag: msg.KeyTag, RequiredEpoch: msg.Epoch}, nil)
+	setup.mockRepo.EXPECT().GetSignatureMap(gomock.Any(), msg.RequestID()).Return(data.SignatureMap, nil)
+	setup.mockRepo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), msg.Epoch).Return(data.ValidatorSet, nil)
+	setup.mockRepo.EXPECT().GetConfigByEpoch(gomock.Any(), msg.Epoch).Return(symbiotic.NetworkConfig{VerificationType: verificationType}, nil)
+}
+
+func TestTryAggregateProofForRequestID_UpgradesProofWithinWindow(t *testing.T) {
+	setup := newTestSetup(t, symbiotic.AggregationPolicyLowLatency, 0)
+	setup.app.cfg.UpgradeWindow = time.Minute
+	msg := createTestSignatureExtended(t, setup.privateKey)
+	requestID := msg.RequestID()
+	data := createTestDataWithQuorum(requestID, msg.Epoch, true, setup.privateKey)
+
+	existing := symbiotic.AggregationProof{KeyTag: msg.KeyTag, Epoch: msg.Epoch, MessageHash: msg.MessageHash, Proof: make([]byte, 230)}
+	upgraded := existing
+	upgraded.Proof = make([]byte, 228)
+
+	expectUpgradeAttempt(setup, msg, data, existing, symbiotic.VerificationTypeBlsBn254Simple)
+	setup.mockRepo.EXPECT().GetAllSignatures(gomock.Any(), requestID).Return(nil, nil)
+	setup.mockAggregator.EXPECT().Aggregate(gomock.Any(), gomock.Any(), gomock.Any()).Return(upgraded, nil)
+	setup.mockP2PClient.EXPECT().BroadcastSignatureAggregatedMessage(gomock.Any(), upgraded).Return(nil)
+	setup.mockMetrics.EXPECT().ObserveOnlyAggregateDuration(gomock.Any())
+	setup.mockMetrics.EXPECT().ObserveAppAggregateDuration(gomock.Any())
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), requestID))
+
+	// the same signatures are not aggregated again
+	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), requestID).Return(upgraded, nil)
+	setup.mockRepo.EXPECT().GetSignatureRequest(gomock.Any(), requestID).Return(symbiotic.SignatureRequest{KeyTag: msg.KeyTag, RequiredEpoch: msg.Epoch}, nil)
+	setup.mockRepo.EXPECT().GetSignatureMap(gomock.Any(), requestID).Return(data.SignatureMap, nil)
+	setup.mockRepo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), msg.Epoch).Return(data.ValidatorSet, nil)
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), requestID))
+
+	// no upgrades once the window passed
+	setup.app.upgrades.since[requestID] = time.Now().Add(-time.Minute)
+	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), requestID).Return(upgraded, nil)
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), requestID))
+
+	setup.app.upgrades.prune(setup.app.cfg.UpgradeWindow, time.Now())
+	require.Empty(t, setup.app.upgrades.since)
+	require.Empty(t, setup.app.upgrades.votingPower)
+
+	// late signatures after the prune do not reopen the window
+	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), requestID).Return(upgraded, nil)
+	setup.mockRepo.EXPECT().GetAggregationProofCreatedAt(gomock.Any(), requestID).Return(time.Now().Add(-time.Minute), nil)
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), requestID))
+	require.Empty(t, setup.app.upgrades.since)
+}
+
+func TestTryAggregateProofForRequestID_SkipsUpgradeOfProofCreatedBeforeWindow(t *testing.T) {
+	setup := newTestSetup(t, symbiotic.AggregationPolicyLowLatency, 0)
+	setup.app.cfg.UpgradeWindow = time.Minute
+	msg := createTestSignatureExtended(t, setup.privateKey)
+	requestID := msg.RequestID()
+	existing := symbiotic.AggregationProof{KeyTag: msg.KeyTag, Epoch: msg.Epoch, MessageHash: msg.MessageHash, Proof: make([]byte, 230)}
+
+	// e.g. the first signature seen after a restart, the window is anchored to the stored proof
+	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), requestID).Return(existing, nil)
+	setup.mockRepo.EXPECT().GetAggregationProofCreatedAt(gomock.Any(), requestID).Return(time.Now().Add(-2*time.Minute), nil)
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), requestID))
+
+	// proofs stored without a creation time are not upgraded
+	setup.mockRepo.EXPECT().GetAggregationProof(gomock.Any(), requestID).Return(existing, nil)
+	setup.mockRepo.EXPECT().GetAggregationProofCreatedAt(gomock.Any(), requestID).Return(time.Time{}, entity.ErrEntityNotFound)
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), requestID))
+	require.Empty(t, setup.app.upgrades.since)
+}
+
+func TestTryAggregateProofForRequestID_SkipsUpgradeThatIsNotCheaper(t *testing.T) {
+	setup := newTestSetup(t, symbiotic.AggregationPolicyLowLatency, 0)
+	setup.app.cfg.UpgradeWindow = time.Minute
+	msg := createTestSignatureExtended(t, setup.privateKey)
+	data := createTestDataWithQuorum(msg.RequestID(), msg.Epoch, true, setup.privateKey)
+
+	existing := symbiotic.AggregationProof{KeyTag: msg.KeyTag, Epoch: msg.Epoch, MessageHash: msg.MessageHash, Proof: make([]byte, 228)}
+
+	expectUpgradeAttempt(setup, msg, data, existing, symbiotic.VerificationTypeBlsBn254Simple)
+	setup.mockRepo.EXPECT().GetAllSignatures(gomock.Any(), msg.RequestID()).Return(nil, nil)
+	setup.mockAggregator.EXPECT().Aggregate(gomock.Any(), gomock.Any(), gomock.Any()).Return(existing, nil)
+	setup.mockMetrics.EXPECT().ObserveOnlyAggregateDuration(gomock.Any())
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), msg.RequestID()))
+}
+
+func TestTryAggregateProofForRequestID_SkipsUpgradeOfZKProofs(t *testing.T) {
+	setup := newTestSetup(t, symbiotic.AggregationPolicyLowLatency, 0)
+	setup.app.cfg.UpgradeWindow = time.Minute
+	msg := createTestSignatureExtended(t, setup.privateKey)
+	data := createTestDataWithQuorum(msg.RequestID(), msg.Epoch, true, setup.privateKey)
+
+	existing := symbiotic.AggregationProof{KeyTag: msg.KeyTag, Epoch: msg.Epoch, MessageHash: msg.MessageHash, Proof: make([]byte, 256)}
+
+	expectUpgradeAttempt(setup, msg, data, existing, symbiotic.VerificationTypeBlsBn254ZK)
+	require.NoError(t, setup.app.TryAggregateProofForRequestID(t.Context(), msg.RequestID()))
+}
@@ -60,6 +60,21 @@ func (mr *MockrepositoryMockRecorder) GetAggregationProof(ctx, requestID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregationProof", reflect.TypeOf((*Mockrepository)(nil).GetAggregationProof), ctx, requestID)
 }
 
+// GetAggregationProofCreatedAt mocks base method.
+func (m *Mockrepository) GetAggregationProofCreatedAt(ctx context.Context, requestID common.Hash) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregationProofCreatedAt", ctx, requestID)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregationProofCreatedAt indicates an expected call of GetAggregationProofCreatedAt.
+func (mr *MockrepositoryMockRecorder) GetAggregationProofCreatedAt(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregationProofCreatedAt", reflect.TypeOf((*Mockrepository)(nil).GetAggregationProofCreatedAt), ctx, requestID)
+}
+
 // GetAllSignatures mocks base method.
 func (m *Mockrepository) GetAllSignatures(ctx context.Context, requestID common.Hash) ([]entity0.Signature, error) {
 	m.ctrl.T.Helper()
@@ -203,6 +218,20 @@ func (m *Mockp2pClient) EXPECT() *Mockp2pClientMockRecorder {
 	return m.recorder
 }
 
+// BroadcastAggregationIntentMessage mocks base method.
+func (m *Mockp2pClient) BroadcastAggregationIntentMessage(ctx context.Context, msg entity.AggregationIntent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BroadcastAggregationIntentMessage", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BroadcastAggregationIntentMessage indicates an expected call of BroadcastAggregationIntentMessage.
+func (mr *Mockp2pClientMockRecorder) BroadcastAggregationIntentMessage(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastAggregationIntentMessage", reflect.TypeOf((*Mockp2pClient)(nil).BroadcastAggregationIntentMessage), ctx, msg)
+}
+
 // BroadcastSignatureAggregatedMessage mocks base method.
 func (m *Mockp2pClient) BroadcastSignatureAggregatedMessage(ctx context.Context, proof entity0.AggregationProof) error {
 	m.ctrl.T.Helper()
@@ -265,6 +294,30 @@ func (mr *MockmetricsMockRecorder) ObserveOnlyAggregateDuration(d any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveOnlyAggregateDuration", reflect.TypeOf((*Mockmetrics)(nil).ObserveOnlyAggregateDuration), d)
 }
 
+// ObserveQueueWait mocks base method.
+func (m *Mockmetrics) ObserveQueueWait(queue, class string, d time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveQueueWait", queue, class, d)
+}
+
+// ObserveQueueWait indicates an expected call of ObserveQueueWait.
+func (mr *MockmetricsMockRecorder) ObserveQueueWait(queue, class, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveQueueWait", reflect.TypeOf((*Mockmetrics)(nil).ObserveQueueWait), queue, class, d)
+}
+
+// SetQueueDepth mocks base method.
+func (m *Mockmetrics) SetQueueDepth(queue, class string, depth int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetQueueDepth", queue, class, depth)
+}
+
+// SetQueueDepth indicates an expected call of SetQueueDepth.
+func (mr *MockmetricsMockRecorder) SetQueueDepth(queue, class, depth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQueueDepth", reflect.TypeOf((*Mockmetrics)(nil).SetQueueDepth), queue, class, depth)
+}
+
 // Mockaggregator is a mock of aggregator interface.
 type Mockaggregator struct {
 	ctrl     *gomock.Controller
@@ -328,32 +381,32 @@ func (m *MockkeyProvider) EXPECT() *MockkeyProviderMockRecorder {
 	return m.recorder
 }
 
-// GetOnchainKeyFromCache mocks base method.
-func (m *MockkeyProvider) GetOnchainKeyFromCache(keyTag entity0.KeyTag) (entity0.CompactPublicKey, error) {
+// GetOnchainKeyForValset mocks base method.
+func (m *MockkeyProvider) GetOnchainKeyForValset(valset entity0.ValidatorSet, keyTag entity0.KeyTag) (entity0.CompactPublicKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOnchainKeyFromCache", keyTag)
+	ret := m.ctrl.Call(m, "GetOnchainKeyForValset", valset, keyTag)
 	ret0, _ := ret[0].(entity0.CompactPublicKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOnchainKeyFromCache indicates an expected call of GetOnchainKeyFromCache.
-func (mr *MockkeyProviderMockRecorder) GetOnchainKeyFromCache(keyTag any) *gomock.Call {
+// GetOnchainKeyForValset indicates an expected call of GetOnchainKeyForValset.
+func (mr *MockkeyProviderMockRecorder) GetOnchainKeyForValset(valset, keyTag any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnchainKeyFromCache", reflect.TypeOf((*MockkeyProvider)(nil).GetOnchainKeyFromCache), keyTag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnchainKeyForValset", reflect.TypeOf((*MockkeyProvider)(nil).GetOnchainKeyForValset), valset, keyTag)
 }
 
-// GetPrivateKey mocks base method.
-func (m *MockkeyProvider) GetPrivateKey(keyTag entity0.KeyTag) (crypto.PrivateKey, error) {
+// GetPrivateKeyForValset mocks base method.
+func (m *MockkeyProvider) GetPrivateKeyForValset(valset entity0.ValidatorSet, keyTag entity0.KeyTag) (crypto.PrivateKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPrivateKey", keyTag)
+	ret := m.ctrl.Call(m, "GetPrivateKeyForValset", valset, keyTag)
 	ret0, _ := ret[0].(crypto.PrivateKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPrivateKey indicates an expected call of GetPrivateKey.
-func (mr *MockkeyProviderMockRecorder) GetPrivateKey(keyTag any) *gomock.Call {
+// GetPrivateKeyForValset indicates an expected call of GetPrivateKeyForValset.
+func (mr *MockkeyProviderMockRecorder) GetPrivateKeyForValset(valset, keyTag any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateKey", reflect.TypeOf((*MockkeyProvider)(nil).GetPrivateKey), keyTag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateKeyForValset", reflect.TypeOf((*MockkeyProvider)(nil).GetPrivateKeyForValset), valset, keyTag)
 }
@@ -28,12 +28,15 @@ import (
 	"github.com/symbioticfi/relay/internal/usecase/metrics"
 	"github.com/symbioticfi/relay/pkg/log"
 	"github.com/symbioticfi/relay/pkg/server"
+	"github.com/symbioticfi/relay/pkg/signals"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
 )
 
 //go:generate mockgen -source=app.go -destination=mocks/app_mock.go -package=mocks
 type signer interface {
 	RequestSignature(ctx context.Context, req symbiotic.SignatureRequest) (common.Hash, error)
+	RequestBatchSignature(ctx context.Context, keyTag symbiotic.KeyTag, requiredEpoch symbiotic.Epoch, messages [][]byte, priority symbiotic.PriorityClass) (symbiotic.MessageBatch, error)
+	CancelSignatureRequest(ctx context.Context, requestID common.Hash) (symbiotic.SignatureRequest, error)
 }
 
 type repo interface {
@@ -51,6 +54,12 @@ type repo interface {
 	GetAggregationProofsStartingFromEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.AggregationProof, error)
 	GetAggregationProofsByEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.AggregationProof, error)
 	GetValidatorSetsStartingFromEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.ValidatorSet, error)
+	GetConfigByEpoch(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.NetworkConfig, error)
+	GetSettlementCommitStatesByEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.SettlementCommitState, error)
+	GetMessageBatch(ctx context.Context, requestID common.Hash) (symbiotic.MessageBatch, error)
+	GetValidatorParticipations(ctx context.Context, epoch symbiotic.Epoch) ([]entity.ValidatorParticipation, error)
+	GetEquivocationEvidenceByEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.EquivocationEvidence, error)
+	GetEquivocationEvidenceStartingFromEpoch(ctx context.Context, epoch symbiotic.Epoch) ([]symbiotic.EquivocationEvidence, error)
 }
 type evmClient interface {
 	GetCurrentEpoch(ctx context.Context) (symbiotic.Epoch, error)
@@ -68,7 +77,21 @@ type deriver interface {
 }
 
 type keyProvider interface {
-	GetOnchainKeyFromCache(keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, error)
+	GetOnchainKeyForValset(valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, error)
+}
+
+const (
+	// ClientIDHeader is the gRPC metadata (or HTTP header) identifying the API client for the priority policy
+	ClientIDHeader = "x-client-id"
+	// ClientTokenHeader is the gRPC metadata (or HTTP header) with the secret token authenticating the client ID
+	ClientTokenHeader = "x-client-token"
+)
+
+// SignalQueue exposes the state of a signal queue for introspection.
+type SignalQueue interface {
+	ID() string
+	Stats(ctx context.Context) (signals.QueueStats, error)
+	DeadLetters(ctx context.Context, limit int) ([]signals.StoredEvent, error)
 }
 
 type Config struct {
@@ -88,6 +111,10 @@ type Config struct {
 	Metrics                *metrics.Metrics `validate:"required"`
 	VerboseLogging         bool
 	MaxAllowedStreamsCount int `validate:"required,gt=0"`
+	SignalQueues           []SignalQueue
+	// Priority assigns signature requests submitted by API clients to scheduling classes, see ClientIDHeader
+	// and ClientTokenHeader
+	Priority entity.PriorityPolicy
 }
 
 func (c Config) Validate() error {
@@ -103,9 +130,10 @@ type grpcHandler struct {
 
 	cfg Config
 
-	proofsHub        *broadcaster.Hub[symbiotic.AggregationProof]
+	proofsHub        *broadcaster.Hub[proofEvent]
 	signatureHub     *broadcaster.Hub[symbiotic.Signature]
 	validatorSetsHub *broadcaster.Hub[symbiotic.ValidatorSet]
+	equivocationHub  *broadcaster.Hub[symbiotic.EquivocationEvidence]
 }
 type SymbioticServer struct {
 	grpcServer       *grpc.Server
@@ -150,8 +178,8 @@ func NewSymbioticServer(ctx context.Context, cfg Config) (*SymbioticServer, erro
 	// Create and register the handler
 	handler := &grpcHandler{
 		cfg: cfg,
-		proofsHub: broadcaster.NewHub[symbiotic.AggregationProof](
-			broadcaster.WithBufferSize[symbiotic.AggregationProof](cfg.MaxAllowedStreamsCount),
+		proofsHub: broadcaster.NewHub[proofEvent](
+			broadcaster.WithBufferSize[proofEvent](cfg.MaxAllowedStreamsCount),
 		),
 		signatureHub: broadcaster.NewHub[symbiotic.Signature](
 			broadcaster.WithBufferSize[symbiotic.Signature](cfg.MaxAllowedStreamsCount),
@@ -159,6 +187,9 @@ func NewSymbioticServer(ctx context.Context, cfg Config) (*SymbioticServer, erro
 		validatorSetsHub: broadcaster.NewHub[symbiotic.ValidatorSet](
 			broadcaster.WithBufferSize[symbiotic.ValidatorSet](cfg.MaxAllowedStreamsCount),
 		),
+		equivocationHub: broadcaster.NewHub[symbiotic.EquivocationEvidence](
+			broadcaster.WithBufferSize[symbiotic.EquivocationEvidence](cfg.MaxAllowedStreamsCount),
+		),
 	}
 
 	apiv1.RegisterSymbioticAPIServiceServer(grpcServer, handler)
@@ -368,7 +399,14 @@ func (a *SymbioticServer) Start(ctx context.Context) error {
 
 func (a *SymbioticServer) HandleProofAggregated() func(context.Context, symbiotic.AggregationProof) error {
 	return func(ctx context.Context, proof symbiotic.AggregationProof) error {
-		a.handler.proofsHub.Broadcast(proof)
+		a.handler.proofsHub.Broadcast(proofEvent{proof: proof})
+		return nil
+	}
+}
+
+func (a *SymbioticServer) HandleProofUpgraded() func(context.Context, symbiotic.AggregationProof) error {
+	return func(ctx context.Context, proof symbiotic.AggregationProof) error {
+		a.handler.proofsHub.Broadcast(proofEvent{proof: proof, upgraded: true})
 		return nil
 	}
 }
@@ -386,3 +424,10 @@ func (a *SymbioticServer) HandleValidatorSet() func(context.Context, symbiotic.V
 		return nil
 	}
 }
+
+func (a *SymbioticServer) HandleEquivocationEvidence() func(context.Context, symbiotic.EquivocationEvidence) error {
+	return func(ctx context.Context, evidence symbiotic.EquivocationEvidence) error {
+		a.handler.equivocationHub.Broadcast(evidence)
+		return nil
+	}
+}
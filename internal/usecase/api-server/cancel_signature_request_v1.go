@@ -0,0 +1,35 @@
+package api_server
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+)
+
+// CancelSignatureRequest handles the gRPC CancelSignatureRequest request
+func (h *grpcHandler) CancelSignatureRequest(ctx context.Context, req *apiv1.CancelSignatureRequestRequest) (*apiv1.CancelSignatureRequestResponse, error) {
+	requestID := common.HexToHash(req.GetRequestId())
+
+	signatureRequest, err := h.cfg.Signer.CancelSignatureRequest(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return nil, status.Errorf(codes.NotFound, "signature request %s not found", req.GetRequestId())
+		}
+		return nil, err
+	}
+
+	signatureRequestPB, err := convertSignatureRequestToPB(requestID, signatureRequest, apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_CANCELLED)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CancelSignatureRequestResponse{
+		SignatureRequest: signatureRequestPB,
+	}, nil
+}
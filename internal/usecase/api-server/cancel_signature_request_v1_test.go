@@ -0,0 +1,69 @@
+package api_server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	"github.com/symbioticfi/relay/internal/usecase/api-server/mocks"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func TestCancelSignatureRequest_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSigner := mocks.NewMocksigner(ctrl)
+	handler := &grpcHandler{cfg: Config{Signer: mockSigner}}
+
+	ctx := context.Background()
+	requestID := common.HexToHash("0xabcd")
+
+	mockSigner.EXPECT().CancelSignatureRequest(ctx, requestID).Return(symbiotic.SignatureRequest{
+		KeyTag:        15,
+		RequiredEpoch: 5,
+		Message:       []byte("test message"),
+		Submitted:     true,
+		Cancelled:     true,
+	}, nil)
+
+	response, err := handler.CancelSignatureRequest(ctx, &apiv1.CancelSignatureRequestRequest{RequestId: requestID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, requestID.Hex(), response.GetSignatureRequest().GetRequestId())
+	require.Equal(t, uint64(5), response.GetSignatureRequest().GetRequiredEpoch())
+	require.Equal(t, apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_CANCELLED, response.GetSignatureRequest().GetStatus())
+}
+
+func TestCancelSignatureRequest_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSigner := mocks.NewMocksigner(ctrl)
+	handler := &grpcHandler{cfg: Config{Signer: mockSigner}}
+
+	ctx := context.Background()
+	requestID := common.HexToHash("0xabcd")
+
+	mockSigner.EXPECT().CancelSignatureRequest(ctx, requestID).Return(symbiotic.SignatureRequest{}, errors.Errorf("failed to get signature request: %w", entity.ErrEntityNotFound))
+
+	_, err := handler.CancelSignatureRequest(ctx, &apiv1.CancelSignatureRequestRequest{RequestId: requestID.Hex()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCancelSignatureRequest_NotCancellable_ReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSigner := mocks.NewMocksigner(ctrl)
+	handler := &grpcHandler{cfg: Config{Signer: mockSigner}}
+
+	ctx := context.Background()
+	requestID := common.HexToHash("0xabcd")
+
+	mockSigner.EXPECT().CancelSignatureRequest(ctx, requestID).Return(symbiotic.SignatureRequest{}, errors.Errorf("request is aggregated: %w", entity.ErrNotCancellable))
+
+	_, err := handler.CancelSignatureRequest(ctx, &apiv1.CancelSignatureRequestRequest{RequestId: requestID.Hex()})
+	require.ErrorIs(t, err, entity.ErrNotCancellable)
+}
@@ -0,0 +1,58 @@
+package api_server
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	"github.com/symbioticfi/relay/symbiotic/usecase/merkle"
+)
+
+// GetBatchedMessageProof handles the gRPC GetBatchedMessageProof request
+func (h *grpcHandler) GetBatchedMessageProof(ctx context.Context, req *apiv1.GetBatchedMessageProofRequest) (*apiv1.GetBatchedMessageProofResponse, error) {
+	requestID := common.HexToHash(req.GetRequestId())
+
+	batch, err := h.cfg.Repo.GetMessageBatch(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message batch for request %s not found", req.GetRequestId())
+		}
+		return nil, errors.Errorf("failed to get message batch: %w", err)
+	}
+
+	index := int(req.GetIndex())
+	if index >= len(batch.Leaves) {
+		return nil, status.Errorf(codes.InvalidArgument, "index %d is out of range, batch contains %d messages", index, len(batch.Leaves))
+	}
+
+	proof, err := merkle.Proof(batch.Leaves, index)
+	if err != nil {
+		return nil, errors.Errorf("failed to build inclusion proof: %w", err)
+	}
+
+	resp := &apiv1.GetBatchedMessageProofResponse{
+		MerkleRoot:   batch.Root.Bytes(),
+		Leaf:         batch.Leaves[index].Bytes(),
+		Index:        req.GetIndex(),
+		MessageCount: uint32(len(batch.Leaves)),
+		InclusionProof: lo.Map(proof, func(node common.Hash, _ int) []byte {
+			return node.Bytes()
+		}),
+	}
+
+	aggProof, err := h.cfg.Repo.GetAggregationProof(ctx, requestID)
+	if err != nil && !errors.Is(err, entity.ErrEntityNotFound) {
+		return nil, errors.Errorf("failed to get aggregation proof: %w", err)
+	}
+	if err == nil {
+		resp.AggregationProof = convertAggregationProofToPB(aggProof)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+package api_server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/merkle"
+)
+
+func newTestMessageBatch(t *testing.T, requestID common.Hash, messages [][]byte) symbiotic.MessageBatch {
+	t.Helper()
+	leaves := merkle.HashLeaves(messages)
+	root, err := merkle.Root(leaves)
+	require.NoError(t, err)
+	return symbiotic.MessageBatch{RequestID: requestID, Epoch: 1, KeyTag: 15, Root: root, Leaves: leaves}
+}
+
+func TestGetBatchedMessageProof_Aggregated_ReturnsVerifiableProof(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+	requestID := common.HexToHash("0x1234")
+	messages := [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+	batch := newTestMessageBatch(t, requestID, messages)
+	aggProof := symbiotic.AggregationProof{MessageHash: []byte("hash"), KeyTag: 15, Epoch: 1, Proof: []byte("proof")}
+
+	setup.mockRepo.EXPECT().GetMessageBatch(ctx, requestID).Return(batch, nil)
+	setup.mockRepo.EXPECT().GetAggregationProof(ctx, requestID).Return(aggProof, nil)
+
+	response, err := setup.handler.GetBatchedMessageProof(ctx, &apiv1.GetBatchedMessageProofRequest{RequestId: requestID.Hex(), Index: 2})
+	require.NoError(t, err)
+	assert.Equal(t, batch.Root.Bytes(), response.GetMerkleRoot())
+	assert.Equal(t, merkle.HashLeaf(messages[2]).Bytes(), response.GetLeaf())
+	assert.Equal(t, uint32(2), response.GetIndex())
+	assert.Equal(t, uint32(3), response.GetMessageCount())
+	require.NotNil(t, response.GetAggregationProof())
+	assert.Equal(t, []byte(aggProof.Proof), response.GetAggregationProof().GetProof())
+
+	proof := make([]common.Hash, 0, len(response.GetInclusionProof()))
+	for _, node := range response.GetInclusionProof() {
+		proof = append(proof, common.BytesToHash(node))
+	}
+	assert.True(t, merkle.Verify(proof, batch.Root, common.BytesToHash(response.GetLeaf())))
+}
+
+func TestGetBatchedMessageProof_NotAggregated_OmitsAggregationProof(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+	requestID := common.HexToHash("0x1234")
+	batch := newTestMessageBatch(t, requestID, [][]byte{[]byte("a"), []byte("b")})
+
+	setup.mockRepo.EXPECT().GetMessageBatch(ctx, requestID).Return(batch, nil)
+	setup.mockRepo.EXPECT().GetAggregationProof(ctx, requestID).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+
+	response, err := setup.handler.GetBatchedMessageProof(ctx, &apiv1.GetBatchedMessageProofRequest{RequestId: requestID.Hex(), Index: 0})
+	require.NoError(t, err)
+	assert.Nil(t, response.GetAggregationProof())
+	assert.Len(t, response.GetInclusionProof(), 1)
+}
+
+func TestGetBatchedMessageProof_IndexOutOfRange(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+	requestID := common.HexToHash("0x1234")
+	batch := newTestMessageBatch(t, requestID, [][]byte{[]byte("a")})
+
+	setup.mockRepo.EXPECT().GetMessageBatch(ctx, requestID).Return(batch, nil)
+
+	_, err := setup.handler.GetBatchedMessageProof(ctx, &apiv1.GetBatchedMessageProofRequest{RequestId: requestID.Hex(), Index: 1})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetBatchedMessageProof_BatchNotFound(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+	requestID := common.HexToHash("0x1234")
+
+	setup.mockRepo.EXPECT().GetMessageBatch(ctx, requestID).Return(symbiotic.MessageBatch{}, entity.ErrEntityNotFound)
+
+	_, err := setup.handler.GetBatchedMessageProof(ctx, &apiv1.GetBatchedMessageProofRequest{RequestId: requestID.Hex()})
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,98 @@
+package api_server
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// GetCommitStatus handles the gRPC GetCommitStatus request
+func (h *grpcHandler) GetCommitStatus(ctx context.Context, req *apiv1.GetCommitStatusRequest) (*apiv1.GetCommitStatusResponse, error) {
+	var epochRequested symbiotic.Epoch
+	if req.Epoch == nil {
+		latestEpoch, err := h.cfg.Repo.GetLatestValidatorSetEpoch(ctx)
+		if err != nil {
+			if errors.Is(err, entity.ErrEntityNotFound) {
+				return nil, status.Error(codes.NotFound, "no validator sets found")
+			}
+			return nil, errors.Errorf("failed to get latest validator set epoch: %w", err)
+		}
+		epochRequested = latestEpoch
+	} else {
+		epochRequested = symbiotic.Epoch(req.GetEpoch())
+	}
+
+	config, err := h.cfg.Repo.GetConfigByEpoch(ctx, epochRequested)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no network config found for epoch %d", epochRequested)
+		}
+		return nil, errors.Errorf("failed to get network config for epoch %d: %w", epochRequested, err)
+	}
+
+	states, err := h.cfg.Repo.GetSettlementCommitStatesByEpoch(ctx, epochRequested)
+	if err != nil {
+		return nil, errors.Errorf("failed to get settlement commit states for epoch %d: %w", epochRequested, err)
+	}
+	statesBySettlement := make(map[symbiotic.CrossChainAddress]symbiotic.SettlementCommitState, len(states))
+	for _, state := range states {
+		statesBySettlement[state.Settlement] = state
+	}
+
+	settlements := make([]*apiv1.SettlementCommitStatus, 0, len(config.Settlements))
+	for _, settlement := range config.Settlements {
+		lastCommittedEpoch, err := h.cfg.EvmClient.GetLastCommittedHeaderEpoch(ctx, settlement)
+		if err != nil {
+			return nil, errors.Errorf("failed to get last committed epoch for chain %d: %w", settlement.ChainId, err)
+		}
+
+		item := &apiv1.SettlementCommitStatus{
+			ChainId:            settlement.ChainId,
+			Address:            settlement.Address.Hex(),
+			LastCommittedEpoch: uint64(lastCommittedEpoch),
+		}
+
+		if state, ok := statesBySettlement[settlement]; ok {
+			item.Status = convertSettlementCommitStatusToPB(state.Status)
+			item.Attempts = state.Attempts
+			item.LastError = state.LastError
+			item.UpdatedAt = timestamppb.New(state.UpdatedAt)
+			if state.TxHash != (common.Hash{}) {
+				item.TxHash = state.TxHash.Hex()
+			}
+		} else if lastCommittedEpoch >= epochRequested {
+			// committed by someone else before this node started tracking the epoch
+			item.Status = apiv1.CommitStatus_COMMIT_STATUS_CONFIRMED
+		}
+
+		settlements = append(settlements, item)
+	}
+
+	return &apiv1.GetCommitStatusResponse{
+		Epoch:       uint64(epochRequested),
+		Settlements: settlements,
+	}, nil
+}
+
+func convertSettlementCommitStatusToPB(commitStatus symbiotic.SettlementCommitStatus) apiv1.CommitStatus {
+	switch commitStatus {
+	case symbiotic.SettlementCommitPending:
+		return apiv1.CommitStatus_COMMIT_STATUS_PENDING
+	case symbiotic.SettlementCommitSubmitted:
+		return apiv1.CommitStatus_COMMIT_STATUS_SUBMITTED
+	case symbiotic.SettlementCommitConfirmed:
+		return apiv1.CommitStatus_COMMIT_STATUS_CONFIRMED
+	case symbiotic.SettlementCommitFailed:
+		return apiv1.CommitStatus_COMMIT_STATUS_FAILED
+	default:
+		return apiv1.CommitStatus_COMMIT_STATUS_UNSPECIFIED
+	}
+}
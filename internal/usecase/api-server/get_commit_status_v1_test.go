@@ -0,0 +1,83 @@
+package api_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func TestGetCommitStatus_WithoutEpoch_ReturnsStatusPerSettlement(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+	epoch := symbiotic.Epoch(20)
+	updatedAt := time.Unix(1700000000, 0)
+
+	submitted := symbiotic.CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x123")}
+	committedElsewhere := symbiotic.CrossChainAddress{ChainId: 2, Address: common.HexToAddress("0x456")}
+	untracked := symbiotic.CrossChainAddress{ChainId: 3, Address: common.HexToAddress("0x789")}
+	txHash := common.HexToHash("0xaa")
+
+	setup.mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(epoch, nil)
+	setup.mockRepo.EXPECT().GetConfigByEpoch(ctx, epoch).Return(symbiotic.NetworkConfig{
+		Settlements: []symbiotic.CrossChainAddress{submitted, committedElsewhere, untracked},
+	}, nil)
+	setup.mockRepo.EXPECT().GetSettlementCommitStatesByEpoch(ctx, epoch).Return([]symbiotic.SettlementCommitState{{
+		Epoch:      epoch,
+		Settlement: submitted,
+		Status:     symbiotic.SettlementCommitSubmitted,
+		TxHash:     txHash,
+		Attempts:   2,
+		LastError:  "nonce too low",
+		UpdatedAt:  updatedAt,
+	}}, nil)
+	setup.mockEvmClient.EXPECT().GetLastCommittedHeaderEpoch(ctx, submitted).Return(symbiotic.Epoch(19), nil)
+	setup.mockEvmClient.EXPECT().GetLastCommittedHeaderEpoch(ctx, committedElsewhere).Return(symbiotic.Epoch(20), nil)
+	setup.mockEvmClient.EXPECT().GetLastCommittedHeaderEpoch(ctx, untracked).Return(symbiotic.Epoch(18), nil)
+
+	response, err := setup.handler.GetCommitStatus(ctx, &apiv1.GetCommitStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), response.GetEpoch())
+	require.Len(t, response.GetSettlements(), 3)
+
+	first := response.GetSettlements()[0]
+	assert.Equal(t, uint64(1), first.GetChainId())
+	assert.Equal(t, submitted.Address.Hex(), first.GetAddress())
+	assert.Equal(t, apiv1.CommitStatus_COMMIT_STATUS_SUBMITTED, first.GetStatus())
+	assert.Equal(t, txHash.Hex(), first.GetTxHash())
+	assert.Equal(t, uint32(2), first.GetAttempts())
+	assert.Equal(t, "nonce too low", first.GetLastError())
+	assert.Equal(t, updatedAt.Unix(), first.GetUpdatedAt().GetSeconds())
+	assert.Equal(t, uint64(19), first.GetLastCommittedEpoch())
+
+	assert.Equal(t, apiv1.CommitStatus_COMMIT_STATUS_CONFIRMED, response.GetSettlements()[1].GetStatus())
+	assert.Empty(t, response.GetSettlements()[1].GetTxHash())
+
+	assert.Equal(t, apiv1.CommitStatus_COMMIT_STATUS_UNSPECIFIED, response.GetSettlements()[2].GetStatus())
+	assert.Nil(t, response.GetSettlements()[2].GetUpdatedAt())
+}
+
+func TestGetCommitStatus_WithEpoch_ConfigNotFound(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+	epoch := uint64(5)
+
+	setup.mockRepo.EXPECT().GetConfigByEpoch(ctx, symbiotic.Epoch(epoch)).Return(symbiotic.NetworkConfig{}, entity.ErrEntityNotFound)
+
+	response, err := setup.handler.GetCommitStatus(ctx, &apiv1.GetCommitStatusRequest{Epoch: &epoch})
+	require.Error(t, err)
+	require.Nil(t, response)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
@@ -56,9 +56,9 @@ func (h *grpcHandler) GetCustomScheduleNodeStatus(ctx context.Context, req *apiv
 	epochStartTime := time.Unix(int64(validatorSet.CaptureTimestamp), 0)
 
 	// Get local validator address
-	pubkey, err := h.cfg.KeyProvider.GetOnchainKeyFromCache(validatorSet.RequiredKeyTag)
+	pubkey, err := h.cfg.KeyProvider.GetOnchainKeyForValset(validatorSet, validatorSet.RequiredKeyTag)
 	if err != nil {
-		return nil, errors.Errorf("failed to get onchain key from cache: %w", err)
+		return nil, errors.Errorf("failed to get onchain key for validator set: %w", err)
 	}
 
 	activeValidators := validatorSet.Validators.GetActiveValidators()
@@ -40,7 +40,7 @@ func TestGetCustomScheduleNodeStatus(t *testing.T) {
 
 		mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
 		mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, requestedEpoch).Return(validatorSet, nil)
-		mockKeyProvider.EXPECT().GetOnchainKeyFromCache(symbiotic.KeyTag(15)).Return(localKey, nil)
+		mockKeyProvider.EXPECT().GetOnchainKeyForValset(gomock.Any(), symbiotic.KeyTag(15)).Return(localKey, nil)
 
 		slotDuration := uint64(60) // 60 seconds per slot
 		req := &apiv1.GetCustomScheduleNodeStatusRequest{
@@ -81,7 +81,7 @@ func TestGetCustomScheduleNodeStatus(t *testing.T) {
 
 		mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
 		mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, currentEpoch).Return(validatorSet, nil)
-		mockKeyProvider.EXPECT().GetOnchainKeyFromCache(symbiotic.KeyTag(15)).Return(localKey, nil)
+		mockKeyProvider.EXPECT().GetOnchainKeyForValset(gomock.Any(), symbiotic.KeyTag(15)).Return(localKey, nil)
 
 		req := &apiv1.GetCustomScheduleNodeStatusRequest{
 			SlotDurationSeconds:    60,
@@ -223,7 +223,7 @@ func TestGetCustomScheduleNodeStatus(t *testing.T) {
 
 		mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
 		mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, requestedEpoch).Return(validatorSet, nil)
-		mockKeyProvider.EXPECT().GetOnchainKeyFromCache(symbiotic.KeyTag(15)).Return(localKey, nil)
+		mockKeyProvider.EXPECT().GetOnchainKeyForValset(gomock.Any(), symbiotic.KeyTag(15)).Return(localKey, nil)
 
 		req := &apiv1.GetCustomScheduleNodeStatusRequest{
 			Epoch:                  (*uint64)(&requestedEpoch),
@@ -263,7 +263,7 @@ func TestGetCustomScheduleNodeStatus(t *testing.T) {
 
 		mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
 		mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, requestedEpoch).Return(validatorSet, nil)
-		mockKeyProvider.EXPECT().GetOnchainKeyFromCache(symbiotic.KeyTag(15)).Return(localKey, nil)
+		mockKeyProvider.EXPECT().GetOnchainKeyForValset(gomock.Any(), symbiotic.KeyTag(15)).Return(localKey, nil)
 
 		req := &apiv1.GetCustomScheduleNodeStatusRequest{
 			Epoch:                  (*uint64)(&requestedEpoch),
@@ -288,6 +288,44 @@ func TestGetCustomScheduleNodeStatus(t *testing.T) {
 		require.Equal(t, 60*time.Second, slotEnd.Sub(slotStart))
 	})
 
+	t.Run("Success_ActiveByCapturedNextKey", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockrepo(ctrl)
+		keyProvider, nextKey := newRotatingKeyProvider(t)
+
+		handler := &grpcHandler{
+			cfg: Config{
+				Repo:        mockRepo,
+				KeyProvider: keyProvider,
+			},
+		}
+
+		ctx := context.Background()
+		requestedEpoch := symbiotic.Epoch(5)
+		currentEpoch := symbiotic.Epoch(10)
+
+		// the validator set captured the next key, the current key is not known to it anymore
+		validatorSet := createTestValidatorSet(requestedEpoch)
+		validatorSet.Validators[0].Keys[0].Payload = nextKey
+
+		mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
+		mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, requestedEpoch).Return(validatorSet, nil)
+
+		req := &apiv1.GetCustomScheduleNodeStatusRequest{
+			Epoch:                  (*uint64)(&requestedEpoch),
+			SlotDurationSeconds:    60,
+			MaxParticipantsPerSlot: 1,
+			MinParticipantsPerSlot: 1,
+		}
+
+		response, err := handler.GetCustomScheduleNodeStatus(ctx, req)
+
+		require.NoError(t, err)
+		require.True(t, response.GetIsActive())
+	})
+
 	t.Run("Success_LocalValidatorNotActive", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -313,7 +351,7 @@ func TestGetCustomScheduleNodeStatus(t *testing.T) {
 
 		mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
 		mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, requestedEpoch).Return(validatorSet, nil)
-		mockKeyProvider.EXPECT().GetOnchainKeyFromCache(symbiotic.KeyTag(15)).Return(localKey, nil)
+		mockKeyProvider.EXPECT().GetOnchainKeyForValset(gomock.Any(), symbiotic.KeyTag(15)).Return(localKey, nil)
 
 		req := &apiv1.GetCustomScheduleNodeStatusRequest{
 			Epoch:                  (*uint64)(&requestedEpoch),
@@ -356,7 +394,7 @@ func TestGetCustomScheduleNodeStatus(t *testing.T) {
 
 		mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
 		mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, requestedEpoch).Return(validatorSet, nil)
-		mockKeyProvider.EXPECT().GetOnchainKeyFromCache(symbiotic.KeyTag(15)).Return(symbiotic.CompactPublicKey(""), expectedError)
+		mockKeyProvider.EXPECT().GetOnchainKeyForValset(gomock.Any(), symbiotic.KeyTag(15)).Return(symbiotic.CompactPublicKey(""), expectedError)
 
 		req := &apiv1.GetCustomScheduleNodeStatusRequest{
 			Epoch:                  (*uint64)(&requestedEpoch),
@@ -369,7 +407,7 @@ func TestGetCustomScheduleNodeStatus(t *testing.T) {
 
 		require.Error(t, err)
 		require.Nil(t, response)
-		require.Contains(t, err.Error(), "failed to get onchain key from cache")
+		require.Contains(t, err.Error(), "failed to get onchain key for validator set")
 	})
 }
 
@@ -0,0 +1,78 @@
+package api_server
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// GetEquivocationEvidence handles the gRPC GetEquivocationEvidence request
+func (h *grpcHandler) GetEquivocationEvidence(ctx context.Context, req *apiv1.GetEquivocationEvidenceRequest) (*apiv1.GetEquivocationEvidenceResponse, error) {
+	var epochRequested symbiotic.Epoch
+	if req.Epoch == nil {
+		latestEpoch, err := h.cfg.Repo.GetLatestValidatorSetEpoch(ctx)
+		if err != nil {
+			if errors.Is(err, entity.ErrEntityNotFound) {
+				return nil, status.Error(codes.NotFound, "no validator sets found")
+			}
+			return nil, errors.Errorf("failed to get latest validator set epoch: %w", err)
+		}
+		epochRequested = latestEpoch
+	} else {
+		epochRequested = symbiotic.Epoch(req.GetEpoch())
+	}
+
+	evidence, err := h.cfg.Repo.GetEquivocationEvidenceByEpoch(ctx, epochRequested)
+	if err != nil {
+		return nil, errors.Errorf("failed to get equivocation evidence for epoch %d: %w", epochRequested, err)
+	}
+
+	items := make([]*apiv1.EquivocationEvidence, 0, len(evidence))
+	for _, e := range evidence {
+		item, err := convertEquivocationEvidenceToPB(e)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return &apiv1.GetEquivocationEvidenceResponse{
+		Epoch:    uint64(epochRequested),
+		Evidence: items,
+	}, nil
+}
+
+func convertEquivocationEvidenceToPB(evidence symbiotic.EquivocationEvidence) (*apiv1.EquivocationEvidence, error) {
+	abiEncoded, err := evidence.AbiEncode()
+	if err != nil {
+		return nil, errors.Errorf("failed to abi encode equivocation evidence: %w", err)
+	}
+
+	return &apiv1.EquivocationEvidence{
+		Operator:        evidence.Operator.Hex(),
+		KeyTag:          uint32(evidence.KeyTag),
+		PublicKey:       evidence.PublicKey,
+		Epoch:           uint64(evidence.Epoch()),
+		DomainSeparator: evidence.First.Commitment.DomainSeparator.Bytes(),
+		Subnetwork:      evidence.First.Commitment.Subnetwork.Bytes(),
+		First:           convertSignedHeaderCommitmentToPB(evidence.First),
+		Second:          convertSignedHeaderCommitmentToPB(evidence.Second),
+		DetectedAt:      timestamppb.New(evidence.DetectedAt),
+		AbiEncoded:      abiEncoded,
+	}, nil
+}
+
+func convertSignedHeaderCommitmentToPB(signed symbiotic.SignedHeaderCommitment) *apiv1.SignedHeaderCommitment {
+	return &apiv1.SignedHeaderCommitment{
+		HeaderHash:    signed.Commitment.HeaderHash.Bytes(),
+		ExtraDataHash: signed.Commitment.ExtraDataHash.Bytes(),
+		Signature:     signed.Signature,
+	}
+}
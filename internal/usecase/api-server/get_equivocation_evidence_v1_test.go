@@ -0,0 +1,80 @@
+package api_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func testEquivocationEvidence(epoch symbiotic.Epoch) symbiotic.EquivocationEvidence {
+	commitment := symbiotic.ValSetHeaderCommitment{
+		DomainSeparator: common.HexToHash("0xd0"),
+		Subnetwork:      common.HexToHash("0x5b"),
+		Epoch:           epoch,
+		HeaderHash:      common.HexToHash("0x01"),
+		ExtraDataHash:   common.HexToHash("0xe1"),
+	}
+	conflicting := commitment
+	conflicting.HeaderHash = common.HexToHash("0x02")
+
+	return symbiotic.EquivocationEvidence{
+		Operator:   common.HexToAddress("0x1"),
+		KeyTag:     15,
+		PublicKey:  []byte{0xaa},
+		First:      symbiotic.SignedHeaderCommitment{Commitment: commitment, Signature: []byte{0x01}},
+		Second:     symbiotic.SignedHeaderCommitment{Commitment: conflicting, Signature: []byte{0x02}},
+		DetectedAt: time.Unix(1_700_000_000, 0).UTC(),
+	}
+}
+
+func TestGetEquivocationEvidence_WithoutEpoch_UsesLatestEpoch(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+	evidence := testEquivocationEvidence(7)
+
+	setup.mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(symbiotic.Epoch(7), nil)
+	setup.mockRepo.EXPECT().GetEquivocationEvidenceByEpoch(ctx, symbiotic.Epoch(7)).Return([]symbiotic.EquivocationEvidence{evidence}, nil)
+
+	response, err := setup.handler.GetEquivocationEvidence(ctx, &apiv1.GetEquivocationEvidenceRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), response.GetEpoch())
+	require.Len(t, response.GetEvidence(), 1)
+
+	item := response.GetEvidence()[0]
+	assert.Equal(t, evidence.Operator.Hex(), item.GetOperator())
+	assert.Equal(t, uint32(15), item.GetKeyTag())
+	assert.Equal(t, uint64(7), item.GetEpoch())
+	assert.Equal(t, evidence.First.Commitment.DomainSeparator.Bytes(), item.GetDomainSeparator())
+	assert.Equal(t, common.HexToHash("0x01").Bytes(), item.GetFirst().GetHeaderHash())
+	assert.Equal(t, common.HexToHash("0x02").Bytes(), item.GetSecond().GetHeaderHash())
+	assert.Equal(t, []byte{0x02}, item.GetSecond().GetSignature())
+	assert.Equal(t, evidence.DetectedAt, item.GetDetectedAt().AsTime())
+
+	abiEncoded, err := evidence.AbiEncode()
+	require.NoError(t, err)
+	assert.Equal(t, abiEncoded, item.GetAbiEncoded())
+}
+
+func TestGetEquivocationEvidence_NoValidatorSet(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+
+	setup.mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(symbiotic.Epoch(0), entity.ErrEntityNotFound)
+
+	response, err := setup.handler.GetEquivocationEvidence(ctx, &apiv1.GetEquivocationEvidenceRequest{})
+	require.Nil(t, response)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
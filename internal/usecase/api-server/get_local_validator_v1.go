@@ -32,9 +32,9 @@ func (h *grpcHandler) GetLocalValidator(ctx context.Context, req *apiv1.GetLocal
 		return nil, err
 	}
 
-	pubkey, err := h.cfg.KeyProvider.GetOnchainKeyFromCache(validatorSet.RequiredKeyTag)
+	pubkey, err := h.cfg.KeyProvider.GetOnchainKeyForValset(validatorSet, validatorSet.RequiredKeyTag)
 	if err != nil {
-		return nil, errors.Errorf("failed to get onchain key from cache: %w", err)
+		return nil, errors.Errorf("failed to get onchain key for validator set: %w", err)
 	}
 
 	validator, found := validatorSet.FindValidatorByKey(validatorSet.RequiredKeyTag, pubkey)
@@ -38,7 +38,7 @@ func TestGetLocalValidator_Success(t *testing.T) {
 
 	mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
 	mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, requestedEpoch).Return(validatorSet, nil)
-	mockKeyProvider.EXPECT().GetOnchainKeyFromCache(symbiotic.KeyTag(15)).Return(localKey, nil)
+	mockKeyProvider.EXPECT().GetOnchainKeyForValset(gomock.Any(), symbiotic.KeyTag(15)).Return(localKey, nil)
 
 	req := &apiv1.GetLocalValidatorRequest{
 		Epoch: (*uint64)(&requestedEpoch),
@@ -76,7 +76,7 @@ func TestGetLocalValidator_UseCurrentEpoch(t *testing.T) {
 
 	mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
 	mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, currentEpoch).Return(validatorSet, nil)
-	mockKeyProvider.EXPECT().GetOnchainKeyFromCache(symbiotic.KeyTag(15)).Return(localKey, nil)
+	mockKeyProvider.EXPECT().GetOnchainKeyForValset(gomock.Any(), symbiotic.KeyTag(15)).Return(localKey, nil)
 
 	req := &apiv1.GetLocalValidatorRequest{}
 
@@ -141,7 +141,7 @@ func TestGetLocalValidator_ErrorWhenKeyProviderFails(t *testing.T) {
 
 	mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
 	mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, requestedEpoch).Return(validatorSet, nil)
-	mockKeyProvider.EXPECT().GetOnchainKeyFromCache(symbiotic.KeyTag(15)).Return(symbiotic.CompactPublicKey(""), expectedError)
+	mockKeyProvider.EXPECT().GetOnchainKeyForValset(gomock.Any(), symbiotic.KeyTag(15)).Return(symbiotic.CompactPublicKey(""), expectedError)
 
 	req := &apiv1.GetLocalValidatorRequest{
 		Epoch: (*uint64)(&requestedEpoch),
@@ -151,7 +151,7 @@ func TestGetLocalValidator_ErrorWhenKeyProviderFails(t *testing.T) {
 
 	require.Error(t, err)
 	require.Nil(t, response)
-	require.Contains(t, err.Error(), "failed to get onchain key from cache")
+	require.Contains(t, err.Error(), "failed to get onchain key for validator set")
 }
 
 func TestGetLocalValidator_ErrorWhenValidatorNotFound(t *testing.T) {
@@ -177,7 +177,7 @@ func TestGetLocalValidator_ErrorWhenValidatorNotFound(t *testing.T) {
 
 	mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
 	mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, requestedEpoch).Return(validatorSet, nil)
-	mockKeyProvider.EXPECT().GetOnchainKeyFromCache(symbiotic.KeyTag(15)).Return(localKey, nil)
+	mockKeyProvider.EXPECT().GetOnchainKeyForValset(gomock.Any(), symbiotic.KeyTag(15)).Return(localKey, nil)
 
 	req := &apiv1.GetLocalValidatorRequest{
 		Epoch: (*uint64)(&requestedEpoch),
@@ -221,3 +221,34 @@ func TestGetLocalValidator_ErrorWhenRepositoryFails(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, response)
 }
+
+func TestGetLocalValidator_FindsValidatorByCapturedNextKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockrepo(ctrl)
+	keyProvider, nextKey := newRotatingKeyProvider(t)
+
+	handler := &grpcHandler{
+		cfg: Config{
+			Repo:        mockRepo,
+			KeyProvider: keyProvider,
+		},
+	}
+
+	ctx := context.Background()
+	currentEpoch := symbiotic.Epoch(10)
+
+	// the validator set captured the next key, the current key is not known to it anymore
+	validatorSet := createTestValidatorSetWithMultipleValidators(currentEpoch)
+	validatorSet.Validators[0].Keys[0].Payload = nextKey
+	expectedValidator := validatorSet.Validators[0]
+
+	mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(currentEpoch, nil)
+	mockRepo.EXPECT().GetValidatorSetByEpoch(ctx, currentEpoch).Return(validatorSet, nil)
+
+	response, err := handler.GetLocalValidator(ctx, &apiv1.GetLocalValidatorRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, expectedValidator.Operator.Hex(), response.GetValidator().GetOperator())
+}
@@ -0,0 +1,66 @@
+package api_server
+
+import (
+	"context"
+
+	"github.com/go-errors/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	"github.com/symbioticfi/relay/pkg/signals"
+)
+
+// GetSignalQueueStatus handles the gRPC GetSignalQueueStatus request
+func (h *grpcHandler) GetSignalQueueStatus(ctx context.Context, req *apiv1.GetSignalQueueStatusRequest) (*apiv1.GetSignalQueueStatusResponse, error) {
+	queues := make([]*apiv1.SignalQueueStatus, 0, len(h.cfg.SignalQueues))
+	for _, queue := range h.cfg.SignalQueues {
+		if req.SignalId != nil && req.GetSignalId() != queue.ID() {
+			continue
+		}
+
+		stats, err := queue.Stats(ctx)
+		if err != nil {
+			return nil, errors.Errorf("failed to get stats for %s signal: %w", queue.ID(), err)
+		}
+
+		var deadLetters []signals.StoredEvent
+		if req.GetDeadLetterLimit() > 0 {
+			deadLetters, err = queue.DeadLetters(ctx, int(req.GetDeadLetterLimit()))
+			if err != nil {
+				return nil, errors.Errorf("failed to get dead letters for %s signal: %w", queue.ID(), err)
+			}
+		}
+
+		queues = append(queues, convertSignalQueueStatusToPB(stats, deadLetters))
+	}
+
+	if req.SignalId != nil && len(queues) == 0 {
+		return nil, status.Errorf(codes.NotFound, "signal %q not found", req.GetSignalId())
+	}
+
+	return &apiv1.GetSignalQueueStatusResponse{Queues: queues}, nil
+}
+
+func convertSignalQueueStatusToPB(stats signals.QueueStats, deadLetters []signals.StoredEvent) *apiv1.SignalQueueStatus {
+	result := &apiv1.SignalQueueStatus{
+		SignalId:        stats.ID,
+		Durable:         stats.Durable,
+		Queued:          uint32(stats.Queued),
+		Processing:      uint32(stats.Processing),
+		Pending:         uint32(stats.Pending),
+		DeadLetterCount: uint32(stats.DeadLetters),
+		DeadLetters:     make([]*apiv1.SignalDeadLetter, 0, len(deadLetters)),
+	}
+	for _, event := range deadLetters {
+		result.DeadLetters = append(result.DeadLetters, &apiv1.SignalDeadLetter{
+			Seq:       event.Seq,
+			Attempts:  event.Attempts,
+			LastError: event.LastError,
+			CreatedAt: timestamppb.New(event.CreatedAt),
+			Payload:   event.Payload,
+		})
+	}
+	return result
+}
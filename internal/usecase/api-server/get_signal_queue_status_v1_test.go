@@ -0,0 +1,89 @@
+package api_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	"github.com/symbioticfi/relay/internal/usecase/api-server/mocks"
+	"github.com/symbioticfi/relay/pkg/signals"
+)
+
+func newMockSignalQueue(ctrl *gomock.Controller, id string) *mocks.MockSignalQueue {
+	queue := mocks.NewMockSignalQueue(ctrl)
+	queue.EXPECT().ID().Return(id).AnyTimes()
+	return queue
+}
+
+func TestGetSignalQueueStatus_ReturnsAllQueues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	durable := newMockSignalQueue(ctrl, "signatureProcessed")
+	inMemory := newMockSignalQueue(ctrl, "validatorSet")
+	handler := &grpcHandler{cfg: Config{SignalQueues: []SignalQueue{durable, inMemory}}}
+
+	createdAt := time.Unix(1700000000, 0)
+	durable.EXPECT().Stats(ctx).Return(signals.QueueStats{
+		ID: "signatureProcessed", Durable: true, Queued: 2, Processing: 1, Pending: 3, DeadLetters: 1,
+	}, nil)
+	durable.EXPECT().DeadLetters(ctx, 5).Return([]signals.StoredEvent{
+		{Seq: 7, Attempts: 5, LastError: "boom", CreatedAt: createdAt, Payload: []byte{0x01}},
+	}, nil)
+	inMemory.EXPECT().Stats(ctx).Return(signals.QueueStats{ID: "validatorSet", Queued: 1}, nil)
+	inMemory.EXPECT().DeadLetters(ctx, 5).Return(nil, nil)
+
+	resp, err := handler.GetSignalQueueStatus(ctx, &apiv1.GetSignalQueueStatusRequest{DeadLetterLimit: 5})
+	require.NoError(t, err)
+	require.Len(t, resp.GetQueues(), 2)
+
+	first := resp.GetQueues()[0]
+	require.Equal(t, "signatureProcessed", first.GetSignalId())
+	require.True(t, first.GetDurable())
+	require.EqualValues(t, 2, first.GetQueued())
+	require.EqualValues(t, 1, first.GetProcessing())
+	require.EqualValues(t, 3, first.GetPending())
+	require.EqualValues(t, 1, first.GetDeadLetterCount())
+	require.Len(t, first.GetDeadLetters(), 1)
+	require.EqualValues(t, 7, first.GetDeadLetters()[0].GetSeq())
+	require.Equal(t, "boom", first.GetDeadLetters()[0].GetLastError())
+	require.Equal(t, createdAt.Unix(), first.GetDeadLetters()[0].GetCreatedAt().AsTime().Unix())
+
+	second := resp.GetQueues()[1]
+	require.Equal(t, "validatorSet", second.GetSignalId())
+	require.False(t, second.GetDurable())
+	require.Empty(t, second.GetDeadLetters())
+}
+
+func TestGetSignalQueueStatus_FiltersBySignalID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	first := newMockSignalQueue(ctrl, "signatureProcessed")
+	second := newMockSignalQueue(ctrl, "aggProofReady")
+	handler := &grpcHandler{cfg: Config{SignalQueues: []SignalQueue{first, second}}}
+
+	second.EXPECT().Stats(ctx).Return(signals.QueueStats{ID: "aggProofReady", Durable: true}, nil)
+
+	signalID := "aggProofReady"
+	resp, err := handler.GetSignalQueueStatus(ctx, &apiv1.GetSignalQueueStatusRequest{SignalId: &signalID})
+	require.NoError(t, err)
+	require.Len(t, resp.GetQueues(), 1)
+	require.Equal(t, "aggProofReady", resp.GetQueues()[0].GetSignalId())
+}
+
+func TestGetSignalQueueStatus_UnknownSignal_ReturnsNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := &grpcHandler{cfg: Config{SignalQueues: []SignalQueue{newMockSignalQueue(ctrl, "validatorSet")}}}
+
+	signalID := "unknown"
+	_, err := handler.GetSignalQueueStatus(context.Background(), &apiv1.GetSignalQueueStatusRequest{SignalId: &signalID})
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
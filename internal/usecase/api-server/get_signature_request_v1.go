@@ -2,14 +2,22 @@ package api_server
 
 import (
 	"context"
+	"encoding/json"
+	"math/big"
+	"slices"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/go-errors/errors"
+	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/symbioticfi/relay/internal/entity"
 	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
 )
 
 // GetSignatureRequest handles the gRPC GetSignatureRequest request
@@ -24,12 +32,129 @@ func (h *grpcHandler) GetSignatureRequest(ctx context.Context, req *apiv1.GetSig
 		return nil, err
 	}
 
+	requestStatus, err := h.signatureRequestStatus(ctx, requestID, signatureRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureRequestPB, err := convertSignatureRequestToPB(requestID, signatureRequest, requestStatus)
+	if err != nil {
+		return nil, err
+	}
+
 	return &apiv1.GetSignatureRequestResponse{
-		SignatureRequest: &apiv1.SignatureRequest{
-			RequestId:     requestID.Hex(),
-			KeyTag:        uint32(signatureRequest.KeyTag),
-			Message:       signatureRequest.Message,
-			RequiredEpoch: uint64(signatureRequest.RequiredEpoch),
-		},
+		SignatureRequest: signatureRequestPB,
+	}, nil
+}
+
+// signatureRequestStatus resolves the lifecycle state of a request from the signatures and the proof stored on this relay
+func (h *grpcHandler) signatureRequestStatus(ctx context.Context, requestID common.Hash, req symbiotic.SignatureRequest) (apiv1.SignatureRequestStatus, error) {
+	aggregated := true
+	if _, err := h.cfg.Repo.GetAggregationProof(ctx, requestID); err != nil {
+		if !errors.Is(err, entity.ErrEntityNotFound) {
+			return apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED, errors.Errorf("failed to get aggregation proof: %w", err)
+		}
+		aggregated = false
+	}
+
+	signatures, err := h.cfg.Repo.GetAllSignatures(ctx, requestID)
+	if err != nil && !errors.Is(err, entity.ErrEntityNotFound) {
+		return apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED, errors.Errorf("failed to get signatures: %w", err)
+	}
+
+	return convertSignatureRequestStatusToPB(req.Status(time.Now(), len(signatures) > 0, aggregated)), nil
+}
+
+// streamedRequestStatus resolves the lifecycle state of a request a streamed signature or proof belongs to,
+// requests are local to relays so it is unspecified for requests unknown to this relay
+func (h *grpcHandler) streamedRequestStatus(ctx context.Context, requestID common.Hash, aggregated bool) (apiv1.SignatureRequestStatus, error) {
+	req, err := h.cfg.Repo.GetSignatureRequest(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED, nil
+		}
+		return apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED, errors.Errorf("failed to get signature request: %w", err)
+	}
+	if !aggregated {
+		// the proof may already be aggregated when a late signature arrives
+		if _, err := h.cfg.Repo.GetAggregationProof(ctx, requestID); err == nil {
+			aggregated = true
+		} else if !errors.Is(err, entity.ErrEntityNotFound) {
+			return apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED, errors.Errorf("failed to get aggregation proof: %w", err)
+		}
+	}
+	return convertSignatureRequestStatusToPB(req.Status(time.Now(), true, aggregated)), nil
+}
+
+func convertSignatureRequestStatusToPB(s symbiotic.SignatureRequestStatus) apiv1.SignatureRequestStatus {
+	switch s {
+	case symbiotic.SignatureRequestPending:
+		return apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_PENDING
+	case symbiotic.SignatureRequestSigned:
+		return apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_SIGNED
+	case symbiotic.SignatureRequestAggregated:
+		return apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_AGGREGATED
+	case symbiotic.SignatureRequestExpired:
+		return apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_EXPIRED
+	case symbiotic.SignatureRequestCancelled:
+		return apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_CANCELLED
+	default:
+		return apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+func convertSignatureRequestToPB(requestID common.Hash, req symbiotic.SignatureRequest, requestStatus apiv1.SignatureRequestStatus) (*apiv1.SignatureRequest, error) {
+	result := &apiv1.SignatureRequest{
+		RequestId:     requestID.Hex(),
+		KeyTag:        uint32(req.KeyTag),
+		Message:       req.Message,
+		RequiredEpoch: uint64(req.RequiredEpoch),
+		Status:        requestStatus,
+	}
+	if !req.Deadline.IsZero() {
+		result.Deadline = timestamppb.New(req.Deadline)
+	}
+	if req.TypedData != nil {
+		typedData, err := convertTypedDataToPB(*req.TypedData)
+		if err != nil {
+			return nil, errors.Errorf("failed to convert typed data of request %s: %w", requestID.Hex(), err)
+		}
+		result.TypedData = typedData
+	}
+	return result, nil
+}
+
+func convertTypedDataToPB(typedData apitypes.TypedData) (*apiv1.TypedData, error) {
+	message, err := json.Marshal(typedData.Message)
+	if err != nil {
+		return nil, errors.Errorf("failed to marshal typed data message: %w", err)
+	}
+
+	domain := &apiv1.Eip712Domain{
+		Name:              typedData.Domain.Name,
+		Version:           typedData.Domain.Version,
+		VerifyingContract: typedData.Domain.VerifyingContract,
+		Salt:              typedData.Domain.Salt,
+	}
+	if typedData.Domain.ChainId != nil {
+		domain.ChainId = (*big.Int)(typedData.Domain.ChainId).Uint64()
+	}
+
+	// types are a map, sort them to keep responses stable
+	names := lo.Keys(typedData.Types)
+	slices.Sort(names)
+
+	return &apiv1.TypedData{
+		Domain: domain,
+		Types: lo.Map(names, func(name string, _ int) *apiv1.TypedDataStruct {
+			return &apiv1.TypedDataStruct{
+				Name: name,
+				Fields: lo.Map(typedData.Types[name], func(field apitypes.Type, _ int) *apiv1.TypedDataField {
+					return &apiv1.TypedDataField{Name: field.Name, Type: field.Type}
+				}),
+			}
+		}),
+		PrimaryType: typedData.PrimaryType,
+		Message:     string(message),
 	}, nil
 }
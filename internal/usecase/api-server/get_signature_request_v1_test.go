@@ -3,6 +3,7 @@ package api_server
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
@@ -28,6 +29,8 @@ func TestGetSignatureRequest_Success(t *testing.T) {
 	}
 
 	setup.mockRepo.EXPECT().GetSignatureRequest(ctx, requestID).Return(expectedRequest, nil)
+	setup.mockRepo.EXPECT().GetAggregationProof(ctx, requestID).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	setup.mockRepo.EXPECT().GetAllSignatures(ctx, requestID).Return([]symbiotic.Signature{{}}, nil)
 
 	req := &apiv1.GetSignatureRequestRequest{
 		RequestId: requestIDStr,
@@ -44,6 +47,65 @@ func TestGetSignatureRequest_Success(t *testing.T) {
 	require.Equal(t, uint32(15), response.GetSignatureRequest().GetKeyTag())
 	require.Equal(t, []byte("test message"), response.GetSignatureRequest().GetMessage())
 	require.Equal(t, uint64(5), response.GetSignatureRequest().GetRequiredEpoch())
+	require.Equal(t, apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_SIGNED, response.GetSignatureRequest().GetStatus())
+	require.Nil(t, response.GetSignatureRequest().GetDeadline())
+}
+
+func TestGetSignatureRequest_Expired_ReturnsDeadlineAndStatus(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+	requestID := common.HexToHash("0xabcd")
+	deadline := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+	setup.mockRepo.EXPECT().GetSignatureRequest(ctx, requestID).Return(symbiotic.SignatureRequest{
+		KeyTag:        15,
+		RequiredEpoch: 5,
+		Message:       []byte("test message"),
+		Deadline:      deadline,
+	}, nil)
+	setup.mockRepo.EXPECT().GetAggregationProof(ctx, requestID).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	setup.mockRepo.EXPECT().GetAllSignatures(ctx, requestID).Return([]symbiotic.Signature{{}}, nil)
+
+	response, err := setup.handler.GetSignatureRequest(ctx, &apiv1.GetSignatureRequestRequest{RequestId: requestID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_EXPIRED, response.GetSignatureRequest().GetStatus())
+	require.True(t, deadline.Equal(response.GetSignatureRequest().GetDeadline().AsTime()))
+}
+
+func TestGetSignatureRequest_WithTypedData_ReturnsDecodedStructure(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+	requestID := common.HexToHash("0xabcd")
+
+	typedData, err := convertTypedDataFromPB(testTypedDataPB())
+	require.NoError(t, err)
+	message, err := symbiotic.EncodeTypedData(typedData)
+	require.NoError(t, err)
+
+	setup.mockRepo.EXPECT().GetSignatureRequest(ctx, requestID).Return(symbiotic.SignatureRequest{
+		KeyTag:        15,
+		RequiredEpoch: 5,
+		Message:       message,
+		TypedData:     &typedData,
+	}, nil)
+	setup.mockRepo.EXPECT().GetAggregationProof(ctx, requestID).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	setup.mockRepo.EXPECT().GetAllSignatures(ctx, requestID).Return(nil, nil)
+
+	response, err := setup.handler.GetSignatureRequest(ctx, &apiv1.GetSignatureRequestRequest{RequestId: requestID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []byte(message), response.GetSignatureRequest().GetMessage())
+
+	expected := testTypedDataPB()
+	actual := response.GetSignatureRequest().GetTypedData()
+	require.NotNil(t, actual)
+	require.Equal(t, expected.GetPrimaryType(), actual.GetPrimaryType())
+	require.Equal(t, expected.GetDomain().GetChainId(), actual.GetDomain().GetChainId())
+	require.Equal(t, expected.GetDomain().GetVerifyingContract(), actual.GetDomain().GetVerifyingContract())
+	require.JSONEq(t, expected.GetMessage(), actual.GetMessage())
+	require.Len(t, actual.GetTypes(), 2)
+	require.Equal(t, "EIP712Domain", actual.GetTypes()[0].GetName())
+	require.Equal(t, "Vote", actual.GetTypes()[1].GetName())
+	require.Len(t, actual.GetTypes()[1].GetFields(), 2)
 }
 
 func TestGetSignatureRequest_NotFound(t *testing.T) {
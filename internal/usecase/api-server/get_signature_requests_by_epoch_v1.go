@@ -4,8 +4,6 @@ import (
 	"context"
 
 	"github.com/go-errors/errors"
-	"github.com/samber/lo"
-	"github.com/symbioticfi/relay/internal/entity"
 	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
 )
@@ -19,14 +17,20 @@ func (h *grpcHandler) GetSignatureRequestsByEpoch(ctx context.Context, req *apiv
 		return nil, errors.Errorf("failed to get signature requests by epoch: %w", err)
 	}
 
+	signatureRequests := make([]*apiv1.SignatureRequest, 0, len(signatureRequestsWithID))
+	for _, reqWithID := range signatureRequestsWithID {
+		requestStatus, err := h.signatureRequestStatus(ctx, reqWithID.RequestID, reqWithID.SignatureRequest)
+		if err != nil {
+			return nil, err
+		}
+		signatureRequest, err := convertSignatureRequestToPB(reqWithID.RequestID, reqWithID.SignatureRequest, requestStatus)
+		if err != nil {
+			return nil, err
+		}
+		signatureRequests = append(signatureRequests, signatureRequest)
+	}
+
 	return &apiv1.GetSignatureRequestsByEpochResponse{
-		SignatureRequests: lo.Map(signatureRequestsWithID, func(reqWithID entity.SignatureRequestWithID, _ int) *apiv1.SignatureRequest {
-			return &apiv1.SignatureRequest{
-				RequestId:     reqWithID.RequestID.Hex(),
-				KeyTag:        uint32(reqWithID.SignatureRequest.KeyTag),
-				Message:       reqWithID.SignatureRequest.Message,
-				RequiredEpoch: uint64(reqWithID.SignatureRequest.RequiredEpoch),
-			}
-		}),
+		SignatureRequests: signatureRequests,
 	}, nil
 }
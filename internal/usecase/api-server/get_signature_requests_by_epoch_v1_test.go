@@ -42,6 +42,10 @@ func TestGetSignatureRequestsByEpoch_Success(t *testing.T) {
 	}
 
 	setup.mockRepo.EXPECT().GetSignatureRequestsWithIDByEpoch(ctx, requestedEpoch).Return(expectedRequests, nil)
+	setup.mockRepo.EXPECT().GetAggregationProof(ctx, requestID1).Return(symbiotic.AggregationProof{}, nil)
+	setup.mockRepo.EXPECT().GetAllSignatures(ctx, requestID1).Return([]symbiotic.Signature{{}}, nil)
+	setup.mockRepo.EXPECT().GetAggregationProof(ctx, requestID2).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	setup.mockRepo.EXPECT().GetAllSignatures(ctx, requestID2).Return(nil, nil)
 
 	req := &apiv1.GetSignatureRequestsByEpochRequest{
 		Epoch: uint64(requestedEpoch),
@@ -52,6 +56,8 @@ func TestGetSignatureRequestsByEpoch_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	require.Len(t, response.GetSignatureRequests(), 2)
+	require.Equal(t, apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_AGGREGATED, response.GetSignatureRequests()[0].GetStatus())
+	require.Equal(t, apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_PENDING, response.GetSignatureRequests()[1].GetStatus())
 
 	// Verify first request
 	require.Equal(t, requestID1.Hex(), response.GetSignatureRequests()[0].GetRequestId())
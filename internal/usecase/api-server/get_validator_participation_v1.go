@@ -0,0 +1,64 @@
+package api_server
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// GetValidatorParticipation handles the gRPC GetValidatorParticipation request
+func (h *grpcHandler) GetValidatorParticipation(ctx context.Context, req *apiv1.GetValidatorParticipationRequest) (*apiv1.GetValidatorParticipationResponse, error) {
+	var epochRequested symbiotic.Epoch
+	if req.Epoch == nil {
+		latestEpoch, err := h.cfg.Repo.GetLatestValidatorSetEpoch(ctx)
+		if err != nil {
+			if errors.Is(err, entity.ErrEntityNotFound) {
+				return nil, status.Error(codes.NotFound, "no validator sets found")
+			}
+			return nil, errors.Errorf("failed to get latest validator set epoch: %w", err)
+		}
+		epochRequested = latestEpoch
+	} else {
+		epochRequested = symbiotic.Epoch(req.GetEpoch())
+	}
+
+	var operator common.Address
+	if req.Operator != nil {
+		if !common.IsHexAddress(req.GetOperator()) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid operator address format: %s", req.GetOperator())
+		}
+		operator = common.HexToAddress(req.GetOperator())
+	}
+
+	participations, err := h.cfg.Repo.GetValidatorParticipations(ctx, epochRequested)
+	if err != nil {
+		return nil, errors.Errorf("failed to get validator participations for epoch %d: %w", epochRequested, err)
+	}
+
+	items := make([]*apiv1.ValidatorParticipation, 0, len(participations))
+	for _, participation := range participations {
+		if req.Operator != nil && participation.Operator != operator {
+			continue
+		}
+		items = append(items, &apiv1.ValidatorParticipation{
+			Operator:      participation.Operator.Hex(),
+			Seen:          participation.Seen,
+			Signed:        participation.Signed,
+			Missed:        participation.Missed,
+			MedianLatency: durationpb.New(participation.MedianLatency()),
+		})
+	}
+
+	return &apiv1.GetValidatorParticipationResponse{
+		Epoch:          uint64(epochRequested),
+		Participations: items,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package api_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func TestGetValidatorParticipation_WithoutEpoch_ReturnsAllValidators(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+	epoch := symbiotic.Epoch(7)
+
+	first := entity.ValidatorParticipation{Epoch: epoch, Operator: common.HexToAddress("0x1"), Seen: 3, Signed: 2, Missed: 1}
+	first.ObserveLatency(0)
+	first.ObserveLatency(0)
+	second := entity.ValidatorParticipation{Epoch: epoch, Operator: common.HexToAddress("0x2"), Seen: 3, Missed: 3}
+
+	setup.mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(epoch, nil)
+	setup.mockRepo.EXPECT().GetValidatorParticipations(ctx, epoch).Return([]entity.ValidatorParticipation{first, second}, nil)
+
+	response, err := setup.handler.GetValidatorParticipation(ctx, &apiv1.GetValidatorParticipationRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), response.GetEpoch())
+	require.Len(t, response.GetParticipations(), 2)
+
+	assert.Equal(t, first.Operator.Hex(), response.GetParticipations()[0].GetOperator())
+	assert.Equal(t, uint64(3), response.GetParticipations()[0].GetSeen())
+	assert.Equal(t, uint64(2), response.GetParticipations()[0].GetSigned())
+	assert.Equal(t, uint64(1), response.GetParticipations()[0].GetMissed())
+	assert.Equal(t, 25*time.Millisecond, response.GetParticipations()[0].GetMedianLatency().AsDuration())
+
+	assert.Equal(t, uint64(3), response.GetParticipations()[1].GetMissed())
+	assert.Zero(t, response.GetParticipations()[1].GetMedianLatency().AsDuration())
+}
+
+func TestGetValidatorParticipation_WithOperator_FiltersValidator(t *testing.T) {
+	setup := newTestSetup(t)
+	ctx := context.Background()
+	epoch := uint64(5)
+	operator := common.HexToAddress("0x2").Hex()
+
+	setup.mockRepo.EXPECT().GetValidatorParticipations(ctx, symbiotic.Epoch(epoch)).Return([]entity.ValidatorParticipation{
+		{Epoch: 5, Operator: common.HexToAddress("0x1"), Seen: 1, Signed: 1},
+		{Epoch: 5, Operator: common.HexToAddress("0x2"), Seen: 1, Missed: 1},
+	}, nil)
+
+	response, err := setup.handler.GetValidatorParticipation(ctx, &apiv1.GetValidatorParticipationRequest{Epoch: &epoch, Operator: &operator})
+	require.NoError(t, err)
+	require.Len(t, response.GetParticipations(), 1)
+	assert.Equal(t, operator, response.GetParticipations()[0].GetOperator())
+	assert.Equal(t, uint64(1), response.GetParticipations()[0].GetMissed())
+}
+
+func TestGetValidatorParticipation_InvalidOperator(t *testing.T) {
+	setup := newTestSetup(t)
+	operator := "not-an-address"
+
+	response, err := setup.handler.GetValidatorParticipation(context.Background(), &apiv1.GetValidatorParticipationRequest{Operator: &operator, Epoch: new(uint64)})
+	require.Nil(t, response)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
@@ -6,10 +6,14 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/symbioticfi/relay/internal/entity"
 	"github.com/symbioticfi/relay/internal/usecase/api-server/mocks"
+	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
 	deriverMocks "github.com/symbioticfi/relay/symbiotic/usecase/valset-deriver/mocks"
 )
 
@@ -61,6 +65,12 @@ func newTestSetup(t *testing.T) *testSetup {
 	}
 }
 
+// expectUnknownSignatureRequests makes the repo report every signature request as unknown to the relay,
+// as for signatures and proofs of requests created on other relays
+func expectUnknownSignatureRequests(mockRepo *mocks.Mockrepo) {
+	mockRepo.EXPECT().GetSignatureRequest(gomock.Any(), gomock.Any()).Return(symbiotic.SignatureRequest{}, entity.ErrEntityNotFound).AnyTimes()
+}
+
 // createTestValidatorSet creates a sample validator set for testing
 // This is the simpler version used for GetValidatorSetHeader tests
 func createTestValidatorSet(epoch symbiotic.Epoch) symbiotic.ValidatorSet {
@@ -148,3 +158,22 @@ func createTestValidatorSetWithMultipleValidators(epoch symbiotic.Epoch) symbiot
 		Status: symbiotic.HeaderDerived,
 	}
 }
+
+// newRotatingKeyProvider creates a key provider of a node in the middle of a key rotation of key tag 15,
+// it returns the onchain key of the next key
+func newRotatingKeyProvider(t *testing.T) (*keyprovider.CacheKeyProvider, symbiotic.CompactPublicKey) {
+	t.Helper()
+
+	keyTag := symbiotic.KeyTag(15)
+	simple, err := keyprovider.NewSimpleKeystoreProvider()
+	require.NoError(t, err)
+
+	current, err := crypto.NewPrivateKey(symbiotic.KeyTypeBlsBn254, []byte("current"))
+	require.NoError(t, err)
+	next, err := crypto.NewPrivateKey(symbiotic.KeyTypeBlsBn254, []byte("next"))
+	require.NoError(t, err)
+	require.NoError(t, simple.AddKey(keyTag, current))
+	require.NoError(t, simple.AddNextKey(keyTag, next))
+
+	return keyprovider.NewCacheKeyProvider(simple), next.PublicKey().OnChain()
+}
@@ -113,11 +113,23 @@ func (s *sseResponseWriter) Flush() {
 	}
 }
 
+// incomingHeaderMatcher forwards the client ID and token headers of HTTP requests to gRPC next to the default headers
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, ClientIDHeader) {
+		return ClientIDHeader, true
+	}
+	if strings.EqualFold(key, ClientTokenHeader) {
+		return ClientTokenHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 // setupHttpProxy configures the HTTP-to-gRPC gateway proxy
 // Returns a start function that should be called after the gRPC server starts listening
 func setupHttpProxy(ctx context.Context, grpcAddr string, httpMux *http.ServeMux) func() error {
 	gwMux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{}),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
 	)
 
 	// Create gRPC client connection to the actual gRPC server via TCP
@@ -35,6 +35,12 @@ func convertToGRPCError(ctx context.Context, err error) error {
 		return status.Error(codes.NotFound, "Chain not found")
 	case errors.Is(err, entity.ErrEntityAlreadyExist):
 		return status.Error(codes.AlreadyExists, "Entity already exists")
+	case errors.Is(err, entity.ErrNotCancellable):
+		return status.Error(codes.FailedPrecondition, "Signature request can not be cancelled")
+	case errors.Is(err, entity.ErrRequestInactive):
+		return status.Error(codes.FailedPrecondition, "Signature request is cancelled or expired")
+	case errors.Is(err, entity.ErrClientUnauthenticated):
+		return status.Error(codes.Unauthenticated, "Client token is missing or invalid")
 	case errors.Is(err, entity.ErrNoPeers):
 		return status.Error(codes.Unavailable, "No peers available")
 	case errors.Is(err, context.Canceled):
@@ -87,6 +87,45 @@ func TestConvertToGRPCError_ErrEntityAlreadyExist_ReturnsAlreadyExists(t *testin
 	assert.Contains(t, st.Message(), "Entity already exists")
 }
 
+func TestConvertToGRPCError_ErrNotCancellable_ReturnsFailedPrecondition(t *testing.T) {
+	ctx := context.Background()
+	err := errors.Errorf("request created by the relay: %w", entity.ErrNotCancellable)
+
+	result := convertToGRPCError(ctx, err)
+
+	require.Error(t, result)
+	st, ok := status.FromError(result)
+	require.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Contains(t, st.Message(), "can not be cancelled")
+}
+
+func TestConvertToGRPCError_ErrRequestInactive_ReturnsFailedPrecondition(t *testing.T) {
+	ctx := context.Background()
+	err := errors.Errorf("request is cancelled: %w", entity.ErrRequestInactive)
+
+	result := convertToGRPCError(ctx, err)
+
+	require.Error(t, result)
+	st, ok := status.FromError(result)
+	require.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Contains(t, st.Message(), "cancelled or expired")
+}
+
+func TestConvertToGRPCError_ErrClientUnauthenticated_ReturnsUnauthenticated(t *testing.T) {
+	ctx := context.Background()
+	err := errors.Errorf("client \"bridge\": %w", entity.ErrClientUnauthenticated)
+
+	result := convertToGRPCError(ctx, err)
+
+	require.Error(t, result)
+	st, ok := status.FromError(result)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.Contains(t, st.Message(), "token")
+}
+
 func TestConvertToGRPCError_ErrNoPeers_ReturnsUnavailable(t *testing.T) {
 	ctx := context.Background()
 	err := entity.ErrNoPeers
@@ -0,0 +1,59 @@
+package api_server
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func (h *grpcHandler) ListenEquivocationEvidence(
+	req *apiv1.ListenEquivocationEvidenceRequest,
+	stream grpc.ServerStreamingServer[apiv1.ListenEquivocationEvidenceResponse],
+) error {
+	ctx := stream.Context()
+
+	if h.equivocationHub.Count() >= h.cfg.MaxAllowedStreamsCount {
+		return status.Errorf(codes.ResourceExhausted, "max allowed streams limit reached")
+	}
+
+	subscriptionID := uuid.New()
+
+	evidenceCh := h.equivocationHub.Subscribe(subscriptionID.String())
+	defer h.equivocationHub.Unsubscribe(subscriptionID.String())
+
+	send := func(evidence symbiotic.EquivocationEvidence) error {
+		item, err := convertEquivocationEvidenceToPB(evidence)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&apiv1.ListenEquivocationEvidenceResponse{Evidence: item})
+	}
+
+	if epoch := req.GetStartEpoch(); epoch != 0 {
+		evidence, err := h.cfg.Repo.GetEquivocationEvidenceStartingFromEpoch(ctx, symbiotic.Epoch(epoch))
+		if err != nil {
+			return err
+		}
+
+		for _, e := range evidence {
+			if err := send(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case evidence := <-evidenceCh:
+			if err := send(evidence); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package api_server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	"github.com/symbioticfi/relay/internal/usecase/api-server/mocks"
+	"github.com/symbioticfi/relay/internal/usecase/broadcaster"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+type mockEquivocationStream struct {
+	ctx        context.Context
+	sentItems  []*apiv1.ListenEquivocationEvidenceResponse
+	sendCalled chan struct{}
+}
+
+func (m *mockEquivocationStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockEquivocationStream) Send(msg *apiv1.ListenEquivocationEvidenceResponse) error {
+	m.sentItems = append(m.sentItems, msg)
+	select {
+	case m.sendCalled <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (m *mockEquivocationStream) SendMsg(interface{}) error {
+	return nil
+}
+
+func (m *mockEquivocationStream) RecvMsg(interface{}) error {
+	return nil
+}
+
+func (m *mockEquivocationStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (m *mockEquivocationStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (m *mockEquivocationStream) SetTrailer(metadata.MD) {
+}
+
+func TestListenEquivocationEvidence_HistoricalAndBroadcast(t *testing.T) {
+	mockRepo := mocks.NewMockrepo(gomock.NewController(t))
+	equivocationHub := broadcaster.NewHub[symbiotic.EquivocationEvidence]()
+
+	handler := &grpcHandler{
+		cfg: Config{
+			Repo:                   mockRepo,
+			MaxAllowedStreamsCount: 10,
+		},
+		equivocationHub: equivocationHub,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &mockEquivocationStream{ctx: ctx, sendCalled: make(chan struct{}, 10)}
+
+	startEpoch := uint64(3)
+	mockRepo.EXPECT().GetEquivocationEvidenceStartingFromEpoch(ctx, symbiotic.Epoch(startEpoch)).
+		Return([]symbiotic.EquivocationEvidence{testEquivocationEvidence(3)}, nil)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.ListenEquivocationEvidence(&apiv1.ListenEquivocationEvidenceRequest{StartEpoch: &startEpoch}, stream)
+	}()
+
+	<-stream.sendCalled
+
+	equivocationHub.Broadcast(testEquivocationEvidence(5))
+	<-stream.sendCalled
+
+	cancel()
+	<-errCh
+
+	require.Len(t, stream.sentItems, 2)
+	require.Equal(t, uint64(3), stream.sentItems[0].GetEvidence().GetEpoch())
+	require.Equal(t, uint64(5), stream.sentItems[1].GetEvidence().GetEpoch())
+}
@@ -1,6 +1,8 @@
 package api_server
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
@@ -9,6 +11,12 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// proofEvent is a proof broadcast to the proof streams, upgraded if it replaced a worse proof of its request
+type proofEvent struct {
+	proof    symbiotic.AggregationProof
+	upgraded bool
+}
+
 func (h *grpcHandler) ListenProofs(
 	req *apiv1.ListenProofsRequest,
 	stream grpc.ServerStreamingServer[apiv1.ListenProofsResponse],
@@ -31,15 +39,7 @@ func (h *grpcHandler) ListenProofs(
 		}
 
 		for _, proof := range proofs {
-			if err = stream.Send(&apiv1.ListenProofsResponse{
-				RequestId: proof.RequestID().Hex(),
-				Epoch:     uint64(proof.Epoch),
-				AggregationProof: &apiv1.AggregationProof{
-					MessageHash: proof.MessageHash,
-					Proof:       proof.Proof,
-					RequestId:   proof.RequestID().Hex(),
-				},
-			}); err != nil {
+			if err := h.sendProof(ctx, stream, proofEvent{proof: proof}); err != nil {
 				return err
 			}
 		}
@@ -49,18 +49,29 @@ func (h *grpcHandler) ListenProofs(
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case proof := <-proofsCh:
-			if err := stream.Send(&apiv1.ListenProofsResponse{
-				RequestId: proof.RequestID().Hex(),
-				Epoch:     uint64(proof.Epoch),
-				AggregationProof: &apiv1.AggregationProof{
-					MessageHash: proof.MessageHash,
-					Proof:       proof.Proof,
-					RequestId:   proof.RequestID().Hex(),
-				},
-			}); err != nil {
+		case event := <-proofsCh:
+			if err := h.sendProof(ctx, stream, event); err != nil {
 				return err
 			}
 		}
 	}
 }
+
+func (h *grpcHandler) sendProof(ctx context.Context, stream grpc.ServerStreamingServer[apiv1.ListenProofsResponse], event proofEvent) error {
+	proof := event.proof
+	requestStatus, err := h.streamedRequestStatus(ctx, proof.RequestID(), true)
+	if err != nil {
+		return err
+	}
+	return stream.Send(&apiv1.ListenProofsResponse{
+		RequestId: proof.RequestID().Hex(),
+		Epoch:     uint64(proof.Epoch),
+		AggregationProof: &apiv1.AggregationProof{
+			MessageHash: proof.MessageHash,
+			Proof:       proof.Proof,
+			RequestId:   proof.RequestID().Hex(),
+		},
+		Status:   requestStatus,
+		Upgraded: event.upgraded,
+	})
+}
@@ -66,7 +66,8 @@ func TestListenProofs_OnlyHistoricalData(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockrepo(ctrl)
-	proofsHub := broadcaster.NewHub[symbiotic.AggregationProof]()
+	expectUnknownSignatureRequests(mockRepo)
+	proofsHub := broadcaster.NewHub[proofEvent]()
 
 	handler := &grpcHandler{
 		cfg: Config{
@@ -125,10 +126,14 @@ func TestListenProofs_OnlyHistoricalData(t *testing.T) {
 }
 
 func TestListenProofs_OnlyBroadcast(t *testing.T) {
-	proofsHub := broadcaster.NewHub[symbiotic.AggregationProof]()
+	proofsHub := broadcaster.NewHub[proofEvent]()
+
+	mockRepo := mocks.NewMockrepo(gomock.NewController(t))
+	expectUnknownSignatureRequests(mockRepo)
 
 	handler := &grpcHandler{
 		cfg: Config{
+			Repo:                   mockRepo,
 			MaxAllowedStreamsCount: 10,
 		},
 		proofsHub: proofsHub,
@@ -158,7 +163,7 @@ func TestListenProofs_OnlyBroadcast(t *testing.T) {
 		Proof:       common.Hex2Bytes("newProof"),
 	}
 
-	proofsHub.Broadcast(newProof)
+	proofsHub.Broadcast(proofEvent{proof: newProof})
 	<-stream.sendCalled
 
 	cancel()
@@ -169,12 +174,62 @@ func TestListenProofs_OnlyBroadcast(t *testing.T) {
 	require.Equal(t, []byte(newProof.Proof), stream.sentItems[0].GetAggregationProof().GetProof())
 }
 
+func TestListenProofs_UpgradedProof(t *testing.T) {
+	proofsHub := broadcaster.NewHub[proofEvent]()
+
+	mockRepo := mocks.NewMockrepo(gomock.NewController(t))
+	expectUnknownSignatureRequests(mockRepo)
+
+	server := &SymbioticServer{handler: &grpcHandler{
+		cfg: Config{
+			Repo:                   mockRepo,
+			MaxAllowedStreamsCount: 10,
+		},
+		proofsHub: proofsHub,
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &mockProofsStream{
+		ctx:        ctx,
+		sendCalled: make(chan struct{}, 10),
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.handler.ListenProofs(&apiv1.ListenProofsRequest{}, stream)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	proof := symbiotic.AggregationProof{
+		MessageHash: common.Hex2Bytes("newHash"),
+		KeyTag:      15,
+		Epoch:       10,
+		Proof:       common.Hex2Bytes("newProof"),
+	}
+
+	require.NoError(t, server.HandleProofAggregated()(ctx, proof))
+	<-stream.sendCalled
+	require.NoError(t, server.HandleProofUpgraded()(ctx, proof))
+	<-stream.sendCalled
+
+	cancel()
+	<-errCh
+
+	require.Len(t, stream.sentItems, 2)
+	require.False(t, stream.sentItems[0].GetUpgraded())
+	require.True(t, stream.sentItems[1].GetUpgraded())
+}
+
 func TestListenProofs_HistoricalAndBroadcast(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockrepo(ctrl)
-	proofsHub := broadcaster.NewHub[symbiotic.AggregationProof]()
+	expectUnknownSignatureRequests(mockRepo)
+	proofsHub := broadcaster.NewHub[proofEvent]()
 
 	handler := &grpcHandler{
 		cfg: Config{
@@ -228,7 +283,7 @@ func TestListenProofs_HistoricalAndBroadcast(t *testing.T) {
 		Proof:       common.Hex2Bytes("newProof"),
 	}
 
-	proofsHub.Broadcast(newProof)
+	proofsHub.Broadcast(proofEvent{proof: newProof})
 	<-stream.sendCalled
 
 	cancel()
@@ -245,7 +300,8 @@ func TestListenProofs_RepositoryError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockrepo(ctrl)
-	proofsHub := broadcaster.NewHub[symbiotic.AggregationProof]()
+	expectUnknownSignatureRequests(mockRepo)
+	proofsHub := broadcaster.NewHub[proofEvent]()
 
 	handler := &grpcHandler{
 		cfg: Config{
@@ -280,7 +336,8 @@ func TestListenProofs_StreamSendError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockrepo(ctrl)
-	proofsHub := broadcaster.NewHub[symbiotic.AggregationProof]()
+	expectUnknownSignatureRequests(mockRepo)
+	proofsHub := broadcaster.NewHub[proofEvent]()
 
 	handler := &grpcHandler{
 		cfg: Config{
@@ -322,10 +379,14 @@ func TestListenProofs_StreamSendError(t *testing.T) {
 }
 
 func TestListenProofs_MultipleBroadcasts(t *testing.T) {
-	proofsHub := broadcaster.NewHub[symbiotic.AggregationProof]()
+	proofsHub := broadcaster.NewHub[proofEvent]()
+
+	mockRepo := mocks.NewMockrepo(gomock.NewController(t))
+	expectUnknownSignatureRequests(mockRepo)
 
 	handler := &grpcHandler{
 		cfg: Config{
+			Repo:                   mockRepo,
 			MaxAllowedStreamsCount: 10,
 		},
 		proofsHub: proofsHub,
@@ -355,7 +416,7 @@ func TestListenProofs_MultipleBroadcasts(t *testing.T) {
 			Epoch:       symbiotic.Epoch(10 + i),
 			Proof:       common.Hex2Bytes(string(rune(i))),
 		}
-		proofsHub.Broadcast(newProof)
+		proofsHub.Broadcast(proofEvent{proof: newProof})
 		<-stream.sendCalled
 	}
 
@@ -366,7 +427,7 @@ func TestListenProofs_MultipleBroadcasts(t *testing.T) {
 }
 
 func TestListenProofs_MaxStreamsReached_ReturnsError(t *testing.T) {
-	proofsHub := broadcaster.NewHub[symbiotic.AggregationProof]()
+	proofsHub := broadcaster.NewHub[proofEvent]()
 
 	handler := &grpcHandler{
 		cfg: Config{
@@ -390,7 +451,8 @@ func TestListenProofs_EmptyHistoricalData(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockrepo(ctrl)
-	proofsHub := broadcaster.NewHub[symbiotic.AggregationProof]()
+	expectUnknownSignatureRequests(mockRepo)
+	proofsHub := broadcaster.NewHub[proofEvent]()
 
 	handler := &grpcHandler{
 		cfg: Config{
@@ -429,7 +491,7 @@ func TestListenProofs_EmptyHistoricalData(t *testing.T) {
 		Proof:       common.Hex2Bytes("newProof"),
 	}
 
-	proofsHub.Broadcast(newProof)
+	proofsHub.Broadcast(proofEvent{proof: newProof})
 	<-stream.sendCalled
 
 	cancel()
@@ -31,10 +31,15 @@ func (h *grpcHandler) ListenSignatures(
 		}
 
 		for _, signature := range signatures {
+			requestStatus, err := h.streamedRequestStatus(ctx, signature.RequestID(), false)
+			if err != nil {
+				return err
+			}
 			if err = stream.Send(&apiv1.ListenSignaturesResponse{
 				RequestId: signature.RequestID().Hex(),
 				Epoch:     uint64(signature.Epoch),
 				Signature: convertSignatureToPB(signature),
+				Status:    requestStatus,
 			}); err != nil {
 				return err
 			}
@@ -46,10 +51,15 @@ func (h *grpcHandler) ListenSignatures(
 		case <-ctx.Done():
 			return ctx.Err()
 		case signature := <-signatureCh:
+			requestStatus, err := h.streamedRequestStatus(ctx, signature.RequestID(), false)
+			if err != nil {
+				return err
+			}
 			if err := stream.Send(&apiv1.ListenSignaturesResponse{
 				RequestId: signature.RequestID().Hex(),
 				Epoch:     uint64(signature.Epoch),
 				Signature: convertSignatureToPB(signature),
+				Status:    requestStatus,
 			}); err != nil {
 				return err
 			}
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/symbioticfi/relay/internal/entity"
 	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
 	"github.com/symbioticfi/relay/internal/usecase/api-server/mocks"
 	"github.com/symbioticfi/relay/internal/usecase/broadcaster"
@@ -67,6 +68,7 @@ func TestListenSignatures_OnlyHistoricalData(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockrepo(ctrl)
+	expectUnknownSignatureRequests(mockRepo)
 	signatureHub := broadcaster.NewHub[symbiotic.Signature]()
 
 	handler := &grpcHandler{
@@ -134,8 +136,12 @@ func TestListenSignatures_OnlyHistoricalData(t *testing.T) {
 func TestListenSignatures_OnlyBroadcast(t *testing.T) {
 	signatureHub := broadcaster.NewHub[symbiotic.Signature]()
 
+	mockRepo := mocks.NewMockrepo(gomock.NewController(t))
+	expectUnknownSignatureRequests(mockRepo)
+
 	handler := &grpcHandler{
 		cfg: Config{
+			Repo:                   mockRepo,
 			MaxAllowedStreamsCount: 10,
 		},
 		signatureHub: signatureHub,
@@ -185,6 +191,7 @@ func TestListenSignatures_HistoricalAndBroadcast(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockrepo(ctrl)
+	expectUnknownSignatureRequests(mockRepo)
 	signatureHub := broadcaster.NewHub[symbiotic.Signature]()
 
 	handler := &grpcHandler{
@@ -262,6 +269,7 @@ func TestListenSignatures_RepositoryError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockrepo(ctrl)
+	expectUnknownSignatureRequests(mockRepo)
 	signatureHub := broadcaster.NewHub[symbiotic.Signature]()
 
 	handler := &grpcHandler{
@@ -297,6 +305,7 @@ func TestListenSignatures_StreamSendError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockrepo(ctrl)
+	expectUnknownSignatureRequests(mockRepo)
 	signatureHub := broadcaster.NewHub[symbiotic.Signature]()
 
 	handler := &grpcHandler{
@@ -345,8 +354,12 @@ func TestListenSignatures_StreamSendError(t *testing.T) {
 func TestListenSignatures_MultipleBroadcasts(t *testing.T) {
 	signatureHub := broadcaster.NewHub[symbiotic.Signature]()
 
+	mockRepo := mocks.NewMockrepo(gomock.NewController(t))
+	expectUnknownSignatureRequests(mockRepo)
+
 	handler := &grpcHandler{
 		cfg: Config{
+			Repo:                   mockRepo,
 			MaxAllowedStreamsCount: 10,
 		},
 		signatureHub: signatureHub,
@@ -409,3 +422,49 @@ func TestListenSignatures_MaxStreamsReached_ReturnsError(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "too many signatures")
 }
+
+func TestListenSignatures_IncludesRequestStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockrepo(ctrl)
+
+	handler := &grpcHandler{
+		cfg: Config{
+			Repo:                   mockRepo,
+			MaxAllowedStreamsCount: 10,
+		},
+		signatureHub: broadcaster.NewHub[symbiotic.Signature](),
+	}
+
+	priv, err := crypto.GeneratePrivateKey(symbiotic.KeyTypeBlsBn254)
+	require.NoError(t, err)
+
+	known := symbiotic.Signature{MessageHash: common.Hex2Bytes("abcd1234"), KeyTag: 15, Epoch: 5, PublicKey: priv.PublicKey()}
+	cancelled := symbiotic.Signature{MessageHash: common.Hex2Bytes("efgh5678"), KeyTag: 15, Epoch: 5, PublicKey: priv.PublicKey()}
+	unknown := symbiotic.Signature{MessageHash: common.Hex2Bytes("ijkl9012"), KeyTag: 15, Epoch: 5, PublicKey: priv.PublicKey()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	startEpoch := uint64(5)
+	mockRepo.EXPECT().GetSignaturesStartingFromEpoch(ctx, symbiotic.Epoch(startEpoch)).Return([]symbiotic.Signature{known, cancelled, unknown}, nil)
+	mockRepo.EXPECT().GetSignatureRequest(ctx, known.RequestID()).Return(symbiotic.SignatureRequest{KeyTag: 15, RequiredEpoch: 5}, nil)
+	mockRepo.EXPECT().GetAggregationProof(ctx, known.RequestID()).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	mockRepo.EXPECT().GetSignatureRequest(ctx, cancelled.RequestID()).Return(symbiotic.SignatureRequest{KeyTag: 15, RequiredEpoch: 5, Cancelled: true}, nil)
+	mockRepo.EXPECT().GetAggregationProof(ctx, cancelled.RequestID()).Return(symbiotic.AggregationProof{}, entity.ErrEntityNotFound)
+	mockRepo.EXPECT().GetSignatureRequest(ctx, unknown.RequestID()).Return(symbiotic.SignatureRequest{}, entity.ErrEntityNotFound)
+
+	stream := &mockSignaturesStream{ctx: ctx}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- handler.ListenSignatures(&apiv1.ListenSignaturesRequest{StartEpoch: &startEpoch}, stream)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	<-errCh
+
+	require.Len(t, stream.sentItems, 3)
+	require.Equal(t, apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_SIGNED, stream.sentItems[0].GetStatus())
+	require.Equal(t, apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_CANCELLED, stream.sentItems[1].GetStatus())
+	require.Equal(t, apiv1.SignatureRequestStatus_SIGNATURE_REQUEST_STATUS_UNSPECIFIED, stream.sentItems[2].GetStatus())
+}
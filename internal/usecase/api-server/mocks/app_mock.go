@@ -15,6 +15,7 @@ import (
 
 	common "github.com/ethereum/go-ethereum/common"
 	entity "github.com/symbioticfi/relay/internal/entity"
+	signals "github.com/symbioticfi/relay/pkg/signals"
 	entity0 "github.com/symbioticfi/relay/symbiotic/entity"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -43,6 +44,36 @@ func (m *Mocksigner) EXPECT() *MocksignerMockRecorder {
 	return m.recorder
 }
 
+// CancelSignatureRequest mocks base method.
+func (m *Mocksigner) CancelSignatureRequest(ctx context.Context, requestID common.Hash) (entity0.SignatureRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSignatureRequest", ctx, requestID)
+	ret0, _ := ret[0].(entity0.SignatureRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSignatureRequest indicates an expected call of CancelSignatureRequest.
+func (mr *MocksignerMockRecorder) CancelSignatureRequest(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSignatureRequest", reflect.TypeOf((*Mocksigner)(nil).CancelSignatureRequest), ctx, requestID)
+}
+
+// RequestBatchSignature mocks base method.
+func (m *Mocksigner) RequestBatchSignature(ctx context.Context, keyTag entity0.KeyTag, requiredEpoch entity0.Epoch, messages [][]byte, priority entity0.PriorityClass) (entity0.MessageBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestBatchSignature", ctx, keyTag, requiredEpoch, messages, priority)
+	ret0, _ := ret[0].(entity0.MessageBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestBatchSignature indicates an expected call of RequestBatchSignature.
+func (mr *MocksignerMockRecorder) RequestBatchSignature(ctx, keyTag, requiredEpoch, messages, priority any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestBatchSignature", reflect.TypeOf((*Mocksigner)(nil).RequestBatchSignature), ctx, keyTag, requiredEpoch, messages, priority)
+}
+
 // RequestSignature mocks base method.
 func (m *Mocksigner) RequestSignature(ctx context.Context, req entity0.SignatureRequest) (common.Hash, error) {
 	m.ctrl.T.Helper()
@@ -142,6 +173,51 @@ func (mr *MockrepoMockRecorder) GetAllSignatures(ctx, requestID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSignatures", reflect.TypeOf((*Mockrepo)(nil).GetAllSignatures), ctx, requestID)
 }
 
+// GetConfigByEpoch mocks base method.
+func (m *Mockrepo) GetConfigByEpoch(ctx context.Context, epoch entity0.Epoch) (entity0.NetworkConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigByEpoch", ctx, epoch)
+	ret0, _ := ret[0].(entity0.NetworkConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfigByEpoch indicates an expected call of GetConfigByEpoch.
+func (mr *MockrepoMockRecorder) GetConfigByEpoch(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigByEpoch", reflect.TypeOf((*Mockrepo)(nil).GetConfigByEpoch), ctx, epoch)
+}
+
+// GetEquivocationEvidenceByEpoch mocks base method.
+func (m *Mockrepo) GetEquivocationEvidenceByEpoch(ctx context.Context, epoch entity0.Epoch) ([]entity0.EquivocationEvidence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEquivocationEvidenceByEpoch", ctx, epoch)
+	ret0, _ := ret[0].([]entity0.EquivocationEvidence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEquivocationEvidenceByEpoch indicates an expected call of GetEquivocationEvidenceByEpoch.
+func (mr *MockrepoMockRecorder) GetEquivocationEvidenceByEpoch(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEquivocationEvidenceByEpoch", reflect.TypeOf((*Mockrepo)(nil).GetEquivocationEvidenceByEpoch), ctx, epoch)
+}
+
+// GetEquivocationEvidenceStartingFromEpoch mocks base method.
+func (m *Mockrepo) GetEquivocationEvidenceStartingFromEpoch(ctx context.Context, epoch entity0.Epoch) ([]entity0.EquivocationEvidence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEquivocationEvidenceStartingFromEpoch", ctx, epoch)
+	ret0, _ := ret[0].([]entity0.EquivocationEvidence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEquivocationEvidenceStartingFromEpoch indicates an expected call of GetEquivocationEvidenceStartingFromEpoch.
+func (mr *MockrepoMockRecorder) GetEquivocationEvidenceStartingFromEpoch(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEquivocationEvidenceStartingFromEpoch", reflect.TypeOf((*Mockrepo)(nil).GetEquivocationEvidenceStartingFromEpoch), ctx, epoch)
+}
+
 // GetLatestValidatorSetEpoch mocks base method.
 func (m *Mockrepo) GetLatestValidatorSetEpoch(arg0 context.Context) (entity0.Epoch, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +248,36 @@ func (mr *MockrepoMockRecorder) GetLatestValidatorSetHeader(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestValidatorSetHeader", reflect.TypeOf((*Mockrepo)(nil).GetLatestValidatorSetHeader), arg0)
 }
 
+// GetMessageBatch mocks base method.
+func (m *Mockrepo) GetMessageBatch(ctx context.Context, requestID common.Hash) (entity0.MessageBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageBatch", ctx, requestID)
+	ret0, _ := ret[0].(entity0.MessageBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageBatch indicates an expected call of GetMessageBatch.
+func (mr *MockrepoMockRecorder) GetMessageBatch(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageBatch", reflect.TypeOf((*Mockrepo)(nil).GetMessageBatch), ctx, requestID)
+}
+
+// GetSettlementCommitStatesByEpoch mocks base method.
+func (m *Mockrepo) GetSettlementCommitStatesByEpoch(ctx context.Context, epoch entity0.Epoch) ([]entity0.SettlementCommitState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettlementCommitStatesByEpoch", ctx, epoch)
+	ret0, _ := ret[0].([]entity0.SettlementCommitState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettlementCommitStatesByEpoch indicates an expected call of GetSettlementCommitStatesByEpoch.
+func (mr *MockrepoMockRecorder) GetSettlementCommitStatesByEpoch(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlementCommitStatesByEpoch", reflect.TypeOf((*Mockrepo)(nil).GetSettlementCommitStatesByEpoch), ctx, epoch)
+}
+
 // GetSignatureRequest mocks base method.
 func (m *Mockrepo) GetSignatureRequest(ctx context.Context, requestID common.Hash) (entity0.SignatureRequest, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +353,21 @@ func (mr *MockrepoMockRecorder) GetSignaturesStartingFromEpoch(ctx, epoch any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignaturesStartingFromEpoch", reflect.TypeOf((*Mockrepo)(nil).GetSignaturesStartingFromEpoch), ctx, epoch)
 }
 
+// GetValidatorParticipations mocks base method.
+func (m *Mockrepo) GetValidatorParticipations(ctx context.Context, epoch entity0.Epoch) ([]entity.ValidatorParticipation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorParticipations", ctx, epoch)
+	ret0, _ := ret[0].([]entity.ValidatorParticipation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorParticipations indicates an expected call of GetValidatorParticipations.
+func (mr *MockrepoMockRecorder) GetValidatorParticipations(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorParticipations", reflect.TypeOf((*Mockrepo)(nil).GetValidatorParticipations), ctx, epoch)
+}
+
 // GetValidatorSetByEpoch mocks base method.
 func (m *Mockrepo) GetValidatorSetByEpoch(arg0 context.Context, epoch entity0.Epoch) (entity0.ValidatorSet, error) {
 	m.ctrl.T.Helper()
@@ -483,17 +604,85 @@ func (m *MockkeyProvider) EXPECT() *MockkeyProviderMockRecorder {
 	return m.recorder
 }
 
-// GetOnchainKeyFromCache mocks base method.
-func (m *MockkeyProvider) GetOnchainKeyFromCache(keyTag entity0.KeyTag) (entity0.CompactPublicKey, error) {
+// GetOnchainKeyForValset mocks base method.
+func (m *MockkeyProvider) GetOnchainKeyForValset(valset entity0.ValidatorSet, keyTag entity0.KeyTag) (entity0.CompactPublicKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOnchainKeyFromCache", keyTag)
+	ret := m.ctrl.Call(m, "GetOnchainKeyForValset", valset, keyTag)
 	ret0, _ := ret[0].(entity0.CompactPublicKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOnchainKeyFromCache indicates an expected call of GetOnchainKeyFromCache.
-func (mr *MockkeyProviderMockRecorder) GetOnchainKeyFromCache(keyTag any) *gomock.Call {
+// GetOnchainKeyForValset indicates an expected call of GetOnchainKeyForValset.
+func (mr *MockkeyProviderMockRecorder) GetOnchainKeyForValset(valset, keyTag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnchainKeyForValset", reflect.TypeOf((*MockkeyProvider)(nil).GetOnchainKeyForValset), valset, keyTag)
+}
+
+// MockSignalQueue is a mock of SignalQueue interface.
+type MockSignalQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignalQueueMockRecorder
+	isgomock struct{}
+}
+
+// MockSignalQueueMockRecorder is the mock recorder for MockSignalQueue.
+type MockSignalQueueMockRecorder struct {
+	mock *MockSignalQueue
+}
+
+// NewMockSignalQueue creates a new mock instance.
+func NewMockSignalQueue(ctrl *gomock.Controller) *MockSignalQueue {
+	mock := &MockSignalQueue{ctrl: ctrl}
+	mock.recorder = &MockSignalQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignalQueue) EXPECT() *MockSignalQueueMockRecorder {
+	return m.recorder
+}
+
+// DeadLetters mocks base method.
+func (m *MockSignalQueue) DeadLetters(ctx context.Context, limit int) ([]signals.StoredEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetters", ctx, limit)
+	ret0, _ := ret[0].([]signals.StoredEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeadLetters indicates an expected call of DeadLetters.
+func (mr *MockSignalQueueMockRecorder) DeadLetters(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetters", reflect.TypeOf((*MockSignalQueue)(nil).DeadLetters), ctx, limit)
+}
+
+// ID mocks base method.
+func (m *MockSignalQueue) ID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ID indicates an expected call of ID.
+func (mr *MockSignalQueueMockRecorder) ID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockSignalQueue)(nil).ID))
+}
+
+// Stats mocks base method.
+func (m *MockSignalQueue) Stats(ctx context.Context) (signals.QueueStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx)
+	ret0, _ := ret[0].(signals.QueueStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockSignalQueueMockRecorder) Stats(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnchainKeyFromCache", reflect.TypeOf((*MockkeyProvider)(nil).GetOnchainKeyFromCache), keyTag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockSignalQueue)(nil).Stats), ctx)
 }
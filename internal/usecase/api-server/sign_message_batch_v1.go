@@ -0,0 +1,52 @@
+package api_server
+
+import (
+	"context"
+
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBatchMessages limits the size of a single batch to keep the stored tree and proof building cheap
+const maxBatchMessages = 10000
+
+// SignMessageBatch handles the gRPC SignMessageBatch request
+func (h *grpcHandler) SignMessageBatch(ctx context.Context, req *apiv1.SignMessageBatchRequest) (*apiv1.SignMessageBatchResponse, error) {
+	if len(req.GetMessages()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "batch must contain at least one message")
+	}
+	if len(req.GetMessages()) > maxBatchMessages {
+		return nil, status.Errorf(codes.InvalidArgument, "batch contains %d messages, at most %d are allowed", len(req.GetMessages()), maxBatchMessages)
+	}
+	keyTag := symbiotic.KeyTag(req.GetKeyTag())
+	if !keyTag.Type().AggregationKey() {
+		return nil, status.Errorf(codes.InvalidArgument, "key tag %s is not an aggregation key", keyTag)
+	}
+
+	requiredEpoch := req.RequiredEpoch
+	if req.RequiredEpoch == nil {
+		latestEpoch, err := h.cfg.Repo.GetLatestValidatorSetEpoch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		requiredEpoch = (*uint64)(&latestEpoch)
+	}
+
+	priority, err := h.clientPriority(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := h.cfg.Signer.RequestBatchSignature(ctx, keyTag, symbiotic.Epoch(*requiredEpoch), req.GetMessages(), priority)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.SignMessageBatchResponse{
+		RequestId:  batch.RequestID.Hex(),
+		Epoch:      *requiredEpoch,
+		MerkleRoot: batch.Root.Bytes(),
+	}, nil
+}
@@ -0,0 +1,63 @@
+package api_server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
+	"github.com/symbioticfi/relay/internal/usecase/api-server/mocks"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func TestSignMessageBatch_WithoutRequiredEpoch_UsesLatestEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSigner := mocks.NewMocksigner(ctrl)
+	mockRepo := mocks.NewMockrepo(ctrl)
+	handler := &grpcHandler{cfg: Config{Signer: mockSigner, Repo: mockRepo}}
+
+	ctx := context.Background()
+	messages := [][]byte{[]byte("first"), []byte("second")}
+	batch := symbiotic.MessageBatch{
+		RequestID: common.HexToHash("0x1234"),
+		Epoch:     7,
+		KeyTag:    15,
+		Root:      common.HexToHash("0xabcd"),
+	}
+
+	mockRepo.EXPECT().GetLatestValidatorSetEpoch(ctx).Return(symbiotic.Epoch(7), nil)
+	mockSigner.EXPECT().RequestBatchSignature(ctx, symbiotic.KeyTag(15), symbiotic.Epoch(7), messages, symbiotic.PriorityUnspecified).Return(batch, nil)
+
+	response, err := handler.SignMessageBatch(ctx, &apiv1.SignMessageBatchRequest{KeyTag: 15, Messages: messages})
+	require.NoError(t, err)
+	assert.Equal(t, batch.RequestID.Hex(), response.GetRequestId())
+	assert.Equal(t, uint64(7), response.GetEpoch())
+	assert.Equal(t, batch.Root.Bytes(), response.GetMerkleRoot())
+}
+
+func TestSignMessageBatch_InvalidRequest(t *testing.T) {
+	handler := &grpcHandler{}
+
+	tests := []struct {
+		name string
+		req  *apiv1.SignMessageBatchRequest
+	}{
+		{name: "empty batch", req: &apiv1.SignMessageBatchRequest{KeyTag: 15}},
+		{name: "too many messages", req: &apiv1.SignMessageBatchRequest{KeyTag: 15, Messages: make([][]byte, maxBatchMessages+1)}},
+		{name: "non aggregation key", req: &apiv1.SignMessageBatchRequest{KeyTag: 0x10, Messages: [][]byte{[]byte("msg")}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.SignMessageBatch(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
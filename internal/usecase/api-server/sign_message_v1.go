@@ -1,7 +1,18 @@
 package api_server
 
 import (
+	"bytes"
 	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/go-errors/errors"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
@@ -18,10 +29,39 @@ func (h *grpcHandler) SignMessage(ctx context.Context, req *apiv1.SignMessageReq
 		requiredEpoch = (*uint64)(&latestEpoch)
 	}
 
+	deadline, err := signRequestDeadline(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	priority, err := h.clientPriority(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	signReq := symbiotic.SignatureRequest{
 		KeyTag:        symbiotic.KeyTag(req.GetKeyTag()),
 		Message:       req.GetMessage(),
 		RequiredEpoch: symbiotic.Epoch(*requiredEpoch),
+		Deadline:      deadline,
+		Submitted:     true,
+		Priority:      priority,
+	}
+
+	if req.TypedData != nil {
+		typedData, err := convertTypedDataFromPB(req.GetTypedData())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid typed data: %v", err)
+		}
+		encoded, err := symbiotic.EncodeTypedData(typedData)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid typed data: %v", err)
+		}
+		if len(signReq.Message) > 0 && !bytes.Equal(signReq.Message, encoded) {
+			return nil, status.Error(codes.InvalidArgument, "message does not match the encoding of typed data")
+		}
+		signReq.Message = encoded
+		signReq.TypedData = &typedData
 	}
 
 	reqID, err := h.cfg.Signer.RequestSignature(ctx, signReq)
@@ -34,3 +74,85 @@ func (h *grpcHandler) SignMessage(ctx context.Context, req *apiv1.SignMessageReq
 		Epoch:     *requiredEpoch,
 	}, nil
 }
+
+// clientPriority returns the scheduling class of the API client identified by the ClientIDHeader metadata
+// and authenticated by the ClientTokenHeader metadata, unspecified if the client is unknown so that the request
+// is classified by its key tag. A known client ID with a missing or wrong token is rejected.
+func (h *grpcHandler) clientPriority(ctx context.Context) (symbiotic.PriorityClass, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return symbiotic.PriorityUnspecified, nil
+	}
+	clientIDs := md.Get(ClientIDHeader)
+	if len(clientIDs) == 0 {
+		return symbiotic.PriorityUnspecified, nil
+	}
+	var token string
+	if tokens := md.Get(ClientTokenHeader); len(tokens) > 0 {
+		token = tokens[0]
+	}
+	return h.cfg.Priority.ClientClass(clientIDs[0], token)
+}
+
+// signRequestDeadline returns the deadline of the request given either as a ttl or as an absolute time, zero if none is set
+func signRequestDeadline(req *apiv1.SignMessageRequest, now time.Time) (time.Time, error) {
+	switch {
+	case req.Ttl != nil && req.Deadline != nil:
+		return time.Time{}, status.Error(codes.InvalidArgument, "only one of ttl and deadline can be set")
+	case req.Ttl != nil:
+		if err := req.GetTtl().CheckValid(); err != nil {
+			return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid ttl: %v", err)
+		}
+		ttl := req.GetTtl().AsDuration()
+		if ttl <= 0 {
+			return time.Time{}, status.Error(codes.InvalidArgument, "ttl must be positive")
+		}
+		return now.Add(ttl), nil
+	case req.Deadline != nil:
+		if err := req.GetDeadline().CheckValid(); err != nil {
+			return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid deadline: %v", err)
+		}
+		deadline := req.GetDeadline().AsTime()
+		if !deadline.After(now) {
+			return time.Time{}, status.Error(codes.InvalidArgument, "deadline must be in the future")
+		}
+		return deadline, nil
+	default:
+		return time.Time{}, nil
+	}
+}
+
+func convertTypedDataFromPB(typedData *apiv1.TypedData) (apitypes.TypedData, error) {
+	message, err := symbiotic.ParseTypedDataMessage([]byte(typedData.GetMessage()))
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	types := make(apitypes.Types, len(typedData.GetTypes()))
+	for _, typ := range typedData.GetTypes() {
+		if _, ok := types[typ.GetName()]; ok {
+			return apitypes.TypedData{}, errors.Errorf("type %q is defined more than once", typ.GetName())
+		}
+		types[typ.GetName()] = lo.Map(typ.GetFields(), func(field *apiv1.TypedDataField, _ int) apitypes.Type {
+			return apitypes.Type{Name: field.GetName(), Type: field.GetType()}
+		})
+	}
+
+	domain := typedData.GetDomain()
+	result := apitypes.TypedData{
+		Types:       types,
+		PrimaryType: typedData.GetPrimaryType(),
+		Domain: apitypes.TypedDataDomain{
+			Name:              domain.GetName(),
+			Version:           domain.GetVersion(),
+			VerifyingContract: domain.GetVerifyingContract(),
+			Salt:              domain.GetSalt(),
+		},
+		Message: message,
+	}
+	if domain.GetChainId() != 0 {
+		result.Domain.ChainId = (*math.HexOrDecimal256)(new(big.Int).SetUint64(domain.GetChainId()))
+	}
+
+	return result, nil
+}
@@ -3,12 +3,19 @@ package api_server
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/symbioticfi/relay/internal/entity"
 	apiv1 "github.com/symbioticfi/relay/internal/gen/api/v1"
 	"github.com/symbioticfi/relay/internal/usecase/api-server/mocks"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
@@ -37,6 +44,7 @@ func TestSignMessage_WithRequiredEpoch_Success(t *testing.T) {
 		KeyTag:        symbiotic.KeyTag(keyTag),
 		Message:       message,
 		RequiredEpoch: symbiotic.Epoch(requiredEpoch),
+		Submitted:     true,
 	}
 
 	mockSigner.EXPECT().
@@ -84,6 +92,7 @@ func TestSignMessage_WithoutRequiredEpoch_UsesLatestEpoch(t *testing.T) {
 		KeyTag:        symbiotic.KeyTag(keyTag),
 		Message:       message,
 		RequiredEpoch: latestEpoch,
+		Submitted:     true,
 	}
 
 	mockSigner.EXPECT().
@@ -160,6 +169,7 @@ func TestSignMessage_RequestSignatureFails_ReturnsError(t *testing.T) {
 		KeyTag:        symbiotic.KeyTag(keyTag),
 		Message:       message,
 		RequiredEpoch: symbiotic.Epoch(requiredEpoch),
+		Submitted:     true,
 	}
 
 	mockSigner.EXPECT().
@@ -178,3 +188,224 @@ func TestSignMessage_RequestSignatureFails_ReturnsError(t *testing.T) {
 	require.Nil(t, response)
 	assert.Equal(t, signerError, err)
 }
+
+func testTypedDataPB() *apiv1.TypedData {
+	return &apiv1.TypedData{
+		Domain: &apiv1.Eip712Domain{
+			Name:              "Governor",
+			Version:           "1",
+			ChainId:           1,
+			VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Types: []*apiv1.TypedDataStruct{
+			{Name: "EIP712Domain", Fields: []*apiv1.TypedDataField{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			}},
+			{Name: "Vote", Fields: []*apiv1.TypedDataField{
+				{Name: "proposal", Type: "uint256"},
+				{Name: "support", Type: "bool"},
+			}},
+		},
+		PrimaryType: "Vote",
+		Message:     `{"proposal":"42","support":true}`,
+	}
+}
+
+func TestSignMessage_WithTypedData_SignsEncoding(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSigner := mocks.NewMocksigner(ctrl)
+	handler := &grpcHandler{cfg: Config{Signer: mockSigner}}
+
+	ctx := context.Background()
+	requiredEpoch := uint64(10)
+	expectedRequestID := common.HexToHash("0x1234")
+
+	typedData, err := convertTypedDataFromPB(testTypedDataPB())
+	require.NoError(t, err)
+	encoded, err := symbiotic.EncodeTypedData(typedData)
+	require.NoError(t, err)
+
+	mockSigner.EXPECT().
+		RequestSignature(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, signReq symbiotic.SignatureRequest) (common.Hash, error) {
+			assert.Equal(t, encoded, signReq.Message)
+			require.NotNil(t, signReq.TypedData)
+			assert.Equal(t, "Vote", signReq.TypedData.PrimaryType)
+			assert.Equal(t, "42", signReq.TypedData.Message["proposal"])
+			return expectedRequestID, nil
+		})
+
+	response, err := handler.SignMessage(ctx, &apiv1.SignMessageRequest{
+		KeyTag:        15,
+		RequiredEpoch: &requiredEpoch,
+		TypedData:     testTypedDataPB(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expectedRequestID.Hex(), response.GetRequestId())
+}
+
+func TestSignMessage_WithInvalidTypedData_ReturnsInvalidArgument(t *testing.T) {
+	handler := &grpcHandler{}
+	requiredEpoch := uint64(10)
+
+	unknownPrimaryType := testTypedDataPB()
+	unknownPrimaryType.PrimaryType = "Ballot"
+
+	invalidMessage := testTypedDataPB()
+	invalidMessage.Message = `{"proposal":`
+
+	tests := []struct {
+		name string
+		req  *apiv1.SignMessageRequest
+	}{
+		{name: "unknown primary type", req: &apiv1.SignMessageRequest{KeyTag: 15, RequiredEpoch: &requiredEpoch, TypedData: unknownPrimaryType}},
+		{name: "invalid message json", req: &apiv1.SignMessageRequest{KeyTag: 15, RequiredEpoch: &requiredEpoch, TypedData: invalidMessage}},
+		{name: "message does not match typed data", req: &apiv1.SignMessageRequest{KeyTag: 15, RequiredEpoch: &requiredEpoch, TypedData: testTypedDataPB(), Message: []byte("other")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.SignMessage(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestSignMessage_WithTTL_SetsDeadline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSigner := mocks.NewMocksigner(ctrl)
+	handler := &grpcHandler{cfg: Config{Signer: mockSigner, Repo: mocks.NewMockrepo(ctrl)}}
+
+	ctx := context.Background()
+	requiredEpoch := uint64(10)
+	before := time.Now()
+
+	mockSigner.EXPECT().RequestSignature(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, req symbiotic.SignatureRequest) (common.Hash, error) {
+		require.True(t, req.Submitted)
+		require.False(t, req.Deadline.Before(before.Add(time.Minute)))
+		require.False(t, req.Deadline.After(time.Now().Add(time.Minute)))
+		return common.HexToHash("0x1234"), nil
+	})
+
+	_, err := handler.SignMessage(ctx, &apiv1.SignMessageRequest{
+		KeyTag:        15,
+		Message:       []byte("test message"),
+		RequiredEpoch: &requiredEpoch,
+		Ttl:           durationpb.New(time.Minute),
+	})
+	require.NoError(t, err)
+}
+
+func TestSignMessage_WithDeadline_SetsDeadline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSigner := mocks.NewMocksigner(ctrl)
+	handler := &grpcHandler{cfg: Config{Signer: mockSigner, Repo: mocks.NewMockrepo(ctrl)}}
+
+	ctx := context.Background()
+	requiredEpoch := uint64(10)
+	deadline := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	mockSigner.EXPECT().RequestSignature(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, req symbiotic.SignatureRequest) (common.Hash, error) {
+		require.True(t, deadline.Equal(req.Deadline))
+		return common.HexToHash("0x1234"), nil
+	})
+
+	_, err := handler.SignMessage(ctx, &apiv1.SignMessageRequest{
+		KeyTag:        15,
+		Message:       []byte("test message"),
+		RequiredEpoch: &requiredEpoch,
+		Deadline:      timestamppb.New(deadline),
+	})
+	require.NoError(t, err)
+}
+
+func TestSignMessage_InvalidExpiry_ReturnsInvalidArgument(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *apiv1.SignMessageRequest
+	}{
+		{
+			name: "ttl and deadline",
+			req:  &apiv1.SignMessageRequest{Ttl: durationpb.New(time.Minute), Deadline: timestamppb.New(time.Now().Add(time.Hour))},
+		},
+		{
+			name: "non positive ttl",
+			req:  &apiv1.SignMessageRequest{Ttl: durationpb.New(0)},
+		},
+		{
+			name: "deadline in the past",
+			req:  &apiv1.SignMessageRequest{Deadline: timestamppb.New(time.Now().Add(-time.Minute))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			handler := &grpcHandler{cfg: Config{Signer: mocks.NewMocksigner(ctrl), Repo: mocks.NewMockrepo(ctrl)}}
+
+			requiredEpoch := uint64(10)
+			tt.req.KeyTag = 15
+			tt.req.Message = []byte("test message")
+			tt.req.RequiredEpoch = &requiredEpoch
+
+			_, err := handler.SignMessage(context.Background(), tt.req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestSignMessage_WithClientID_UsesClientPriority(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSigner := mocks.NewMocksigner(ctrl)
+	handler := &grpcHandler{cfg: Config{
+		Signer: mockSigner,
+		Repo:   mocks.NewMockrepo(ctrl),
+		Priority: entity.PriorityPolicy{
+			Clients:      map[string]symbiotic.PriorityClass{"bridge": symbiotic.PriorityHigh},
+			ClientTokens: map[string]string{"bridge": "secret"},
+		},
+	}}
+
+	requiredEpoch := uint64(10)
+	req := &apiv1.SignMessageRequest{KeyTag: 15, Message: []byte("test message"), RequiredEpoch: &requiredEpoch}
+	withHeaders := func(kv ...string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected symbiotic.PriorityClass
+	}{
+		{name: "known client", ctx: withHeaders(ClientIDHeader, "bridge", ClientTokenHeader, "secret"), expected: symbiotic.PriorityHigh},
+		{name: "unknown client", ctx: withHeaders(ClientIDHeader, "other"), expected: symbiotic.PriorityUnspecified},
+		{name: "no client", ctx: context.Background(), expected: symbiotic.PriorityUnspecified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSigner.EXPECT().RequestSignature(tt.ctx, gomock.Any()).DoAndReturn(func(_ context.Context, signReq symbiotic.SignatureRequest) (common.Hash, error) {
+				require.Equal(t, tt.expected, signReq.Priority)
+				return common.HexToHash("0x1234"), nil
+			})
+
+			_, err := handler.SignMessage(tt.ctx, req)
+			require.NoError(t, err)
+		})
+	}
+
+	// a caller can't claim the class of a client without its token
+	for name, ctx := range map[string]context.Context{
+		"missing token": withHeaders(ClientIDHeader, "bridge"),
+		"wrong token":   withHeaders(ClientIDHeader, "Bridge", ClientTokenHeader, "guess"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := handler.SignMessage(ctx, req)
+			require.ErrorIs(t, err, entity.ErrClientUnauthenticated)
+		})
+	}
+}
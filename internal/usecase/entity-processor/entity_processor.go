@@ -1,18 +1,22 @@
 package entity_processor
 
 import (
+	"bytes"
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-errors/errors"
 	validate "github.com/go-playground/validator/v10"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/symbioticfi/relay/internal/entity"
 	"github.com/symbioticfi/relay/pkg/log"
 	"github.com/symbioticfi/relay/pkg/signals"
 	"github.com/symbioticfi/relay/pkg/tracing"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
 )
 
 //go:generate mockgen -source=entity_processor.go -destination=mocks/entity_processor.go -package=mocks
@@ -21,12 +25,16 @@ import (
 type Repository interface {
 	SaveSignature(ctx context.Context, signature symbiotic.Signature, validator symbiotic.Validator, activeIndex uint32) error
 	GetSignatureByIndex(ctx context.Context, requestID common.Hash, validatorIndex uint32) (symbiotic.Signature, error)
+	GetSignatureRequest(ctx context.Context, requestID common.Hash) (symbiotic.SignatureRequest, error)
 	GetValidatorByKey(ctx context.Context, epoch symbiotic.Epoch, keyTag symbiotic.KeyTag, publicKey []byte) (symbiotic.Validator, uint32, error)
 	GetValidatorSetByEpoch(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.ValidatorSet, error)
 	GetAggregationProof(ctx context.Context, requestID common.Hash) (symbiotic.AggregationProof, error)
 	SaveProof(ctx context.Context, aggregationProof symbiotic.AggregationProof) error
+	UpgradeProof(ctx context.Context, aggregationProof symbiotic.AggregationProof) error
 	UpdateValidatorSetStatus(ctx context.Context, epoch symbiotic.Epoch, item symbiotic.ValidatorSetStatus) error
 	GetLatestAggregatedValsetHeader(ctx context.Context) (symbiotic.ValidatorSetHeader, error)
+	SaveHeaderCommitmentSignature(ctx context.Context, keyTag symbiotic.KeyTag, operator common.Address, signed symbiotic.SignedHeaderCommitment) (symbiotic.SignedHeaderCommitment, error)
+	SaveEquivocationEvidence(ctx context.Context, evidence symbiotic.EquivocationEvidence) error
 }
 
 type Aggregator interface {
@@ -37,6 +45,10 @@ type AggProofSignal interface {
 	Emit(payload symbiotic.AggregationProof) error
 }
 
+type EquivocationSignal interface {
+	Emit(payload symbiotic.EquivocationEvidence) error
+}
+
 type Metrics interface {
 	ObserveEpoch(epochType string, epochNumber uint64)
 }
@@ -47,6 +59,18 @@ type Config struct {
 	AggProofSignal           AggProofSignal                       `validate:"required"`
 	SignatureProcessedSignal *signals.Signal[symbiotic.Signature] `validate:"required"`
 	Metrics                  Metrics                              `validate:"required"`
+	// EquivocationSignal, if set, is emitted for every new equivocation evidence
+	EquivocationSignal EquivocationSignal
+	// AggProofUpgradedSignal, if set, is emitted for every proof that replaced a worse proof of its request
+	AggProofUpgradedSignal AggProofSignal
+	// BatchWindow is how long a received BLS signature waits for others to be verified together with,
+	// signatures are verified one by one if zero
+	BatchWindow time.Duration `validate:"gte=0"`
+	// MaxBatchSize caps the number of signatures verified in a batch, unlimited if zero
+	MaxBatchSize int `validate:"gte=0"`
+	// MaxConcurrentSignatures is how many received signatures are processed concurrently at most, e.g. by the signal
+	// workers handling gossip. Batches are verified once that many wait without waiting for the window, zero if unknown
+	MaxConcurrentSignatures int `validate:"gte=0"`
 }
 
 func (c Config) Validate() error {
@@ -59,7 +83,8 @@ func (c Config) Validate() error {
 
 // EntityProcessor handles both signature and aggregation proof processing with SignatureMap operations
 type EntityProcessor struct {
-	cfg Config
+	cfg      Config
+	verifier *batchVerifier
 }
 
 // NewEntityProcessor creates a new entity processor
@@ -69,7 +94,8 @@ func NewEntityProcessor(cfg Config) (*EntityProcessor, error) {
 	}
 
 	return &EntityProcessor{
-		cfg: cfg,
+		cfg:      cfg,
+		verifier: newBatchVerifier(cfg.BatchWindow, cfg.MaxBatchSize, cfg.MaxConcurrentSignatures),
 	}, nil
 }
 
@@ -82,51 +108,120 @@ func (s *EntityProcessor) ProcessSignature(ctx context.Context, signature symbio
 	)
 	defer span.End()
 
-	ctx = log.WithAttrs(ctx,
+	ctx = signatureLogContext(ctx, signature)
+	slog.DebugContext(ctx, "Started processing signature", "self", self)
+
+	validator, activeIndex, err := s.checkSignature(ctx, span, signature, self)
+	if err != nil {
+		return err
+	}
+
+	// if self signature ignore verification
+	if !self {
+		if err := s.verifier.Verify(ctx, signature); err != nil {
+			tracing.RecordError(span, err)
+			return errors.Errorf("failed to verify signature: %w", err)
+		}
+	}
+
+	return s.storeSignature(ctx, span, signature, validator, activeIndex)
+}
+
+// ProcessSignatures processes signatures received from peers in bulk, like ProcessSignature does for a single one,
+// but verifies them together in batches. It returns the processing error of every signature, nil for processed ones.
+func (s *EntityProcessor) ProcessSignatures(ctx context.Context, signatures []symbiotic.Signature) []error {
+	ctx, span := tracing.StartSpan(ctx, "entity_processor.ProcessSignatures",
+		tracing.AttrSignatureCount.Int(len(signatures)),
+	)
+	defer span.End()
+
+	type checkedSignature struct {
+		index       int
+		validator   symbiotic.Validator
+		activeIndex uint32
+	}
+
+	errs := make([]error, len(signatures))
+	checked := make([]checkedSignature, 0, len(signatures))
+	toVerify := make([]symbiotic.Signature, 0, len(signatures))
+	for i, signature := range signatures {
+		validator, activeIndex, err := s.checkSignature(signatureLogContext(ctx, signature), span, signature, false)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		checked = append(checked, checkedSignature{index: i, validator: validator, activeIndex: activeIndex})
+		toVerify = append(toVerify, signature)
+	}
+
+	for j, err := range s.verifier.VerifyAll(toVerify) {
+		c := checked[j]
+		if err != nil {
+			tracing.RecordError(span, err)
+			errs[c.index] = errors.Errorf("failed to verify signature: %w", err)
+			continue
+		}
+		errs[c.index] = s.storeSignature(signatureLogContext(ctx, signatures[c.index]), span, signatures[c.index], c.validator, c.activeIndex)
+	}
+
+	return errs
+}
+
+func signatureLogContext(ctx context.Context, signature symbiotic.Signature) context.Context {
+	return log.WithAttrs(ctx,
 		slog.String("requestId", signature.RequestID().Hex()),
 		slog.Uint64("epoch", uint64(signature.Epoch)),
 		slog.Uint64("keyTag", uint64(signature.KeyTag)),
 	)
-	slog.DebugContext(ctx, "Started processing signature", "self", self)
+}
 
+// checkSignature resolves the validator of the signature and, unless it is a self signature, checks that the
+// validator is active and the signature is not stored yet
+func (s *EntityProcessor) checkSignature(ctx context.Context, span trace.Span, signature symbiotic.Signature, self bool) (symbiotic.Validator, uint32, error) {
 	validator, activeIndex, err := s.cfg.Repo.GetValidatorByKey(ctx, signature.Epoch, signature.KeyTag, signature.PublicKey.OnChain())
 	if err != nil {
 		tracing.RecordError(span, err)
-		return errors.Errorf("validator not found for public key %x, keyTag=%v, epoch=%v: %w", signature.PublicKey.OnChain(), signature.KeyTag, signature.Epoch, err)
+		return symbiotic.Validator{}, 0, errors.Errorf("validator not found for public key %x, keyTag=%v, epoch=%v: %w", signature.PublicKey.OnChain(), signature.KeyTag, signature.Epoch, err)
 	}
 
 	tracing.SetAttributes(span, tracing.AttrValidatorIndex.Int(int(activeIndex)))
 
 	// if self signature ignore validator check and signature existence check
-	if !self {
-		if !validator.IsActive {
-			err := errors.Errorf("validator %s is not active", validator.Operator.Hex())
-			tracing.RecordError(span, err)
-			return err
-		}
+	if self {
+		return validator, activeIndex, nil
+	}
 
-		_, err = s.cfg.Repo.GetSignatureByIndex(ctx, signature.RequestID(), activeIndex)
-		if err == nil {
-			tracing.AddEvent(span, "signature_already_exists")
-			return errors.Errorf("signature already exists for request ID %s and validator index %d: %w", signature.RequestID().Hex(), activeIndex, entity.ErrEntityAlreadyExist)
-		}
-		if !errors.Is(err, entity.ErrEntityNotFound) {
-			tracing.RecordError(span, err)
-			return errors.Errorf("failed to check existing signature: %w", err)
-		}
+	if !validator.IsActive {
+		err := errors.Errorf("validator %s is not active", validator.Operator.Hex())
+		tracing.RecordError(span, err)
+		return symbiotic.Validator{}, 0, err
+	}
 
-		err = signature.PublicKey.VerifyWithHash(signature.MessageHash, signature.Signature)
-		if err != nil {
-			tracing.RecordError(span, err)
-			return errors.Errorf("failed to verify signature: %w", err)
-		}
+	_, err = s.cfg.Repo.GetSignatureByIndex(ctx, signature.RequestID(), activeIndex)
+	if err == nil {
+		tracing.AddEvent(span, "signature_already_exists")
+		return symbiotic.Validator{}, 0, errors.Errorf("signature already exists for request ID %s and validator index %d: %w", signature.RequestID().Hex(), activeIndex, entity.ErrEntityAlreadyExist)
+	}
+	if !errors.Is(err, entity.ErrEntityNotFound) {
+		tracing.RecordError(span, err)
+		return symbiotic.Validator{}, 0, errors.Errorf("failed to check existing signature: %w", err)
 	}
 
+	return validator, activeIndex, nil
+}
+
+func (s *EntityProcessor) storeSignature(ctx context.Context, span trace.Span, signature symbiotic.Signature, validator symbiotic.Validator, activeIndex uint32) error {
 	if err := s.cfg.Repo.SaveSignature(ctx, signature, validator, activeIndex); err != nil {
 		tracing.RecordError(span, err)
 		return errors.Errorf("failed to add signature: %w", err)
 	}
 
+	if err := s.checkHeaderCommitment(ctx, signature, validator); err != nil {
+		// the signature itself is valid and stored, only its header commitment is not accounted
+		tracing.RecordError(span, err)
+		slog.WarnContext(ctx, "Failed to check header commitment of signature", "error", err)
+	}
+
 	tracing.AddEvent(span, "emitting_signal")
 	if err := s.cfg.SignatureProcessedSignal.Emit(signature); err != nil {
 		tracing.RecordError(span, err)
@@ -136,7 +231,91 @@ func (s *EntityProcessor) ProcessSignature(ctx context.Context, signature symbio
 	return nil
 }
 
-// ProcessAggregationProof processes an aggregation proof by saving it and removing from pending collection
+// headerCommitment returns the validator set header commitment a signature signs, or false if it is not a header
+// signature. The commitment is derived from the locally stored signature request, so signatures are accounted no matter
+// whether the sender attached the commitment, e.g. when they arrive through sync. The commitment attached by the sender
+// is only used for headers this relay has no request of, and only if it hashes to the signed message.
+func (s *EntityProcessor) headerCommitment(ctx context.Context, signature symbiotic.Signature) (symbiotic.ValSetHeaderCommitment, bool, error) {
+	req, err := s.cfg.Repo.GetSignatureRequest(ctx, signature.RequestID())
+	if err == nil {
+		if req.TypedData == nil || req.TypedData.PrimaryType != symbiotic.ValSetHeaderCommitPrimaryType {
+			return symbiotic.ValSetHeaderCommitment{}, false, nil
+		}
+		commitment, err := symbiotic.ValSetHeaderCommitmentFromTypedData(*req.TypedData)
+		if err != nil {
+			return symbiotic.ValSetHeaderCommitment{}, false, errors.Errorf("failed to get header commitment of signature request: %w", err)
+		}
+		return commitment, true, nil
+	}
+	if !errors.Is(err, entity.ErrEntityNotFound) {
+		return symbiotic.ValSetHeaderCommitment{}, false, errors.Errorf("failed to get signature request: %w", err)
+	}
+
+	if signature.HeaderCommitment == nil {
+		return symbiotic.ValSetHeaderCommitment{}, false, nil
+	}
+	commitment := *signature.HeaderCommitment
+
+	// the signature was verified against the message hash, so the commitment is signed if it hashes to it
+	msgHash, err := crypto.HashMessage(signature.KeyTag.Type(), commitment.Message())
+	if err != nil {
+		return symbiotic.ValSetHeaderCommitment{}, false, errors.Errorf("failed to hash header commitment: %w", err)
+	}
+	if !bytes.Equal(msgHash, signature.MessageHash) {
+		return symbiotic.ValSetHeaderCommitment{}, false, errors.Errorf("header commitment does not match message hash %x", signature.MessageHash)
+	}
+	return commitment, true, nil
+}
+
+// checkHeaderCommitment records the header commitment of a header signature and stores equivocation evidence if the
+// validator signed a conflicting commitment for the same epoch before
+func (s *EntityProcessor) checkHeaderCommitment(ctx context.Context, signature symbiotic.Signature, validator symbiotic.Validator) error {
+	commitment, ok, err := s.headerCommitment(ctx, signature)
+	if err != nil || !ok {
+		return err
+	}
+
+	signed := symbiotic.SignedHeaderCommitment{Commitment: commitment, Signature: signature.Signature}
+	first, err := s.cfg.Repo.SaveHeaderCommitmentSignature(ctx, signature.KeyTag, validator.Operator, signed)
+	if err != nil {
+		return errors.Errorf("failed to save header commitment signature: %w", err)
+	}
+	if !first.Commitment.ConflictsWith(commitment) {
+		return nil
+	}
+
+	evidence := symbiotic.EquivocationEvidence{
+		Operator:   validator.Operator,
+		KeyTag:     signature.KeyTag,
+		PublicKey:  signature.PublicKey.Raw(),
+		First:      first,
+		Second:     signed,
+		DetectedAt: time.Now(),
+	}
+	if err := s.cfg.Repo.SaveEquivocationEvidence(ctx, evidence); err != nil {
+		if errors.Is(err, entity.ErrEntityAlreadyExist) {
+			return nil
+		}
+		return errors.Errorf("failed to save equivocation evidence: %w", err)
+	}
+
+	slog.WarnContext(ctx, "Detected validator set header equivocation",
+		"operator", validator.Operator.Hex(),
+		"headerEpoch", commitment.Epoch,
+		"firstHeaderHash", first.Commitment.HeaderHash.Hex(),
+		"secondHeaderHash", commitment.HeaderHash.Hex(),
+	)
+
+	if s.cfg.EquivocationSignal != nil {
+		if err := s.cfg.EquivocationSignal.Emit(evidence); err != nil {
+			return errors.Errorf("failed to emit equivocation signal: %w", err)
+		}
+	}
+	return nil
+}
+
+// ProcessAggregationProof processes an aggregation proof by saving it and removing from pending collection.
+// A proof of a request that already has one replaces it if it improves on it, otherwise ErrEntityAlreadyExist is returned.
 func (s *EntityProcessor) ProcessAggregationProof(ctx context.Context, aggregationProof symbiotic.AggregationProof) error {
 	ctx, span := tracing.StartSpan(ctx, "entity_processor.ProcessAggregationProof",
 		tracing.AttrRequestID.String(aggregationProof.RequestID().Hex()),
@@ -153,12 +332,13 @@ func (s *EntityProcessor) ProcessAggregationProof(ctx context.Context, aggregati
 	)
 	slog.DebugContext(ctx, "Started processing aggregation proof")
 
-	_, err := s.cfg.Repo.GetAggregationProof(ctx, aggregationProof.RequestID())
-	if err == nil {
+	existingProof, err := s.cfg.Repo.GetAggregationProof(ctx, aggregationProof.RequestID())
+	upgrade := err == nil
+	if upgrade && !aggregationProof.Improves(existingProof) {
 		tracing.AddEvent(span, "proof_already_exists")
 		return errors.Errorf("aggregation proof already exists for request ID %s: %w", aggregationProof.RequestID().Hex(), entity.ErrEntityAlreadyExist)
 	}
-	if !errors.Is(err, entity.ErrEntityNotFound) {
+	if err != nil && !errors.Is(err, entity.ErrEntityNotFound) {
 		tracing.RecordError(span, err)
 		return errors.Errorf("failed to check existing aggregation proof: %w", err)
 	}
@@ -182,6 +362,10 @@ func (s *EntityProcessor) ProcessAggregationProof(ctx context.Context, aggregati
 		return err
 	}
 
+	if upgrade {
+		return s.upgradeAggregationProof(ctx, span, aggregationProof, existingProof)
+	}
+
 	if err := s.cfg.Repo.SaveProof(ctx, aggregationProof); err != nil {
 		tracing.RecordError(span, err)
 		return errors.Errorf("failed to add aggregation proof: %w", err)
@@ -200,3 +384,25 @@ func (s *EntityProcessor) ProcessAggregationProof(ctx context.Context, aggregati
 
 	return nil
 }
+
+func (s *EntityProcessor) upgradeAggregationProof(ctx context.Context, span trace.Span, aggregationProof, existingProof symbiotic.AggregationProof) error {
+	if err := s.cfg.Repo.UpgradeProof(ctx, aggregationProof); err != nil {
+		tracing.RecordError(span, err)
+		return errors.Errorf("failed to upgrade aggregation proof: %w", err)
+	}
+
+	tracing.AddEvent(span, "proof_upgraded")
+	slog.InfoContext(ctx, "Aggregation proof upgraded",
+		"previousProofSize", len(existingProof.Proof),
+		"proofSize", len(aggregationProof.Proof),
+	)
+
+	if s.cfg.AggProofUpgradedSignal == nil {
+		return nil
+	}
+	if err := s.cfg.AggProofUpgradedSignal.Emit(aggregationProof); err != nil {
+		tracing.RecordError(span, err)
+		return errors.Errorf("failed to emit aggregation proof upgraded signal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package entity_processor
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+// batchVerifier verifies BLS signatures arriving concurrently in micro-batches.
+// The first signature of a batch waits for the window to collect others of the same key type, then the whole batch
+// is verified with one multi-pairing that needs a pairing per distinct message. Signatures of the same request share
+// their pairing, the scalar multiplications still grow with the batch.
+//
+// Batching trades latency for throughput: a signature waits up to the window for others. Callers verifying
+// concurrently are bounded, e.g. by the signal workers handling gossip, so pending batches are verified as soon as
+// all of them wait, since no further signature can join. Only under low load a signature waits the whole window.
+type batchVerifier struct {
+	window      time.Duration
+	maxSize     int
+	concurrency int
+
+	mu      sync.Mutex
+	pending map[symbiotic.KeyType]*pendingBatch
+	// waiting is the number of signatures in pending batches
+	waiting int
+}
+
+type pendingBatch struct {
+	items   []crypto.BatchItem
+	results []chan error
+}
+
+// newBatchVerifier creates a verifier, concurrency is the maximum number of concurrent Verify calls, zero if unknown.
+func newBatchVerifier(window time.Duration, maxSize, concurrency int) *batchVerifier {
+	return &batchVerifier{
+		window:      window,
+		maxSize:     maxSize,
+		concurrency: concurrency,
+		pending:     make(map[symbiotic.KeyType]*pendingBatch),
+	}
+}
+
+// Verify blocks until the batch of the signature is verified and returns the result of the signature.
+// Signatures are verified right away if batching is disabled or not supported by the key type.
+func (v *batchVerifier) Verify(ctx context.Context, signature symbiotic.Signature) error {
+	keyType := signature.KeyTag.Type()
+	if v.window <= 0 || !crypto.SupportsBatchVerification(keyType) {
+		return signature.PublicKey.VerifyWithHash(signature.MessageHash, signature.Signature)
+	}
+
+	result := make(chan error, 1)
+
+	v.mu.Lock()
+	batch, ok := v.pending[keyType]
+	if !ok {
+		batch = &pendingBatch{}
+		v.pending[keyType] = batch
+		time.AfterFunc(v.window, func() { v.flush(keyType, batch) })
+	}
+	batch.items = append(batch.items, batchItem(signature))
+	batch.results = append(batch.results, result)
+	v.waiting++
+	var ready map[symbiotic.KeyType]*pendingBatch
+	if v.concurrency > 0 && v.waiting >= v.concurrency {
+		// every caller waits, no further signature can join the batches
+		ready = maps.Clone(v.pending)
+	} else if v.maxSize > 0 && len(batch.items) >= v.maxSize {
+		ready = map[symbiotic.KeyType]*pendingBatch{keyType: batch}
+	}
+	v.mu.Unlock()
+
+	for readyKeyType, readyBatch := range ready {
+		v.flush(readyKeyType, readyBatch)
+	}
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return errors.Errorf("signature verification cancelled: %w", ctx.Err())
+	}
+}
+
+// VerifyAll verifies signatures that are already at hand, e.g. from a sync response, without waiting for the window.
+// It returns the result of every signature.
+func (v *batchVerifier) VerifyAll(signatures []symbiotic.Signature) []error {
+	errs := make([]error, len(signatures))
+
+	byKeyType := make(map[symbiotic.KeyType][]int)
+	for i, signature := range signatures {
+		keyType := signature.KeyTag.Type()
+		byKeyType[keyType] = append(byKeyType[keyType], i)
+	}
+
+	for keyType, indexes := range byKeyType {
+		for start := 0; start < len(indexes); start += v.chunkSize(len(indexes)) {
+			chunk := indexes[start:min(start+v.chunkSize(len(indexes)), len(indexes))]
+
+			items := make([]crypto.BatchItem, 0, len(chunk))
+			for _, i := range chunk {
+				items = append(items, batchItem(signatures[i]))
+			}
+			for j, err := range crypto.VerifyEach(keyType, items) {
+				errs[chunk[j]] = err
+			}
+		}
+	}
+
+	return errs
+}
+
+func (v *batchVerifier) chunkSize(total int) int {
+	if v.maxSize > 0 {
+		return v.maxSize
+	}
+	return total
+}
+
+func (v *batchVerifier) flush(keyType symbiotic.KeyType, batch *pendingBatch) {
+	v.mu.Lock()
+	if v.pending[keyType] != batch {
+		// already flushed when it got full
+		v.mu.Unlock()
+		return
+	}
+	delete(v.pending, keyType)
+	v.waiting -= len(batch.items)
+	v.mu.Unlock()
+
+	for i, err := range crypto.VerifyEach(keyType, batch.items) {
+		batch.results[i] <- err
+	}
+}
+
+func batchItem(signature symbiotic.Signature) crypto.BatchItem {
+	return crypto.BatchItem{
+		PublicKey:   signature.PublicKey,
+		MessageHash: signature.MessageHash,
+		Signature:   signature.Signature,
+	}
+}
@@ -0,0 +1,191 @@
+package entity_processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+func blsSignatures(t *testing.T, count int) []symbiotic.Signature {
+	t.Helper()
+
+	req := randomSignatureRequest(t, symbiotic.Epoch(1))
+	signatures := make([]symbiotic.Signature, 0, count)
+	for range count {
+		privateKey, err := crypto.GeneratePrivateKey(symbiotic.KeyTypeBlsBn254)
+		require.NoError(t, err)
+		signatures = append(signatures, signatureExtendedForRequest(t, privateKey, req))
+	}
+	return signatures
+}
+
+func TestBatchVerifier_Verify_ConcurrentSignaturesShareBatch(t *testing.T) {
+	t.Parallel()
+
+	verifier := newBatchVerifier(50*time.Millisecond, 0, 0)
+	signatures := blsSignatures(t, 6)
+	// valid signature of another key
+	signatures[2].Signature = signatures[3].Signature
+
+	errs := make([]error, len(signatures))
+	var eg errgroup.Group
+	for i, signature := range signatures {
+		eg.Go(func() error {
+			errs[i] = verifier.Verify(t.Context(), signature)
+			return nil
+		})
+	}
+	require.NoError(t, eg.Wait())
+
+	for i, err := range errs {
+		if i == 2 {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err, "signature %d", i)
+	}
+	require.Empty(t, verifier.pending)
+}
+
+func TestBatchVerifier_Verify_FullBatchDoesNotWaitForWindow(t *testing.T) {
+	t.Parallel()
+
+	verifier := newBatchVerifier(time.Hour, 3, 0)
+	signatures := blsSignatures(t, 3)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	var eg errgroup.Group
+	for _, signature := range signatures {
+		eg.Go(func() error {
+			return verifier.Verify(ctx, signature)
+		})
+	}
+	require.NoError(t, eg.Wait())
+}
+
+func TestBatchVerifier_Verify_AllCallersWaitingDoesNotWaitForWindow(t *testing.T) {
+	t.Parallel()
+
+	// batches are far from full but every concurrent caller waits, e.g. all gossip workers
+	verifier := newBatchVerifier(time.Hour, 256, 4)
+	signatures := blsSignatures(t, 4)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	var eg errgroup.Group
+	for _, signature := range signatures {
+		eg.Go(func() error {
+			return verifier.Verify(ctx, signature)
+		})
+	}
+	require.NoError(t, eg.Wait())
+	require.Empty(t, verifier.pending)
+	require.Zero(t, verifier.waiting)
+}
+
+func TestBatchVerifier_Verify_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	verifier := newBatchVerifier(time.Hour, 0, 0)
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	require.ErrorIs(t, verifier.Verify(ctx, blsSignatures(t, 1)[0]), context.Canceled)
+}
+
+func TestBatchVerifier_Verify_WithoutWindowVerifiesDirectly(t *testing.T) {
+	t.Parallel()
+
+	verifier := newBatchVerifier(0, 0, 0)
+	signatures := blsSignatures(t, 2)
+	require.NoError(t, verifier.Verify(t.Context(), signatures[0]))
+
+	signatures[1].Signature = signatures[0].Signature
+	require.Error(t, verifier.Verify(t.Context(), signatures[1]))
+	require.Empty(t, verifier.pending)
+}
+
+func TestBatchVerifier_VerifyAll(t *testing.T) {
+	t.Parallel()
+
+	verifier := newBatchVerifier(time.Hour, 4, 0)
+	signatures := blsSignatures(t, 10)
+	signatures[1].Signature = signatures[0].Signature
+	signatures[9].Signature = []byte{1, 2, 3}
+
+	privateKey, err := crypto.GeneratePrivateKey(symbiotic.KeyTypeEcdsaSecp256k1)
+	require.NoError(t, err)
+	req := randomSignatureRequest(t, symbiotic.Epoch(1))
+	req.KeyTag = symbiotic.KeyTag(0x10)
+	signatures = append(signatures, signatureExtendedForRequest(t, privateKey, req))
+
+	errs := verifier.VerifyAll(signatures)
+	require.Len(t, errs, len(signatures))
+	for i, err := range errs {
+		if i == 1 || i == 9 {
+			require.Error(t, err, "signature %d", i)
+			continue
+		}
+		require.NoError(t, err, "signature %d", i)
+	}
+}
+
+func TestEntityProcessor_ProcessSignatures(t *testing.T) {
+	t.Parallel()
+
+	for name, newRepo := range backends() {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newRepo(t)
+			epoch := symbiotic.Epoch(300)
+			req := randomSignatureRequest(t, epoch)
+			_, privateKeys := setupValidatorSetHeader(t, repo, epoch, big.NewInt(1000))
+
+			processor, err := NewEntityProcessor(Config{
+				Repo:                     repo,
+				Aggregator:               createMockAggregator(t),
+				AggProofSignal:           createMockAggProofSignal(t),
+				SignatureProcessedSignal: createMockSignatureProcessedSignal(t),
+				Metrics:                  doNothingMetrics{},
+				BatchWindow:              time.Millisecond,
+			})
+			require.NoError(t, err)
+
+			stored := signatureExtendedForRequest(t, privateKeys[0][req.KeyTag], req)
+			require.NoError(t, processor.ProcessSignature(t.Context(), stored, false))
+
+			invalid := signatureExtendedForRequest(t, privateKeys[2][req.KeyTag], req)
+			invalid.Signature = signatureExtendedForRequest(t, privateKeys[3][req.KeyTag], req).Signature
+
+			signatures := []symbiotic.Signature{
+				stored,
+				signatureExtendedForRequest(t, privateKeys[1][req.KeyTag], req),
+				invalid,
+				signatureExtendedForRequest(t, privateKeys[3][req.KeyTag], req),
+			}
+
+			errs := processor.ProcessSignatures(t.Context(), signatures)
+			require.Len(t, errs, len(signatures))
+			require.ErrorIs(t, errs[0], entity.ErrEntityAlreadyExist)
+			require.NoError(t, errs[1])
+			require.ErrorContains(t, errs[2], "failed to verify signature")
+			require.NoError(t, errs[3])
+
+			sigMap, err := repo.GetSignatureMap(t.Context(), stored.RequestID())
+			require.NoError(t, err)
+			require.Equal(t, uint64(3), sigMap.SignedValidatorsBitmap.GetCardinality())
+		})
+	}
+}
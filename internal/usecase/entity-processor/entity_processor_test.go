@@ -367,6 +367,148 @@ func TestEntityProcessor_ProcessSignature_ExactQuorumThreshold(t *testing.T) {
 	}
 }
 
+func TestEntityProcessor_ProcessSignature_RecordsHeaderEquivocation(t *testing.T) {
+	t.Parallel()
+
+	for name, newRepo := range backends() {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newRepo(t)
+			epoch := symbiotic.Epoch(303)
+			_, privateKeys := setupValidatorSetHeader(t, repo, epoch, big.NewInt(1000))
+
+			commitment := symbiotic.ValSetHeaderCommitment{
+				DomainSeparator: common.BytesToHash(randomBytes(t, 32)),
+				Subnetwork:      common.BytesToHash(randomBytes(t, 32)),
+				Epoch:           epoch + 1,
+				HeaderHash:      common.BytesToHash(randomBytes(t, 32)),
+				ExtraDataHash:   common.BytesToHash(randomBytes(t, 32)),
+			}
+			conflicting := commitment
+			conflicting.HeaderHash = common.BytesToHash(randomBytes(t, 32))
+
+			var emitted []symbiotic.EquivocationEvidence
+			equivocationSignal := mocks.NewMockEquivocationSignal(gomock.NewController(t))
+			equivocationSignal.EXPECT().Emit(gomock.Any()).DoAndReturn(func(evidence symbiotic.EquivocationEvidence) error {
+				emitted = append(emitted, evidence)
+				return nil
+			}).AnyTimes()
+
+			processor, err := NewEntityProcessor(Config{
+				Repo:                     repo,
+				Aggregator:               createMockAggregator(t),
+				AggProofSignal:           createMockAggProofSignal(t),
+				SignatureProcessedSignal: createMockSignatureProcessedSignal(t),
+				Metrics:                  doNothingMetrics{},
+				EquivocationSignal:       equivocationSignal,
+			})
+			require.NoError(t, err)
+
+			headerSignature := func(c symbiotic.ValSetHeaderCommitment) symbiotic.Signature {
+				sig := signatureExtendedForRequest(t, privateKeys[0][15], symbiotic.SignatureRequest{
+					KeyTag:        15,
+					RequiredEpoch: epoch,
+					Message:       c.Message(),
+				})
+				sig.HeaderCommitment = &c
+				return sig
+			}
+
+			require.NoError(t, processor.ProcessSignature(t.Context(), headerSignature(commitment), false))
+			require.Empty(t, emitted)
+
+			// a commitment not matching the signed message is not accounted
+			forged := signatureExtendedForRequest(t, privateKeys[0][15], randomSignatureRequest(t, epoch))
+			forged.HeaderCommitment = &conflicting
+			require.NoError(t, processor.ProcessSignature(t.Context(), forged, false))
+			require.Empty(t, emitted)
+
+			second := headerSignature(conflicting)
+			require.NoError(t, processor.ProcessSignature(t.Context(), second, false))
+			require.Len(t, emitted, 1)
+
+			evidence, err := repo.GetEquivocationEvidenceByEpoch(t.Context(), epoch+1)
+			require.NoError(t, err)
+			require.Len(t, evidence, 1)
+			require.Equal(t, commitment, evidence[0].First.Commitment)
+			require.Equal(t, conflicting, evidence[0].Second.Commitment)
+			require.Equal(t, second.Signature, evidence[0].Second.Signature)
+			require.Equal(t, symbiotic.RawPublicKey(second.PublicKey.Raw()), evidence[0].PublicKey)
+		})
+	}
+}
+
+func TestEntityProcessor_ProcessSignature_RecordsHeaderEquivocationWithoutAttachedCommitment(t *testing.T) {
+	t.Parallel()
+
+	for name, newRepo := range backends() {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newRepo(t)
+			epoch := symbiotic.Epoch(304)
+			_, privateKeys := setupValidatorSetHeader(t, repo, epoch, big.NewInt(1000))
+
+			networkData := symbiotic.NetworkData{
+				Subnetwork: common.BytesToHash(randomBytes(t, 32)),
+				Eip712Data: symbiotic.Eip712Domain{Name: "Middleware", Version: "1"},
+			}
+			typedData := symbiotic.ValSetHeaderCommitTypedData(networkData, epoch+1, common.BytesToHash(randomBytes(t, 32)), common.BytesToHash(randomBytes(t, 32)))
+			local, err := symbiotic.ValSetHeaderCommitmentFromTypedData(typedData)
+			require.NoError(t, err)
+			localReq := symbiotic.SignatureRequest{
+				KeyTag:        15,
+				RequiredEpoch: epoch,
+				Message:       local.Message(),
+				TypedData:     &typedData,
+			}
+
+			conflicting := local
+			conflicting.HeaderHash = common.BytesToHash(randomBytes(t, 32))
+
+			var emitted []symbiotic.EquivocationEvidence
+			equivocationSignal := mocks.NewMockEquivocationSignal(gomock.NewController(t))
+			equivocationSignal.EXPECT().Emit(gomock.Any()).DoAndReturn(func(evidence symbiotic.EquivocationEvidence) error {
+				emitted = append(emitted, evidence)
+				return nil
+			}).AnyTimes()
+
+			processor, err := NewEntityProcessor(Config{
+				Repo:                     repo,
+				Aggregator:               createMockAggregator(t),
+				AggProofSignal:           createMockAggProofSignal(t),
+				SignatureProcessedSignal: createMockSignatureProcessedSignal(t),
+				Metrics:                  doNothingMetrics{},
+				EquivocationSignal:       equivocationSignal,
+			})
+			require.NoError(t, err)
+
+			first := signatureExtendedForRequest(t, privateKeys[0][15], symbiotic.SignatureRequest{
+				KeyTag:        15,
+				RequiredEpoch: epoch,
+				Message:       conflicting.Message(),
+			})
+			first.HeaderCommitment = &conflicting
+			require.NoError(t, processor.ProcessSignature(t.Context(), first, false))
+			require.Empty(t, emitted)
+
+			// the signature of the locally known header carries no commitment, like a synced one
+			second := signatureExtendedForRequest(t, privateKeys[0][15], localReq)
+			require.NoError(t, repo.SaveSignatureRequest(t.Context(), second.RequestID(), localReq))
+			require.NoError(t, processor.ProcessSignature(t.Context(), second, false))
+			require.Len(t, emitted, 1)
+
+			evidence, err := repo.GetEquivocationEvidenceByEpoch(t.Context(), epoch+1)
+			require.NoError(t, err)
+			require.Len(t, evidence, 1)
+			require.Equal(t, conflicting, evidence[0].First.Commitment)
+			require.Equal(t, local, evidence[0].Second.Commitment)
+			require.Equal(t, second.Signature, evidence[0].Second.Signature)
+		})
+	}
+}
+
 // Helper functions
 
 func createMockAggregator(t *testing.T) *mocks.MockAggregator {
@@ -683,6 +825,53 @@ func TestEntityProcessor_ProcessAggregationProof_FailsWhenAlreadyExists(t *testi
 	}
 }
 
+func TestEntityProcessor_ProcessAggregationProof_UpgradesToCheaperProof(t *testing.T) {
+	t.Parallel()
+
+	for name, newRepo := range backends() {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newRepo(t)
+			req := randomSignatureRequest(t, symbiotic.Epoch(400))
+			msg := symbiotic.AggregationProof{
+				KeyTag:      req.KeyTag,
+				Epoch:       req.RequiredEpoch,
+				MessageHash: computeMessageHash(t, req.KeyTag, req.Message),
+				Proof:       randomBytes(t, 230),
+			}
+			require.NoError(t, repo.SaveSignatureRequest(t.Context(), msg.RequestID(), req))
+			setupValidatorSetHeader(t, repo, req.RequiredEpoch, big.NewInt(670))
+
+			upgraded := msg
+			upgraded.Proof = msg.Proof[:226]
+
+			upgradedSignal := mocks.NewMockAggProofSignal(gomock.NewController(t))
+			upgradedSignal.EXPECT().Emit(upgraded).Return(nil)
+
+			processor, err := NewEntityProcessor(Config{
+				Repo:                     repo,
+				Aggregator:               createMockAggregator(t),
+				AggProofSignal:           createMockAggProofSignal(t),
+				AggProofUpgradedSignal:   upgradedSignal,
+				SignatureProcessedSignal: createMockSignatureProcessedSignal(t),
+				Metrics:                  doNothingMetrics{},
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, processor.ProcessAggregationProof(t.Context(), msg))
+			require.NoError(t, processor.ProcessAggregationProof(t.Context(), upgraded))
+
+			// the stored proof is never replaced by a worse one
+			require.ErrorIs(t, processor.ProcessAggregationProof(t.Context(), msg), entity.ErrEntityAlreadyExist)
+
+			savedProof, err := repo.GetAggregationProof(t.Context(), msg.RequestID())
+			require.NoError(t, err)
+			require.Equal(t, upgraded, savedProof)
+		})
+	}
+}
+
 func TestEntityProcessor_ProcessSignature_SavesAggregationProofPendingForAggregationKeys(t *testing.T) {
 	t.Parallel()
 
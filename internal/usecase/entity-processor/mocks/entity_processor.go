@@ -87,6 +87,21 @@ func (mr *MockRepositoryMockRecorder) GetSignatureByIndex(ctx, requestID, valida
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignatureByIndex", reflect.TypeOf((*MockRepository)(nil).GetSignatureByIndex), ctx, requestID, validatorIndex)
 }
 
+// GetSignatureRequest mocks base method.
+func (m *MockRepository) GetSignatureRequest(ctx context.Context, requestID common.Hash) (entity.SignatureRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignatureRequest", ctx, requestID)
+	ret0, _ := ret[0].(entity.SignatureRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignatureRequest indicates an expected call of GetSignatureRequest.
+func (mr *MockRepositoryMockRecorder) GetSignatureRequest(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignatureRequest", reflect.TypeOf((*MockRepository)(nil).GetSignatureRequest), ctx, requestID)
+}
+
 // GetValidatorByKey mocks base method.
 func (m *MockRepository) GetValidatorByKey(ctx context.Context, epoch entity.Epoch, keyTag entity.KeyTag, publicKey []byte) (entity.Validator, uint32, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +133,35 @@ func (mr *MockRepositoryMockRecorder) GetValidatorSetByEpoch(ctx, epoch any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorSetByEpoch", reflect.TypeOf((*MockRepository)(nil).GetValidatorSetByEpoch), ctx, epoch)
 }
 
+// SaveEquivocationEvidence mocks base method.
+func (m *MockRepository) SaveEquivocationEvidence(ctx context.Context, evidence entity.EquivocationEvidence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEquivocationEvidence", ctx, evidence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveEquivocationEvidence indicates an expected call of SaveEquivocationEvidence.
+func (mr *MockRepositoryMockRecorder) SaveEquivocationEvidence(ctx, evidence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEquivocationEvidence", reflect.TypeOf((*MockRepository)(nil).SaveEquivocationEvidence), ctx, evidence)
+}
+
+// SaveHeaderCommitmentSignature mocks base method.
+func (m *MockRepository) SaveHeaderCommitmentSignature(ctx context.Context, keyTag entity.KeyTag, operator common.Address, signed entity.SignedHeaderCommitment) (entity.SignedHeaderCommitment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveHeaderCommitmentSignature", ctx, keyTag, operator, signed)
+	ret0, _ := ret[0].(entity.SignedHeaderCommitment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveHeaderCommitmentSignature indicates an expected call of SaveHeaderCommitmentSignature.
+func (mr *MockRepositoryMockRecorder) SaveHeaderCommitmentSignature(ctx, keyTag, operator, signed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveHeaderCommitmentSignature", reflect.TypeOf((*MockRepository)(nil).SaveHeaderCommitmentSignature), ctx, keyTag, operator, signed)
+}
+
 // SaveProof mocks base method.
 func (m *MockRepository) SaveProof(ctx context.Context, aggregationProof entity.AggregationProof) error {
 	m.ctrl.T.Helper()
@@ -160,6 +204,20 @@ func (mr *MockRepositoryMockRecorder) UpdateValidatorSetStatus(ctx, epoch, item
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValidatorSetStatus", reflect.TypeOf((*MockRepository)(nil).UpdateValidatorSetStatus), ctx, epoch, item)
 }
 
+// UpgradeProof mocks base method.
+func (m *MockRepository) UpgradeProof(ctx context.Context, aggregationProof entity.AggregationProof) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeProof", ctx, aggregationProof)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpgradeProof indicates an expected call of UpgradeProof.
+func (mr *MockRepositoryMockRecorder) UpgradeProof(ctx, aggregationProof any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeProof", reflect.TypeOf((*MockRepository)(nil).UpgradeProof), ctx, aggregationProof)
+}
+
 // MockAggregator is a mock of Aggregator interface.
 type MockAggregator struct {
 	ctrl     *gomock.Controller
@@ -237,6 +295,44 @@ func (mr *MockAggProofSignalMockRecorder) Emit(payload any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emit", reflect.TypeOf((*MockAggProofSignal)(nil).Emit), payload)
 }
 
+// MockEquivocationSignal is a mock of EquivocationSignal interface.
+type MockEquivocationSignal struct {
+	ctrl     *gomock.Controller
+	recorder *MockEquivocationSignalMockRecorder
+	isgomock struct{}
+}
+
+// MockEquivocationSignalMockRecorder is the mock recorder for MockEquivocationSignal.
+type MockEquivocationSignalMockRecorder struct {
+	mock *MockEquivocationSignal
+}
+
+// NewMockEquivocationSignal creates a new mock instance.
+func NewMockEquivocationSignal(ctrl *gomock.Controller) *MockEquivocationSignal {
+	mock := &MockEquivocationSignal{ctrl: ctrl}
+	mock.recorder = &MockEquivocationSignalMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEquivocationSignal) EXPECT() *MockEquivocationSignalMockRecorder {
+	return m.recorder
+}
+
+// Emit mocks base method.
+func (m *MockEquivocationSignal) Emit(payload entity.EquivocationEvidence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Emit", payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Emit indicates an expected call of Emit.
+func (mr *MockEquivocationSignalMockRecorder) Emit(payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emit", reflect.TypeOf((*MockEquivocationSignal)(nil).Emit), payload)
+}
+
 // MockMetrics is a mock of Metrics interface.
 type MockMetrics struct {
 	ctrl     *gomock.Controller
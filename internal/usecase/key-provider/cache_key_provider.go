@@ -1,25 +1,39 @@
 package keyprovider
 
 import (
+	"context"
+	"log/slog"
 	"sync"
+	"time"
 
-	"github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
 )
 
 type CacheKeyProvider struct {
 	KeyProvider
 
 	nodeKeyMap *sync.Map // map[keyTag]CompactPublicKey
+	nextKeyMap *sync.Map // map[keyTag]CompactPublicKey
+}
+
+// reloadableKeyProvider is a key provider whose keys can change while the relay runs.
+type reloadableKeyProvider interface {
+	Reload() (bool, error)
 }
 
 func NewCacheKeyProvider(kp KeyProvider) *CacheKeyProvider {
 	return &CacheKeyProvider{
 		KeyProvider: kp,
 		nodeKeyMap:  &sync.Map{},
+		nextKeyMap:  &sync.Map{},
 	}
 }
 
-func (c *CacheKeyProvider) GetOnchainKeyFromCache(keyTag entity.KeyTag) (entity.CompactPublicKey, error) {
+func (c *CacheKeyProvider) GetOnchainKeyFromCache(keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, error) {
 	onchainKey, ok := c.nodeKeyMap.Load(keyTag)
 	if !ok {
 		symbPrivate, err := c.GetPrivateKey(keyTag)
@@ -30,5 +44,102 @@ func (c *CacheKeyProvider) GetOnchainKeyFromCache(keyTag entity.KeyTag) (entity.
 		onchainKey = symbPrivate.PublicKey().OnChain()
 		c.nodeKeyMap.Store(keyTag, onchainKey)
 	}
-	return onchainKey.(entity.CompactPublicKey), nil
+	return onchainKey.(symbiotic.CompactPublicKey), nil
+}
+
+// GetOnchainKeyForValset returns the onchain key the validator set knows the node by.
+// During key rotation it is the next key once the validator set captured it and the current key before that.
+func (c *CacheKeyProvider) GetOnchainKeyForValset(valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, error) {
+	nextKey, captured, err := c.nextKeyCaptured(valset, keyTag)
+	if err != nil {
+		return nil, err
+	}
+	if captured {
+		return nextKey, nil
+	}
+	return c.GetOnchainKeyFromCache(keyTag)
+}
+
+// GetPrivateKeyForValset returns the private key matching GetOnchainKeyForValset,
+// so requests of epochs before the rotation are still signed with the current key.
+func (c *CacheKeyProvider) GetPrivateKeyForValset(valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag) (crypto.PrivateKey, error) {
+	_, captured, err := c.nextKeyCaptured(valset, keyTag)
+	if err != nil {
+		return nil, err
+	}
+	if captured {
+		return c.getNextPrivateKey(keyTag)
+	}
+	return c.GetPrivateKey(keyTag)
+}
+
+func (c *CacheKeyProvider) nextKeyCaptured(valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, bool, error) {
+	nextKey, ok, err := c.getNextOnchainKey(keyTag)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	_, found := valset.FindValidatorByKey(keyTag, nextKey)
+	return nextKey, found, nil
+}
+
+func (c *CacheKeyProvider) getNextOnchainKey(keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, bool, error) {
+	if onchainKey, ok := c.nextKeyMap.Load(keyTag); ok {
+		return onchainKey.(symbiotic.CompactPublicKey), true, nil
+	}
+
+	nextPrivate, err := c.getNextPrivateKey(keyTag)
+	if err != nil {
+		if errors.Is(err, entity.ErrKeyNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	onchainKey := nextPrivate.PublicKey().OnChain()
+	c.nextKeyMap.Store(keyTag, onchainKey)
+	return onchainKey, true, nil
+}
+
+func (c *CacheKeyProvider) getNextPrivateKey(keyTag symbiotic.KeyTag) (crypto.PrivateKey, error) {
+	alias, err := NextKeyTagToAlias(keyTag)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetPrivateKeyByAlias(alias)
+}
+
+// Reload reloads the keys of the underlying provider if it supports it and drops the cached onchain keys
+// when the keys changed, so a next key added by rotate-key is picked up and a promoted one no longer counts as next.
+func (c *CacheKeyProvider) Reload() error {
+	reloadable, ok := c.KeyProvider.(reloadableKeyProvider)
+	if !ok {
+		return nil
+	}
+	changed, err := reloadable.Reload()
+	if err != nil || !changed {
+		return err
+	}
+	c.nodeKeyMap.Clear()
+	c.nextKeyMap.Clear()
+	slog.Info("Reloaded changed keystore")
+	return nil
+}
+
+// WatchKeys reloads the keys every interval until the context is canceled, failed reloads are retried on the next tick.
+func (c *CacheKeyProvider) WatchKeys(ctx context.Context, interval time.Duration) {
+	if _, ok := c.KeyProvider.(reloadableKeyProvider); !ok {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Reload(); err != nil {
+				slog.WarnContext(ctx, "Failed to reload keys", "error", err)
+			}
+		}
+	}
 }
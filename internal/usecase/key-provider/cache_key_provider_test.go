@@ -0,0 +1,116 @@
+package keyprovider
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+func TestCacheKeyProvider_SelectsKeyForValset(t *testing.T) {
+	const keyTag = symbiotic.KeyTag(15)
+
+	simple, err := NewSimpleKeystoreProvider()
+	require.NoError(t, err)
+
+	current, err := crypto.NewPrivateKey(symbiotic.KeyTypeBlsBn254, []byte{'a', 'b', 'c'})
+	require.NoError(t, err)
+	next, err := crypto.NewPrivateKey(symbiotic.KeyTypeBlsBn254, []byte{'d', 'e', 'f'})
+	require.NoError(t, err)
+	require.NoError(t, simple.AddKey(keyTag, current))
+
+	kp := NewCacheKeyProvider(simple)
+	valsetWith := func(key crypto.PrivateKey) symbiotic.ValidatorSet {
+		return symbiotic.ValidatorSet{
+			RequiredKeyTag: keyTag,
+			Validators: symbiotic.Validators{{
+				Operator:    common.HexToAddress("0x1"),
+				VotingPower: symbiotic.ToVotingPower(big.NewInt(1)),
+				IsActive:    true,
+				Keys:        []symbiotic.ValidatorKey{{Tag: keyTag, Payload: key.PublicKey().OnChain()}},
+			}},
+		}
+	}
+	oldValset := valsetWith(current)
+	newValset := valsetWith(next)
+
+	// no next key, the current key is used for any validator set
+	onchainKey, err := kp.GetOnchainKeyForValset(newValset, keyTag)
+	require.NoError(t, err)
+	require.Equal(t, current.PublicKey().OnChain(), onchainKey)
+
+	require.NoError(t, simple.AddNextKey(keyTag, next))
+
+	onchainKey, err = kp.GetOnchainKeyForValset(oldValset, keyTag)
+	require.NoError(t, err)
+	require.Equal(t, current.PublicKey().OnChain(), onchainKey)
+	privateKey, err := kp.GetPrivateKeyForValset(oldValset, keyTag)
+	require.NoError(t, err)
+	require.Equal(t, current.Bytes(), privateKey.Bytes())
+
+	onchainKey, err = kp.GetOnchainKeyForValset(newValset, keyTag)
+	require.NoError(t, err)
+	require.Equal(t, next.PublicKey().OnChain(), onchainKey)
+	privateKey, err = kp.GetPrivateKeyForValset(newValset, keyTag)
+	require.NoError(t, err)
+	require.Equal(t, next.Bytes(), privateKey.Bytes())
+}
+
+func TestCacheKeyProvider_ReloadPicksUpRotation(t *testing.T) {
+	const keyTag = symbiotic.KeyTag(15)
+	path := filepath.Join(t.TempDir(), "keystore.jks")
+
+	current, err := crypto.NewPrivateKey(symbiotic.KeyTypeBlsBn254, []byte{'a', 'b', 'c'})
+	require.NoError(t, err)
+	next, err := crypto.NewPrivateKey(symbiotic.KeyTypeBlsBn254, []byte{'d', 'e', 'f'})
+	require.NoError(t, err)
+
+	cli, err := NewKeystoreProvider(path, "password")
+	require.NoError(t, err)
+	require.NoError(t, cli.AddKey(SYMBIOTIC_KEY_NAMESPACE, keyTag, current, "password", false))
+
+	relay, err := NewKeystoreProvider(path, "password")
+	require.NoError(t, err)
+	kp := NewCacheKeyProvider(relay)
+	onchainKey, err := kp.GetOnchainKeyFromCache(keyTag)
+	require.NoError(t, err)
+	require.Equal(t, current.PublicKey().OnChain(), onchainKey)
+	_, ok, err := kp.getNextOnchainKey(keyTag)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// rotate-key stores the next key in the file the relay reads
+	require.NoError(t, cli.AddNextKey(keyTag, next, "password", false))
+	touch(t, path, time.Minute)
+	require.NoError(t, kp.Reload())
+	nextKey, ok, err := kp.getNextOnchainKey(keyTag)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, next.PublicKey().OnChain(), nextKey)
+
+	// rotate-key --finalize promotes it, the cached next key is dropped
+	require.NoError(t, cli.PromoteNextKey(keyTag, "password"))
+	touch(t, path, 2*time.Minute)
+	require.NoError(t, kp.Reload())
+	_, ok, err = kp.getNextOnchainKey(keyTag)
+	require.NoError(t, err)
+	require.False(t, ok)
+	onchainKey, err = kp.GetOnchainKeyFromCache(keyTag)
+	require.NoError(t, err)
+	require.Equal(t, next.PublicKey().OnChain(), onchainKey)
+}
+
+// touch moves the modification time of the file forward, writes within the timestamp granularity of the filesystem
+// would not be seen as changes otherwise.
+func touch(t *testing.T, path string, by time.Duration) {
+	t.Helper()
+	modTime := time.Now().Add(by)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
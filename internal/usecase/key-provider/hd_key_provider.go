@@ -0,0 +1,140 @@
+package keyprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	ethKeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/go-errors/errors"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto/hd"
+)
+
+const seedFileVersion = 1
+
+// Relay keys are derived from a single seed, the namespace index keeps keys of different namespaces apart:
+//
+//	secp256k1 keys:  m/44'/60'/{namespace}'/0/{id}
+//	BLS12-381 keys:  m/12381/60/{namespace}/{id}
+//	BN254 keys:      m/254/60/{namespace}/{id}
+//
+// namespace is 0 for evm, 1 for p2p, 2 for symb and 3 for symbnext keys,
+// so the default evm key is the first account of the mnemonic in Ethereum wallets.
+var hdNamespaceIndex = map[string]int{
+	EVM_KEY_NAMESPACE:            0,
+	P2P_KEY_NAMESPACE:            1,
+	SYMBIOTIC_KEY_NAMESPACE:      2,
+	NEXT_SYMBIOTIC_KEY_NAMESPACE: 3,
+}
+
+type seedFile struct {
+	Version int                    `json:"version"`
+	Crypto  ethKeystore.CryptoJSON `json:"crypto"`
+}
+
+// DerivationPath returns the HD derivation path of the key.
+func DerivationPath(namespace string, keyType symbiotic.KeyType, id int) (string, error) {
+	// validates the key type and id the same way keystore aliases do
+	if _, err := ToAlias(namespace, keyType, id); err != nil {
+		return "", err
+	}
+	nsIndex, ok := hdNamespaceIndex[namespace]
+	if !ok {
+		return "", errors.Errorf("namespace %q does not support HD derivation", namespace)
+	}
+	if id < 0 || id >= 1<<31 {
+		return "", errors.Errorf("key id %d is out of HD derivation range", id)
+	}
+
+	switch keyType {
+	case symbiotic.KeyTypeEcdsaSecp256k1:
+		return fmt.Sprintf("m/44'/60'/%d'/0/%d", nsIndex, id), nil
+	case symbiotic.KeyTypeBls12381:
+		return fmt.Sprintf("m/12381/60/%d/%d", nsIndex, id), nil
+	case symbiotic.KeyTypeBlsBn254:
+		return fmt.Sprintf("m/254/60/%d/%d", nsIndex, id), nil
+	case symbiotic.KeyTypeInvalid:
+		return "", errors.New("unsupported key type")
+	}
+	return "", errors.New("unsupported key type")
+}
+
+// DeriveKey derives the key from the seed along its DerivationPath.
+func DeriveKey(seed []byte, namespace string, keyType symbiotic.KeyType, id int) (crypto.PrivateKey, error) {
+	path, err := DerivationPath(namespace, keyType, id)
+	if err != nil {
+		return nil, err
+	}
+	return hd.DerivePrivateKey(seed, keyType, path)
+}
+
+// NewHDKeyProvider derives the keys with the given aliases from the seed.
+func NewHDKeyProvider(seed []byte, aliases []string) (*SimpleKeystoreProvider, error) {
+	kp, err := NewSimpleKeystoreProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, alias := range aliases {
+		namespace, keyType, id, err := AliasToKeyTypeId(alias)
+		if err != nil {
+			return nil, errors.Errorf("invalid key alias %q: %w", alias, err)
+		}
+		key, err := DeriveKey(seed, namespace, keyType, id)
+		if err != nil {
+			return nil, errors.Errorf("failed to derive key %q: %w", alias, err)
+		}
+		if err := kp.AddKeyByNamespaceTypeId(namespace, keyType, id, key); err != nil {
+			return nil, err
+		}
+	}
+
+	return kp, nil
+}
+
+// SaveSeed encrypts the seed with the password and writes it to the file.
+func SaveSeed(filePath string, seed []byte, password string, force bool) error {
+	if _, err := os.Stat(filePath); err == nil && !force {
+		return errors.New("seed file already exists")
+	}
+
+	encrypted, err := ethKeystore.EncryptDataV3(seed, []byte(password), ethKeystore.StandardScryptN, ethKeystore.StandardScryptP)
+	if err != nil {
+		return errors.Errorf("failed to encrypt seed: %w", err)
+	}
+	data, err := json.Marshal(seedFile{Version: seedFileVersion, Crypto: encrypted})
+	if err != nil {
+		return errors.Errorf("failed to marshal seed file: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, data, 0600)
+}
+
+// LoadSeed reads the seed written by SaveSeed.
+func LoadSeed(filePath, password string) ([]byte, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var file seedFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, errors.Errorf("failed to parse seed file: %w", err)
+	}
+	if file.Version != seedFileVersion {
+		return nil, errors.Errorf("unsupported seed file version %d", file.Version)
+	}
+
+	seed, err := ethKeystore.DecryptDataV3(file.Crypto, password)
+	if err != nil {
+		return nil, errors.Errorf("failed to decrypt seed: %w", err)
+	}
+	return seed, nil
+}
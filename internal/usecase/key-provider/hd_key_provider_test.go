@@ -0,0 +1,85 @@
+package keyprovider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto/hd"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestDerivationPath(t *testing.T) {
+	tests := []struct {
+		namespace string
+		keyType   symbiotic.KeyType
+		id        int
+		expected  string
+	}{
+		{EVM_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, DEFAULT_EVM_CHAIN_ID, "m/44'/60'/0'/0/0"},
+		{EVM_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, 31337, "m/44'/60'/0'/0/31337"},
+		{P2P_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, P2P_HOST_IDENTITY_KEY_ID, "m/44'/60'/1'/0/1"},
+		{SYMBIOTIC_KEY_NAMESPACE, symbiotic.KeyTypeBlsBn254, 15, "m/254/60/2/15"},
+		{SYMBIOTIC_KEY_NAMESPACE, symbiotic.KeyTypeBls12381, 1, "m/12381/60/2/1"},
+		{SYMBIOTIC_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, 0, "m/44'/60'/2'/0/0"},
+		{NEXT_SYMBIOTIC_KEY_NAMESPACE, symbiotic.KeyTypeBlsBn254, 15, "m/254/60/3/15"},
+	}
+	for _, tt := range tests {
+		path, err := DerivationPath(tt.namespace, tt.keyType, tt.id)
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, path)
+	}
+
+	_, err := DerivationPath("unknown", symbiotic.KeyTypeEcdsaSecp256k1, 0)
+	require.Error(t, err)
+	_, err = DerivationPath(SYMBIOTIC_KEY_NAMESPACE, symbiotic.KeyTypeBlsBn254, 16)
+	require.Error(t, err)
+	_, err = DerivationPath(EVM_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, 1<<31)
+	require.Error(t, err)
+}
+
+func TestNewHDKeyProvider(t *testing.T) {
+	seed, err := hd.SeedFromMnemonic(testMnemonic, "")
+	require.NoError(t, err)
+
+	kp, err := NewHDKeyProvider(seed, []string{"symb-bls_bn254-15", "evm-ecdsa_secp256k1-0", "p2p-ecdsa_secp256k1-1"})
+	require.NoError(t, err)
+
+	blsKey, err := kp.GetPrivateKey(15)
+	require.NoError(t, err)
+	expected, err := DeriveKey(seed, SYMBIOTIC_KEY_NAMESPACE, symbiotic.KeyTypeBlsBn254, 15)
+	require.NoError(t, err)
+	require.Equal(t, expected.Bytes(), blsKey.Bytes())
+
+	// evm keys of other chains fall back to the default key
+	evmKey, err := kp.GetPrivateKeyByNamespaceTypeId(EVM_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, 1)
+	require.NoError(t, err)
+	defaultEvmKey, err := kp.GetPrivateKeyByNamespaceTypeId(EVM_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, DEFAULT_EVM_CHAIN_ID)
+	require.NoError(t, err)
+	require.Equal(t, defaultEvmKey.Bytes(), evmKey.Bytes())
+
+	exists, err := kp.HasKeyByNamespaceTypeId(P2P_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, P2P_HOST_IDENTITY_KEY_ID)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	_, err = NewHDKeyProvider(seed, []string{"invalid"})
+	require.Error(t, err)
+}
+
+func TestSaveAndLoadSeed(t *testing.T) {
+	path := t.TempDir() + "/seed.json"
+	seed, err := hd.SeedFromMnemonic(testMnemonic, "")
+	require.NoError(t, err)
+
+	require.NoError(t, SaveSeed(path, seed, "password", false))
+	require.Error(t, SaveSeed(path, seed, "password", false))
+
+	loaded, err := LoadSeed(path, "password")
+	require.NoError(t, err)
+	require.Equal(t, seed, loaded)
+
+	_, err = LoadSeed(path, "wrong")
+	require.Error(t, err)
+}
@@ -12,8 +12,10 @@ import (
 
 const (
 	SYMBIOTIC_KEY_NAMESPACE = "symb"
-	EVM_KEY_NAMESPACE       = "evm"
-	P2P_KEY_NAMESPACE       = "p2p"
+	// NEXT_SYMBIOTIC_KEY_NAMESPACE holds keys registered on chain during rotation that are not captured by a validator set yet
+	NEXT_SYMBIOTIC_KEY_NAMESPACE = "symbnext"
+	EVM_KEY_NAMESPACE            = "evm"
+	P2P_KEY_NAMESPACE            = "p2p"
 
 	// DEFAULT_EVM_CHAIN_ID chain id used to identify the default key for all chains
 	DEFAULT_EVM_CHAIN_ID = 0
@@ -41,6 +43,10 @@ func KeyTagToAlias(keyTag symbiotic.KeyTag) (string, error) {
 	return KeyTagToAliasWithNS(SYMBIOTIC_KEY_NAMESPACE, keyTag)
 }
 
+func NextKeyTagToAlias(keyTag symbiotic.KeyTag) (string, error) {
+	return KeyTagToAliasWithNS(NEXT_SYMBIOTIC_KEY_NAMESPACE, keyTag)
+}
+
 func ToAlias(namespace string, keyType symbiotic.KeyType, keyId int) (string, error) {
 	keyTypeStr, err := keyType.String()
 	if err != nil {
@@ -51,7 +57,7 @@ func ToAlias(namespace string, keyType symbiotic.KeyType, keyId int) (string, er
 		return "", errors.New("namespace must not contain dash")
 	}
 
-	if namespace == SYMBIOTIC_KEY_NAMESPACE && (keyId < 0 || keyId > 15) {
+	if (namespace == SYMBIOTIC_KEY_NAMESPACE || namespace == NEXT_SYMBIOTIC_KEY_NAMESPACE) && (keyId < 0 || keyId > 15) {
 		return "", errors.New("key ID must be between 0 and 15 for symbiotic namespace")
 	}
 
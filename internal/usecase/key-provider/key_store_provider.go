@@ -4,6 +4,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/symbioticfi/relay/internal/entity"
@@ -15,31 +16,83 @@ import (
 )
 
 type KeystoreProvider struct {
-	ks       keystore.KeyStore
 	filePath string
+	password string
+
+	mu      sync.RWMutex
+	ks      keystore.KeyStore
+	modTime time.Time // modification time of the loaded file
 }
 
 func NewKeystoreProvider(filePath, password string) (*KeystoreProvider, error) {
+	ks, modTime, err := loadKeystore(filePath, password)
+	if err != nil {
+		return nil, err
+	}
+	return &KeystoreProvider{filePath: filePath, password: password, ks: ks, modTime: modTime}, nil
+}
+
+// Reload loads the keystore file again if it was modified since it was loaded, so keys added or promoted
+// by other processes, e.g. the rotate-key command, are used without a restart. It reports whether the keys changed.
+func (k *KeystoreProvider) Reload() (bool, error) {
+	info, err := os.Stat(k.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	k.mu.RLock()
+	modTime := k.modTime
+	k.mu.RUnlock()
+	if info.ModTime().Equal(modTime) {
+		return false, nil
+	}
+
+	ks, modTime, err := loadKeystore(k.filePath, k.password)
+	if err != nil {
+		return false, errors.Errorf("failed to reload keystore: %w", err)
+	}
+	k.mu.Lock()
+	k.ks = ks
+	k.modTime = modTime
+	k.mu.Unlock()
+	return true, nil
+}
+
+func loadKeystore(filePath, password string) (keystore.KeyStore, time.Time, error) {
 	ks := keystore.New()
 
 	f, err := os.Open(filePath)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return &KeystoreProvider{ks: ks, filePath: filePath}, nil
+			return ks, time.Time{}, nil
 		}
-		return nil, err
+		return keystore.KeyStore{}, time.Time{}, err
 	}
 	defer f.Close()
 
+	info, err := f.Stat()
+	if err != nil {
+		return keystore.KeyStore{}, time.Time{}, err
+	}
 	err = ks.Load(f, []byte(password))
 	if err != nil {
-		return nil, err
+		return keystore.KeyStore{}, time.Time{}, err
 	}
-	return &KeystoreProvider{ks: ks, filePath: filePath}, nil
+	return ks, info.ModTime(), nil
+}
+
+// store returns the loaded keystore, keys are only changed through it by the process that owns the file.
+func (k *KeystoreProvider) store() keystore.KeyStore {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.ks
 }
 
 func (k *KeystoreProvider) GetAliases() []string {
-	return k.ks.Aliases()
+	return k.store().Aliases()
 }
 
 func (k *KeystoreProvider) GetPrivateKey(keyTag symbiotic.KeyTag) (crypto.PrivateKey, error) {
@@ -52,7 +105,7 @@ func (k *KeystoreProvider) GetPrivateKey(keyTag symbiotic.KeyTag) (crypto.Privat
 }
 
 func (k *KeystoreProvider) GetPrivateKeyByAlias(alias string) (crypto.PrivateKey, error) {
-	entry, err := k.ks.GetPrivateKeyEntry(alias, []byte{})
+	entry, err := k.store().GetPrivateKeyEntry(alias, []byte{})
 	if err != nil {
 		if errors.Is(err, keystore.ErrEntryNotFound) {
 			return nil, errors.New(entity.ErrKeyNotFound)
@@ -92,11 +145,11 @@ func (k *KeystoreProvider) HasKey(keyTag symbiotic.KeyTag) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	return k.ks.IsPrivateKeyEntry(alias), nil
+	return k.store().IsPrivateKeyEntry(alias), nil
 }
 
 func (k *KeystoreProvider) HasKeyByAlias(alias string) (bool, error) {
-	return k.ks.IsPrivateKeyEntry(alias), nil
+	return k.store().IsPrivateKeyEntry(alias), nil
 }
 
 func (k *KeystoreProvider) HasKeyByNamespaceTypeId(namespace string, keyType symbiotic.KeyType, id int) (bool, error) {
@@ -104,7 +157,7 @@ func (k *KeystoreProvider) HasKeyByNamespaceTypeId(namespace string, keyType sym
 	if err != nil {
 		return false, err
 	}
-	return k.ks.IsPrivateKeyEntry(alias), nil
+	return k.store().IsPrivateKeyEntry(alias), nil
 }
 
 func (k *KeystoreProvider) AddKey(namespace string, keyTag symbiotic.KeyTag, privateKey crypto.PrivateKey, password string, force bool) error {
@@ -122,7 +175,7 @@ func (k *KeystoreProvider) AddKey(namespace string, keyTag symbiotic.KeyTag, pri
 		return err
 	}
 
-	err = k.ks.SetPrivateKeyEntry(alias, keystore.PrivateKeyEntry{
+	err = k.store().SetPrivateKeyEntry(alias, keystore.PrivateKeyEntry{
 		CreationTime:     time.Now(),
 		PrivateKey:       privateKey.Bytes(),
 		CertificateChain: nil,
@@ -158,7 +211,7 @@ func (k *KeystoreProvider) DeleteKey(keyTag symbiotic.KeyTag, password string) e
 		return err
 	}
 
-	k.ks.DeleteEntry(alias)
+	k.store().DeleteEntry(alias)
 
 	err = k.dump(password)
 	if err != nil {
@@ -183,7 +236,7 @@ func (k *KeystoreProvider) AddKeyByNamespaceTypeId(ns string, tp symbiotic.KeyTy
 		return err
 	}
 
-	err = k.ks.SetPrivateKeyEntry(alias, keystore.PrivateKeyEntry{
+	err = k.store().SetPrivateKeyEntry(alias, keystore.PrivateKeyEntry{
 		CreationTime:     time.Now(),
 		PrivateKey:       privateKey.Bytes(),
 		CertificateChain: nil,
@@ -219,7 +272,7 @@ func (k *KeystoreProvider) DeleteKeyByNamespaceTypeId(ns string, tp symbiotic.Ke
 		return err
 	}
 
-	k.ks.DeleteEntry(alias)
+	k.store().DeleteEntry(alias)
 
 	err = k.dump(password)
 	if err != nil {
@@ -229,6 +282,39 @@ func (k *KeystoreProvider) DeleteKeyByNamespaceTypeId(ns string, tp symbiotic.Ke
 	return nil
 }
 
+// AddNextKey stores the key a key tag rotates to, it is used for signing once a validator set captures it.
+func (k *KeystoreProvider) AddNextKey(keyTag symbiotic.KeyTag, privateKey crypto.PrivateKey, password string, force bool) error {
+	return k.AddKeyByNamespaceTypeId(NEXT_SYMBIOTIC_KEY_NAMESPACE, keyTag.Type(), int(keyTag&0x0F), privateKey, password, force)
+}
+
+// PromoteNextKey finishes the rotation of a key tag: the next key replaces the current one.
+func (k *KeystoreProvider) PromoteNextKey(keyTag symbiotic.KeyTag, password string) error {
+	nextAlias, err := NextKeyTagToAlias(keyTag)
+	if err != nil {
+		return err
+	}
+	entry, err := k.store().GetPrivateKeyEntry(nextAlias, []byte{})
+	if err != nil {
+		if errors.Is(err, keystore.ErrEntryNotFound) {
+			return errors.New("next key does not exist")
+		}
+		return err
+	}
+
+	alias, err := KeyTagToAlias(keyTag)
+	if err != nil {
+		return err
+	}
+
+	entry.CreationTime = time.Now()
+	if err := k.store().SetPrivateKeyEntry(alias, entry, []byte{}); err != nil {
+		return err
+	}
+	k.store().DeleteEntry(nextAlias)
+
+	return k.dump(password)
+}
+
 func (k *KeystoreProvider) dump(password string) error {
 	dir := filepath.Dir(k.filePath)
 	if err := os.MkdirAll(dir, 0755); err != nil {
@@ -247,7 +333,7 @@ func (k *KeystoreProvider) dump(password string) error {
 		}
 	}()
 
-	err = k.ks.Store(f, []byte(password))
+	err = k.store().Store(f, []byte(password))
 	if err != nil {
 		return err
 	}
@@ -107,3 +107,42 @@ func TestDefaultEVMKey(t *testing.T) {
 	_, err = kp.GetPrivateKeyByNamespaceTypeId(SYMBIOTIC_KEY_NAMESPACE, symbiotic.KeyTypeBlsBn254, 11)
 	require.ErrorIs(t, err, entity.ErrKeyNotFound, "expected entry not found error for non-existing key")
 }
+
+func TestNextKeyPromotion(t *testing.T) {
+	path := t.TempDir() + "/TMP-keystore"
+	password := "password"
+
+	kp, err := NewKeystoreProvider(path, password)
+	require.NoError(t, err)
+
+	current, err := crypto.NewPrivateKey(symbiotic.KeyTypeBlsBn254, []byte{'a', 'b', 'c'})
+	require.NoError(t, err)
+	next, err := crypto.NewPrivateKey(symbiotic.KeyTypeBlsBn254, []byte{'d', 'e', 'f'})
+	require.NoError(t, err)
+
+	require.NoError(t, kp.AddKey(SYMBIOTIC_KEY_NAMESPACE, 15, current, password, false))
+	require.NoError(t, kp.AddNextKey(15, next, password, false))
+	require.Error(t, kp.AddNextKey(15, next, password, false))
+
+	kp, err = NewKeystoreProvider(path, password)
+	require.NoError(t, err)
+
+	storedCurrent, err := kp.GetPrivateKey(15)
+	require.NoError(t, err)
+	require.Equal(t, current.Bytes(), storedCurrent.Bytes())
+
+	require.NoError(t, kp.PromoteNextKey(15, password))
+
+	kp, err = NewKeystoreProvider(path, password)
+	require.NoError(t, err)
+
+	storedCurrent, err = kp.GetPrivateKey(15)
+	require.NoError(t, err)
+	require.Equal(t, next.Bytes(), storedCurrent.Bytes())
+
+	exists, err := kp.HasKeyByNamespaceTypeId(NEXT_SYMBIOTIC_KEY_NAMESPACE, symbiotic.KeyTypeBlsBn254, 15)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.Error(t, kp.PromoteNextKey(15, password))
+}
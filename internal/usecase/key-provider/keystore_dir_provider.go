@@ -0,0 +1,182 @@
+package keyprovider
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-errors/errors"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+// DefaultPasswordKey is the password file entry used for keystore files without their own entry.
+const DefaultPasswordKey = "*"
+
+// KeystoreFile is a key read from a JSON keystore file.
+type KeystoreFile struct {
+	Name  string
+	Alias string
+	Key   crypto.PrivateKey
+}
+
+// NewKeystoreDirProvider loads keys from a directory of EIP-2335 and geth-style keystore files, see ReadKeystoreDir.
+func NewKeystoreDirProvider(dir string, passwords map[string]string) (*SimpleKeystoreProvider, error) {
+	files, err := ReadKeystoreDir(dir, passwords)
+	if err != nil {
+		return nil, err
+	}
+
+	kp, err := NewSimpleKeystoreProvider()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		namespace, keyType, id, err := AliasToKeyTypeId(file.Alias)
+		if err != nil {
+			return nil, err
+		}
+		if err := kp.AddKeyByNamespaceTypeId(namespace, keyType, id, file.Key); err != nil {
+			return nil, err
+		}
+	}
+	return kp, nil
+}
+
+// ReadKeystoreDir decrypts all *.json keystore files of the directory with their passwords from the password file.
+// The alias of a key is the file name without extension, e.g. symb-bls_bn254-15.json, or the relay derivation path
+// recorded in an EIP-2335 keystore.
+func ReadKeystoreDir(dir string, passwords map[string]string) ([]KeystoreFile, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	files := make([]KeystoreFile, 0, len(names))
+	aliases := make(map[string]string, len(names))
+	for _, name := range names {
+		base := filepath.Base(name)
+		password, ok := passwords[base]
+		if !ok {
+			password, ok = passwords[DefaultPasswordKey]
+		}
+		if !ok {
+			return nil, errors.Errorf("no password for keystore file %s", base)
+		}
+
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		decrypted, err := DecryptKeystoreJSON(data, password)
+		if err != nil {
+			return nil, errors.Errorf("failed to decrypt keystore file %s: %w", base, err)
+		}
+		alias, err := KeystoreFileAlias(base, decrypted)
+		if err != nil {
+			return nil, errors.Errorf("failed to get alias of keystore file %s: %w", base, err)
+		}
+		if other, ok := aliases[alias]; ok {
+			return nil, errors.Errorf("keystore files %s and %s hold the same key %s", other, base, alias)
+		}
+		aliases[alias] = base
+
+		_, keyType, _, err := AliasToKeyTypeId(alias)
+		if err != nil {
+			return nil, err
+		}
+		key, err := crypto.NewPrivateKey(keyType, decrypted.Secret)
+		if err != nil {
+			return nil, errors.Errorf("invalid key in keystore file %s: %w", base, err)
+		}
+		files = append(files, KeystoreFile{Name: base, Alias: alias, Key: key})
+	}
+	return files, nil
+}
+
+// KeystoreFileAlias returns the alias of a decrypted keystore file from its name or its derivation path.
+func KeystoreFileAlias(fileName string, key KeystoreJSON) (string, error) {
+	alias := strings.TrimSuffix(fileName, filepath.Ext(fileName))
+	namespace, keyType, id, err := AliasToKeyTypeId(alias)
+	if err == nil {
+		// EIP-2335 does not record the curve of BLS keys, the alias decides it
+		if keyType != key.KeyType && !(isBLS(keyType) && isBLS(key.KeyType)) {
+			return "", errors.Errorf("alias %s does not match the key type of the keystore", alias)
+		}
+		return ToAlias(namespace, keyType, id)
+	}
+
+	if key.Path == "" {
+		return "", errors.New("file name is not a key alias and the keystore has no derivation path")
+	}
+	namespace, id, err = parseDerivationPath(key.Path, key.KeyType)
+	if err != nil {
+		return "", err
+	}
+	return ToAlias(namespace, key.KeyType, id)
+}
+
+// parseDerivationPath is the inverse of DerivationPath for BLS keys.
+func parseDerivationPath(path string, keyType symbiotic.KeyType) (string, int, error) {
+	expected, err := DerivationPath(SYMBIOTIC_KEY_NAMESPACE, keyType, 0)
+	if err != nil {
+		return "", 0, err
+	}
+	expectedParts := strings.Split(expected, "/")
+	parts := strings.Split(path, "/")
+	if !isBLS(keyType) || len(parts) != len(expectedParts) || parts[1] != expectedParts[1] || parts[2] != expectedParts[2] {
+		return "", 0, errors.Errorf("derivation path %s is not a relay key path", path)
+	}
+
+	nsIndex, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid derivation path %s: %w", path, err)
+	}
+	id, err := strconv.Atoi(parts[4])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid derivation path %s: %w", path, err)
+	}
+	for namespace, index := range hdNamespaceIndex {
+		if index == nsIndex {
+			return namespace, id, nil
+		}
+	}
+	return "", 0, errors.Errorf("derivation path %s is not a relay key path", path)
+}
+
+func isBLS(keyType symbiotic.KeyType) bool {
+	return keyType == symbiotic.KeyTypeBlsBn254 || keyType == symbiotic.KeyTypeBls12381
+}
+
+// ParsePasswordFile reads keystore passwords, one "<file name>=<password>" entry per line.
+// The "*" entry is used for files without their own entry, empty lines and lines starting with # are skipped.
+func ParsePasswordFile(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	passwords := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		name, password, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, errors.Errorf("invalid password file line %d, expected <file name>=<password>", lineNum)
+		}
+		passwords[strings.TrimSpace(name)] = password
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return passwords, nil
+}
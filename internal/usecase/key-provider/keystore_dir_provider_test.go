@@ -0,0 +1,86 @@
+package keyprovider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+func writeKeystoreFile(t *testing.T, dir, name string, keyType symbiotic.KeyType, path, password string) crypto.PrivateKey {
+	t.Helper()
+	key, err := crypto.GeneratePrivateKey(keyType)
+	require.NoError(t, err)
+	data, err := EncryptKeystoreJSON(key, keyType, path, password)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0600))
+	return key
+}
+
+func TestNewKeystoreDirProvider(t *testing.T) {
+	lightScrypt(t)
+	dir := t.TempDir()
+
+	blsKey := writeKeystoreFile(t, dir, "symb-bls_bn254-15.json", symbiotic.KeyTypeBlsBn254, "", "bls-password")
+	evmKey := writeKeystoreFile(t, dir, "evm-ecdsa_secp256k1-0.json", symbiotic.KeyTypeEcdsaSecp256k1, "", "default")
+	// the alias of a key exported by the relay is recovered from its derivation path
+	path, err := DerivationPath(SYMBIOTIC_KEY_NAMESPACE, symbiotic.KeyTypeBls12381, 1)
+	require.NoError(t, err)
+	derivedKey := writeKeystoreFile(t, dir, "keystore-0.json", symbiotic.KeyTypeBls12381, path, "default")
+
+	passwordFile := filepath.Join(t.TempDir(), "passwords.txt")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("# keystore passwords\nsymb-bls_bn254-15.json=bls-password\n\n*=default\n"), 0600))
+	passwords, err := ParsePasswordFile(passwordFile)
+	require.NoError(t, err)
+
+	kp, err := NewKeystoreDirProvider(dir, passwords)
+	require.NoError(t, err)
+
+	stored, err := kp.GetPrivateKey(15)
+	require.NoError(t, err)
+	require.Equal(t, blsKey.PublicKey().Raw(), stored.PublicKey().Raw())
+
+	stored, err = kp.GetPrivateKeyByNamespaceTypeId(EVM_KEY_NAMESPACE, symbiotic.KeyTypeEcdsaSecp256k1, 0)
+	require.NoError(t, err)
+	require.Equal(t, evmKey.Bytes(), stored.Bytes())
+
+	stored, err = kp.GetPrivateKeyByNamespaceTypeId(SYMBIOTIC_KEY_NAMESPACE, symbiotic.KeyTypeBls12381, 1)
+	require.NoError(t, err)
+	require.Equal(t, derivedKey.PublicKey().Raw(), stored.PublicKey().Raw())
+}
+
+func TestReadKeystoreDir_Errors(t *testing.T) {
+	lightScrypt(t)
+
+	t.Run("missing password", func(t *testing.T) {
+		dir := t.TempDir()
+		writeKeystoreFile(t, dir, "symb-bls_bn254-15.json", symbiotic.KeyTypeBlsBn254, "", "password")
+		_, err := ReadKeystoreDir(dir, map[string]string{})
+		require.ErrorContains(t, err, "no password")
+	})
+
+	t.Run("unknown alias", func(t *testing.T) {
+		dir := t.TempDir()
+		writeKeystoreFile(t, dir, "validator.json", symbiotic.KeyTypeEcdsaSecp256k1, "", "password")
+		_, err := ReadKeystoreDir(dir, map[string]string{DefaultPasswordKey: "password"})
+		require.ErrorContains(t, err, "not a key alias")
+	})
+
+	t.Run("key type mismatch", func(t *testing.T) {
+		dir := t.TempDir()
+		writeKeystoreFile(t, dir, "symb-bls_bn254-15.json", symbiotic.KeyTypeEcdsaSecp256k1, "", "password")
+		_, err := ReadKeystoreDir(dir, map[string]string{DefaultPasswordKey: "password"})
+		require.ErrorContains(t, err, "does not match")
+	})
+}
+
+func TestParsePasswordFile_InvalidLine(t *testing.T) {
+	passwordFile := filepath.Join(t.TempDir(), "passwords.txt")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("no separator\n"), 0600))
+	_, err := ParsePasswordFile(passwordFile)
+	require.Error(t, err)
+}
@@ -0,0 +1,327 @@
+package keyprovider
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	ethKeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+const (
+	// eip2335Version is the version of EIP-2335 BLS keystores.
+	eip2335Version = 4
+	// web3SecretStorageVersion is the version of geth-style secp256k1 keystores.
+	web3SecretStorageVersion = 3
+)
+
+// eip2335ScryptN is the scrypt cost of exported EIP-2335 keystores, the value recommended by the EIP.
+var eip2335ScryptN = 1 << 18
+
+// web3ScryptN is the scrypt cost of exported geth-style keystores.
+var web3ScryptN = ethKeystore.StandardScryptN
+
+type eip2335Keystore struct {
+	Crypto      eip2335Crypto `json:"crypto"`
+	Description string        `json:"description"`
+	Pubkey      string        `json:"pubkey"`
+	Path        string        `json:"path"`
+	UUID        string        `json:"uuid"`
+	Version     int           `json:"version"`
+}
+
+type eip2335Crypto struct {
+	Kdf      eip2335Module `json:"kdf"`
+	Checksum eip2335Module `json:"checksum"`
+	Cipher   eip2335Module `json:"cipher"`
+}
+
+type eip2335Module struct {
+	Function string          `json:"function"`
+	Params   json.RawMessage `json:"params"`
+	Message  string          `json:"message"`
+}
+
+type eip2335ScryptParams struct {
+	DkLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	Salt  string `json:"salt"`
+}
+
+type eip2335Pbkdf2Params struct {
+	DkLen int    `json:"dklen"`
+	C     int    `json:"c"`
+	Prf   string `json:"prf"`
+	Salt  string `json:"salt"`
+}
+
+type eip2335CipherParams struct {
+	IV string `json:"iv"`
+}
+
+// KeystoreJSON is a key decrypted from a standard JSON keystore.
+type KeystoreJSON struct {
+	// Secret is the raw private key.
+	Secret []byte
+	// KeyType is the type of the key, secp256k1 for geth-style keystores and BLS for EIP-2335 ones.
+	// EIP-2335 does not record the curve, BLS12-381 is assumed unless the path is a BN254 path of DerivationPath.
+	KeyType symbiotic.KeyType
+	// Path is the derivation path recorded in EIP-2335 keystores, empty for geth-style keystores.
+	Path string
+}
+
+// DecryptKeystoreJSON decrypts an EIP-2335 BLS keystore or a geth-style V3 secp256k1 keystore.
+func DecryptKeystoreJSON(data []byte, password string) (KeystoreJSON, error) {
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return KeystoreJSON{}, errors.Errorf("failed to parse keystore: %w", err)
+	}
+
+	switch header.Version {
+	case web3SecretStorageVersion:
+		// geth panics on an IV of the wrong length instead of returning an error
+		var ks struct {
+			Crypto struct {
+				CipherParams eip2335CipherParams `json:"cipherparams"`
+			} `json:"crypto"`
+		}
+		if err := json.Unmarshal(data, &ks); err != nil {
+			return KeystoreJSON{}, errors.Errorf("failed to parse keystore: %w", err)
+		}
+		if err := checkIV(ks.Crypto.CipherParams.IV); err != nil {
+			return KeystoreJSON{}, err
+		}
+		key, err := ethKeystore.DecryptKey(data, password)
+		if err != nil {
+			return KeystoreJSON{}, errors.Errorf("failed to decrypt keystore: %w", err)
+		}
+		return KeystoreJSON{
+			Secret:  ethCrypto.FromECDSA(key.PrivateKey),
+			KeyType: symbiotic.KeyTypeEcdsaSecp256k1,
+		}, nil
+	case eip2335Version:
+		var ks eip2335Keystore
+		if err := json.Unmarshal(data, &ks); err != nil {
+			return KeystoreJSON{}, errors.Errorf("failed to parse keystore: %w", err)
+		}
+		secret, err := decryptEIP2335(ks.Crypto, password)
+		if err != nil {
+			return KeystoreJSON{}, err
+		}
+		keyType := symbiotic.KeyTypeBls12381
+		if strings.HasPrefix(ks.Path, "m/254/") {
+			keyType = symbiotic.KeyTypeBlsBn254
+		}
+		return KeystoreJSON{Secret: secret, KeyType: keyType, Path: ks.Path}, nil
+	default:
+		return KeystoreJSON{}, errors.Errorf("unsupported keystore version %d", header.Version)
+	}
+}
+
+// EncryptKeystoreJSON encrypts the key into an EIP-2335 keystore for BLS keys or a geth-style V3 keystore for secp256k1 keys.
+// path is recorded in EIP-2335 keystores only.
+func EncryptKeystoreJSON(key crypto.PrivateKey, keyType symbiotic.KeyType, path, password string) ([]byte, error) {
+	switch keyType {
+	case symbiotic.KeyTypeEcdsaSecp256k1:
+		ecdsaKey, err := ethCrypto.ToECDSA(key.Bytes())
+		if err != nil {
+			return nil, errors.Errorf("invalid secp256k1 key: %w", err)
+		}
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return nil, err
+		}
+		return ethKeystore.EncryptKey(&ethKeystore.Key{
+			Id:         id,
+			Address:    ethCrypto.PubkeyToAddress(ecdsaKey.PublicKey),
+			PrivateKey: ecdsaKey,
+		}, password, web3ScryptN, ethKeystore.StandardScryptP)
+	case symbiotic.KeyTypeBlsBn254, symbiotic.KeyTypeBls12381:
+		secret := make([]byte, 32)
+		copy(secret[32-len(key.Bytes()):], key.Bytes())
+		encrypted, err := encryptEIP2335(secret, password)
+		if err != nil {
+			return nil, err
+		}
+		typeName, err := keyType.String()
+		if err != nil {
+			return nil, err
+		}
+		return json.MarshalIndent(eip2335Keystore{
+			Crypto:      encrypted,
+			Description: "symbiotic relay " + typeName + " key",
+			Pubkey:      hex.EncodeToString(key.PublicKey().Raw()),
+			Path:        path,
+			UUID:        uuid.NewString(),
+			Version:     eip2335Version,
+		}, "", "  ")
+	case symbiotic.KeyTypeInvalid:
+		return nil, errors.New("unsupported key type")
+	}
+	return nil, errors.New("unsupported key type")
+}
+
+func decryptEIP2335(c eip2335Crypto, password string) ([]byte, error) {
+	decryptionKey, err := eip2335DecryptionKey(c.Kdf, password)
+	if err != nil {
+		return nil, err
+	}
+
+	cipherMessage, err := hex.DecodeString(c.Cipher.Message)
+	if err != nil {
+		return nil, errors.Errorf("invalid cipher message: %w", err)
+	}
+
+	if c.Checksum.Function != "sha256" {
+		return nil, errors.Errorf("unsupported checksum function %q", c.Checksum.Function)
+	}
+	checksum := sha256.Sum256(append(append([]byte{}, decryptionKey[16:32]...), cipherMessage...))
+	if hex.EncodeToString(checksum[:]) != strings.ToLower(c.Checksum.Message) {
+		return nil, errors.New("invalid keystore password")
+	}
+
+	if c.Cipher.Function != "aes-128-ctr" {
+		return nil, errors.Errorf("unsupported cipher function %q", c.Cipher.Function)
+	}
+	var cipherParams eip2335CipherParams
+	if err := json.Unmarshal(c.Cipher.Params, &cipherParams); err != nil {
+		return nil, errors.Errorf("invalid cipher params: %w", err)
+	}
+	if err := checkIV(cipherParams.IV); err != nil {
+		return nil, err
+	}
+	iv, _ := hex.DecodeString(cipherParams.IV)
+
+	return aes128CTR(decryptionKey[:16], iv, cipherMessage)
+}
+
+// checkIV checks that the hex encoded cipher IV is an AES block long, cipher.NewCTR panics otherwise.
+func checkIV(ivHex string) error {
+	iv, err := hex.DecodeString(ivHex)
+	if err != nil {
+		return errors.Errorf("invalid cipher iv: %w", err)
+	}
+	if len(iv) != aes.BlockSize {
+		return errors.Errorf("invalid cipher iv length %d, expected %d", len(iv), aes.BlockSize)
+	}
+	return nil
+}
+
+func encryptEIP2335(secret []byte, password string) (eip2335Crypto, error) {
+	salt := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(salt); err != nil {
+		return eip2335Crypto{}, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return eip2335Crypto{}, err
+	}
+
+	kdfParams, err := json.Marshal(eip2335ScryptParams{DkLen: 32, N: eip2335ScryptN, R: 8, P: 1, Salt: hex.EncodeToString(salt)})
+	if err != nil {
+		return eip2335Crypto{}, err
+	}
+	kdf := eip2335Module{Function: "scrypt", Params: kdfParams}
+	decryptionKey, err := eip2335DecryptionKey(kdf, password)
+	if err != nil {
+		return eip2335Crypto{}, err
+	}
+
+	cipherMessage, err := aes128CTR(decryptionKey[:16], iv, secret)
+	if err != nil {
+		return eip2335Crypto{}, err
+	}
+	checksum := sha256.Sum256(append(append([]byte{}, decryptionKey[16:32]...), cipherMessage...))
+	cipherParams, err := json.Marshal(eip2335CipherParams{IV: hex.EncodeToString(iv)})
+	if err != nil {
+		return eip2335Crypto{}, err
+	}
+
+	return eip2335Crypto{
+		Kdf:      kdf,
+		Checksum: eip2335Module{Function: "sha256", Params: json.RawMessage("{}"), Message: hex.EncodeToString(checksum[:])},
+		Cipher:   eip2335Module{Function: "aes-128-ctr", Params: cipherParams, Message: hex.EncodeToString(cipherMessage)},
+	}, nil
+}
+
+func eip2335DecryptionKey(kdf eip2335Module, password string) ([]byte, error) {
+	processed := eip2335Password(password)
+
+	var key []byte
+	switch kdf.Function {
+	case "scrypt":
+		var params eip2335ScryptParams
+		if err := json.Unmarshal(kdf.Params, &params); err != nil {
+			return nil, errors.Errorf("invalid scrypt params: %w", err)
+		}
+		salt, err := hex.DecodeString(params.Salt)
+		if err != nil {
+			return nil, errors.Errorf("invalid scrypt salt: %w", err)
+		}
+		key, err = scrypt.Key(processed, salt, params.N, params.R, params.P, params.DkLen)
+		if err != nil {
+			return nil, errors.Errorf("scrypt failed: %w", err)
+		}
+	case "pbkdf2":
+		var params eip2335Pbkdf2Params
+		if err := json.Unmarshal(kdf.Params, &params); err != nil {
+			return nil, errors.Errorf("invalid pbkdf2 params: %w", err)
+		}
+		if params.Prf != "hmac-sha256" {
+			return nil, errors.Errorf("unsupported pbkdf2 prf %q", params.Prf)
+		}
+		salt, err := hex.DecodeString(params.Salt)
+		if err != nil {
+			return nil, errors.Errorf("invalid pbkdf2 salt: %w", err)
+		}
+		key, err = pbkdf2.Key(sha256.New, string(processed), salt, params.C, params.DkLen)
+		if err != nil {
+			return nil, errors.Errorf("pbkdf2 failed: %w", err)
+		}
+	default:
+		return nil, errors.Errorf("unsupported kdf function %q", kdf.Function)
+	}
+	if len(key) < 32 {
+		return nil, errors.New("kdf dklen must be at least 32 bytes")
+	}
+	return key, nil
+}
+
+// eip2335Password normalizes the password to NFKD and strips control codes as EIP-2335 requires.
+func eip2335Password(password string) []byte {
+	normalized := norm.NFKD.String(password)
+	return []byte(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, normalized))
+}
+
+func aes128CTR(key, iv, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(out, data)
+	return out, nil
+}
@@ -0,0 +1,116 @@
+package keyprovider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+// EIP-2335 test vectors
+const (
+	eip2335TestPassword = "𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑"
+	eip2335TestSecret   = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+
+	eip2335Pbkdf2Keystore = `{
+		"crypto": {
+			"kdf": {"function": "pbkdf2", "params": {"dklen": 32, "c": 262144, "prf": "hmac-sha256", "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"}, "message": ""},
+			"checksum": {"function": "sha256", "params": {}, "message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"},
+			"cipher": {"function": "aes-128-ctr", "params": {"iv": "264daa3f303d7259501c93d997d84fe6"}, "message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"}
+		},
+		"description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+		"pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+		"path": "m/12381/60/0/0",
+		"uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+		"version": 4
+	}`
+
+	eip2335ScryptKeystore = `{
+		"crypto": {
+			"kdf": {"function": "scrypt", "params": {"dklen": 32, "n": 262144, "p": 1, "r": 8, "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"}, "message": ""},
+			"checksum": {"function": "sha256", "params": {}, "message": "d2217fe5f3e9a1e34581ef8a78f7c9928e436d36dacc5e846690a5581e8ea484"},
+			"cipher": {"function": "aes-128-ctr", "params": {"iv": "264daa3f303d7259501c93d997d84fe6"}, "message": "06ae90d55fe0a6e9c5c3bc5b170827b2e5cce3929ed3f116c2811e6366dfe20f"}
+		},
+		"description": "This is a test keystore that uses scrypt to secure the secret.",
+		"pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+		"path": "m/12381/60/3141592653/0/0",
+		"uuid": "1d85ae20-35c5-4611-98e8-aa14a633906f",
+		"version": 4
+	}`
+)
+
+func TestDecryptKeystoreJSON_EIP2335Vectors(t *testing.T) {
+	for name, data := range map[string]string{"pbkdf2": eip2335Pbkdf2Keystore, "scrypt": eip2335ScryptKeystore} {
+		t.Run(name, func(t *testing.T) {
+			key, err := DecryptKeystoreJSON([]byte(data), eip2335TestPassword)
+			require.NoError(t, err)
+			require.Equal(t, eip2335TestSecret, common.Bytes2Hex(key.Secret))
+			require.Equal(t, symbiotic.KeyTypeBls12381, key.KeyType)
+
+			_, err = DecryptKeystoreJSON([]byte(data), "wrong")
+			require.ErrorContains(t, err, "invalid keystore password")
+		})
+	}
+}
+
+func TestEncryptKeystoreJSON_RoundTrip(t *testing.T) {
+	lightScrypt(t)
+
+	for _, keyType := range []symbiotic.KeyType{symbiotic.KeyTypeBlsBn254, symbiotic.KeyTypeBls12381, symbiotic.KeyTypeEcdsaSecp256k1} {
+		key, err := crypto.GeneratePrivateKey(keyType)
+		require.NoError(t, err)
+		path, err := DerivationPath(SYMBIOTIC_KEY_NAMESPACE, keyType, 1)
+		require.NoError(t, err)
+
+		data, err := EncryptKeystoreJSON(key, keyType, path, "password")
+		require.NoError(t, err)
+
+		decrypted, err := DecryptKeystoreJSON(data, "password")
+		require.NoError(t, err)
+		require.Equal(t, keyType, decrypted.KeyType)
+
+		restored, err := crypto.NewPrivateKey(decrypted.KeyType, decrypted.Secret)
+		require.NoError(t, err)
+		require.Equal(t, key.PublicKey().Raw(), restored.PublicKey().Raw())
+
+		_, err = DecryptKeystoreJSON(data, "wrong")
+		require.Error(t, err)
+	}
+}
+
+func TestDecryptKeystoreJSON_MalformedIV(t *testing.T) {
+	lightScrypt(t)
+
+	iv := regexp.MustCompile(`"iv":\s*"[0-9a-f]*"`)
+	for _, keyType := range []symbiotic.KeyType{symbiotic.KeyTypeBlsBn254, symbiotic.KeyTypeEcdsaSecp256k1} {
+		key, err := crypto.GeneratePrivateKey(keyType)
+		require.NoError(t, err)
+
+		data, err := EncryptKeystoreJSON(key, keyType, "", "password")
+		require.NoError(t, err)
+		require.True(t, iv.Match(data))
+
+		for _, malformed := range []string{`"iv":""`, `"iv":"00112233"`} {
+			_, err = DecryptKeystoreJSON(iv.ReplaceAll(data, []byte(malformed)), "password")
+			require.ErrorContains(t, err, "invalid cipher iv length")
+		}
+	}
+}
+
+func TestEIP2335Password_StripsControlCodes(t *testing.T) {
+	require.Equal(t, []byte("password"), eip2335Password("pass\x7fwo\u0080rd\n"))
+}
+
+// lightScrypt lowers the scrypt cost of exported keystores for the duration of the test.
+func lightScrypt(t *testing.T) {
+	t.Helper()
+	prevEIP2335, prevWeb3 := eip2335ScryptN, web3ScryptN
+	eip2335ScryptN, web3ScryptN = 1<<12, 1<<12
+	t.Cleanup(func() {
+		eip2335ScryptN, web3ScryptN = prevEIP2335, prevWeb3
+	})
+}
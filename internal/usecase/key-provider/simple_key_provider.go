@@ -116,6 +116,20 @@ func (k *SimpleKeystoreProvider) AddKeyByNamespaceTypeId(ns string, tp symbiotic
 	return nil
 }
 
+func (k *SimpleKeystoreProvider) AddNextKey(keyTag symbiotic.KeyTag, privateKey crypto.PrivateKey) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	alias, err := NextKeyTagToAlias(keyTag)
+	if err != nil {
+		return err
+	}
+
+	k.keys[alias] = privateKey
+
+	return nil
+}
+
 func (k *SimpleKeystoreProvider) DeleteKey(keyTag symbiotic.KeyTag) error {
 	k.mu.Lock()
 	defer k.mu.Unlock()
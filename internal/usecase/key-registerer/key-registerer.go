@@ -143,10 +143,5 @@ func keyCommitmentData(eip712Domain symbiotic.Eip712Domain, operator common.Addr
 		},
 	}
 
-	_, preHashedData, err := apitypes.TypedDataAndHash(typedData)
-	if err != nil {
-		return nil, err
-	}
-
-	return []byte(preHashedData), nil
+	return symbiotic.EncodeTypedData(typedData)
 }
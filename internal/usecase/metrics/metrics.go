@@ -23,6 +23,10 @@ type Metrics struct {
 	appAggregationDuration prometheus.Summary
 	aggregationProofSize   *prometheus.HistogramVec
 
+	// scheduling of signature requests
+	schedulerQueueDepth *prometheus.GaugeVec
+	schedulerQueueWait  *prometheus.HistogramVec
+
 	// p2p
 	p2pPeerMessagesSent            *prometheus.CounterVec
 	p2pSyncProcessedSignatures     *prometheus.CounterVec
@@ -38,6 +42,10 @@ type Metrics struct {
 	evmMethodCall     *prometheus.HistogramVec
 	evmCommitGasUsed  *prometheus.HistogramVec
 	evmCommitGasPrice *prometheus.HistogramVec
+	// commit strategy
+	evmCommitDecisions     *prometheus.CounterVec
+	evmCommitSimulatedGas  *prometheus.HistogramVec
+	evmCommitSimulatedCost *prometheus.HistogramVec
 
 	// epoch
 	epochsTotal *prometheus.GaugeVec
@@ -45,6 +53,13 @@ type Metrics struct {
 
 	// pruner
 	prunedEpochsTotal *prometheus.CounterVec
+
+	// participation of the local operator
+	participationRequests      *prometheus.GaugeVec
+	participationMedianLatency prometheus.Gauge
+
+	// external voting power
+	externalVotingPowerDisagreements *prometheus.CounterVec
 }
 
 func New(cfg Config) *Metrics {
@@ -119,6 +134,26 @@ func newMetrics(registerer prometheus.Registerer) *Metrics {
 	}, []string{"chainId"})
 	all = append(all, m.evmCommitGasPrice)
 
+	m.evmCommitDecisions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "symbiotic_relay_evm_commit_decisions_total",
+		Help: "Total number of commit strategy decisions by chain and decision (send, wait, capped)",
+	}, []string{"chainId", "decision"})
+	all = append(all, m.evmCommitDecisions)
+
+	m.evmCommitSimulatedGas = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "symbiotic_relay_evm_commit_simulated_gas",
+		Help:    "Estimated gas of simulated EVM commit operations",
+		Buckets: []float64{1e5, 2e5, 3e5, 5e5, 7e5, 1e6, 3e6, 5e6, 7e6, 1e7, 1e8, 1e9, 1e10, 1e11, 1e12},
+	}, []string{"chainId"})
+	all = append(all, m.evmCommitSimulatedGas)
+
+	m.evmCommitSimulatedCost = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "symbiotic_relay_evm_commit_simulated_cost_wei",
+		Help:    "Predicted cost in wei of simulated EVM commit operations at the fee per gas of the simulation",
+		Buckets: prometheus.ExponentialBuckets(1e13, 10, 8),
+	}, []string{"chainId"})
+	all = append(all, m.evmCommitSimulatedCost)
+
 	m.p2pSyncProcessedSignatures = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "symbiotic_relay_p2p_sync_processed_signatures_total",
 		Help: "Total number of signatures processed during P2P sync",
@@ -208,6 +243,19 @@ func newMetrics(registerer prometheus.Registerer) *Metrics {
 	)
 	all = append(all, m.requestsInFlight)
 
+	m.schedulerQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "symbiotic_relay_scheduler_queue_depth",
+		Help: "Number of signature requests waiting in a scheduling queue per priority class",
+	}, []string{"queue", "class"})
+	all = append(all, m.schedulerQueueDepth)
+
+	m.schedulerQueueWait = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "symbiotic_relay_scheduler_queue_wait_seconds",
+		Help:    "Time signature requests wait in a scheduling queue per priority class",
+		Buckets: defaultBuckets,
+	}, []string{"queue", "class"})
+	all = append(all, m.schedulerQueueWait)
+
 	m.epochsTotal = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "symbiotic_relay_epochs_total",
 		Help: "Latest number of epochs",
@@ -226,6 +274,24 @@ func newMetrics(registerer prometheus.Registerer) *Metrics {
 	}, []string{"entity_type"})
 	all = append(all, m.prunedEpochsTotal)
 
+	m.participationRequests = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "symbiotic_relay_participation_requests",
+		Help: "Number of signature requests of the latest epoch the local operator was expected to sign by result",
+	}, []string{"result"})
+	all = append(all, m.participationRequests)
+
+	m.participationMedianLatency = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "symbiotic_relay_participation_median_latency_seconds",
+		Help: "Median signing latency of the local operator in the latest epoch relative to the first signature of a request",
+	})
+	all = append(all, m.participationMedianLatency)
+
+	m.externalVotingPowerDisagreements = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "symbiotic_relay_external_voting_power_disagreements_total",
+		Help: "Total number of times external voting power provider replicas returned different voting powers",
+	}, []string{"provider_id", "policy"})
+	all = append(all, m.externalVotingPowerDisagreements)
+
 	// BadgerDB expvar metrics bridged to Prometheus.
 	// BadgerDB registers these via expvar in init(); we expose them on /metrics.
 	badgerExpvarCollector := collectors.NewExpvarCollector(map[string]*prometheus.Desc{
@@ -274,6 +340,14 @@ func (m *Metrics) ObserveAppAggregateDuration(d time.Duration) {
 	m.appAggregationDuration.Observe(d.Seconds())
 }
 
+func (m *Metrics) SetQueueDepth(queue, class string, depth int) {
+	m.schedulerQueueDepth.WithLabelValues(queue, class).Set(float64(depth))
+}
+
+func (m *Metrics) ObserveQueueWait(queue, class string, d time.Duration) {
+	m.schedulerQueueWait.WithLabelValues(queue, class).Observe(d.Seconds())
+}
+
 func (m *Metrics) ObserveP2PPeerMessageSent(messageType, status string) {
 	m.p2pPeerMessagesSent.WithLabelValues(messageType, status).Add(1)
 }
@@ -288,6 +362,16 @@ func (m *Metrics) ObserveCommitValsetHeaderParams(chainID uint64, gasUsed uint64
 	m.evmCommitGasPrice.WithLabelValues(strconv.FormatInt(int64(chainID), 10)).Observe(gasPrice)
 }
 
+func (m *Metrics) ObserveCommitDecision(chainID uint64, decision string) {
+	m.evmCommitDecisions.WithLabelValues(strconv.FormatUint(chainID, 10), decision).Inc()
+}
+
+func (m *Metrics) ObserveCommitSimulation(chainID uint64, gasEstimate uint64, cost *big.Int) {
+	m.evmCommitSimulatedGas.WithLabelValues(strconv.FormatUint(chainID, 10)).Observe(float64(gasEstimate))
+	costWei, _ := cost.Float64()
+	m.evmCommitSimulatedCost.WithLabelValues(strconv.FormatUint(chainID, 10)).Observe(costWei)
+}
+
 func (m *Metrics) ObserveP2PSyncSignaturesProcessed(resultType string, count int) {
 	m.p2pSyncProcessedSignatures.WithLabelValues(resultType).Add(float64(count))
 }
@@ -320,6 +404,17 @@ func (m *Metrics) IncPrunedEpochsCount(entityType string) {
 	m.prunedEpochsTotal.WithLabelValues(entityType).Inc()
 }
 
+func (m *Metrics) SetLocalParticipation(seen, signed, missed uint64, medianLatency time.Duration) {
+	m.participationRequests.WithLabelValues("seen").Set(float64(seen))
+	m.participationRequests.WithLabelValues("signed").Set(float64(signed))
+	m.participationRequests.WithLabelValues("missed").Set(float64(missed))
+	m.participationMedianLatency.Set(medianLatency.Seconds())
+}
+
+func (m *Metrics) ObserveExternalVotingPowerDisagreement(providerID string, policy string) {
+	m.externalVotingPowerDisagreements.WithLabelValues(providerID, policy).Inc()
+}
+
 func (m *Metrics) ObserveEpoch(epochType string, epochNumber uint64) {
 	m.epochsTotal.WithLabelValues(epochType).Set(float64(epochNumber))
 	m.epochTime.WithLabelValues(epochType).Set(float64(time.Now().Unix()))
@@ -0,0 +1,210 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: participation_tracker.go
+//
+// Generated by this command:
+//
+//	mockgen -source=participation_tracker.go -destination=mocks/participation_tracker.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	common "github.com/ethereum/go-ethereum/common"
+	entity "github.com/symbioticfi/relay/internal/entity"
+	entity0 "github.com/symbioticfi/relay/symbiotic/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Mockrepo is a mock of repo interface.
+type Mockrepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockrepoMockRecorder
+	isgomock struct{}
+}
+
+// MockrepoMockRecorder is the mock recorder for Mockrepo.
+type MockrepoMockRecorder struct {
+	mock *Mockrepo
+}
+
+// NewMockrepo creates a new mock instance.
+func NewMockrepo(ctrl *gomock.Controller) *Mockrepo {
+	mock := &Mockrepo{ctrl: ctrl}
+	mock.recorder = &MockrepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockrepo) EXPECT() *MockrepoMockRecorder {
+	return m.recorder
+}
+
+// AddValidatorParticipations mocks base method.
+func (m *Mockrepo) AddValidatorParticipations(ctx context.Context, participations []entity.ValidatorParticipation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddValidatorParticipations", ctx, participations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddValidatorParticipations indicates an expected call of AddValidatorParticipations.
+func (mr *MockrepoMockRecorder) AddValidatorParticipations(ctx, participations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddValidatorParticipations", reflect.TypeOf((*Mockrepo)(nil).AddValidatorParticipations), ctx, participations)
+}
+
+// GetLatestValidatorSetEpoch mocks base method.
+func (m *Mockrepo) GetLatestValidatorSetEpoch(ctx context.Context) (entity0.Epoch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestValidatorSetEpoch", ctx)
+	ret0, _ := ret[0].(entity0.Epoch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestValidatorSetEpoch indicates an expected call of GetLatestValidatorSetEpoch.
+func (mr *MockrepoMockRecorder) GetLatestValidatorSetEpoch(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestValidatorSetEpoch", reflect.TypeOf((*Mockrepo)(nil).GetLatestValidatorSetEpoch), ctx)
+}
+
+// GetSignatureMap mocks base method.
+func (m *Mockrepo) GetSignatureMap(ctx context.Context, requestID common.Hash) (entity.SignatureMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignatureMap", ctx, requestID)
+	ret0, _ := ret[0].(entity.SignatureMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignatureMap indicates an expected call of GetSignatureMap.
+func (mr *MockrepoMockRecorder) GetSignatureMap(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignatureMap", reflect.TypeOf((*Mockrepo)(nil).GetSignatureMap), ctx, requestID)
+}
+
+// GetValidatorByKey mocks base method.
+func (m *Mockrepo) GetValidatorByKey(ctx context.Context, epoch entity0.Epoch, keyTag entity0.KeyTag, publicKey []byte) (entity0.Validator, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorByKey", ctx, epoch, keyTag, publicKey)
+	ret0, _ := ret[0].(entity0.Validator)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetValidatorByKey indicates an expected call of GetValidatorByKey.
+func (mr *MockrepoMockRecorder) GetValidatorByKey(ctx, epoch, keyTag, publicKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorByKey", reflect.TypeOf((*Mockrepo)(nil).GetValidatorByKey), ctx, epoch, keyTag, publicKey)
+}
+
+// GetValidatorParticipations mocks base method.
+func (m *Mockrepo) GetValidatorParticipations(ctx context.Context, epoch entity0.Epoch) ([]entity.ValidatorParticipation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorParticipations", ctx, epoch)
+	ret0, _ := ret[0].([]entity.ValidatorParticipation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorParticipations indicates an expected call of GetValidatorParticipations.
+func (mr *MockrepoMockRecorder) GetValidatorParticipations(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorParticipations", reflect.TypeOf((*Mockrepo)(nil).GetValidatorParticipations), ctx, epoch)
+}
+
+// GetValidatorSetByEpoch mocks base method.
+func (m *Mockrepo) GetValidatorSetByEpoch(ctx context.Context, epoch entity0.Epoch) (entity0.ValidatorSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorSetByEpoch", ctx, epoch)
+	ret0, _ := ret[0].(entity0.ValidatorSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorSetByEpoch indicates an expected call of GetValidatorSetByEpoch.
+func (mr *MockrepoMockRecorder) GetValidatorSetByEpoch(ctx, epoch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorSetByEpoch", reflect.TypeOf((*Mockrepo)(nil).GetValidatorSetByEpoch), ctx, epoch)
+}
+
+// MockkeyProvider is a mock of keyProvider interface.
+type MockkeyProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockkeyProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockkeyProviderMockRecorder is the mock recorder for MockkeyProvider.
+type MockkeyProviderMockRecorder struct {
+	mock *MockkeyProvider
+}
+
+// NewMockkeyProvider creates a new mock instance.
+func NewMockkeyProvider(ctrl *gomock.Controller) *MockkeyProvider {
+	mock := &MockkeyProvider{ctrl: ctrl}
+	mock.recorder = &MockkeyProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockkeyProvider) EXPECT() *MockkeyProviderMockRecorder {
+	return m.recorder
+}
+
+// GetOnchainKeyForValset mocks base method.
+func (m *MockkeyProvider) GetOnchainKeyForValset(valset entity0.ValidatorSet, keyTag entity0.KeyTag) (entity0.CompactPublicKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOnchainKeyForValset", valset, keyTag)
+	ret0, _ := ret[0].(entity0.CompactPublicKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOnchainKeyForValset indicates an expected call of GetOnchainKeyForValset.
+func (mr *MockkeyProviderMockRecorder) GetOnchainKeyForValset(valset, keyTag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnchainKeyForValset", reflect.TypeOf((*MockkeyProvider)(nil).GetOnchainKeyForValset), valset, keyTag)
+}
+
+// Mockmetrics is a mock of metrics interface.
+type Mockmetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockmetricsMockRecorder
+	isgomock struct{}
+}
+
+// MockmetricsMockRecorder is the mock recorder for Mockmetrics.
+type MockmetricsMockRecorder struct {
+	mock *Mockmetrics
+}
+
+// NewMockmetrics creates a new mock instance.
+func NewMockmetrics(ctrl *gomock.Controller) *Mockmetrics {
+	mock := &Mockmetrics{ctrl: ctrl}
+	mock.recorder = &MockmetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockmetrics) EXPECT() *MockmetricsMockRecorder {
+	return m.recorder
+}
+
+// SetLocalParticipation mocks base method.
+func (m *Mockmetrics) SetLocalParticipation(seen, signed, missed uint64, medianLatency time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLocalParticipation", seen, signed, missed, medianLatency)
+}
+
+// SetLocalParticipation indicates an expected call of SetLocalParticipation.
+func (mr *MockmetricsMockRecorder) SetLocalParticipation(seen, signed, missed, medianLatency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLocalParticipation", reflect.TypeOf((*Mockmetrics)(nil).SetLocalParticipation), seen, signed, missed, medianLatency)
+}
@@ -0,0 +1,322 @@
+package participation_tracker
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	"github.com/symbioticfi/relay/pkg/log"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+//go:generate mockgen -source=participation_tracker.go -destination=mocks/participation_tracker.go -package=mocks
+
+// closedRetentionEpochs is how many epochs closed requests are remembered for, so that late signatures and proofs
+// don't open them again
+const closedRetentionEpochs = 2
+
+type repo interface {
+	GetValidatorByKey(ctx context.Context, epoch symbiotic.Epoch, keyTag symbiotic.KeyTag, publicKey []byte) (symbiotic.Validator, uint32, error)
+	GetSignatureMap(ctx context.Context, requestID common.Hash) (entity.SignatureMap, error)
+	GetValidatorSetByEpoch(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.ValidatorSet, error)
+	GetLatestValidatorSetEpoch(ctx context.Context) (symbiotic.Epoch, error)
+	AddValidatorParticipations(ctx context.Context, participations []entity.ValidatorParticipation) error
+	GetValidatorParticipations(ctx context.Context, epoch symbiotic.Epoch) ([]entity.ValidatorParticipation, error)
+}
+
+type keyProvider interface {
+	GetOnchainKeyForValset(valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, error)
+}
+
+type metrics interface {
+	SetLocalParticipation(seen, signed, missed uint64, medianLatency time.Duration)
+}
+
+type Config struct {
+	Repo        repo        `validate:"required"`
+	KeyProvider keyProvider `validate:"required"`
+	Metrics     metrics     `validate:"required"`
+	// FlushInterval is how often closed requests are accounted and persisted, it is also the grace period for
+	// late signatures of a request after its aggregation proof is known
+	FlushInterval time.Duration `validate:"gt=0"`
+}
+
+func (c Config) Validate() error {
+	if err := validator.New().Struct(c); err != nil {
+		return errors.Errorf("invalid config: %w", err)
+	}
+
+	return nil
+}
+
+// Tracker accounts which validators signed each request into per epoch participation records.
+// A request is open from its first signature or proof seen by this node and is closed, i.e. accounted from its
+// signature map, one flush interval after its aggregation proof is known or once a newer validator set exists.
+// Latencies are measured from the first signature of the request received by this node.
+// Open requests live in memory only, requests open during a restart are not accounted.
+type Tracker struct {
+	cfg Config
+	now func() time.Time
+
+	mu     sync.Mutex
+	open   map[common.Hash]*openRequest
+	closed map[common.Hash]symbiotic.Epoch
+}
+
+type openRequest struct {
+	epoch            symbiotic.Epoch
+	firstSignatureAt time.Time
+	proofAt          time.Time
+	// latencies of the signatures received by this node by active validator index
+	latencies map[uint32]time.Duration
+}
+
+func New(cfg Config) (*Tracker, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Tracker{
+		cfg:    cfg,
+		now:    time.Now,
+		open:   make(map[common.Hash]*openRequest),
+		closed: make(map[common.Hash]symbiotic.Epoch),
+	}, nil
+}
+
+func (t *Tracker) Start(ctx context.Context) error {
+	ctx = log.WithComponent(ctx, "participation_tracker")
+
+	slog.InfoContext(ctx, "Starting participation tracker", "flushInterval", t.cfg.FlushInterval)
+
+	ticker := time.NewTicker(t.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := t.Flush(ctx); err != nil {
+				slog.ErrorContext(ctx, "Failed to flush validator participation", "error", err)
+			}
+		}
+	}
+}
+
+// HandleSignatureProcessed records the signing latency of a stored signature.
+func (t *Tracker) HandleSignatureProcessed(ctx context.Context, signature symbiotic.Signature) error {
+	_, activeIndex, err := t.cfg.Repo.GetValidatorByKey(ctx, signature.Epoch, signature.KeyTag, signature.PublicKey.OnChain())
+	if err != nil {
+		return errors.Errorf("failed to get validator of signature: %w", err)
+	}
+
+	now := t.now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	req, ok := t.openRequest(signature.RequestID(), signature.Epoch, now)
+	if !ok {
+		return nil
+	}
+	if _, ok := req.latencies[activeIndex]; !ok {
+		req.latencies[activeIndex] = now.Sub(req.firstSignatureAt)
+	}
+	return nil
+}
+
+// HandleProofAggregated starts the grace period after which the request of the proof is accounted.
+func (t *Tracker) HandleProofAggregated(_ context.Context, proof symbiotic.AggregationProof) error {
+	now := t.now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	req, ok := t.openRequest(proof.RequestID(), proof.Epoch, now)
+	if ok && req.proofAt.IsZero() {
+		req.proofAt = now
+	}
+	return nil
+}
+
+// openRequest returns the open request, opening it if needed, false if the request was already accounted.
+// Must be called with the lock held.
+func (t *Tracker) openRequest(requestID common.Hash, epoch symbiotic.Epoch, now time.Time) (*openRequest, bool) {
+	if _, ok := t.closed[requestID]; ok {
+		return nil, false
+	}
+	req, ok := t.open[requestID]
+	if !ok {
+		req = &openRequest{
+			epoch:            epoch,
+			firstSignatureAt: now,
+			latencies:        make(map[uint32]time.Duration),
+		}
+		t.open[requestID] = req
+	}
+	return req, true
+}
+
+// Flush accounts the requests that are closed by now, persists their participation and updates the metrics
+// of the local operator.
+func (t *Tracker) Flush(ctx context.Context) error {
+	latestEpoch, err := t.cfg.Repo.GetLatestValidatorSetEpoch(ctx)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return nil
+		}
+		return errors.Errorf("failed to get latest validator set epoch: %w", err)
+	}
+
+	closing := t.closeRequests(latestEpoch)
+
+	deltas := make(map[symbiotic.Epoch]map[common.Address]*entity.ValidatorParticipation)
+	for requestID, req := range closing {
+		if err := t.account(ctx, requestID, req, deltas); err != nil {
+			slog.WarnContext(ctx, "Failed to account request participation", "requestId", requestID.Hex(), "error", err)
+		}
+	}
+
+	if participations := flattenDeltas(deltas); len(participations) > 0 {
+		if err := t.cfg.Repo.AddValidatorParticipations(ctx, participations); err != nil {
+			return errors.Errorf("failed to save validator participations: %w", err)
+		}
+		slog.DebugContext(ctx, "Accounted validator participation", "requests", len(closing), "records", len(participations))
+	}
+
+	if err := t.updateLocalMetrics(ctx, latestEpoch); err != nil {
+		return errors.Errorf("failed to update local participation metrics: %w", err)
+	}
+	return nil
+}
+
+func (t *Tracker) closeRequests(latestEpoch symbiotic.Epoch) map[common.Hash]*openRequest {
+	now := t.now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	closing := make(map[common.Hash]*openRequest)
+	for requestID, req := range t.open {
+		proofGraceOver := !req.proofAt.IsZero() && now.Sub(req.proofAt) >= t.cfg.FlushInterval
+		if !proofGraceOver && req.epoch >= latestEpoch {
+			continue
+		}
+		closing[requestID] = req
+		t.closed[requestID] = req.epoch
+		delete(t.open, requestID)
+	}
+
+	for requestID, epoch := range t.closed {
+		if epoch+closedRetentionEpochs < latestEpoch {
+			delete(t.closed, requestID)
+		}
+	}
+
+	return closing
+}
+
+// account adds the outcome of the request for every active validator of its epoch to deltas.
+func (t *Tracker) account(ctx context.Context, requestID common.Hash, req *openRequest, deltas map[symbiotic.Epoch]map[common.Address]*entity.ValidatorParticipation) error {
+	sigMap, err := t.cfg.Repo.GetSignatureMap(ctx, requestID)
+	if err != nil {
+		return errors.Errorf("failed to get signature map: %w", err)
+	}
+
+	valset, err := t.cfg.Repo.GetValidatorSetByEpoch(ctx, req.epoch)
+	if err != nil {
+		return errors.Errorf("failed to get validator set for epoch %d: %w", req.epoch, err)
+	}
+
+	epochDeltas, ok := deltas[req.epoch]
+	if !ok {
+		epochDeltas = make(map[common.Address]*entity.ValidatorParticipation)
+		deltas[req.epoch] = epochDeltas
+	}
+
+	var activeIndex uint32
+	for _, v := range valset.Validators {
+		if !v.IsActive {
+			continue
+		}
+
+		participation, ok := epochDeltas[v.Operator]
+		if !ok {
+			participation = &entity.ValidatorParticipation{Epoch: req.epoch, Operator: v.Operator}
+			epochDeltas[v.Operator] = participation
+		}
+
+		participation.Seen++
+		if sigMap.SignedValidatorsBitmap.Contains(activeIndex) {
+			participation.Signed++
+			if latency, ok := req.latencies[activeIndex]; ok {
+				participation.ObserveLatency(latency)
+			}
+		} else {
+			participation.Missed++
+		}
+		activeIndex++
+	}
+	return nil
+}
+
+func flattenDeltas(deltas map[symbiotic.Epoch]map[common.Address]*entity.ValidatorParticipation) []entity.ValidatorParticipation {
+	var participations []entity.ValidatorParticipation
+	for _, epochDeltas := range deltas {
+		for _, participation := range epochDeltas {
+			participations = append(participations, *participation)
+		}
+	}
+	sort.Slice(participations, func(i, j int) bool {
+		if participations[i].Epoch != participations[j].Epoch {
+			return participations[i].Epoch < participations[j].Epoch
+		}
+		return participations[i].Operator.Cmp(participations[j].Operator) < 0
+	})
+	return participations
+}
+
+func (t *Tracker) updateLocalMetrics(ctx context.Context, epoch symbiotic.Epoch) error {
+	valset, err := t.cfg.Repo.GetValidatorSetByEpoch(ctx, epoch)
+	if err != nil {
+		return errors.Errorf("failed to get validator set for epoch %d: %w", epoch, err)
+	}
+
+	pubkey, err := t.cfg.KeyProvider.GetOnchainKeyForValset(valset, valset.RequiredKeyTag)
+	if err != nil {
+		if errors.Is(err, entity.ErrKeyNotFound) {
+			return nil
+		}
+		return errors.Errorf("failed to get onchain key: %w", err)
+	}
+
+	local, found := valset.FindValidatorByKey(valset.RequiredKeyTag, pubkey)
+	if !found {
+		// not a validator in this epoch
+		return nil
+	}
+
+	participations, err := t.cfg.Repo.GetValidatorParticipations(ctx, epoch)
+	if err != nil {
+		return errors.Errorf("failed to get validator participations: %w", err)
+	}
+
+	participation := entity.ValidatorParticipation{Epoch: epoch, Operator: local.Operator}
+	for _, p := range participations {
+		if p.Operator == local.Operator {
+			participation = p
+			break
+		}
+	}
+
+	t.cfg.Metrics.SetLocalParticipation(participation.Seen, participation.Signed, participation.Missed, participation.MedianLatency())
+	return nil
+}
@@ -0,0 +1,187 @@
+package participation_tracker
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	"github.com/symbioticfi/relay/internal/usecase/participation-tracker/mocks"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+const testKeyTag = symbiotic.KeyTag(15)
+
+type testSetup struct {
+	repo        *mocks.Mockrepo
+	keyProvider *mocks.MockkeyProvider
+	metrics     *mocks.Mockmetrics
+	tracker     *Tracker
+	now         time.Time
+	keys        []crypto.PrivateKey
+	valset      symbiotic.ValidatorSet
+}
+
+// newTestSetup creates a tracker with a valset of epoch 1 where the validator at inactiveIndex is not active
+// and the first validator is the local operator.
+func newTestSetup(t *testing.T, validators, inactiveIndex int) *testSetup {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+
+	s := &testSetup{
+		repo:        mocks.NewMockrepo(ctrl),
+		keyProvider: mocks.NewMockkeyProvider(ctrl),
+		metrics:     mocks.NewMockmetrics(ctrl),
+		now:         time.Unix(1_700_000_000, 0),
+	}
+
+	s.valset = symbiotic.ValidatorSet{Epoch: 1, RequiredKeyTag: testKeyTag}
+	for i := range validators {
+		key, err := crypto.GeneratePrivateKey(symbiotic.KeyTypeBlsBn254)
+		require.NoError(t, err)
+		s.keys = append(s.keys, key)
+		s.valset.Validators = append(s.valset.Validators, symbiotic.Validator{
+			Operator: common.HexToAddress(fmt.Sprintf("0x%040d", i+1)),
+			IsActive: i != inactiveIndex,
+			Keys:     []symbiotic.ValidatorKey{{Tag: testKeyTag, Payload: key.PublicKey().OnChain()}},
+		})
+	}
+
+	tracker, err := New(Config{
+		Repo:          s.repo,
+		KeyProvider:   s.keyProvider,
+		Metrics:       s.metrics,
+		FlushInterval: time.Second,
+	})
+	require.NoError(t, err)
+	tracker.now = func() time.Time { return s.now }
+	s.tracker = tracker
+
+	return s
+}
+
+func (s *testSetup) signature(t *testing.T, validator int, message string) symbiotic.Signature {
+	t.Helper()
+
+	sig, hash, err := s.keys[validator].Sign([]byte(message))
+	require.NoError(t, err)
+
+	return symbiotic.Signature{
+		KeyTag:      testKeyTag,
+		Epoch:       s.valset.Epoch,
+		MessageHash: hash,
+		PublicKey:   s.keys[validator].PublicKey(),
+		Signature:   sig,
+	}
+}
+
+func (s *testSetup) expectValidatorByKey(validator int, activeIndex uint32) {
+	s.repo.EXPECT().GetValidatorByKey(gomock.Any(), s.valset.Epoch, testKeyTag, s.keys[validator].PublicKey().OnChain()).
+		Return(s.valset.Validators[validator], activeIndex, nil)
+}
+
+func (s *testSetup) expectLocalMetrics(epoch symbiotic.Epoch, stored []entity.ValidatorParticipation, seen, signed, missed uint64) {
+	s.repo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), epoch).Return(s.valset, nil)
+	s.keyProvider.EXPECT().GetOnchainKeyForValset(gomock.Any(), testKeyTag).Return(symbiotic.CompactPublicKey(s.keys[0].PublicKey().OnChain()), nil)
+	s.repo.EXPECT().GetValidatorParticipations(gomock.Any(), epoch).Return(stored, nil)
+	s.metrics.EXPECT().SetLocalParticipation(seen, signed, missed, gomock.Any())
+}
+
+func TestTracker_AccountsRequestAfterProofGracePeriod(t *testing.T) {
+	t.Parallel()
+	s := newTestSetup(t, 4, 2)
+
+	first := s.signature(t, 0, "message")
+	second := s.signature(t, 1, "message")
+	requestID := first.RequestID()
+	proof := symbiotic.AggregationProof{MessageHash: first.MessageHash, KeyTag: testKeyTag, Epoch: first.Epoch}
+
+	s.expectValidatorByKey(0, 0)
+	require.NoError(t, s.tracker.HandleSignatureProcessed(t.Context(), first))
+	s.now = s.now.Add(300 * time.Millisecond)
+	s.expectValidatorByKey(1, 1)
+	require.NoError(t, s.tracker.HandleSignatureProcessed(t.Context(), second))
+	require.NoError(t, s.tracker.HandleProofAggregated(t.Context(), proof))
+
+	// within the grace period nothing is accounted
+	s.repo.EXPECT().GetLatestValidatorSetEpoch(gomock.Any()).Return(symbiotic.Epoch(1), nil)
+	s.expectLocalMetrics(1, nil, 0, 0, 0)
+	require.NoError(t, s.tracker.Flush(t.Context()))
+
+	s.now = s.now.Add(time.Second)
+	// the third active validator signed late, after the proof, the inactive one is not accounted
+	sigMap := entity.SignatureMap{RequestID: requestID, SignedValidatorsBitmap: entity.NewBitmapOf(0, 1, 2)}
+
+	var saved []entity.ValidatorParticipation
+	s.repo.EXPECT().GetLatestValidatorSetEpoch(gomock.Any()).Return(symbiotic.Epoch(1), nil)
+	s.repo.EXPECT().GetSignatureMap(gomock.Any(), requestID).Return(sigMap, nil)
+	s.repo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), symbiotic.Epoch(1)).Return(s.valset, nil)
+	s.repo.EXPECT().AddValidatorParticipations(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, participations []entity.ValidatorParticipation) error {
+			saved = participations
+			return nil
+		})
+	s.expectLocalMetrics(1, []entity.ValidatorParticipation{{Epoch: 1, Operator: s.valset.Validators[0].Operator, Seen: 1, Signed: 1}}, 1, 1, 0)
+	require.NoError(t, s.tracker.Flush(t.Context()))
+
+	require.Len(t, saved, 3)
+	for i, p := range saved {
+		require.Equal(t, symbiotic.Epoch(1), p.Epoch)
+		require.Equal(t, uint64(1), p.Seen)
+		require.Equal(t, uint64(1), p.Signed)
+		require.Zero(t, p.Missed)
+		require.NotEqual(t, s.valset.Validators[2].Operator, p.Operator, "record %d", i)
+	}
+	require.Equal(t, 50*time.Millisecond/2, saved[0].MedianLatency())
+	require.Equal(t, 375*time.Millisecond, saved[1].MedianLatency())
+	// the late signature was not received by this node, so its latency is unknown
+	require.Zero(t, saved[2].MedianLatency())
+
+	// a late signature doesn't open the accounted request again
+	late := s.signature(t, 3, "message")
+	s.expectValidatorByKey(3, 2)
+	require.NoError(t, s.tracker.HandleSignatureProcessed(t.Context(), late))
+	require.Empty(t, s.tracker.open)
+}
+
+func TestTracker_AccountsUnfinishedRequestOnNewEpoch(t *testing.T) {
+	t.Parallel()
+	s := newTestSetup(t, 3, -1)
+
+	sig := s.signature(t, 1, "message")
+	requestID := sig.RequestID()
+	s.expectValidatorByKey(1, 1)
+	require.NoError(t, s.tracker.HandleSignatureProcessed(t.Context(), sig))
+
+	var saved []entity.ValidatorParticipation
+	s.repo.EXPECT().GetLatestValidatorSetEpoch(gomock.Any()).Return(symbiotic.Epoch(2), nil)
+	s.repo.EXPECT().GetSignatureMap(gomock.Any(), requestID).
+		Return(entity.SignatureMap{RequestID: requestID, SignedValidatorsBitmap: entity.NewBitmapOf(1)}, nil)
+	s.repo.EXPECT().GetValidatorSetByEpoch(gomock.Any(), symbiotic.Epoch(1)).Return(s.valset, nil)
+	s.repo.EXPECT().AddValidatorParticipations(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, participations []entity.ValidatorParticipation) error {
+			saved = participations
+			return nil
+		})
+	s.expectLocalMetrics(2, nil, 0, 0, 0)
+	require.NoError(t, s.tracker.Flush(t.Context()))
+
+	require.Len(t, saved, 3)
+	require.Equal(t, uint64(1), saved[0].Missed)
+	require.Equal(t, uint64(1), saved[1].Signed)
+	require.Equal(t, uint64(1), saved[2].Missed)
+	require.Empty(t, s.tracker.open)
+}
+
+func TestTracker_Flush_NoValidatorSetYet(t *testing.T) {
+	t.Parallel()
+	s := newTestSetup(t, 1, -1)
+
+	s.repo.EXPECT().GetLatestValidatorSetEpoch(gomock.Any()).Return(symbiotic.Epoch(0), entity.ErrEntityNotFound)
+	require.NoError(t, s.tracker.Flush(t.Context()))
+}
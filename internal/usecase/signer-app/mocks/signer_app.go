@@ -44,6 +44,35 @@ func (m *Mockrepo) EXPECT() *MockrepoMockRecorder {
 	return m.recorder
 }
 
+// CancelSignatureRequest mocks base method.
+func (m *Mockrepo) CancelSignatureRequest(ctx context.Context, requestID common.Hash) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSignatureRequest", ctx, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelSignatureRequest indicates an expected call of CancelSignatureRequest.
+func (mr *MockrepoMockRecorder) CancelSignatureRequest(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSignatureRequest", reflect.TypeOf((*Mockrepo)(nil).CancelSignatureRequest), ctx, requestID)
+}
+
+// GetAggregationProof mocks base method.
+func (m *Mockrepo) GetAggregationProof(ctx context.Context, requestID common.Hash) (entity.AggregationProof, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregationProof", ctx, requestID)
+	ret0, _ := ret[0].(entity.AggregationProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregationProof indicates an expected call of GetAggregationProof.
+func (mr *MockrepoMockRecorder) GetAggregationProof(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregationProof", reflect.TypeOf((*Mockrepo)(nil).GetAggregationProof), ctx, requestID)
+}
+
 // GetSignaturePending mocks base method.
 func (m *Mockrepo) GetSignaturePending(ctx context.Context, limit int) ([]common.Hash, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +132,20 @@ func (mr *MockrepoMockRecorder) RemoveSignaturePending(ctx, epoch, requestID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSignaturePending", reflect.TypeOf((*Mockrepo)(nil).RemoveSignaturePending), ctx, epoch, requestID)
 }
 
+// SaveMessageBatch mocks base method.
+func (m *Mockrepo) SaveMessageBatch(ctx context.Context, batch entity.MessageBatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMessageBatch", ctx, batch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMessageBatch indicates an expected call of SaveMessageBatch.
+func (mr *MockrepoMockRecorder) SaveMessageBatch(ctx, batch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMessageBatch", reflect.TypeOf((*Mockrepo)(nil).SaveMessageBatch), ctx, batch)
+}
+
 // SaveSignatureRequest mocks base method.
 func (m *Mockrepo) SaveSignatureRequest(ctx context.Context, requestID common.Hash, req entity.SignatureRequest) error {
 	m.ctrl.T.Helper()
@@ -179,34 +222,34 @@ func (m *MockkeyProvider) EXPECT() *MockkeyProviderMockRecorder {
 	return m.recorder
 }
 
-// GetOnchainKeyFromCache mocks base method.
-func (m *MockkeyProvider) GetOnchainKeyFromCache(keyTag entity.KeyTag) (entity.CompactPublicKey, error) {
+// GetOnchainKeyForValset mocks base method.
+func (m *MockkeyProvider) GetOnchainKeyForValset(valset entity.ValidatorSet, keyTag entity.KeyTag) (entity.CompactPublicKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOnchainKeyFromCache", keyTag)
+	ret := m.ctrl.Call(m, "GetOnchainKeyForValset", valset, keyTag)
 	ret0, _ := ret[0].(entity.CompactPublicKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOnchainKeyFromCache indicates an expected call of GetOnchainKeyFromCache.
-func (mr *MockkeyProviderMockRecorder) GetOnchainKeyFromCache(keyTag any) *gomock.Call {
+// GetOnchainKeyForValset indicates an expected call of GetOnchainKeyForValset.
+func (mr *MockkeyProviderMockRecorder) GetOnchainKeyForValset(valset, keyTag any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnchainKeyFromCache", reflect.TypeOf((*MockkeyProvider)(nil).GetOnchainKeyFromCache), keyTag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnchainKeyForValset", reflect.TypeOf((*MockkeyProvider)(nil).GetOnchainKeyForValset), valset, keyTag)
 }
 
-// GetPrivateKey mocks base method.
-func (m *MockkeyProvider) GetPrivateKey(keyTag entity.KeyTag) (crypto.PrivateKey, error) {
+// GetPrivateKeyForValset mocks base method.
+func (m *MockkeyProvider) GetPrivateKeyForValset(valset entity.ValidatorSet, keyTag entity.KeyTag) (crypto.PrivateKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPrivateKey", keyTag)
+	ret := m.ctrl.Call(m, "GetPrivateKeyForValset", valset, keyTag)
 	ret0, _ := ret[0].(crypto.PrivateKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPrivateKey indicates an expected call of GetPrivateKey.
-func (mr *MockkeyProviderMockRecorder) GetPrivateKey(keyTag any) *gomock.Call {
+// GetPrivateKeyForValset indicates an expected call of GetPrivateKeyForValset.
+func (mr *MockkeyProviderMockRecorder) GetPrivateKeyForValset(valset, keyTag any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateKey", reflect.TypeOf((*MockkeyProvider)(nil).GetPrivateKey), keyTag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateKeyForValset", reflect.TypeOf((*MockkeyProvider)(nil).GetPrivateKeyForValset), valset, keyTag)
 }
 
 // Mockmetrics is a mock of metrics interface.
@@ -257,6 +300,30 @@ func (mr *MockmetricsMockRecorder) ObservePKSignDuration(d any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObservePKSignDuration", reflect.TypeOf((*Mockmetrics)(nil).ObservePKSignDuration), d)
 }
 
+// ObserveQueueWait mocks base method.
+func (m *Mockmetrics) ObserveQueueWait(queue, class string, d time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveQueueWait", queue, class, d)
+}
+
+// ObserveQueueWait indicates an expected call of ObserveQueueWait.
+func (mr *MockmetricsMockRecorder) ObserveQueueWait(queue, class, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveQueueWait", reflect.TypeOf((*Mockmetrics)(nil).ObserveQueueWait), queue, class, d)
+}
+
+// SetQueueDepth mocks base method.
+func (m *Mockmetrics) SetQueueDepth(queue, class string, depth int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetQueueDepth", queue, class, depth)
+}
+
+// SetQueueDepth indicates an expected call of SetQueueDepth.
+func (mr *MockmetricsMockRecorder) SetQueueDepth(queue, class, depth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQueueDepth", reflect.TypeOf((*Mockmetrics)(nil).SetQueueDepth), queue, class, depth)
+}
+
 // MockentityProcessor is a mock of entityProcessor interface.
 type MockentityProcessor struct {
 	ctrl     *gomock.Controller
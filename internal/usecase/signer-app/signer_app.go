@@ -1,6 +1,7 @@
 package signer_app
 
 import (
+	"bytes"
 	"context"
 	"log/slog"
 	"time"
@@ -8,15 +9,16 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/symbioticfi/relay/internal/entity"
+	"github.com/symbioticfi/relay/pkg/fairqueue"
 	"github.com/symbioticfi/relay/pkg/log"
 	"github.com/symbioticfi/relay/pkg/tracing"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
 	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+	"github.com/symbioticfi/relay/symbiotic/usecase/merkle"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-errors/errors"
 	validate "github.com/go-playground/validator/v10"
-	"k8s.io/client-go/util/workqueue"
 )
 
 //go:generate mockgen -source=signer_app.go -destination=mocks/signer_app.go -package=mocks
@@ -26,7 +28,10 @@ type repo interface {
 	RemoveSignaturePending(ctx context.Context, epoch symbiotic.Epoch, requestID common.Hash) error
 	GetSignaturePending(ctx context.Context, limit int) ([]common.Hash, error)
 	GetSignatureRequest(ctx context.Context, requestID common.Hash) (symbiotic.SignatureRequest, error)
+	CancelSignatureRequest(ctx context.Context, requestID common.Hash) error
+	GetAggregationProof(ctx context.Context, requestID common.Hash) (symbiotic.AggregationProof, error)
 	GetValidatorSetByEpoch(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.ValidatorSet, error)
+	SaveMessageBatch(ctx context.Context, batch symbiotic.MessageBatch) error
 }
 
 type p2pService interface {
@@ -34,13 +39,15 @@ type p2pService interface {
 }
 
 type keyProvider interface {
-	GetPrivateKey(keyTag symbiotic.KeyTag) (crypto.PrivateKey, error)
-	GetOnchainKeyFromCache(keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, error)
+	GetPrivateKeyForValset(valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag) (crypto.PrivateKey, error)
+	GetOnchainKeyForValset(valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, error)
 }
 
 type metrics interface {
 	ObservePKSignDuration(d time.Duration)
 	ObserveAppSignDuration(d time.Duration)
+	SetQueueDepth(queue, class string, depth int)
+	ObserveQueueWait(queue, class string, d time.Duration)
 }
 
 type entityProcessor interface {
@@ -53,19 +60,23 @@ type Config struct {
 	Repo            repo            `validate:"required"`
 	EntityProcessor entityProcessor `validate:"required"`
 	Metrics         metrics         `validate:"required"`
+	Priority        entity.PriorityPolicy
 }
 
 func (c Config) Validate() error {
 	if err := validate.New().Struct(c); err != nil {
 		return errors.Errorf("failed to validate config: %w", err)
 	}
+	if err := c.Priority.Validate(); err != nil {
+		return errors.Errorf("failed to validate priority policy: %w", err)
+	}
 
 	return nil
 }
 
 type SignerApp struct {
 	cfg   Config
-	queue *workqueue.Typed[common.Hash]
+	queue *fairqueue.Queue[common.Hash]
 }
 
 func NewSignerApp(cfg Config) (*SignerApp, error) {
@@ -73,9 +84,14 @@ func NewSignerApp(cfg Config) (*SignerApp, error) {
 		return nil, errors.Errorf("failed to validate config: %w", err)
 	}
 
+	queue, err := fairqueue.New[common.Hash](cfg.Priority.QueueConfig("signer", cfg.Metrics))
+	if err != nil {
+		return nil, errors.Errorf("failed to create signer queue: %w", err)
+	}
+
 	app := &SignerApp{
 		cfg:   cfg,
-		queue: workqueue.NewTyped[common.Hash](),
+		queue: queue,
 	}
 
 	return app, nil
@@ -98,6 +114,21 @@ func (s *SignerApp) RequestSignature(ctx context.Context, req symbiotic.Signatur
 		return common.Hash{}, err
 	}
 
+	if req.TypedData != nil {
+		// the typed data is the source of truth, the signed message is always its canonical encoding
+		encoded, err := symbiotic.EncodeTypedData(*req.TypedData)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return common.Hash{}, err
+		}
+		if len(req.Message) > 0 && !bytes.Equal(req.Message, encoded) {
+			err = errors.New("message does not match the encoding of typed data")
+			tracing.RecordError(span, err)
+			return common.Hash{}, err
+		}
+		req.Message = encoded
+	}
+
 	msgHash, err := crypto.HashMessage(req.KeyTag.Type(), req.Message)
 	if err != nil {
 		tracing.RecordError(span, err)
@@ -114,20 +145,118 @@ func (s *SignerApp) RequestSignature(ctx context.Context, req symbiotic.Signatur
 	tracing.SetAttributes(span, tracing.AttrRequestID.String(requestId.Hex()))
 
 	err = s.cfg.Repo.SaveSignatureRequest(ctx, requestId, req)
-	if err != nil && !errors.Is(err, entity.ErrEntityAlreadyExist) {
+	if errors.Is(err, entity.ErrEntityAlreadyExist) {
+		// the stored request is kept, a cancelled or expired one is not revived by requesting the message again
+		existing, err := s.cfg.Repo.GetSignatureRequest(ctx, requestId)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return common.Hash{}, errors.Errorf("failed to get signature request: %w", err)
+		}
+		if !existing.Active(time.Now()) {
+			err = errors.Errorf("request %s: %w", requestId.Hex(), entity.ErrRequestInactive)
+			tracing.RecordError(span, err)
+			return common.Hash{}, err
+		}
+	} else if err != nil {
 		tracing.RecordError(span, err)
-		return common.Hash{}, errors.Errorf("failed to get signature request: %w", err)
+		return common.Hash{}, errors.Errorf("failed to save signature request: %w", err)
 	}
 
-	s.queue.Add(requestId)
+	s.queue.Add(requestId, s.cfg.Priority.Class(req).String())
 
 	tracing.AddEvent(span, "signature_requested")
 	// does not return the actual signature yet
 	return requestId, nil
 }
 
+// RequestBatchSignature creates a single signature request over the Merkle root of the messages
+// and stores the batch so that inclusion proofs of individual messages can be built later.
+// Only aggregation key tags are supported since messages are proven against the aggregation proof of the root.
+func (s *SignerApp) RequestBatchSignature(ctx context.Context, keyTag symbiotic.KeyTag, requiredEpoch symbiotic.Epoch, messages [][]byte, priority symbiotic.PriorityClass) (symbiotic.MessageBatch, error) {
+	if !keyTag.Type().AggregationKey() {
+		return symbiotic.MessageBatch{}, errors.Errorf("key tag %s is not an aggregation key", keyTag)
+	}
+
+	leaves := merkle.HashLeaves(messages)
+	root, err := merkle.Root(leaves)
+	if err != nil {
+		return symbiotic.MessageBatch{}, errors.Errorf("failed to build merkle tree: %w", err)
+	}
+
+	msgHash, err := crypto.HashMessage(keyTag.Type(), root.Bytes())
+	if err != nil {
+		return symbiotic.MessageBatch{}, errors.Errorf("failed to hash merkle root: %w", err)
+	}
+	batch := symbiotic.MessageBatch{
+		RequestID: symbiotic.Signature{MessageHash: msgHash, KeyTag: keyTag, Epoch: requiredEpoch}.RequestID(),
+		Epoch:     requiredEpoch,
+		KeyTag:    keyTag,
+		Root:      root,
+		Leaves:    leaves,
+	}
+	// the batch is saved before the request, a request without its batch could never be proven,
+	// a batch without its request is completed by requesting it again
+	if err := s.cfg.Repo.SaveMessageBatch(ctx, batch); err != nil && !errors.Is(err, entity.ErrEntityAlreadyExist) {
+		return symbiotic.MessageBatch{}, errors.Errorf("failed to save message batch: %w", err)
+	}
+
+	// batches are only requested through the API, so they can be cancelled like single messages
+	requestID, err := s.RequestSignature(ctx, symbiotic.SignatureRequest{
+		KeyTag:        keyTag,
+		RequiredEpoch: requiredEpoch,
+		Message:       root.Bytes(),
+		Submitted:     true,
+		Priority:      priority,
+	})
+	if err != nil {
+		return symbiotic.MessageBatch{}, err
+	}
+
+	slog.DebugContext(ctx, "Requested batch signature", "requestId", requestID.Hex(), "messages", len(messages), "root", root.Hex())
+	return batch, nil
+}
+
+// CancelSignatureRequest cancels a request submitted through the API of this relay, the relay stops signing,
+// syncing and aggregating it. Requests created by the relay itself, aggregated and expired ones can not be cancelled.
+// Cancelling a cancelled request is a no-op.
+func (s *SignerApp) CancelSignatureRequest(ctx context.Context, requestID common.Hash) (symbiotic.SignatureRequest, error) {
+	ctx = log.WithComponent(ctx, "signer")
+	ctx = log.WithAttrs(ctx, slog.String("requestId", requestID.Hex()))
+
+	req, err := s.cfg.Repo.GetSignatureRequest(ctx, requestID)
+	if err != nil {
+		return symbiotic.SignatureRequest{}, errors.Errorf("failed to get signature request: %w", err)
+	}
+	if req.Cancelled {
+		return req, nil
+	}
+	if !req.Submitted {
+		return symbiotic.SignatureRequest{}, errors.Errorf("request is created by the relay: %w", entity.ErrNotCancellable)
+	}
+	if req.Expired(time.Now()) {
+		return symbiotic.SignatureRequest{}, errors.Errorf("request is expired: %w", entity.ErrNotCancellable)
+	}
+
+	_, err = s.cfg.Repo.GetAggregationProof(ctx, requestID)
+	if err == nil {
+		return symbiotic.SignatureRequest{}, errors.Errorf("request is aggregated: %w", entity.ErrNotCancellable)
+	}
+	if !errors.Is(err, entity.ErrEntityNotFound) {
+		return symbiotic.SignatureRequest{}, errors.Errorf("failed to get aggregation proof: %w", err)
+	}
+
+	if err := s.cfg.Repo.CancelSignatureRequest(ctx, requestID); err != nil {
+		return symbiotic.SignatureRequest{}, errors.Errorf("failed to cancel signature request: %w", err)
+	}
+	req.Cancelled = true
+
+	slog.InfoContext(ctx, "Cancelled signature request", "epoch", req.RequiredEpoch)
+	return req, nil
+}
+
+// EnqueueRequestID queues a validator set header request, header requests are always signed first
 func (s *SignerApp) EnqueueRequestID(ctx context.Context, requestID common.Hash) {
-	s.queue.Add(requestID)
+	s.queue.Add(requestID, symbiotic.PriorityHeader.String())
 	slog.DebugContext(ctx, "Enqueued signature request", "requestId", requestID.Hex())
 }
 
@@ -152,22 +281,32 @@ func (s *SignerApp) completeSign(ctx context.Context, requestID common.Hash, p2p
 		tracing.AttrKeyTag.String(req.KeyTag.String()),
 	)
 
+	if !req.Active(time.Now()) {
+		tracing.AddEvent(span, "request_inactive_skipping")
+		slog.DebugContext(ctx, "Skipped signing, request is cancelled or expired", "cancelled", req.Cancelled, "deadline", req.Deadline)
+		if err := s.cfg.Repo.RemoveSignaturePending(ctx, req.RequiredEpoch, requestID); err != nil {
+			tracing.RecordError(span, err)
+			return errors.Errorf("failed to remove self signature request pending: %w", err)
+		}
+		return nil
+	}
+
 	valset, err := s.cfg.Repo.GetValidatorSetByEpoch(ctx, req.RequiredEpoch)
 	if err != nil {
 		tracing.RecordError(span, err)
 		return errors.Errorf("failed to get validator set: %w", err)
 	}
 
-	private, err := s.cfg.KeyProvider.GetPrivateKey(req.KeyTag)
+	private, err := s.cfg.KeyProvider.GetPrivateKeyForValset(valset, req.KeyTag)
 	if err != nil {
 		tracing.RecordError(span, err)
 		return errors.Errorf("failed to get private key: %w", err)
 	}
 
-	onchainKey, err := s.cfg.KeyProvider.GetOnchainKeyFromCache(valset.RequiredKeyTag)
+	onchainKey, err := s.cfg.KeyProvider.GetOnchainKeyForValset(valset, valset.RequiredKeyTag)
 	if err != nil {
 		tracing.RecordError(span, err)
-		return errors.Errorf("failed to get onchain symb key from cache: %w", err)
+		return errors.Errorf("failed to get onchain symb key: %w", err)
 	}
 
 	if !valset.IsSigner(onchainKey) {
@@ -211,6 +350,15 @@ func (s *SignerApp) completeSign(ctx context.Context, requestID common.Hash, p2p
 		PublicKey:   private.PublicKey(),
 		Signature:   signature,
 	}
+	if req.TypedData != nil && req.TypedData.PrimaryType == symbiotic.ValSetHeaderCommitPrimaryType {
+		// header signatures carry their content, so that other relays can detect conflicting ones
+		commitment, err := symbiotic.ValSetHeaderCommitmentFromTypedData(*req.TypedData)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return errors.Errorf("failed to get header commitment: %w", err)
+		}
+		extendedSignature.HeaderCommitment = &commitment
+	}
 
 	ctx = log.WithAttrs(ctx, slog.String("requestId", extendedSignature.RequestID().Hex()))
 
@@ -309,8 +457,12 @@ func (s *SignerApp) handleMissedSignaturesOnce(ctx context.Context) error {
 	tracing.AddEvent(span, "queuing_pending_requests", attribute.Int("count", len(pendingRequests)))
 	slog.InfoContext(ctx, "Found pending self signature requests", "count", len(pendingRequests))
 	for _, reqID := range pendingRequests {
-		slog.InfoContext(ctx, "Queued pending self signature request", "requestId", reqID.Hex())
-		s.queue.Add(reqID)
+		class := symbiotic.PriorityNormal
+		if req, err := s.cfg.Repo.GetSignatureRequest(ctx, reqID); err == nil {
+			class = s.cfg.Priority.Class(req)
+		}
+		slog.InfoContext(ctx, "Queued pending self signature request", "requestId", reqID.Hex(), "priority", class)
+		s.queue.Add(reqID, class.String())
 	}
 	return nil
 }
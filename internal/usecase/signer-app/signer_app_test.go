@@ -1,6 +1,7 @@
 package signer_app
 
 import (
+	"context"
 	"crypto/rand"
 	"log/slog"
 	"math/big"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -24,6 +26,7 @@ import (
 	"github.com/symbioticfi/relay/pkg/signals"
 	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
 	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+	"github.com/symbioticfi/relay/symbiotic/usecase/merkle"
 )
 
 func TestSign_HappyPath(t *testing.T) {
@@ -70,6 +73,270 @@ func TestSign_HappyPath(t *testing.T) {
 	}
 }
 
+func TestSign_WithNextKeyCapturedByValset(t *testing.T) {
+	for name, newRepo := range backends() {
+		t.Run(name, func(t *testing.T) {
+			setup := newTestSetup(t, newRepo)
+			req := createTestSignatureRequest(lo.RandomString(100, lo.AllCharset))
+			currentKey := newPrivateKey(t)
+			nextKey := newPrivateKey(t)
+			// the validator set of the request epoch already captured the rotated key
+			createTestValidatorSet(t, setup, nextKey)
+
+			require.NoError(t, setup.keyProvider.AddKey(req.KeyTag, currentKey))
+			require.NoError(t, setup.keyProvider.AddNextKey(req.KeyTag, nextKey))
+
+			setup.mockP2P.EXPECT().BroadcastSignatureGeneratedMessage(gomock.Any(), gomock.Any()).Return(nil)
+			setup.mockMetrics.EXPECT().ObservePKSignDuration(gomock.Any())
+			setup.mockMetrics.EXPECT().ObserveAppSignDuration(gomock.Any())
+
+			go setup.app.HandleSignatureRequests(t.Context(), 1, setup.mockP2P)
+
+			reqID, err := setup.app.RequestSignature(t.Context(), req)
+			require.NoError(t, err)
+
+			require.Eventually(t, func() bool {
+				signatures, err := setup.repo.GetAllSignatures(t.Context(), reqID)
+				return err == nil && len(signatures) == 1
+			}, 5*time.Second, 50*time.Millisecond)
+
+			signatures, err := setup.repo.GetAllSignatures(t.Context(), reqID)
+			require.NoError(t, err)
+			require.NoError(t, nextKey.PublicKey().Verify(req.Message, signatures[0].Signature))
+		})
+	}
+}
+
+func TestRequestSignature_WithTypedData_StoresEncodingAndTypedData(t *testing.T) {
+	for name, newRepo := range backends() {
+		t.Run(name, func(t *testing.T) {
+			setup := newTestSetup(t, newRepo)
+			typedData, err := symbiotic.ParseTypedData([]byte(`{
+				"types": {
+					"EIP712Domain": [{"name": "name", "type": "string"}],
+					"Vote": [{"name": "proposal", "type": "uint256"}]
+				},
+				"primaryType": "Vote",
+				"domain": {"name": "Governor"},
+				"message": {"proposal": 42}
+			}`))
+			require.NoError(t, err)
+			encoded, err := symbiotic.EncodeTypedData(typedData)
+			require.NoError(t, err)
+
+			reqID, err := setup.app.RequestSignature(t.Context(), symbiotic.SignatureRequest{
+				KeyTag:        symbiotic.KeyTag(15),
+				RequiredEpoch: symbiotic.Epoch(1),
+				TypedData:     &typedData,
+			})
+			require.NoError(t, err)
+
+			savedReq, err := setup.repo.GetSignatureRequest(t.Context(), reqID)
+			require.NoError(t, err)
+			require.Equal(t, encoded, savedReq.Message)
+			require.Equal(t, &typedData, savedReq.TypedData)
+
+			// a message that differs from the typed data encoding is rejected
+			_, err = setup.app.RequestSignature(t.Context(), symbiotic.SignatureRequest{
+				KeyTag:        symbiotic.KeyTag(15),
+				RequiredEpoch: symbiotic.Epoch(1),
+				Message:       []byte("other"),
+				TypedData:     &typedData,
+			})
+			require.ErrorContains(t, err, "does not match")
+		})
+	}
+}
+
+func TestRequestSignature_SchedulesByPriority(t *testing.T) {
+	setup := newTestSetup(t, backends()["badger"])
+	setup.app.cfg.Priority = entity.PriorityPolicy{
+		KeyTags: map[symbiotic.KeyTag]symbiotic.PriorityClass{symbiotic.KeyTag(15): symbiotic.PriorityLow},
+	}
+
+	// workers are not started, so requests stay queued
+	low, err := setup.app.RequestSignature(t.Context(), createTestSignatureRequest("low"))
+	require.NoError(t, err)
+	highReq := createTestSignatureRequest("high")
+	highReq.Priority = symbiotic.PriorityHigh
+	high, err := setup.app.RequestSignature(t.Context(), highReq)
+	require.NoError(t, err)
+	header := common.HexToHash("0x01")
+	setup.app.EnqueueRequestID(t.Context(), header)
+
+	var order []common.Hash
+	for range 3 {
+		item, ok := setup.app.queue.TryGet()
+		require.True(t, ok)
+		order = append(order, item)
+	}
+	require.Equal(t, []common.Hash{header, high, low}, order)
+}
+
+func TestRequestBatchSignature(t *testing.T) {
+	for name, newRepo := range backends() {
+		t.Run(name, func(t *testing.T) {
+			setup := newTestSetup(t, newRepo)
+			messages := [][]byte{[]byte("first"), []byte("second"), []byte("third")}
+
+			batch, err := setup.app.RequestBatchSignature(t.Context(), symbiotic.KeyTag(15), symbiotic.Epoch(1), messages, symbiotic.PriorityUnspecified)
+			require.NoError(t, err)
+			require.Len(t, batch.Leaves, len(messages))
+
+			root, err := merkle.Root(merkle.HashLeaves(messages))
+			require.NoError(t, err)
+			require.Equal(t, root, batch.Root)
+
+			// Verify that the root is what gets signed
+			savedReq, err := setup.repo.GetSignatureRequest(t.Context(), batch.RequestID)
+			require.NoError(t, err)
+			require.Equal(t, symbiotic.RawMessage(root.Bytes()), savedReq.Message)
+
+			savedBatch, err := setup.repo.GetMessageBatch(t.Context(), batch.RequestID)
+			require.NoError(t, err)
+			require.Equal(t, batch, savedBatch)
+
+			// Requesting the same batch again is idempotent
+			again, err := setup.app.RequestBatchSignature(t.Context(), symbiotic.KeyTag(15), symbiotic.Epoch(1), messages, symbiotic.PriorityUnspecified)
+			require.NoError(t, err)
+			require.Equal(t, batch.RequestID, again.RequestID)
+		})
+	}
+}
+
+// failingBatchRepo fails to save message batches.
+type failingBatchRepo struct {
+	cached.Repository
+}
+
+func (r failingBatchRepo) SaveMessageBatch(context.Context, symbiotic.MessageBatch) error {
+	return errors.New("disk full")
+}
+
+func TestRequestBatchSignature_BatchSaveFailureLeavesNoRequest(t *testing.T) {
+	setup := newTestSetup(t, func(t *testing.T) cached.Repository {
+		return failingBatchRepo{Repository: backends()["badger"](t)}
+	})
+	messages := [][]byte{[]byte("first"), []byte("second")}
+
+	_, err := setup.app.RequestBatchSignature(t.Context(), symbiotic.KeyTag(15), symbiotic.Epoch(1), messages, symbiotic.PriorityUnspecified)
+	require.ErrorContains(t, err, "failed to save message batch")
+
+	root, err := merkle.Root(merkle.HashLeaves(messages))
+	require.NoError(t, err)
+	msgHash, err := crypto.HashMessage(symbiotic.KeyTypeBlsBn254, root.Bytes())
+	require.NoError(t, err)
+	requestID := symbiotic.Signature{MessageHash: msgHash, KeyTag: 15, Epoch: 1}.RequestID()
+	_, err = setup.repo.GetSignatureRequest(t.Context(), requestID)
+	require.ErrorIs(t, err, entity.ErrEntityNotFound)
+}
+
+func TestRequestBatchSignature_InvalidInput(t *testing.T) {
+	setup := newTestSetup(t, backends()["badger"])
+
+	_, err := setup.app.RequestBatchSignature(t.Context(), symbiotic.KeyTag(15), symbiotic.Epoch(1), nil, symbiotic.PriorityUnspecified)
+	require.Error(t, err)
+
+	// ecdsa keys can't be aggregated
+	_, err = setup.app.RequestBatchSignature(t.Context(), symbiotic.KeyTag(0x10), symbiotic.Epoch(1), [][]byte{[]byte("msg")}, symbiotic.PriorityUnspecified)
+	require.ErrorContains(t, err, "not an aggregation key")
+}
+
+func TestCancelSignatureRequest(t *testing.T) {
+	for name, newRepo := range backends() {
+		t.Run(name, func(t *testing.T) {
+			setup := newTestSetup(t, newRepo)
+			privateKey := newPrivateKey(t)
+			createTestValidatorSet(t, setup, privateKey)
+			require.NoError(t, setup.keyProvider.AddKey(symbiotic.KeyTag(15), privateKey))
+
+			req := createTestSignatureRequest(lo.RandomString(100, lo.AllCharset))
+			req.Submitted = true
+			reqID, err := setup.app.RequestSignature(t.Context(), req)
+			require.NoError(t, err)
+
+			cancelled, err := setup.app.CancelSignatureRequest(t.Context(), reqID)
+			require.NoError(t, err)
+			require.True(t, cancelled.Cancelled)
+
+			// cancelling again is a no-op
+			_, err = setup.app.CancelSignatureRequest(t.Context(), reqID)
+			require.NoError(t, err)
+
+			savedReq, err := setup.repo.GetSignatureRequest(t.Context(), reqID)
+			require.NoError(t, err)
+			require.True(t, savedReq.Cancelled)
+			pending, err := setup.repo.GetSignaturePending(t.Context(), 0)
+			require.NoError(t, err)
+			require.Empty(t, pending)
+
+			// requesting the message again does not revive the cancelled request
+			_, err = setup.app.RequestSignature(t.Context(), req)
+			require.ErrorIs(t, err, entity.ErrRequestInactive)
+			savedReq, err = setup.repo.GetSignatureRequest(t.Context(), reqID)
+			require.NoError(t, err)
+			require.True(t, savedReq.Cancelled)
+
+			// the queued request is not signed, no broadcast is expected by the p2p mock
+			require.NoError(t, setup.app.completeSign(t.Context(), reqID, setup.mockP2P))
+			signatures, err := setup.repo.GetAllSignatures(t.Context(), reqID)
+			require.NoError(t, err)
+			require.Empty(t, signatures)
+		})
+	}
+}
+
+func TestCancelSignatureRequest_NotCancellable(t *testing.T) {
+	setup := newTestSetup(t, backends()["bbolt"])
+
+	// requests created by the relay itself
+	internal := createTestSignatureRequest("internal")
+	internalID, err := setup.app.RequestSignature(t.Context(), internal)
+	require.NoError(t, err)
+	_, err = setup.app.CancelSignatureRequest(t.Context(), internalID)
+	require.ErrorIs(t, err, entity.ErrNotCancellable)
+
+	expired := createTestSignatureRequest("expired")
+	expired.Submitted = true
+	expired.Deadline = time.Now().Add(-time.Minute)
+	expiredID, err := setup.app.RequestSignature(t.Context(), expired)
+	require.NoError(t, err)
+	_, err = setup.app.CancelSignatureRequest(t.Context(), expiredID)
+	require.ErrorIs(t, err, entity.ErrNotCancellable)
+
+	// an expired request is not extended by requesting the message again with a later deadline
+	expired.Deadline = time.Now().Add(time.Hour)
+	_, err = setup.app.RequestSignature(t.Context(), expired)
+	require.ErrorIs(t, err, entity.ErrRequestInactive)
+
+	_, err = setup.app.CancelSignatureRequest(t.Context(), common.HexToHash("0x01"))
+	require.ErrorIs(t, err, entity.ErrEntityNotFound)
+}
+
+func TestSign_SkipsExpiredRequest(t *testing.T) {
+	for name, newRepo := range backends() {
+		t.Run(name, func(t *testing.T) {
+			setup := newTestSetup(t, newRepo)
+			privateKey := newPrivateKey(t)
+			createTestValidatorSet(t, setup, privateKey)
+			require.NoError(t, setup.keyProvider.AddKey(symbiotic.KeyTag(15), privateKey))
+
+			req := createTestSignatureRequest(lo.RandomString(100, lo.AllCharset))
+			req.Deadline = time.Now().Add(-time.Second)
+			reqID, err := setup.app.RequestSignature(t.Context(), req)
+			require.NoError(t, err)
+
+			require.NoError(t, setup.app.completeSign(t.Context(), reqID, setup.mockP2P))
+			signatures, err := setup.repo.GetAllSignatures(t.Context(), reqID)
+			require.NoError(t, err)
+			require.Empty(t, signatures)
+			pending, err := setup.repo.GetSignaturePending(t.Context(), 0)
+			require.NoError(t, err)
+			require.Empty(t, pending)
+		})
+	}
+}
+
 type testSetup struct {
 	ctrl        *gomock.Controller
 	repo        cached.Repository
@@ -113,6 +380,8 @@ func newTestSetup(t *testing.T, newRepo repoFactory) *testSetup {
 	// Create mocks for other dependencies
 	mockP2P := mocks.NewMockp2pService(ctrl)
 	mockMetrics := mocks.NewMockmetrics(ctrl)
+	mockMetrics.EXPECT().SetQueueDepth(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockMetrics.EXPECT().ObserveQueueWait(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
 	// Create mock aggregator for entity processor
 	mockEntityAggregator := entity_mocks.NewMockAggregator(ctrl)
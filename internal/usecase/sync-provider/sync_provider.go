@@ -2,6 +2,7 @@ package sync_provider
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-errors/errors"
@@ -24,7 +25,7 @@ type repo interface {
 }
 
 type entityProcessor interface {
-	ProcessSignature(ctx context.Context, signature symbiotic.Signature, self bool) error
+	ProcessSignatures(ctx context.Context, signatures []symbiotic.Signature) []error
 	ProcessAggregationProof(ctx context.Context, proof symbiotic.AggregationProof) error
 }
 
@@ -50,3 +51,17 @@ func New(cfg Config) (*Syncer, error) {
 		cfg: cfg,
 	}, nil
 }
+
+// dropInactiveRequest reports whether the request is cancelled or expired and removes it from the aggregation pending
+// collection then, so that it is no longer synced. Signatures received later add it back and it is dropped again.
+func (s *Syncer) dropInactiveRequest(ctx context.Context, req symbiotic.SignatureRequestWithID) (bool, error) {
+	if req.Active(time.Now()) {
+		return false, nil
+	}
+	err := s.cfg.Repo.RemoveAggregationProofPending(ctx, req.RequiredEpoch, req.RequestID)
+	// ignore not found and tx conflict errors, as they indicate the request was already removed or is being processed
+	if err != nil && !errors.Is(err, entity.ErrEntityNotFound) && !errors.Is(err, entity.ErrTxConflict) {
+		return true, errors.Errorf("failed to remove inactive request from pending collection: %w", err)
+	}
+	return true, nil
+}
@@ -57,6 +57,11 @@ func (s *Syncer) BuildWantAggregationProofsRequest(ctx context.Context) (entity.
 				if !req.KeyTag.Type().AggregationKey() {
 					continue // Skip non-aggregation requests
 				}
+				if inactive, err := s.dropInactiveRequest(ctx, req); err != nil {
+					return entity.WantAggregationProofsRequest{}, err
+				} else if inactive {
+					continue // Cancelled or expired, skip
+				}
 				// check if proof exists
 				_, err := s.cfg.Repo.GetAggregationProof(ctx, req.RequestID)
 				if err == nil {
@@ -90,6 +90,13 @@ func (s *Syncer) buildWantSignaturesMap(ctx context.Context) (map[common.Hash]en
 			for _, req := range requests {
 				reqSignatureID := req.RequestID
 
+				if inactive, err := s.dropInactiveRequest(ctx, req); err != nil {
+					return nil, err
+				} else if inactive {
+					lastHash = reqSignatureID
+					continue
+				}
+
 				// Get current signature map
 				sigMap, err := s.cfg.Repo.GetSignatureMap(ctx, reqSignatureID)
 				if err != nil {
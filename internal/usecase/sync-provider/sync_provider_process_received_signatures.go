@@ -10,8 +10,15 @@ import (
 
 	"github.com/symbioticfi/relay/internal/entity"
 	"github.com/symbioticfi/relay/pkg/tracing"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
 )
 
+type receivedSignature struct {
+	requestID      common.Hash
+	validatorIndex uint32
+	epoch          symbiotic.Epoch
+}
+
 // ProcessReceivedSignatures validates and processes signatures received from peer nodes during
 // synchronization, updating local storage and tracking statistics for monitoring.
 //
@@ -20,7 +27,7 @@ import (
 // 2. Retrieves original signature request metadata (epoch, key type, etc.)
 // 3. Reconstructs and validates public keys from signature data
 // 4. Cross-references validator information to ensure consistency
-// 5. Processes valid signatures through the signature processor
+// 5. Processes valid signatures through the signature processor, which verifies them in batches
 // 6. Emits signature received signals for downstream components
 // 7. Tracks comprehensive statistics for all outcomes
 //
@@ -41,10 +48,14 @@ func (s *Syncer) ProcessReceivedSignatures(ctx context.Context, response entity.
 	)
 	defer span.End()
 
-	var stats entity.SignatureProcessingStats
+	var (
+		stats      entity.SignatureProcessingStats
+		signatures []symbiotic.Signature
+		received   []receivedSignature
+	)
 
-	for requestID, signatures := range response.Signatures {
-		for _, validatorSig := range signatures {
+	for requestID, validatorSigs := range response.Signatures {
+		for _, validatorSig := range validatorSigs {
 			// Validate that we actually requested this validator's signature
 			requestedBitmap, exists := wantSignatures[requestID]
 			if !exists {
@@ -70,28 +81,38 @@ func (s *Syncer) ProcessReceivedSignatures(ctx context.Context, response entity.
 				continue
 			}
 
-			if err := s.cfg.EntityProcessor.ProcessSignature(ctx, validatorSig.Signature, false); err != nil {
-				if errors.Is(err, entity.ErrEntityAlreadyExist) {
-					slog.DebugContext(ctx, "Signature already exists",
-						"requestId", requestID.Hex(),
-						"validatorIndex", validatorSig.ValidatorIndex)
-					stats.AlreadyExistCount++
-				} else {
-					slog.WarnContext(ctx, "Failed to process received signature",
-						"requestId", requestID.Hex(),
-						"validatorIndex", validatorSig.ValidatorIndex,
-						"error", err)
-					stats.ProcessingFailCount++
-				}
-				continue
-			}
+			signatures = append(signatures, validatorSig.Signature)
+			received = append(received, receivedSignature{
+				requestID:      requestID,
+				validatorIndex: validatorSig.ValidatorIndex,
+				epoch:          sigReq.RequiredEpoch,
+			})
+		}
+	}
 
-			slog.DebugContext(ctx, "Processed received signature",
-				"requestId", requestID.Hex(),
-				"epoch", uint64(sigReq.RequiredEpoch),
-			)
-			stats.ProcessedCount++
+	for i, err := range s.cfg.EntityProcessor.ProcessSignatures(ctx, signatures) {
+		sig := received[i]
+		if err != nil {
+			if errors.Is(err, entity.ErrEntityAlreadyExist) {
+				slog.DebugContext(ctx, "Signature already exists",
+					"requestId", sig.requestID.Hex(),
+					"validatorIndex", sig.validatorIndex)
+				stats.AlreadyExistCount++
+			} else {
+				slog.WarnContext(ctx, "Failed to process received signature",
+					"requestId", sig.requestID.Hex(),
+					"validatorIndex", sig.validatorIndex,
+					"error", err)
+				stats.ProcessingFailCount++
+			}
+			continue
 		}
+
+		slog.DebugContext(ctx, "Processed received signature",
+			"requestId", sig.requestID.Hex(),
+			"epoch", uint64(sig.epoch),
+		)
+		stats.ProcessedCount++
 	}
 
 	tracing.SetAttributes(span,
@@ -176,6 +176,81 @@ func TestAskSignatures_HandleWantSignaturesRequest_Integration(t *testing.T) {
 	}
 }
 
+func TestBuildWantRequests_DropsInactiveRequests(t *testing.T) {
+	for name, newRepo := range backends() {
+		t.Run(name, func(t *testing.T) {
+			repo := newRepo(t)
+			privateKey := newPrivateKey(t)
+			validatorSet := createTestValidatorSet(t, privateKey, newPrivateKey(t))
+			require.NoError(t, repo.SaveNextValsetData(t.Context(), entity.NextValsetData{
+				NextValidatorSet:  validatorSet,
+				NextNetworkConfig: randomNetworkConfig(),
+				PrevValidatorSet:  validatorSet,
+				PrevNetworkConfig: randomNetworkConfig(),
+			}))
+
+			processor, err := entity_processor.NewEntityProcessor(entity_processor.Config{
+				Repo:                     repo,
+				Aggregator:               createMockAggregator(t),
+				AggProofSignal:           createMockAggProofSignal(t),
+				SignatureProcessedSignal: createMockSignatureProcessedSignal(t),
+				Metrics:                  doNothingMetrics{},
+			})
+			require.NoError(t, err)
+
+			// every request gets a signature of the first validator, so the second one is missing
+			saveSignedRequest := func(req symbiotic.SignatureRequest) common.Hash {
+				signature, hash, err := privateKey.Sign(req.Message)
+				require.NoError(t, err)
+				sig := symbiotic.Signature{
+					MessageHash: hash,
+					Signature:   signature,
+					PublicKey:   privateKey.PublicKey(),
+					Epoch:       req.RequiredEpoch,
+					KeyTag:      req.KeyTag,
+				}
+				require.NoError(t, repo.SaveSignatureRequest(t.Context(), sig.RequestID(), req))
+				require.NoError(t, processor.ProcessSignature(t.Context(), sig, false))
+				return sig.RequestID()
+			}
+
+			active := saveSignedRequest(createTestSignatureRequest(t))
+			cancelled := createTestSignatureRequest(t)
+			cancelled.Cancelled = true
+			saveSignedRequest(cancelled)
+			expired := createTestSignatureRequest(t)
+			expired.Deadline = time.Now().Add(-time.Second)
+			saveSignedRequest(expired)
+
+			syncer, err := New(Config{
+				Repo:                        repo,
+				EntityProcessor:             processor,
+				EpochsToSync:                1,
+				MaxSignatureRequestsPerSync: 100,
+				MaxResponseSignatureCount:   100,
+				MaxAggProofRequestsPerSync:  100,
+				MaxResponseAggProofCount:    100,
+			})
+			require.NoError(t, err)
+
+			wantSignatures, err := syncer.BuildWantSignaturesRequest(t.Context())
+			require.NoError(t, err)
+			require.Len(t, wantSignatures.WantSignatures, 1)
+			require.Contains(t, wantSignatures.WantSignatures, active)
+
+			wantProofs, err := syncer.BuildWantAggregationProofsRequest(t.Context())
+			require.NoError(t, err)
+			require.Equal(t, []common.Hash{active}, wantProofs.RequestIDs)
+
+			// inactive requests are removed from the pending collection
+			pending, err := repo.GetSignatureRequestsWithoutAggregationProof(t.Context(), validatorSet.Epoch, 0, common.Hash{})
+			require.NoError(t, err)
+			require.Len(t, pending, 1)
+			require.Equal(t, active, pending[0].RequestID)
+		})
+	}
+}
+
 func createMockAggregator(t *testing.T) *mocks.MockAggregator {
 	t.Helper()
 	ctrl := gomock.NewController(t)
@@ -206,3 +206,37 @@ func (s *Runner) runAggregationProofSync(ctx context.Context) error {
 
 	return nil
 }
+
+// SyncAggregationProofs requests specific aggregation proofs from peers and stores the verified ones.
+// It works regardless of Enabled since it is used on demand, e.g. when the committer needs proofs that were pruned locally.
+func (s *Runner) SyncAggregationProofs(ctx context.Context, requestIDs []common.Hash) (entity.AggregationProofProcessingStats, error) {
+	ctx, span := tracing.StartSpan(ctx, "sync_runner.SyncAggregationProofsByRequestIDs",
+		attribute.Int("request.request_ids_count", len(requestIDs)),
+	)
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.SyncTimeout)
+	defer cancel()
+
+	s.cfg.Metrics.ObserveP2PSyncRequestedAggregationProofs(len(requestIDs))
+
+	response, err := s.cfg.P2PService.SendWantAggregationProofsRequest(ctx, entity.WantAggregationProofsRequest{RequestIDs: requestIDs})
+	if err != nil {
+		tracing.RecordError(span, err)
+		return entity.AggregationProofProcessingStats{}, errors.Errorf("failed to send want aggregation proofs request: %w", err)
+	}
+
+	stats, err := s.cfg.Provider.ProcessReceivedAggregationProofs(ctx, response)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return entity.AggregationProofProcessingStats{}, errors.Errorf("failed to process received aggregation proofs: %w", err)
+	}
+
+	tracing.SetAttributes(span, attribute.Int("processed_count", stats.ProcessedCount))
+	s.cfg.Metrics.ObserveP2PSyncAggregationProofsProcessed("processed", stats.ProcessedCount)
+	s.cfg.Metrics.ObserveP2PSyncAggregationProofsProcessed("verification_fails", stats.VerificationFailCount)
+	s.cfg.Metrics.ObserveP2PSyncAggregationProofsProcessed("processing_fails", stats.ProcessingFailCount)
+	s.cfg.Metrics.ObserveP2PSyncAggregationProofsProcessed("already_exist", stats.AlreadyExistCount)
+
+	return stats, nil
+}
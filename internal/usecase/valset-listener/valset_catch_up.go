@@ -0,0 +1,166 @@
+package valset_listener
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+type aggregationProofSyncer interface {
+	SyncAggregationProofs(ctx context.Context, requestIDs []common.Hash) (entity.AggregationProofProcessingStats, error)
+}
+
+// catchUpCommit is a header missed by a lagging settlement together with the data to commit it.
+type catchUpCommit struct {
+	header    symbiotic.ValidatorSetHeader
+	extraData []symbiotic.ExtraData
+	proof     []byte
+}
+
+// catchUpSettlement replays headers missed by the settlement in order, starting right after its last committed epoch
+// and stopping before the target epoch or after CatchUpMaxEpochs headers. Returns the last epoch committed to the settlement.
+func (s *Service) catchUpSettlement(ctx context.Context, settlement symbiotic.CrossChainAddress, lastCommittedEpoch, targetEpoch symbiotic.Epoch) (symbiotic.Epoch, error) {
+	if s.cfg.CatchUpMaxEpochs == 0 {
+		return lastCommittedEpoch, nil
+	}
+
+	from := lastCommittedEpoch + 1
+	to := min(targetEpoch-1, lastCommittedEpoch+symbiotic.Epoch(s.cfg.CatchUpMaxEpochs))
+
+	slog.InfoContext(ctx, "Settlement is behind, replaying missed headers",
+		"settlement", settlement,
+		"lastCommittedEpoch", lastCommittedEpoch,
+		"targetEpoch", targetEpoch,
+		"catchUpTo", to,
+	)
+
+	commits, err := s.loadCatchUpCommits(ctx, from, to)
+	if err != nil && len(commits) == 0 {
+		return lastCommittedEpoch, err
+	}
+	if err != nil {
+		// commit what is available, the rest is retried on the next tick
+		slog.WarnContext(ctx, "Failed to load all missed headers, replaying available ones", "settlement", settlement, "error", err)
+	}
+
+	for _, commit := range commits {
+		if intent, ok := s.commitIntents.activeIntent(commit.header.Epoch, settlement, time.Now()); ok {
+			slog.InfoContext(ctx, "Stopped catch-up, another committer is already committing to settlement",
+				"settlement", settlement,
+				"epoch", commit.header.Epoch,
+				"pendingTxHash", intent.txHash,
+			)
+			return lastCommittedEpoch, nil
+		}
+
+		// missed headers are never deferred, the settlement can't accept newer headers before them
+		if err := s.sendValsetCommit(ctx, settlement, commit.header, commit.extraData, commit.proof, time.Time{}); err != nil {
+			return lastCommittedEpoch, err
+		}
+		lastCommittedEpoch = commit.header.Epoch
+	}
+
+	return lastCommittedEpoch, nil
+}
+
+// loadCatchUpCommits loads headers and proofs for epochs in [from, to]. Proofs missing locally are requested from peers.
+// The result is a gapless prefix of the range, an error is returned along with it if the range could not be loaded completely.
+func (s *Service) loadCatchUpCommits(ctx context.Context, from, to symbiotic.Epoch) ([]catchUpCommit, error) {
+	var (
+		commits    []catchUpCommit
+		requestIDs []common.Hash
+		missing    []common.Hash
+		loadErr    error
+	)
+
+	for epoch := from; epoch <= to; epoch++ {
+		commit, requestID, err := s.loadCatchUpCommit(ctx, epoch)
+		if err != nil {
+			loadErr = err
+			break
+		}
+		if commit.proof == nil {
+			missing = append(missing, requestID)
+		}
+		commits = append(commits, commit)
+		requestIDs = append(requestIDs, requestID)
+	}
+
+	if len(missing) > 0 {
+		if err := s.syncMissingProofs(ctx, missing); err != nil {
+			return truncateAtMissingProof(commits), err
+		}
+
+		for i := range commits {
+			if commits[i].proof != nil {
+				continue
+			}
+			proof, err := s.cfg.Repo.GetAggregationProof(ctx, requestIDs[i])
+			if err != nil {
+				return truncateAtMissingProof(commits), errors.Errorf("aggregation proof for epoch %d is not available: %w", commits[i].header.Epoch, err)
+			}
+			commits[i].proof = proof.Proof
+		}
+	}
+
+	return commits, loadErr
+}
+
+// loadCatchUpCommit loads the header of the epoch and its proof, the proof is left empty if it is not stored locally.
+func (s *Service) loadCatchUpCommit(ctx context.Context, epoch symbiotic.Epoch) (catchUpCommit, common.Hash, error) {
+	metadata, err := s.cfg.Repo.GetValidatorSetMetadata(ctx, epoch)
+	if err != nil {
+		return catchUpCommit{}, common.Hash{}, errors.Errorf("failed to get validator set metadata for epoch %d: %w", epoch, err)
+	}
+
+	valset, err := s.cfg.Repo.GetValidatorSetByEpoch(ctx, epoch)
+	if err != nil {
+		return catchUpCommit{}, common.Hash{}, errors.Errorf("failed to get validator set for epoch %d: %w", epoch, err)
+	}
+
+	header, err := valset.GetHeader()
+	if err != nil {
+		return catchUpCommit{}, common.Hash{}, errors.Errorf("failed to get validator set header for epoch %d: %w", epoch, err)
+	}
+
+	commit := catchUpCommit{header: header, extraData: metadata.ExtraData}
+	proof, err := s.cfg.Repo.GetAggregationProof(ctx, metadata.RequestID)
+	if err != nil && !errors.Is(err, entity.ErrEntityNotFound) {
+		return catchUpCommit{}, common.Hash{}, errors.Errorf("failed to get aggregation proof for epoch %d: %w", epoch, err)
+	}
+	if err == nil {
+		commit.proof = proof.Proof
+	}
+
+	return commit, metadata.RequestID, nil
+}
+
+// syncMissingProofs requests pruned or never received proofs from peers.
+func (s *Service) syncMissingProofs(ctx context.Context, requestIDs []common.Hash) error {
+	if s.proofSyncer == nil {
+		return errors.Errorf("%d aggregation proofs are missing and proof sync is not available", len(requestIDs))
+	}
+
+	stats, err := s.proofSyncer.SyncAggregationProofs(ctx, requestIDs)
+	if err != nil {
+		return errors.Errorf("failed to sync missing aggregation proofs: %w", err)
+	}
+	slog.InfoContext(ctx, "Synced missing aggregation proofs for catch-up", "requested", len(requestIDs), "processed", stats.ProcessedCount)
+	return nil
+}
+
+// truncateAtMissingProof drops commits starting from the first one without a proof since headers must be committed in order.
+func truncateAtMissingProof(commits []catchUpCommit) []catchUpCommit {
+	for i, commit := range commits {
+		if commit.proof == nil {
+			return commits[:i]
+		}
+	}
+	return commits
+}
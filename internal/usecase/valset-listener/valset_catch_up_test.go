@@ -0,0 +1,159 @@
+package valset_listener
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+type catchUpRepo struct {
+	*commitStateRepo
+	proofs map[common.Hash]symbiotic.AggregationProof
+}
+
+func catchUpRequestID(epoch symbiotic.Epoch) common.Hash {
+	return common.BigToHash(big.NewInt(int64(epoch) + 1000))
+}
+
+func (r *catchUpRepo) GetValidatorSetMetadata(_ context.Context, epoch symbiotic.Epoch) (symbiotic.ValidatorSetMetadata, error) {
+	return symbiotic.ValidatorSetMetadata{Epoch: epoch, RequestID: catchUpRequestID(epoch)}, nil
+}
+
+func (r *catchUpRepo) GetValidatorSetByEpoch(_ context.Context, epoch symbiotic.Epoch) (symbiotic.ValidatorSet, error) {
+	return symbiotic.ValidatorSet{
+		Version:         1,
+		RequiredKeyTag:  testKeyTag,
+		Epoch:           epoch,
+		QuorumThreshold: symbiotic.ToVotingPower(big.NewInt(100)),
+		Validators: []symbiotic.Validator{{
+			Operator:    common.HexToAddress("0x01"),
+			VotingPower: symbiotic.ToVotingPower(big.NewInt(100)),
+			IsActive:    true,
+			Keys:        []symbiotic.ValidatorKey{{Tag: testKeyTag, Payload: symbiotic.CompactPublicKey("key")}},
+		}},
+	}, nil
+}
+
+func (r *catchUpRepo) GetAggregationProof(_ context.Context, requestID common.Hash) (symbiotic.AggregationProof, error) {
+	proof, ok := r.proofs[requestID]
+	if !ok {
+		return symbiotic.AggregationProof{}, entity.ErrEntityNotFound
+	}
+	return proof, nil
+}
+
+type stubProofSyncer struct {
+	repo      *catchUpRepo
+	available map[common.Hash]symbiotic.AggregationProof
+	requested []common.Hash
+}
+
+func (s *stubProofSyncer) SyncAggregationProofs(_ context.Context, requestIDs []common.Hash) (entity.AggregationProofProcessingStats, error) {
+	s.requested = append(s.requested, requestIDs...)
+	stats := entity.AggregationProofProcessingStats{}
+	for _, requestID := range requestIDs {
+		if proof, ok := s.available[requestID]; ok {
+			s.repo.proofs[requestID] = proof
+			stats.ProcessedCount++
+		}
+	}
+	return stats, nil
+}
+
+type recordingCommitClient struct {
+	evmClient
+	committed []symbiotic.Epoch
+}
+
+func (c *recordingCommitClient) CommitValsetHeader(_ context.Context, _ symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, _ []symbiotic.ExtraData, _ []byte, _ ...symbiotic.EVMOption) (symbiotic.TxResult, error) {
+	c.committed = append(c.committed, header.Epoch)
+	return symbiotic.TxResult{}, nil
+}
+
+func newCatchUpTestService(maxEpochs uint64, storedProofs ...symbiotic.Epoch) (*Service, *catchUpRepo, *recordingCommitClient) {
+	repo := &catchUpRepo{
+		commitStateRepo: &commitStateRepo{states: make(map[symbiotic.CrossChainAddress]symbiotic.SettlementCommitState)},
+		proofs:          make(map[common.Hash]symbiotic.AggregationProof),
+	}
+	for _, epoch := range storedProofs {
+		repo.proofs[catchUpRequestID(epoch)] = symbiotic.AggregationProof{Proof: []byte{byte(epoch)}}
+	}
+	client := &recordingCommitClient{}
+	return &Service{
+		cfg:           Config{Repo: repo, EvmClient: client, CatchUpMaxEpochs: maxEpochs},
+		commitIntents: newCommitIntentTracker(),
+	}, repo, client
+}
+
+func TestCatchUpSettlement(t *testing.T) {
+	t.Parallel()
+
+	settlement := symbiotic.CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x01")}
+
+	t.Run("replays missed headers in order", func(t *testing.T) {
+		s, _, client := newCatchUpTestService(10, 6, 7, 8, 9)
+
+		last, err := s.catchUpSettlement(t.Context(), settlement, 5, 10)
+		require.NoError(t, err)
+		require.Equal(t, symbiotic.Epoch(9), last)
+		require.Equal(t, []symbiotic.Epoch{6, 7, 8, 9}, client.committed)
+	})
+
+	t.Run("bounded by max epochs", func(t *testing.T) {
+		s, _, client := newCatchUpTestService(2, 6, 7, 8, 9)
+
+		last, err := s.catchUpSettlement(t.Context(), settlement, 5, 10)
+		require.NoError(t, err)
+		require.Equal(t, symbiotic.Epoch(7), last)
+		require.Equal(t, []symbiotic.Epoch{6, 7}, client.committed)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		s, _, client := newCatchUpTestService(0, 6, 7, 8, 9)
+
+		last, err := s.catchUpSettlement(t.Context(), settlement, 5, 10)
+		require.NoError(t, err)
+		require.Equal(t, symbiotic.Epoch(5), last)
+		require.Empty(t, client.committed)
+	})
+
+	t.Run("requests pruned proofs from peers", func(t *testing.T) {
+		s, repo, client := newCatchUpTestService(10, 8, 9)
+		syncer := &stubProofSyncer{repo: repo, available: map[common.Hash]symbiotic.AggregationProof{
+			catchUpRequestID(6): {Proof: []byte{6}},
+			catchUpRequestID(7): {Proof: []byte{7}},
+		}}
+		s.proofSyncer = syncer
+
+		last, err := s.catchUpSettlement(t.Context(), settlement, 5, 10)
+		require.NoError(t, err)
+		require.Equal(t, symbiotic.Epoch(9), last)
+		require.Equal(t, []common.Hash{catchUpRequestID(6), catchUpRequestID(7)}, syncer.requested)
+		require.Equal(t, []symbiotic.Epoch{6, 7, 8, 9}, client.committed)
+	})
+
+	t.Run("stops at first proof unavailable from peers", func(t *testing.T) {
+		s, repo, client := newCatchUpTestService(10, 6, 8, 9)
+		s.proofSyncer = &stubProofSyncer{repo: repo}
+
+		last, err := s.catchUpSettlement(t.Context(), settlement, 5, 10)
+		require.NoError(t, err)
+		require.Equal(t, symbiotic.Epoch(6), last)
+		require.Equal(t, []symbiotic.Epoch{6}, client.committed)
+	})
+
+	t.Run("fails without any proof available", func(t *testing.T) {
+		s, _, client := newCatchUpTestService(10)
+
+		last, err := s.catchUpSettlement(t.Context(), settlement, 5, 10)
+		require.ErrorContains(t, err, "proof sync is not available")
+		require.Equal(t, symbiotic.Epoch(5), last)
+		require.Empty(t, client.committed)
+	})
+}
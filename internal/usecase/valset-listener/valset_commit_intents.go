@@ -0,0 +1,213 @@
+package valset_listener
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	"github.com/symbioticfi/relay/pkg/log"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+const (
+	// commitIntentTTL is how long an intent without a transaction hash suppresses commits by other committers
+	commitIntentTTL = time.Minute
+	// pendingCommitTxTTL is how long a known commit transaction hash suppresses commits by other committers,
+	// after that the transaction is assumed to be dropped
+	pendingCommitTxTTL = 5 * time.Minute
+)
+
+type commitIntentBroadcaster interface {
+	BroadcastCommitIntentMessage(ctx context.Context, msg entity.CommitIntent) error
+}
+
+type commitIntentKey struct {
+	epoch      symbiotic.Epoch
+	settlement symbiotic.CrossChainAddress
+}
+
+type observedCommitIntent struct {
+	publicKey  symbiotic.RawPublicKey
+	txHash     common.Hash
+	observedAt time.Time
+}
+
+// commitIntentTracker keeps the latest commit intent gossiped by other committers per epoch and settlement
+// and the time the local node first saw each pending proof.
+type commitIntentTracker struct {
+	mutex        sync.Mutex
+	intents      map[commitIntentKey]observedCommitIntent
+	firstPending map[symbiotic.Epoch]time.Time
+}
+
+func newCommitIntentTracker() *commitIntentTracker {
+	return &commitIntentTracker{
+		intents:      make(map[commitIntentKey]observedCommitIntent),
+		firstPending: make(map[symbiotic.Epoch]time.Time),
+	}
+}
+
+// observe records an intent received at the given time.
+// A known transaction hash is not overwritten by a later intent without one.
+func (t *commitIntentTracker) observe(intent entity.CommitIntent, now time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := commitIntentKey{epoch: intent.Epoch, settlement: intent.Settlement}
+	observed := observedCommitIntent{publicKey: intent.PublicKey, txHash: intent.TxHash, observedAt: now}
+	if prev, ok := t.intents[key]; ok && observed.txHash == (common.Hash{}) && prev.txHash != (common.Hash{}) &&
+		bytes.Equal(prev.publicKey, observed.publicKey) {
+		observed.txHash = prev.txHash
+	}
+	t.intents[key] = observed
+}
+
+// activeIntent returns a non-expired intent of another committer for the epoch and settlement.
+func (t *commitIntentTracker) activeIntent(epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress, now time.Time) (observedCommitIntent, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	intent, ok := t.intents[commitIntentKey{epoch: epoch, settlement: settlement}]
+	if !ok {
+		return observedCommitIntent{}, false
+	}
+	ttl := commitIntentTTL
+	if intent.txHash != (common.Hash{}) {
+		ttl = pendingCommitTxTTL
+	}
+	if now.Sub(intent.observedAt) >= ttl {
+		return observedCommitIntent{}, false
+	}
+	return intent, true
+}
+
+// takeoverReference returns the moment since which the committer of the current slot is considered silent for the epoch
+// and settlement: the latest of the slot start, the last intent observed for them and the time the proof was first seen
+// pending locally.
+func (t *commitIntentTracker) takeoverReference(epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress, slotStart, now time.Time) time.Time {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	firstPending, ok := t.firstPending[epoch]
+	if !ok {
+		firstPending = now
+		t.firstPending[epoch] = now
+	}
+
+	reference := slotStart
+	lastActivity := t.intents[commitIntentKey{epoch: epoch, settlement: settlement}].observedAt
+	for _, ts := range []time.Time{lastActivity, firstPending} {
+		if ts.After(reference) {
+			reference = ts
+		}
+	}
+	return reference
+}
+
+// prune drops state of epochs that are already committed.
+func (t *commitIntentTracker) prune(lastCommittedEpoch symbiotic.Epoch) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for key := range t.intents {
+		if key.epoch <= lastCommittedEpoch {
+			delete(t.intents, key)
+		}
+	}
+	for epoch := range t.firstPending {
+		if epoch <= lastCommittedEpoch {
+			delete(t.firstPending, epoch)
+		}
+	}
+}
+
+// HandleCommitIntentMessage records a commit intent gossiped by another committer.
+// Intents are only accepted if they are signed by a committer of the epoch and are not older than commitIntentTTL.
+func (s *Service) HandleCommitIntentMessage(ctx context.Context, msg entity.P2PMessage[entity.CommitIntent]) error {
+	intent := msg.Message
+	ctx = log.WithAttrs(ctx,
+		slog.Uint64("epoch", uint64(intent.Epoch)),
+		slog.String("sender", msg.SenderInfo.Sender),
+	)
+
+	now := time.Now()
+	sentAt := time.Unix(int64(intent.Timestamp), 0)
+	if now.Sub(sentAt) > commitIntentTTL || sentAt.Sub(now) > commitIntentTTL {
+		slog.DebugContext(ctx, "Ignored stale commit intent", "sentAt", sentAt)
+		return nil
+	}
+
+	valset, err := s.cfg.Repo.GetValidatorSetByEpoch(ctx, intent.Epoch)
+	if err != nil {
+		return errors.Errorf("failed to get validator set for commit intent epoch %d: %w", intent.Epoch, err)
+	}
+
+	publicKey, err := crypto.NewPublicKey(valset.RequiredKeyTag.Type(), intent.PublicKey)
+	if err != nil {
+		return errors.Errorf("failed to parse commit intent public key: %w", err)
+	}
+	if err := publicKey.Verify(intent.SigningMessage(), intent.Signature); err != nil {
+		return errors.Errorf("invalid commit intent signature: %w", err)
+	}
+	if !valset.IsCommitter(publicKey.OnChain()) {
+		return errors.Errorf("commit intent is not signed by a committer of epoch %d", intent.Epoch)
+	}
+
+	if s.cfg.KeyProvider != nil {
+		ownKey, err := s.cfg.KeyProvider.GetOnchainKeyForValset(valset, valset.RequiredKeyTag)
+		if err == nil && bytes.Equal(ownKey, publicKey.OnChain()) {
+			return nil
+		}
+	}
+
+	s.commitIntents.observe(intent, now)
+	slog.DebugContext(ctx, "Observed commit intent",
+		"settlement", intent.Settlement,
+		"txHash", intent.TxHash,
+	)
+	return nil
+}
+
+// broadcastCommitIntent signs and gossips a commit intent, failures are only logged
+// since intents are advisory and commits proceed without them.
+func (s *Service) broadcastCommitIntent(ctx context.Context, keyTag symbiotic.KeyTag, epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress, txHash common.Hash) {
+	if s.intentBroadcaster == nil || s.cfg.KeyProvider == nil {
+		return
+	}
+
+	valset, err := s.cfg.Repo.GetValidatorSetByEpoch(ctx, epoch)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get validator set to sign commit intent", "error", err)
+		return
+	}
+
+	privateKey, err := s.cfg.KeyProvider.GetPrivateKeyForValset(valset, keyTag)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get key to sign commit intent", "error", err)
+		return
+	}
+
+	intent := entity.CommitIntent{
+		Epoch:      epoch,
+		Settlement: settlement,
+		TxHash:     txHash,
+		Timestamp:  symbiotic.Timestamp(uint64(time.Now().Unix())),
+		PublicKey:  privateKey.PublicKey().Raw(),
+	}
+	intent.Signature, _, err = privateKey.Sign(intent.SigningMessage())
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to sign commit intent", "error", err)
+		return
+	}
+
+	if err := s.intentBroadcaster.BroadcastCommitIntentMessage(ctx, intent); err != nil {
+		slog.WarnContext(ctx, "Failed to broadcast commit intent", "error", err, "settlement", settlement, "txHash", txHash)
+	}
+}
@@ -0,0 +1,234 @@
+package valset_listener
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+const testKeyTag = symbiotic.KeyTag(15)
+
+type stubRepo struct {
+	repo
+	valset symbiotic.ValidatorSet
+}
+
+func (r stubRepo) GetValidatorSetByEpoch(_ context.Context, _ symbiotic.Epoch) (symbiotic.ValidatorSet, error) {
+	return r.valset, nil
+}
+
+type stubKeyProvider struct {
+	key crypto.PrivateKey
+}
+
+func (p stubKeyProvider) GetPrivateKeyForValset(_ symbiotic.ValidatorSet, _ symbiotic.KeyTag) (crypto.PrivateKey, error) {
+	return p.key, nil
+}
+
+func (p stubKeyProvider) GetOnchainKeyForValset(_ symbiotic.ValidatorSet, _ symbiotic.KeyTag) (symbiotic.CompactPublicKey, error) {
+	return p.key.PublicKey().OnChain(), nil
+}
+
+type recordingBroadcaster struct {
+	intents []entity.CommitIntent
+}
+
+func (b *recordingBroadcaster) BroadcastCommitIntentMessage(_ context.Context, msg entity.CommitIntent) error {
+	b.intents = append(b.intents, msg)
+	return nil
+}
+
+func generateTestKey(t *testing.T) crypto.PrivateKey {
+	t.Helper()
+	key, err := crypto.GeneratePrivateKey(testKeyTag.Type())
+	require.NoError(t, err)
+	return key
+}
+
+func newCommitIntentTestService(t *testing.T, own crypto.PrivateKey, committers ...crypto.PrivateKey) *Service {
+	t.Helper()
+
+	valset := symbiotic.ValidatorSet{RequiredKeyTag: testKeyTag, Epoch: 10}
+	for i, key := range append([]crypto.PrivateKey{own}, committers...) {
+		valset.Validators = append(valset.Validators, symbiotic.Validator{
+			Operator:    common.BigToAddress(big.NewInt(int64(i + 1))),
+			VotingPower: symbiotic.ToVotingPower(big.NewInt(100)),
+			IsActive:    true,
+			Keys:        []symbiotic.ValidatorKey{{Tag: testKeyTag, Payload: key.PublicKey().OnChain()}},
+		})
+		valset.CommitterIndices = append(valset.CommitterIndices, uint32(i))
+	}
+
+	return &Service{
+		cfg: Config{
+			Repo:                     stubRepo{valset: valset},
+			KeyProvider:              stubKeyProvider{key: own},
+			CommitterTakeoverTimeout: 30 * time.Second,
+		},
+		commitIntents: newCommitIntentTracker(),
+	}
+}
+
+func signedIntent(t *testing.T, key crypto.PrivateKey, txHash common.Hash) entity.CommitIntent {
+	t.Helper()
+	intent := entity.CommitIntent{
+		Epoch:      10,
+		Settlement: symbiotic.CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x01")},
+		TxHash:     txHash,
+		Timestamp:  symbiotic.Timestamp(uint64(time.Now().Unix())),
+		PublicKey:  key.PublicKey().Raw(),
+	}
+	var err error
+	intent.Signature, _, err = key.Sign(intent.SigningMessage())
+	require.NoError(t, err)
+	return intent
+}
+
+func TestHandleCommitIntentMessage(t *testing.T) {
+	t.Parallel()
+
+	own := generateTestKey(t)
+	other := generateTestKey(t)
+	outsider := generateTestKey(t)
+	settlement := symbiotic.CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x01")}
+
+	t.Run("records intent of another committer", func(t *testing.T) {
+		s := newCommitIntentTestService(t, own, other)
+		txHash := common.HexToHash("0xaa")
+
+		err := s.HandleCommitIntentMessage(t.Context(), entity.P2PMessage[entity.CommitIntent]{Message: signedIntent(t, other, txHash)})
+		require.NoError(t, err)
+
+		intent, ok := s.commitIntents.activeIntent(10, settlement, time.Now())
+		require.True(t, ok)
+		require.Equal(t, txHash, intent.txHash)
+	})
+
+	t.Run("ignores own intents", func(t *testing.T) {
+		s := newCommitIntentTestService(t, own, other)
+
+		err := s.HandleCommitIntentMessage(t.Context(), entity.P2PMessage[entity.CommitIntent]{Message: signedIntent(t, own, common.Hash{})})
+		require.NoError(t, err)
+
+		_, ok := s.commitIntents.activeIntent(10, settlement, time.Now())
+		require.False(t, ok)
+	})
+
+	t.Run("rejects intent of non-committer", func(t *testing.T) {
+		s := newCommitIntentTestService(t, own, other)
+
+		err := s.HandleCommitIntentMessage(t.Context(), entity.P2PMessage[entity.CommitIntent]{Message: signedIntent(t, outsider, common.Hash{})})
+		require.ErrorContains(t, err, "not signed by a committer")
+	})
+
+	t.Run("rejects tampered intent", func(t *testing.T) {
+		s := newCommitIntentTestService(t, own, other)
+		intent := signedIntent(t, other, common.Hash{})
+		intent.TxHash = common.HexToHash("0xbb")
+
+		err := s.HandleCommitIntentMessage(t.Context(), entity.P2PMessage[entity.CommitIntent]{Message: intent})
+		require.ErrorContains(t, err, "invalid commit intent signature")
+	})
+
+	t.Run("ignores stale intent", func(t *testing.T) {
+		s := newCommitIntentTestService(t, own, other)
+		intent := signedIntent(t, other, common.Hash{})
+		intent.Timestamp -= symbiotic.Timestamp(uint64((2 * commitIntentTTL).Seconds()))
+
+		err := s.HandleCommitIntentMessage(t.Context(), entity.P2PMessage[entity.CommitIntent]{Message: intent})
+		require.NoError(t, err)
+
+		_, ok := s.commitIntents.activeIntent(10, settlement, time.Now())
+		require.False(t, ok)
+	})
+}
+
+func TestCommitIntentTracker_ActiveIntentExpires(t *testing.T) {
+	t.Parallel()
+
+	tracker := newCommitIntentTracker()
+	settlement := symbiotic.CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x01")}
+	now := time.Now()
+
+	tracker.observe(entity.CommitIntent{Epoch: 5, Settlement: settlement, PublicKey: []byte("key")}, now)
+	_, ok := tracker.activeIntent(5, settlement, now.Add(commitIntentTTL-time.Second))
+	require.True(t, ok)
+	_, ok = tracker.activeIntent(5, settlement, now.Add(commitIntentTTL))
+	require.False(t, ok, "intent without tx hash expires after commitIntentTTL")
+
+	txHash := common.HexToHash("0xaa")
+	tracker.observe(entity.CommitIntent{Epoch: 5, Settlement: settlement, PublicKey: []byte("key"), TxHash: txHash}, now)
+	// a repeated intent without tx hash keeps the known pending transaction
+	tracker.observe(entity.CommitIntent{Epoch: 5, Settlement: settlement, PublicKey: []byte("key")}, now)
+	intent, ok := tracker.activeIntent(5, settlement, now.Add(pendingCommitTxTTL-time.Second))
+	require.True(t, ok)
+	require.Equal(t, txHash, intent.txHash)
+
+	tracker.prune(5)
+	_, ok = tracker.activeIntent(5, settlement, now)
+	require.False(t, ok, "intents of committed epochs are pruned")
+}
+
+func TestShouldTakeOver(t *testing.T) {
+	t.Parallel()
+
+	s := &Service{
+		cfg:           Config{CommitterTakeoverTimeout: 30 * time.Second},
+		commitIntents: newCommitIntentTracker(),
+	}
+	now := time.Now()
+	settlement := symbiotic.CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x01")}
+	otherSettlement := symbiotic.CrossChainAddress{ChainId: 2, Address: common.HexToAddress("0x02")}
+
+	// proof seen long ago but the slot has just started
+	s.commitIntents.firstPending[1] = now.Add(-time.Hour)
+	require.False(t, s.shouldTakeOver(t.Context(), 1, settlement, committerTakeover{rank: 1, slotStart: now.Add(-10 * time.Second)}))
+	require.True(t, s.shouldTakeOver(t.Context(), 1, settlement, committerTakeover{rank: 1, slotStart: now.Add(-31 * time.Second)}))
+
+	// further committers wait one more timeout each
+	require.False(t, s.shouldTakeOver(t.Context(), 1, settlement, committerTakeover{rank: 2, slotStart: now.Add(-31 * time.Second)}))
+	require.True(t, s.shouldTakeOver(t.Context(), 1, settlement, committerTakeover{rank: 2, slotStart: now.Add(-61 * time.Second)}))
+
+	// a proof seen for the first time starts the silence period
+	require.False(t, s.shouldTakeOver(t.Context(), 2, settlement, committerTakeover{rank: 1, slotStart: now.Add(-time.Hour)}))
+
+	// an observed intent resets the silence period of its epoch and settlement only
+	s.commitIntents.observe(entity.CommitIntent{Epoch: 1, Settlement: settlement}, time.Now())
+	require.False(t, s.shouldTakeOver(t.Context(), 1, settlement, committerTakeover{rank: 1, slotStart: now.Add(-time.Hour)}))
+	require.True(t, s.shouldTakeOver(t.Context(), 1, otherSettlement, committerTakeover{rank: 1, slotStart: now.Add(-time.Hour)}))
+	s.commitIntents.firstPending[3] = now.Add(-time.Hour)
+	require.True(t, s.shouldTakeOver(t.Context(), 3, settlement, committerTakeover{rank: 1, slotStart: now.Add(-time.Hour)}))
+
+	// the activity is pruned with the intents of committed epochs
+	s.commitIntents.prune(1)
+	s.commitIntents.firstPending[1] = now.Add(-time.Hour)
+	require.True(t, s.shouldTakeOver(t.Context(), 1, settlement, committerTakeover{rank: 1, slotStart: now.Add(-time.Hour)}))
+}
+
+func TestBroadcastCommitIntent_SignsIntent(t *testing.T) {
+	t.Parallel()
+
+	own := generateTestKey(t)
+	broadcaster := &recordingBroadcaster{}
+	s := newCommitIntentTestService(t, own)
+	s.intentBroadcaster = broadcaster
+
+	settlement := symbiotic.CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x01")}
+	txHash := common.HexToHash("0xaa")
+	s.broadcastCommitIntent(t.Context(), testKeyTag, 10, settlement, txHash)
+
+	require.Len(t, broadcaster.intents, 1)
+	intent := broadcaster.intents[0]
+	require.Equal(t, symbiotic.Epoch(10), intent.Epoch)
+	require.Equal(t, settlement, intent.Settlement)
+	require.Equal(t, txHash, intent.TxHash)
+	require.NoError(t, own.PublicKey().Verify(intent.SigningMessage(), intent.Signature))
+}
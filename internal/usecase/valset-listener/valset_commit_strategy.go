@@ -0,0 +1,103 @@
+package valset_listener
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// applyCommitStrategy simulates the commit if the policy asks for it and decides how to send it by the strategy
+// of the settlement chain. It returns the options to send the commit with, or send=false if the commit waits for
+// cheaper gas. A commit that is predicted to revert is returned as an error without being sent.
+func (s *Service) applyCommitStrategy(ctx context.Context, settlement symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte, nextEpochStart time.Time) (_ []symbiotic.EVMOption, send bool, _ error) {
+	strategy := s.cfg.CommitPolicy.Strategy(settlement.ChainId)
+	if !s.cfg.CommitPolicy.Simulate && strategy.MaxFeePerGas == nil {
+		return nil, true, nil
+	}
+
+	simulation, err := s.cfg.EvmClient.SimulateCommitValsetHeader(ctx, settlement, header, extraData, proof)
+	if err != nil {
+		return nil, false, errors.Errorf("failed to simulate commit: %w", err)
+	}
+
+	decision := strategy.Decide(simulation.FeePerGas, time.Now(), nextEpochStart)
+	s.cfg.Metrics.ObserveCommitSimulation(settlement.ChainId, simulation.GasEstimate, simulation.Cost())
+	s.cfg.Metrics.ObserveCommitDecision(settlement.ChainId, string(decision))
+	slog.InfoContext(ctx, "Simulated valset header commit",
+		"settlement", settlement,
+		"epoch", header.Epoch,
+		"gasEstimate", simulation.GasEstimate,
+		"feePerGas", simulation.FeePerGas,
+		"cost", simulation.Cost(),
+		"maxFeePerGas", strategy.MaxFeePerGas,
+		"decision", decision,
+	)
+
+	switch decision {
+	case entity.CommitDecisionWait:
+		slog.InfoContext(ctx, "Deferred commit, gas above cap",
+			"settlement", settlement,
+			"epoch", header.Epoch,
+			"sendBy", nextEpochStart.Add(-strategy.WaitForCheaperGas),
+		)
+		return nil, false, nil
+	case entity.CommitDecisionCapped:
+		return []symbiotic.EVMOption{symbiotic.WithMaxFeePerGas(strategy.MaxFeePerGas)}, true, nil
+	default:
+		return nil, true, nil
+	}
+}
+
+// deferredCommitTracker remembers per settlement the earliest epoch whose commit waits for cheaper gas.
+// Later epochs of the settlement are held back meanwhile, otherwise the catch-up of the next epoch would
+// replay the deferred header right away and bypass the wait.
+type deferredCommitTracker struct {
+	mutex  sync.Mutex
+	epochs map[symbiotic.CrossChainAddress]symbiotic.Epoch
+}
+
+// deferCommit records that the commit of the epoch to the settlement waits for cheaper gas.
+func (t *deferredCommitTracker) deferCommit(settlement symbiotic.CrossChainAddress, epoch symbiotic.Epoch) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.epochs == nil {
+		t.epochs = make(map[symbiotic.CrossChainAddress]symbiotic.Epoch)
+	}
+	if deferred, ok := t.epochs[settlement]; !ok || epoch < deferred {
+		t.epochs[settlement] = epoch
+	}
+}
+
+// sent forgets the deferral of the settlement once the epoch or a later one is sent.
+func (t *deferredCommitTracker) sent(settlement symbiotic.CrossChainAddress, epoch symbiotic.Epoch) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if deferred, ok := t.epochs[settlement]; ok && deferred <= epoch {
+		delete(t.epochs, settlement)
+	}
+}
+
+// blockingEpoch returns the deferred epoch that holds back the commit of a later epoch to the settlement.
+// Deferrals of epochs the settlement already committed are forgotten.
+func (t *deferredCommitTracker) blockingEpoch(settlement symbiotic.CrossChainAddress, epoch, lastCommittedEpoch symbiotic.Epoch) (symbiotic.Epoch, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	deferred, ok := t.epochs[settlement]
+	if !ok {
+		return 0, false
+	}
+	if deferred <= lastCommittedEpoch {
+		delete(t.epochs, settlement)
+		return 0, false
+	}
+	return deferred, deferred < epoch
+}
@@ -0,0 +1,128 @@
+package valset_listener
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+type simulatingClient struct {
+	stubSettlementsClient
+	simulation  symbiotic.TxSimulation
+	simulateErr error
+	mutex       sync.Mutex
+	sentOptions []*symbiotic.EVMOptions
+}
+
+func (c *simulatingClient) SimulateCommitValsetHeader(_ context.Context, _ symbiotic.CrossChainAddress, _ symbiotic.ValidatorSetHeader, _ []symbiotic.ExtraData, _ []byte) (symbiotic.TxSimulation, error) {
+	return c.simulation, c.simulateErr
+}
+
+func (c *simulatingClient) CommitValsetHeader(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte, opts ...symbiotic.EVMOption) (symbiotic.TxResult, error) {
+	c.mutex.Lock()
+	c.sentOptions = append(c.sentOptions, symbiotic.AppliedEVMOptions(opts...))
+	c.mutex.Unlock()
+	return c.stubSettlementsClient.CommitValsetHeader(ctx, addr, header, extraData, proof, opts...)
+}
+
+type recordingCommitMetrics struct {
+	metrics
+	mutex     sync.Mutex
+	decisions []string
+}
+
+func (m *recordingCommitMetrics) ObserveCommitDecision(_ uint64, decision string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.decisions = append(m.decisions, decision)
+}
+
+func (m *recordingCommitMetrics) ObserveCommitSimulation(_ uint64, _ uint64, _ *big.Int) {}
+
+func TestCommitValsetToSettlement_CommitStrategy(t *testing.T) {
+	t.Parallel()
+
+	settlement := symbiotic.CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x01")}
+	header := symbiotic.ValidatorSetHeader{Epoch: 10}
+	newService := func(simulation symbiotic.TxSimulation, simulateErr error) (*Service, *commitStateRepo, *simulatingClient, *recordingCommitMetrics) {
+		repo := &commitStateRepo{states: make(map[symbiotic.CrossChainAddress]symbiotic.SettlementCommitState)}
+		client := &simulatingClient{
+			stubSettlementsClient: stubSettlementsClient{settlements: map[symbiotic.CrossChainAddress]settlementStub{
+				settlement: {lastCommittedEpoch: 9, txHash: common.HexToHash("0xaa")},
+			}},
+			simulation:  simulation,
+			simulateErr: simulateErr,
+		}
+		metrics := &recordingCommitMetrics{}
+		return &Service{
+			cfg: Config{
+				Repo:      repo,
+				EvmClient: client,
+				Metrics:   metrics,
+				CommitPolicy: entity.CommitPolicy{Chains: map[uint64]entity.CommitStrategy{
+					1: {MaxFeePerGas: big.NewInt(100), WaitForCheaperGas: time.Minute},
+				}},
+			},
+			commitIntents: newCommitIntentTracker(),
+		}, repo, client, metrics
+	}
+	expensive := symbiotic.TxSimulation{GasEstimate: 300_000, FeePerGas: big.NewInt(200)}
+
+	t.Run("waits for cheaper gas before the deadline", func(t *testing.T) {
+		s, repo, client, metrics := newService(expensive, nil)
+
+		require.NoError(t, s.commitValsetToSettlement(t.Context(), settlement, header, nil, nil, time.Now().Add(time.Hour), nil))
+		require.Empty(t, client.sentOptions)
+		require.Equal(t, symbiotic.SettlementCommitPending, repo.states[settlement].Status)
+		require.Zero(t, repo.states[settlement].Attempts)
+		require.Equal(t, []string{string(entity.CommitDecisionWait)}, metrics.decisions)
+	})
+
+	t.Run("sends capped after the deadline", func(t *testing.T) {
+		s, repo, client, metrics := newService(expensive, nil)
+
+		require.NoError(t, s.commitValsetToSettlement(t.Context(), settlement, header, nil, nil, time.Now().Add(30*time.Second), nil))
+		require.Len(t, client.sentOptions, 1)
+		require.Equal(t, big.NewInt(100), client.sentOptions[0].MaxFeePerGas)
+		require.Equal(t, symbiotic.SettlementCommitConfirmed, repo.states[settlement].Status)
+		require.Equal(t, []string{string(entity.CommitDecisionCapped)}, metrics.decisions)
+	})
+
+	t.Run("sends uncapped below the cap", func(t *testing.T) {
+		s, _, client, metrics := newService(symbiotic.TxSimulation{GasEstimate: 300_000, FeePerGas: big.NewInt(50)}, nil)
+
+		require.NoError(t, s.commitValsetToSettlement(t.Context(), settlement, header, nil, nil, time.Now().Add(time.Hour), nil))
+		require.Len(t, client.sentOptions, 1)
+		require.Nil(t, client.sentOptions[0].MaxFeePerGas)
+		require.Equal(t, []string{string(entity.CommitDecisionSend)}, metrics.decisions)
+	})
+
+	t.Run("later epoch waits while an earlier one is deferred", func(t *testing.T) {
+		s, repo, client, metrics := newService(expensive, nil)
+
+		require.NoError(t, s.commitValsetToSettlement(t.Context(), settlement, header, nil, nil, time.Now().Add(time.Hour), nil))
+		require.NoError(t, s.commitValsetToSettlement(t.Context(), settlement, symbiotic.ValidatorSetHeader{Epoch: 11}, nil, nil, time.Now().Add(2*time.Hour), nil))
+		require.Empty(t, client.sentOptions)
+		require.Equal(t, symbiotic.SettlementCommitPending, repo.states[settlement].Status)
+		require.Equal(t, []string{string(entity.CommitDecisionWait)}, metrics.decisions)
+	})
+
+	t.Run("predicted revert is not sent", func(t *testing.T) {
+		s, repo, client, _ := newService(symbiotic.TxSimulation{}, errors.New("transaction would revert: InvalidEpoch"))
+
+		err := s.commitValsetToSettlement(t.Context(), settlement, header, nil, nil, time.Now().Add(time.Hour), nil)
+		require.ErrorContains(t, err, "InvalidEpoch")
+		require.Empty(t, client.sentOptions)
+		require.Equal(t, symbiotic.SettlementCommitFailed, repo.states[settlement].Status)
+		require.Contains(t, repo.states[settlement].LastError, "InvalidEpoch")
+	})
+}
@@ -3,8 +3,10 @@ package valset_listener
 import (
 	"context"
 	"log/slog"
+	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-errors/errors"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -19,8 +21,21 @@ const (
 	commitCheckBatchSize            = 5
 )
 
-func (s *Service) StartCommitterLoop(ctx context.Context) error {
+// committerTakeover describes a committer that is allowed to commit outside its slot
+// once the committers ahead of it stay silent for long enough.
+type committerTakeover struct {
+	rank      uint64
+	slotStart time.Time
+}
+
+// StartCommitterLoop periodically commits pending proofs to settlements.
+// Commit intents are gossiped through the broadcaster so that other committers can suppress
+// duplicate commits, broadcaster may be nil. The proof syncer is used to fetch proofs that are
+// missing locally when replaying headers to lagging settlements, it may be nil as well.
+func (s *Service) StartCommitterLoop(ctx context.Context, broadcaster commitIntentBroadcaster, proofSyncer aggregationProofSyncer) error {
 	ctx = log.WithComponent(ctx, "valset_committer_loop")
+	s.intentBroadcaster = broadcaster
+	s.proofSyncer = proofSyncer
 	// get the latest epoch and try to find schedule of committers and start committing
 	slog.InfoContext(ctx, "Starting valset committer loop")
 
@@ -83,17 +98,22 @@ func (s *Service) tryToCommitPendingProofs(ctx context.Context) (uint64, error)
 	}
 
 	tickInterval := max(nwCfg.CommitterSlotDuration/2, minCommitterPollIntervalSeconds)
+	if takeoverSeconds := uint64(s.cfg.CommitterTakeoverTimeout.Seconds()); takeoverSeconds > 0 {
+		// poll often enough to take over close to the timeout
+		tickInterval = max(min(tickInterval, takeoverSeconds), minCommitterPollIntervalSeconds)
+	}
 
 	tracing.SetAttributes(span,
 		tracing.AttrValidatorCount.Int(len(valset.Validators)),
 		attribute.Int64("tick_interval", int64(tickInterval)),
 	)
 
+	var takeover *committerTakeover
 	if s.cfg.ForceCommitter {
 		tracing.SetAttributes(span, attribute.Bool("force_committer", true))
 		slog.DebugContext(ctx, "Force committer mode enabled", "epoch", valsetHeader.Epoch)
 	} else {
-		onchainKey, err := s.cfg.KeyProvider.GetOnchainKeyFromCache(valset.RequiredKeyTag)
+		onchainKey, err := s.cfg.KeyProvider.GetOnchainKeyForValset(valset, valset.RequiredKeyTag)
 		if err != nil {
 			if errors.Is(err, entity.ErrKeyNotFound) {
 				tracing.AddEvent(span, "skipped_no_key")
@@ -106,7 +126,12 @@ func (s *Service) tryToCommitPendingProofs(ctx context.Context) (uint64, error)
 
 		now := symbiotic.Timestamp(uint64(time.Now().Unix()))
 
-		if !valset.IsActiveCommitter(ctx, nwCfg.CommitterSlotDuration, now, minCommitterPollIntervalSeconds, onchainKey) {
+		if valset.IsActiveCommitter(ctx, nwCfg.CommitterSlotDuration, now, minCommitterPollIntervalSeconds, onchainKey) {
+			tracing.AddEvent(span, "confirmed_active_committer")
+		} else if takeover = s.takeoverCandidate(valset, nwCfg, now, onchainKey); takeover != nil {
+			tracing.AddEvent(span, "takeover_candidate")
+			tracing.SetAttributes(span, attribute.Int64("takeover_rank", int64(takeover.rank)))
+		} else {
 			tracing.AddEvent(span, "skipped_not_active_committer")
 			slog.DebugContext(ctx, "Skipped proof commitment, not a committer for this validator set",
 				"key", onchainKey,
@@ -118,13 +143,12 @@ func (s *Service) tryToCommitPendingProofs(ctx context.Context) (uint64, error)
 			)
 			return tickInterval, nil
 		}
-
-		tracing.AddEvent(span, "confirmed_active_committer")
 	}
 
 	lastCommittedEpoch := s.detectLastCommittedEpochFromDB(ctx)
 
 	tracing.SetAttributes(span, attribute.Int64("last_committed_epoch", int64(lastCommittedEpoch)))
+	s.commitIntents.prune(lastCommittedEpoch)
 
 	if lastCommittedEpoch >= valset.Epoch {
 		tracing.AddEvent(span, "all_epochs_committed")
@@ -170,7 +194,7 @@ func (s *Service) tryToCommitPendingProofs(ctx context.Context) (uint64, error)
 
 	processedCount := 0
 	for _, proofKey := range pendingProofs {
-		err = s.processPendingProof(ctx, proofKey)
+		err = s.processPendingProof(ctx, proofKey, takeover)
 		if err != nil {
 			tracing.RecordError(span, err)
 			slog.ErrorContext(ctx, "Error processing pending proof",
@@ -188,7 +212,7 @@ func (s *Service) tryToCommitPendingProofs(ctx context.Context) (uint64, error)
 	return tickInterval, nil
 }
 
-func (s *Service) processPendingProof(ctx context.Context, proofKey symbiotic.ProofCommitKey) error {
+func (s *Service) processPendingProof(ctx context.Context, proofKey symbiotic.ProofCommitKey, takeover *committerTakeover) error {
 	ctx, span := tracing.StartSpan(ctx, "valset_listener.ProcessPendingProof",
 		tracing.AttrRequestID.String(proofKey.RequestID.Hex()),
 		tracing.AttrEpoch.Int64(int64(proofKey.Epoch)),
@@ -236,9 +260,9 @@ func (s *Service) processPendingProof(ctx context.Context, proofKey symbiotic.Pr
 		return errors.Errorf("failed to get validator set header: %w", err)
 	}
 
-	pubkey, err := s.cfg.KeyProvider.GetOnchainKeyFromCache(header.RequiredKeyTag)
+	pubkey, err := s.cfg.KeyProvider.GetOnchainKeyForValset(targetValset, header.RequiredKeyTag)
 	if err != nil {
-		return errors.Errorf("failed to get onchain key from cache: %w", err)
+		return errors.Errorf("failed to get onchain key: %w", err)
 	}
 
 	validator, found := targetValset.FindValidatorByKey(header.RequiredKeyTag, pubkey)
@@ -251,7 +275,7 @@ func (s *Service) processPendingProof(ctx context.Context, proofKey symbiotic.Pr
 
 	slog.DebugContext(ctx, "Committing proof to settlements", "header", header, "extraData", extraData)
 
-	ok, err := s.commitValsetToAllSettlements(ctx, config, header, extraData, proof.Proof)
+	ok, err := s.commitValsetToAllSettlements(ctx, config, header, extraData, proof.Proof, takeover)
 	if !ok {
 		_err := errors.Errorf("failed to commit valset to all settlements for epoch %d, error=%w", proofKey.Epoch, err)
 		tracing.RecordError(span, _err)
@@ -264,6 +288,45 @@ func (s *Service) processPendingProof(ctx context.Context, proofKey symbiotic.Pr
 	return nil
 }
 
+// takeoverCandidate returns the takeover position of the node if early takeover is enabled and the node is a committer.
+func (s *Service) takeoverCandidate(valset symbiotic.ValidatorSet, nwCfg symbiotic.NetworkConfig, now symbiotic.Timestamp, onchainKey symbiotic.CompactPublicKey) *committerTakeover {
+	if s.cfg.CommitterTakeoverTimeout <= 0 {
+		return nil
+	}
+	rank, slotStart, ok := valset.CommitterFailoverRank(nwCfg.CommitterSlotDuration, now, onchainKey)
+	if !ok || rank == 0 {
+		return nil
+	}
+	return &committerTakeover{rank: rank, slotStart: time.Unix(int64(slotStart), 0)}
+}
+
+// shouldTakeOver reports whether the committers ahead of the node stayed silent for the epoch and settlement long enough.
+// The n-th committer after the active one waits n takeover timeouts since the slot start, the last commit intent
+// observed for the epoch and settlement or the moment the proof was first seen locally, whichever is the latest.
+func (s *Service) shouldTakeOver(ctx context.Context, epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress, takeover committerTakeover) bool {
+	now := time.Now()
+	reference := s.commitIntents.takeoverReference(epoch, settlement, takeover.slotStart, now)
+	wait := time.Duration(takeover.rank) * s.cfg.CommitterTakeoverTimeout
+	if silence := now.Sub(reference); silence < wait {
+		slog.DebugContext(ctx, "Waiting for committers ahead to commit",
+			"epoch", epoch,
+			"settlement", settlement,
+			"rank", takeover.rank,
+			"silence", silence,
+			"takeoverAfter", wait,
+		)
+		return false
+	}
+
+	slog.InfoContext(ctx, "No commit activity observed, taking over commit",
+		"epoch", epoch,
+		"settlement", settlement,
+		"rank", takeover.rank,
+		"silentSince", reference,
+	)
+	return true
+}
+
 func (s *Service) detectLastCommittedEpochFromDB(ctx context.Context) symbiotic.Epoch {
 	uncommitted, err := s.cfg.Repo.GetFirstUncommittedValidatorSetEpoch(ctx)
 	if err != nil {
@@ -315,48 +378,175 @@ func (s *Service) detectLastCommittedEpochFromChain(ctx context.Context, config
 //  3. The performance benefit of reduced latency outweighs the minimal reorg risk
 //  4. Final cleanup only happens after finalized block confirmation in the status tracker
 //
+// Settlements are committed concurrently and the progress of each one is stored as a SettlementCommitState.
+//
 // Returns a bool to indicate if at least once settlement commit worked and error if any commitment fails
-func (s *Service) commitValsetToAllSettlements(ctx context.Context, config symbiotic.NetworkConfig, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte) (bool, error) {
-	errs := []error{}
-	for _, settlement := range config.Settlements {
-		slog.DebugContext(ctx, "Attempting to commit valset header to settlement", "settlement", settlement)
+func (s *Service) commitValsetToAllSettlements(ctx context.Context, config symbiotic.NetworkConfig, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte, takeover *committerTakeover) (bool, error) {
+	// settlements are independent chains, commit to all of them concurrently so a slow chain does not delay the others
+	errs := make([]error, len(config.Settlements))
+	// commits waiting for cheaper gas are sent at the latest before the next epoch starts
+	nextEpochStart := time.Unix(int64(header.CaptureTimestamp)+int64(config.EpochDuration), 0)
+	var wg sync.WaitGroup
+	for i, settlement := range config.Settlements {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = s.commitValsetToSettlement(ctx, settlement, header, extraData, proof, nextEpochStart, takeover)
+		}()
+	}
+	wg.Wait()
 
-		// todo replace it with tx check instead of call to contract
-		// if commit tx was sent but still not finalized this check will
-		// return false positive and trigger one more commitment tx
-		committed, err := s.cfg.EvmClient.IsValsetHeaderCommittedAt(ctx, settlement, header.Epoch, symbiotic.WithEVMBlockNumber(symbiotic.BlockNumberLatest))
+	failed := 0
+	for _, err := range errs {
 		if err != nil {
-			errs = append(errs, errors.Errorf("failed to check if header is committed at epoch %d: %v/%s: %w", header.Epoch, settlement.ChainId, settlement.Address.Hex(), err))
-			continue
+			failed++
 		}
+	}
 
-		if committed {
-			slog.DebugContext(ctx, "Valset header already committed at settlement", "settlement", settlement, "epoch", header.Epoch)
-			continue
-		}
+	return len(config.Settlements) == 0 || failed != len(config.Settlements), errors.Join(errs...)
+}
 
-		lastCommittedEpoch, err := s.cfg.EvmClient.GetLastCommittedHeaderEpoch(ctx, settlement, symbiotic.WithEVMBlockNumber(symbiotic.BlockNumberLatest))
-		if err != nil {
-			errs = append(errs, errors.Errorf("failed to get last committed header epoch: %v/%s: %w", settlement.ChainId, settlement.Address.Hex(), err))
-			continue
-		}
+// commitValsetToSettlement commits the header to the settlement after replaying the headers it missed.
+// A takeover candidate only commits once the committers ahead of it stayed silent for the first epoch the settlement misses.
+func (s *Service) commitValsetToSettlement(ctx context.Context, settlement symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte, nextEpochStart time.Time, takeover *committerTakeover) error {
+	slog.DebugContext(ctx, "Attempting to commit valset header to settlement", "settlement", settlement)
 
-		if header.Epoch != lastCommittedEpoch+1 {
-			errs = append(errs, errors.Errorf("commits should be consequent: %v/%s", settlement.ChainId, settlement.Address.Hex()))
-			continue
-		}
+	// todo replace it with tx check instead of call to contract
+	// if commit tx was sent but still not finalized this check will
+	// return false positive and trigger one more commitment tx
+	committed, err := s.cfg.EvmClient.IsValsetHeaderCommittedAt(ctx, settlement, header.Epoch, symbiotic.WithEVMBlockNumber(symbiotic.BlockNumberLatest))
+	if err != nil {
+		return errors.Errorf("failed to check if header is committed at epoch %d: %v/%s: %w", header.Epoch, settlement.ChainId, settlement.Address.Hex(), err)
+	}
+
+	if committed {
+		slog.DebugContext(ctx, "Valset header already committed at settlement", "settlement", settlement, "epoch", header.Epoch)
+		s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+			state.Status = symbiotic.SettlementCommitConfirmed
+			state.LastError = ""
+		})
+		return nil
+	}
+
+	lastCommittedEpoch, err := s.cfg.EvmClient.GetLastCommittedHeaderEpoch(ctx, settlement, symbiotic.WithEVMBlockNumber(symbiotic.BlockNumberLatest))
+	if err != nil {
+		return errors.Errorf("failed to get last committed header epoch: %v/%s: %w", settlement.ChainId, settlement.Address.Hex(), err)
+	}
 
-		result, err := s.cfg.EvmClient.CommitValsetHeader(ctx, settlement, header, extraData, proof)
+	if deferredEpoch, ok := s.deferredCommits.blockingEpoch(settlement, header.Epoch, lastCommittedEpoch); ok {
+		slog.InfoContext(ctx, "Skipped commit, an earlier epoch waits for cheaper gas",
+			"settlement", settlement,
+			"epoch", header.Epoch,
+			"deferredEpoch", deferredEpoch,
+		)
+		s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+			state.Status = symbiotic.SettlementCommitPending
+		})
+		return nil
+	}
+
+	if takeover != nil && !s.shouldTakeOver(ctx, lastCommittedEpoch+1, settlement, *takeover) {
+		s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+			state.Status = symbiotic.SettlementCommitPending
+		})
+		return nil
+	}
+
+	if header.Epoch > lastCommittedEpoch+1 {
+		lastCommittedEpoch, err = s.catchUpSettlement(ctx, settlement, lastCommittedEpoch, header.Epoch)
 		if err != nil {
-			errs = append(errs, errors.Errorf("failed to commit valset header to settlement %v/%s: %w", settlement.ChainId, settlement.Address.Hex(), err))
-			continue
+			err = errors.Errorf("failed to catch up settlement %v/%s: %w", settlement.ChainId, settlement.Address.Hex(), err)
+			s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+				state.Status = symbiotic.SettlementCommitPending
+				state.LastError = err.Error()
+			})
+			return err
 		}
+	}
 
-		slog.InfoContext(ctx, "Validator set header committed",
+	if header.Epoch != lastCommittedEpoch+1 {
+		err := errors.Errorf("commits should be consequent: %v/%s", settlement.ChainId, settlement.Address.Hex())
+		s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+			state.Status = symbiotic.SettlementCommitPending
+			state.LastError = err.Error()
+		})
+		return err
+	}
+
+	if intent, ok := s.commitIntents.activeIntent(header.Epoch, settlement, time.Now()); ok {
+		slog.InfoContext(ctx, "Skipped commit, another committer is already committing to settlement",
 			"settlement", settlement,
-			"txHash", result.TxHash,
+			"epoch", header.Epoch,
+			"pendingTxHash", intent.txHash,
 		)
+		s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+			state.Status = symbiotic.SettlementCommitPending
+			if intent.txHash != (common.Hash{}) {
+				state.Status = symbiotic.SettlementCommitSubmitted
+				state.TxHash = intent.txHash
+			}
+		})
+		return nil
+	}
+
+	return s.sendValsetCommit(ctx, settlement, header, extraData, proof, nextEpochStart)
+}
+
+// sendValsetCommit sends the commit transaction of the header to the settlement and tracks its state.
+// The commit strategy of the settlement chain may defer the commit until nextEpochStart, a zero time never defers.
+func (s *Service) sendValsetCommit(ctx context.Context, settlement symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte, nextEpochStart time.Time) error {
+	opts, send, err := s.applyCommitStrategy(ctx, settlement, header, extraData, proof, nextEpochStart)
+	if err != nil {
+		err = errors.Errorf("failed to commit valset header to settlement %v/%s: %w", settlement.ChainId, settlement.Address.Hex(), err)
+		s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+			state.Status = symbiotic.SettlementCommitFailed
+			state.LastError = err.Error()
+		})
+		return err
+	}
+	if !send {
+		s.deferredCommits.deferCommit(settlement, header.Epoch)
+		s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+			state.Status = symbiotic.SettlementCommitPending
+		})
+		return nil
 	}
 
-	return len(config.Settlements) == 0 || len(errs) != len(config.Settlements), errors.Join(errs...)
+	s.deferredCommits.sent(settlement, header.Epoch)
+	s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+		state.Status = symbiotic.SettlementCommitPending
+		state.Attempts++
+	})
+	s.broadcastCommitIntent(ctx, header.RequiredKeyTag, header.Epoch, settlement, common.Hash{})
+	opts = append(opts, symbiotic.WithTxSentHook(func(txHash common.Hash) {
+		s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+			state.Status = symbiotic.SettlementCommitSubmitted
+			state.TxHash = txHash
+		})
+		s.broadcastCommitIntent(ctx, header.RequiredKeyTag, header.Epoch, settlement, txHash)
+	}))
+	result, err := s.cfg.EvmClient.CommitValsetHeader(ctx, settlement, header, extraData, proof, opts...)
+	if err != nil {
+		err = errors.Errorf("failed to commit valset header to settlement %v/%s: %w", settlement.ChainId, settlement.Address.Hex(), err)
+		s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+			state.Status = symbiotic.SettlementCommitFailed
+			if result.TxHash != (common.Hash{}) {
+				state.TxHash = result.TxHash
+			}
+			state.LastError = err.Error()
+		})
+		return err
+	}
+
+	s.updateSettlementCommitState(ctx, header.Epoch, settlement, func(state *symbiotic.SettlementCommitState) {
+		state.Status = symbiotic.SettlementCommitConfirmed
+		state.TxHash = result.TxHash
+		state.LastError = ""
+	})
+	slog.InfoContext(ctx, "Validator set header committed",
+		"settlement", settlement,
+		"epoch", header.Epoch,
+		"txHash", result.TxHash,
+	)
+	return nil
 }
@@ -37,6 +37,9 @@ type repo interface {
 	GetFirstUncommittedValidatorSetEpoch(ctx context.Context) (symbiotic.Epoch, error)
 	UpdateValidatorSetStatus(ctx context.Context, epoch symbiotic.Epoch, item symbiotic.ValidatorSetStatus) error
 	GetLatestAggregatedValsetHeader(ctx context.Context) (symbiotic.ValidatorSetHeader, error)
+	SaveSettlementCommitState(ctx context.Context, state symbiotic.SettlementCommitState) error
+	GetSettlementCommitState(ctx context.Context, epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress) (symbiotic.SettlementCommitState, error)
+	GetValidatorSetMetadata(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.ValidatorSetMetadata, error)
 }
 
 type deriver interface {
@@ -47,18 +50,21 @@ type deriver interface {
 type metrics interface {
 	ObserveAggregationProofSize(proofSize int, validatorCount int)
 	ObserveEpoch(epochType string, epochNumber uint64)
+	ObserveCommitDecision(chainID uint64, decision string)
+	ObserveCommitSimulation(chainID uint64, gasEstimate uint64, cost *big.Int)
 }
 
 type keyProvider interface {
-	GetPrivateKey(keyTag symbiotic.KeyTag) (crypto.PrivateKey, error)
-	GetOnchainKeyFromCache(keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, error)
+	GetPrivateKeyForValset(valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag) (crypto.PrivateKey, error)
+	GetOnchainKeyForValset(valset symbiotic.ValidatorSet, keyTag symbiotic.KeyTag) (symbiotic.CompactPublicKey, error)
 }
 
 type evmClient interface {
 	GetCurrentEpoch(ctx context.Context) (symbiotic.Epoch, error)
 	GetEpochStart(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.Timestamp, error)
 	GetConfig(ctx context.Context, timestamp symbiotic.Timestamp, epoch symbiotic.Epoch) (symbiotic.NetworkConfig, error)
-	CommitValsetHeader(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte) (symbiotic.TxResult, error)
+	CommitValsetHeader(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte, opts ...symbiotic.EVMOption) (symbiotic.TxResult, error)
+	SimulateCommitValsetHeader(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte) (symbiotic.TxSimulation, error)
 	IsValsetHeaderCommittedAtEpochs(ctx context.Context, addr symbiotic.CrossChainAddress, epochs []symbiotic.Epoch) ([]bool, error)
 	GetLastCommittedHeaderEpoch(ctx context.Context, addr symbiotic.CrossChainAddress, evmOptions ...symbiotic.EVMOption) (symbiotic.Epoch, error)
 	IsValsetHeaderCommittedAt(ctx context.Context, addr symbiotic.CrossChainAddress, epoch symbiotic.Epoch, opts ...symbiotic.EVMOption) (_ bool, err error)
@@ -76,12 +82,24 @@ type Config struct {
 	Metrics             metrics `validate:"required"`
 	ForceCommitter      bool
 	EpochRetentionCount uint64
+	// CommitterTakeoverTimeout is how long committers wait for an intent or a commit from the committer
+	// of the current slot before the next one takes over, each further committer waits one more timeout.
+	// Zero disables early takeover and committers only act in their own slots.
+	CommitterTakeoverTimeout time.Duration `validate:"gte=0"`
+	// CatchUpMaxEpochs is the maximum number of missed headers replayed to a lagging settlement
+	// in a single commit attempt. Zero disables catch-up commits.
+	CatchUpMaxEpochs uint64
+	// CommitPolicy selects the fee cap, gas waiting and simulation of commits per settlement chain
+	CommitPolicy entity.CommitPolicy
 }
 
 func (c Config) Validate() error {
 	if err := validator.New().Struct(c); err != nil {
 		return errors.Errorf("invalid config: %w", err)
 	}
+	if err := c.CommitPolicy.Validate(); err != nil {
+		return errors.Errorf("invalid commit policy: %w", err)
+	}
 
 	return nil
 }
@@ -89,6 +107,11 @@ func (c Config) Validate() error {
 type Service struct {
 	cfg   Config
 	mutex sync.Mutex
+
+	commitIntents     *commitIntentTracker
+	deferredCommits   deferredCommitTracker
+	intentBroadcaster commitIntentBroadcaster
+	proofSyncer       aggregationProofSyncer
 }
 
 func New(cfg Config) (*Service, error) {
@@ -97,7 +120,8 @@ func New(cfg Config) (*Service, error) {
 	}
 
 	return &Service{
-		cfg: cfg,
+		cfg:           cfg,
+		commitIntents: newCommitIntentTracker(),
 	}, nil
 }
 
@@ -414,16 +438,16 @@ func (s *Service) process(
 		tracing.RecordError(span, err)
 		return errors.Errorf("failed to get validator set header: %w", err)
 	}
-	commitmentData, err := s.headerCommitmentData(networkData, header, extraData)
+	commitmentTypedData, commitmentData, err := s.headerCommitmentData(networkData, header, extraData)
 	if err != nil {
 		tracing.RecordError(span, err)
 		return errors.Errorf("failed to get header commitment hash: %w", err)
 	}
 
-	onchainKey, err := s.cfg.KeyProvider.GetOnchainKeyFromCache(prevValSet.RequiredKeyTag)
+	onchainKey, err := s.cfg.KeyProvider.GetOnchainKeyForValset(prevValSet, prevValSet.RequiredKeyTag)
 	if err != nil {
 		tracing.RecordError(span, err)
-		return errors.Errorf("failed to get onchain symb key from cache: %w", err)
+		return errors.Errorf("failed to get onchain symb key: %w", err)
 	}
 
 	// if we are a signer, sign the commitment, otherwise just save the metadata
@@ -435,6 +459,8 @@ func (s *Service) process(
 			KeyTag:        prevValSet.RequiredKeyTag,
 			RequiredEpoch: prevValSet.Epoch,
 			Message:       commitmentData,
+			TypedData:     &commitmentTypedData,
+			Priority:      symbiotic.PriorityHeader,
 		}
 	}
 
@@ -504,49 +530,25 @@ func (s *Service) headerCommitmentData(
 	networkData symbiotic.NetworkData,
 	header symbiotic.ValidatorSetHeader,
 	extraData []symbiotic.ExtraData,
-) ([]byte, error) {
+) (apitypes.TypedData, []byte, error) {
 	headerHash, err := header.Hash()
 	if err != nil {
-		return nil, errors.Errorf("failed to hash valset header: %w", err)
+		return apitypes.TypedData{}, nil, errors.Errorf("failed to hash valset header: %w", err)
 	}
 
 	extraDataHash, err := symbiotic.ExtraDataList(extraData).Hash()
 	if err != nil {
-		return nil, errors.Errorf("failed to hash extra data: %w", err)
-	}
-
-	typedData := apitypes.TypedData{
-		Types: apitypes.Types{
-			"EIP712Domain": []apitypes.Type{
-				{Name: "name", Type: "string"},
-				{Name: "version", Type: "string"},
-			},
-			"ValSetHeaderCommit": []apitypes.Type{
-				{Name: "subnetwork", Type: "bytes32"},
-				{Name: "epoch", Type: "uint48"},
-				{Name: "headerHash", Type: "bytes32"},
-				{Name: "extraDataHash", Type: "bytes32"},
-			},
-		},
-		Domain: apitypes.TypedDataDomain{
-			Name:    networkData.Eip712Data.Name,
-			Version: networkData.Eip712Data.Version,
-		},
-		PrimaryType: "ValSetHeaderCommit",
-		Message: map[string]interface{}{
-			"subnetwork":    networkData.Subnetwork,
-			"epoch":         new(big.Int).SetUint64(uint64(header.Epoch)),
-			"headerHash":    headerHash,
-			"extraDataHash": extraDataHash,
-		},
+		return apitypes.TypedData{}, nil, errors.Errorf("failed to hash extra data: %w", err)
 	}
 
+	typedData := symbiotic.ValSetHeaderCommitTypedData(networkData, header.Epoch, headerHash, common.BytesToHash(extraDataHash))
+
 	_, data, err := apitypes.TypedDataAndHash(typedData)
 	if err != nil {
-		return nil, errors.Errorf("failed to get typed data hash: %w", err)
+		return apitypes.TypedData{}, nil, errors.Errorf("failed to get typed data hash: %w", err)
 	}
 
-	return []byte(data), nil
+	return typedData, []byte(data), nil
 }
 
 func (s *Service) derive(ctx context.Context, epoch symbiotic.Epoch) (symbiotic.ValidatorSet, symbiotic.NetworkConfig, error) {
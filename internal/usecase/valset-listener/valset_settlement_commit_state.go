@@ -0,0 +1,32 @@
+package valset_listener
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// updateSettlementCommitState applies the update to the stored commit state of the settlement.
+// The state is informational only, so storage failures are logged and do not interrupt the commit.
+func (s *Service) updateSettlementCommitState(ctx context.Context, epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress, update func(state *symbiotic.SettlementCommitState)) {
+	state, err := s.cfg.Repo.GetSettlementCommitState(ctx, epoch, settlement)
+	if err != nil {
+		if !errors.Is(err, entity.ErrEntityNotFound) {
+			slog.WarnContext(ctx, "Failed to get settlement commit state", "settlement", settlement, "epoch", epoch, "error", err)
+			return
+		}
+		state = symbiotic.SettlementCommitState{Epoch: epoch, Settlement: settlement}
+	}
+
+	update(&state)
+	state.UpdatedAt = time.Now()
+
+	if err := s.cfg.Repo.SaveSettlementCommitState(ctx, state); err != nil {
+		slog.WarnContext(ctx, "Failed to save settlement commit state", "settlement", settlement, "epoch", epoch, "error", err)
+	}
+}
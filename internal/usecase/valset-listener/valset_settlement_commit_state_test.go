@@ -0,0 +1,138 @@
+package valset_listener
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+type commitStateRepo struct {
+	repo
+	mutex  sync.Mutex
+	states map[symbiotic.CrossChainAddress]symbiotic.SettlementCommitState
+}
+
+func (r *commitStateRepo) SaveSettlementCommitState(_ context.Context, state symbiotic.SettlementCommitState) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.states[state.Settlement] = state
+	return nil
+}
+
+func (r *commitStateRepo) GetSettlementCommitState(_ context.Context, _ symbiotic.Epoch, settlement symbiotic.CrossChainAddress) (symbiotic.SettlementCommitState, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	state, ok := r.states[settlement]
+	if !ok {
+		return symbiotic.SettlementCommitState{}, entity.ErrEntityNotFound
+	}
+	return state, nil
+}
+
+type settlementStub struct {
+	committed          bool
+	lastCommittedEpoch symbiotic.Epoch
+	txHash             common.Hash
+	commitErr          error
+}
+
+type stubSettlementsClient struct {
+	evmClient
+	settlements map[symbiotic.CrossChainAddress]settlementStub
+}
+
+func (c stubSettlementsClient) IsValsetHeaderCommittedAt(_ context.Context, addr symbiotic.CrossChainAddress, _ symbiotic.Epoch, _ ...symbiotic.EVMOption) (bool, error) {
+	return c.settlements[addr].committed, nil
+}
+
+func (c stubSettlementsClient) GetLastCommittedHeaderEpoch(_ context.Context, addr symbiotic.CrossChainAddress, _ ...symbiotic.EVMOption) (symbiotic.Epoch, error) {
+	return c.settlements[addr].lastCommittedEpoch, nil
+}
+
+func (c stubSettlementsClient) CommitValsetHeader(_ context.Context, addr symbiotic.CrossChainAddress, _ symbiotic.ValidatorSetHeader, _ []symbiotic.ExtraData, _ []byte, opts ...symbiotic.EVMOption) (symbiotic.TxResult, error) {
+	settlement := c.settlements[addr]
+	if hook := symbiotic.AppliedEVMOptions(opts...).OnTxSent; hook != nil {
+		hook(settlement.txHash)
+	}
+	if settlement.commitErr != nil {
+		return symbiotic.TxResult{}, settlement.commitErr
+	}
+	return symbiotic.TxResult{TxHash: settlement.txHash}, nil
+}
+
+func TestCommitValsetToAllSettlements_TracksStatePerSettlement(t *testing.T) {
+	t.Parallel()
+
+	alreadyCommitted := symbiotic.CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x01")}
+	committed := symbiotic.CrossChainAddress{ChainId: 2, Address: common.HexToAddress("0x02")}
+	failing := symbiotic.CrossChainAddress{ChainId: 3, Address: common.HexToAddress("0x03")}
+	lagging := symbiotic.CrossChainAddress{ChainId: 4, Address: common.HexToAddress("0x04")}
+
+	repo := &commitStateRepo{states: make(map[symbiotic.CrossChainAddress]symbiotic.SettlementCommitState)}
+	s := &Service{
+		cfg: Config{
+			Repo: repo,
+			EvmClient: stubSettlementsClient{settlements: map[symbiotic.CrossChainAddress]settlementStub{
+				alreadyCommitted: {committed: true, lastCommittedEpoch: 10},
+				committed:        {lastCommittedEpoch: 9, txHash: common.HexToHash("0xaa")},
+				failing:          {lastCommittedEpoch: 9, txHash: common.HexToHash("0xbb"), commitErr: errors.New("execution reverted")},
+				lagging:          {lastCommittedEpoch: 7},
+			}},
+		},
+		commitIntents: newCommitIntentTracker(),
+	}
+
+	config := symbiotic.NetworkConfig{Settlements: []symbiotic.CrossChainAddress{alreadyCommitted, committed, failing, lagging}}
+	ok, err := s.commitValsetToAllSettlements(t.Context(), config, symbiotic.ValidatorSetHeader{Epoch: 10}, nil, nil, nil)
+	require.True(t, ok)
+	require.ErrorContains(t, err, "execution reverted")
+	require.ErrorContains(t, err, "commits should be consequent")
+
+	require.Equal(t, symbiotic.SettlementCommitConfirmed, repo.states[alreadyCommitted].Status)
+	require.Zero(t, repo.states[alreadyCommitted].Attempts)
+
+	require.Equal(t, symbiotic.SettlementCommitConfirmed, repo.states[committed].Status)
+	require.Equal(t, common.HexToHash("0xaa"), repo.states[committed].TxHash)
+	require.Equal(t, uint32(1), repo.states[committed].Attempts)
+
+	require.Equal(t, symbiotic.SettlementCommitFailed, repo.states[failing].Status)
+	require.Equal(t, common.HexToHash("0xbb"), repo.states[failing].TxHash, "tx hash reported by the sent hook is kept")
+	require.Contains(t, repo.states[failing].LastError, "execution reverted")
+
+	require.Equal(t, symbiotic.SettlementCommitPending, repo.states[lagging].Status)
+	require.Contains(t, repo.states[lagging].LastError, "commits should be consequent")
+}
+
+func TestCommitValsetToAllSettlements_ActiveIntentMarksSubmitted(t *testing.T) {
+	t.Parallel()
+
+	settlement := symbiotic.CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x01")}
+	repo := &commitStateRepo{states: make(map[symbiotic.CrossChainAddress]symbiotic.SettlementCommitState)}
+	s := &Service{
+		cfg: Config{
+			Repo: repo,
+			EvmClient: stubSettlementsClient{settlements: map[symbiotic.CrossChainAddress]settlementStub{
+				settlement: {lastCommittedEpoch: 9, commitErr: errors.New("must not be called")},
+			}},
+		},
+		commitIntents: newCommitIntentTracker(),
+	}
+	txHash := common.HexToHash("0xcc")
+	s.commitIntents.observe(entity.CommitIntent{Epoch: 10, Settlement: settlement, PublicKey: []byte("key"), TxHash: txHash}, time.Now())
+
+	ok, err := s.commitValsetToAllSettlements(t.Context(), symbiotic.NetworkConfig{Settlements: []symbiotic.CrossChainAddress{settlement}}, symbiotic.ValidatorSetHeader{Epoch: 10}, nil, nil, nil)
+	require.True(t, ok)
+	require.NoError(t, err)
+
+	require.Equal(t, symbiotic.SettlementCommitSubmitted, repo.states[settlement].Status)
+	require.Equal(t, txHash, repo.states[settlement].TxHash)
+	require.Zero(t, repo.states[settlement].Attempts)
+}
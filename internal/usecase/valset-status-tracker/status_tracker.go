@@ -26,6 +26,8 @@ type repo interface {
 	SaveFirstUncommittedValidatorSetEpoch(_ context.Context, epoch symbiotic.Epoch) error
 	GetLatestValidatorSetEpoch(ctx context.Context) (symbiotic.Epoch, error)
 	GetLatestAggregatedValsetHeader(ctx context.Context) (symbiotic.ValidatorSetHeader, error)
+	GetSettlementCommitState(ctx context.Context, epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress) (symbiotic.SettlementCommitState, error)
+	SaveSettlementCommitState(ctx context.Context, state symbiotic.SettlementCommitState) error
 }
 
 type metrics interface {
@@ -216,6 +218,8 @@ func (s *Service) trackCommittedEpochs(ctx context.Context) error {
 			if hash != committedHash {
 				return errors.Errorf("header hash for epoch %d is not equal to committed hash, derived: %s, committed: %s", epoch, hash.Hex(), committedHash.Hex())
 			}
+
+			s.markSettlementCommitConfirmed(ctx, valset.Epoch, settlement)
 		}
 
 		if isCommitted {
@@ -235,6 +239,28 @@ func (s *Service) trackCommittedEpochs(ctx context.Context) error {
 	return nil
 }
 
+// markSettlementCommitConfirmed records a finalized commit of the epoch to the settlement,
+// keeping the tx hash and attempts collected by the committer if any.
+func (s *Service) markSettlementCommitConfirmed(ctx context.Context, epoch symbiotic.Epoch, settlement symbiotic.CrossChainAddress) {
+	state, err := s.cfg.Repo.GetSettlementCommitState(ctx, epoch, settlement)
+	if err != nil && !errors.Is(err, entity.ErrEntityNotFound) {
+		slog.WarnContext(ctx, "Failed to get settlement commit state", "settlement", settlement, "epoch", epoch, "error", err)
+		return
+	}
+	if err == nil && state.Status == symbiotic.SettlementCommitConfirmed {
+		return
+	}
+
+	state.Epoch = epoch
+	state.Settlement = settlement
+	state.Status = symbiotic.SettlementCommitConfirmed
+	state.LastError = ""
+	state.UpdatedAt = time.Now()
+	if err := s.cfg.Repo.SaveSettlementCommitState(ctx, state); err != nil {
+		slog.WarnContext(ctx, "Failed to save settlement commit state", "settlement", settlement, "epoch", epoch, "error", err)
+	}
+}
+
 func (s *Service) findLatestNonZeroSettlements(ctx context.Context) ([]symbiotic.CrossChainAddress, error) {
 	currentEpoch, err := s.cfg.EvmClient.GetCurrentEpoch(ctx)
 	if err != nil {
@@ -0,0 +1,250 @@
+package fairqueue
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+)
+
+// Class is a scheduling class of the queue.
+// Strict classes are served before all weighted ones in the order they are configured,
+// weighted classes share the remaining capacity in proportion to their weights.
+type Class struct {
+	Name   string
+	Weight int
+	Strict bool
+}
+
+// Observer receives the depth and the wait time of every class of the queue, e.g. to export them as metrics.
+type Observer interface {
+	SetQueueDepth(queue, class string, depth int)
+	ObserveQueueWait(queue, class string, d time.Duration)
+}
+
+// Config defines the configuration of a Queue.
+type Config struct {
+	// Name identifies the queue for the observer
+	Name    string
+	Classes []Class
+	// DefaultClass is used for items added with a class the queue is not configured with
+	DefaultClass string
+	Observer     Observer
+}
+
+func (c Config) Validate() error {
+	if len(c.Classes) == 0 {
+		return errors.New("at least one class is required")
+	}
+	names := make(map[string]struct{}, len(c.Classes))
+	for _, class := range c.Classes {
+		if _, ok := names[class.Name]; ok {
+			return errors.Errorf("class %q is configured more than once", class.Name)
+		}
+		names[class.Name] = struct{}{}
+		if !class.Strict && class.Weight <= 0 {
+			return errors.Errorf("weight of class %q must be positive", class.Name)
+		}
+	}
+	if _, ok := names[c.DefaultClass]; !ok {
+		return errors.Errorf("default class %q is not configured", c.DefaultClass)
+	}
+	return nil
+}
+
+type entry[T comparable] struct {
+	item     T
+	queuedAt time.Time
+}
+
+type classState[T comparable] struct {
+	Class
+
+	items []entry[T]
+	// current is the smooth weighted round robin counter of the class
+	current int
+}
+
+// Queue is a work queue with weighted fair scheduling between classes.
+// Like a k8s workqueue it deduplicates items: an item is queued at most once and is never processed
+// by two workers concurrently, an item added while it is processed is queued again once it is done.
+type Queue[T comparable] struct {
+	cfg     Config
+	classes []*classState[T]
+	byName  map[string]*classState[T]
+
+	mu         sync.Mutex
+	cond       *sync.Cond
+	queued     map[T]struct{}
+	processing map[T]struct{}
+	// requeue holds the class of items added while they were processed
+	requeue  map[T]string
+	shutdown bool
+}
+
+func New[T comparable](cfg Config) (*Queue[T], error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Errorf("invalid fair queue config: %w", err)
+	}
+
+	q := &Queue[T]{
+		cfg:        cfg,
+		byName:     make(map[string]*classState[T], len(cfg.Classes)),
+		queued:     make(map[T]struct{}),
+		processing: make(map[T]struct{}),
+		requeue:    make(map[T]string),
+	}
+	q.cond = sync.NewCond(&q.mu)
+	for _, class := range cfg.Classes {
+		state := &classState[T]{Class: class}
+		q.classes = append(q.classes, state)
+		q.byName[class.Name] = state
+	}
+
+	return q, nil
+}
+
+// Add queues the item in the class, it is a no-op if the item is already queued.
+func (q *Queue[T]) Add(item T, class string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.shutdown {
+		return
+	}
+	if _, ok := q.queued[item]; ok {
+		return
+	}
+	if _, ok := q.processing[item]; ok {
+		q.requeue[item] = class
+		return
+	}
+	q.push(item, class)
+}
+
+// Get blocks until an item is available and returns the item of the class to be served next.
+// It reports shutdown once the queue is shut down and drained.
+func (q *Queue[T]) Get() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.queued) == 0 && !q.shutdown {
+		q.cond.Wait()
+	}
+	if len(q.queued) == 0 {
+		var zero T
+		return zero, true
+	}
+	return q.pop(), false
+}
+
+// TryGet returns the item of the class to be served next without blocking, false if the queue is empty.
+func (q *Queue[T]) TryGet() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.queued) == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.pop(), true
+}
+
+// Done marks the item as processed, items added during processing are queued again.
+func (q *Queue[T]) Done(item T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.processing, item)
+	if class, ok := q.requeue[item]; ok {
+		delete(q.requeue, item)
+		if !q.shutdown {
+			q.push(item, class)
+		}
+	}
+}
+
+// ShutDown makes Get return shutdown once the queued items are drained, new items are ignored.
+func (q *Queue[T]) ShutDown() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.shutdown = true
+	q.cond.Broadcast()
+}
+
+// Len returns the number of queued items in the class.
+func (q *Queue[T]) Len(class string) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	state, ok := q.byName[class]
+	if !ok {
+		return 0
+	}
+	return len(state.items)
+}
+
+func (q *Queue[T]) push(item T, class string) {
+	state, ok := q.byName[class]
+	if !ok {
+		state = q.byName[q.cfg.DefaultClass]
+	}
+	state.items = append(state.items, entry[T]{item: item, queuedAt: time.Now()})
+	q.queued[item] = struct{}{}
+	q.observeDepth(state)
+	q.cond.Signal()
+}
+
+func (q *Queue[T]) pop() T {
+	state := q.next()
+	e := state.items[0]
+	state.items[0] = entry[T]{}
+	state.items = state.items[1:]
+	if len(state.items) == 0 {
+		// idle classes neither bank nor owe turns
+		state.current = 0
+	}
+
+	delete(q.queued, e.item)
+	q.processing[e.item] = struct{}{}
+
+	q.observeDepth(state)
+	if q.cfg.Observer != nil {
+		q.cfg.Observer.ObserveQueueWait(q.cfg.Name, state.Name, time.Since(e.queuedAt))
+	}
+	return e.item
+}
+
+// next picks the class to serve, the first non-empty strict class or else the weighted class chosen by
+// smooth weighted round robin, which interleaves classes instead of serving them in bursts.
+// Must be called with a non-empty queue.
+func (q *Queue[T]) next() *classState[T] {
+	var (
+		best  *classState[T]
+		total int
+	)
+	for _, state := range q.classes {
+		if state.Strict && len(state.items) > 0 {
+			return state
+		}
+	}
+	for _, state := range q.classes {
+		if state.Strict || len(state.items) == 0 {
+			continue
+		}
+		state.current += state.Weight
+		total += state.Weight
+		if best == nil || state.current > best.current {
+			best = state
+		}
+	}
+	best.current -= total
+	return best
+}
+
+func (q *Queue[T]) observeDepth(state *classState[T]) {
+	if q.cfg.Observer != nil {
+		q.cfg.Observer.SetQueueDepth(q.cfg.Name, state.Name, len(state.items))
+	}
+}
@@ -0,0 +1,148 @@
+package fairqueue
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQueue(t *testing.T, observer Observer) *Queue[int] {
+	t.Helper()
+	q, err := New[int](Config{
+		Name: "test",
+		Classes: []Class{
+			{Name: "header", Strict: true},
+			{Name: "high", Weight: 3},
+			{Name: "low", Weight: 1},
+		},
+		DefaultClass: "low",
+		Observer:     observer,
+	})
+	require.NoError(t, err)
+	return q
+}
+
+func drain(q *Queue[int]) []int {
+	var items []int
+	for {
+		item, ok := q.TryGet()
+		if !ok {
+			return items
+		}
+		q.Done(item)
+		items = append(items, item)
+	}
+}
+
+func TestQueue_StrictClassFirst(t *testing.T) {
+	q := newTestQueue(t, nil)
+	q.Add(1, "low")
+	q.Add(2, "high")
+	q.Add(3, "header")
+
+	require.Equal(t, []int{3, 2, 1}, drain(q))
+}
+
+func TestQueue_WeightedFairOrder(t *testing.T) {
+	q := newTestQueue(t, nil)
+	for i := range 8 {
+		q.Add(100+i, "high")
+		q.Add(200+i, "low")
+	}
+
+	items := drain(q)
+	require.Len(t, items, 16)
+	// the first 8 items are served 3:1, low priority items are interleaved instead of starved
+	high := 0
+	for _, item := range items[:8] {
+		if item < 200 {
+			high++
+		}
+	}
+	require.Equal(t, 6, high)
+	require.Contains(t, items[:4], 200)
+}
+
+func TestQueue_UnknownClassUsesDefault(t *testing.T) {
+	q := newTestQueue(t, nil)
+	q.Add(1, "unknown")
+	require.Equal(t, 1, q.Len("low"))
+}
+
+func TestQueue_Deduplicates(t *testing.T) {
+	q := newTestQueue(t, nil)
+	q.Add(1, "low")
+	q.Add(1, "low")
+	require.Equal(t, 1, q.Len("low"))
+
+	item, ok := q.TryGet()
+	require.True(t, ok)
+	// added while processing, queued again once done
+	q.Add(item, "high")
+	require.Equal(t, 0, q.Len("high"))
+	q.Done(item)
+	require.Equal(t, 1, q.Len("high"))
+}
+
+func TestQueue_GetBlocksUntilAddOrShutdown(t *testing.T) {
+	q := newTestQueue(t, nil)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		item, shutdown := q.Get()
+		require.False(t, shutdown)
+		require.Equal(t, 1, item)
+		q.Done(item)
+
+		_, shutdown = q.Get()
+		require.True(t, shutdown)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	q.Add(1, "low")
+	time.Sleep(10 * time.Millisecond)
+	q.ShutDown()
+	wg.Wait()
+}
+
+type recordingObserver struct {
+	mu    sync.Mutex
+	depth map[string]int
+	waits map[string]int
+}
+
+func (o *recordingObserver) SetQueueDepth(_, class string, depth int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.depth[class] = depth
+}
+
+func (o *recordingObserver) ObserveQueueWait(_, class string, _ time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.waits[class]++
+}
+
+func TestQueue_ReportsDepthAndWait(t *testing.T) {
+	observer := &recordingObserver{depth: map[string]int{}, waits: map[string]int{}}
+	q := newTestQueue(t, observer)
+	q.Add(1, "high")
+	q.Add(2, "high")
+	require.Equal(t, 2, observer.depth["high"])
+
+	drain(q)
+	require.Equal(t, 0, observer.depth["high"])
+	require.Equal(t, 2, observer.waits["high"])
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.Error(t, Config{}.Validate())
+	require.Error(t, Config{Classes: []Class{{Name: "a", Weight: 1}}, DefaultClass: "b"}.Validate())
+	require.Error(t, Config{Classes: []Class{{Name: "a"}}, DefaultClass: "a"}.Validate())
+	require.Error(t, Config{Classes: []Class{{Name: "a", Weight: 1}, {Name: "a", Weight: 1}}, DefaultClass: "a"}.Validate())
+	require.NoError(t, Config{Classes: []Class{{Name: "a", Strict: true}, {Name: "b", Weight: 1}}, DefaultClass: "b"}.Validate())
+}
@@ -0,0 +1,268 @@
+package signals
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-errors/errors"
+)
+
+const (
+	// RedeliveryInterval is how often durable signals look for persisted events that are due for delivery.
+	RedeliveryInterval = time.Second
+
+	// maxBackoffDoublings bounds the exponential growth of the retry backoff.
+	maxBackoffDoublings = 30
+)
+
+// StoredEvent is a signal event persisted by a Store.
+type StoredEvent struct {
+	// Seq is the store-assigned sequence number, unique per signal
+	Seq uint64
+	// Payload is the encoded event payload
+	Payload []byte
+	// Attempts is the number of failed delivery attempts so far
+	Attempts uint32
+	// NextAttemptAt is the earliest time the event can be delivered again
+	NextAttemptAt time.Time
+	// LastError is the error returned by the handlers on the last failed attempt
+	LastError string
+	// CreatedAt is the time the event was first emitted
+	CreatedAt time.Time
+}
+
+// Store persists signal events so they survive restarts.
+// Events are kept until they are removed after successful delivery or moved to the dead-letter storage.
+type Store interface {
+	SaveSignalEvent(ctx context.Context, signalID string, payload []byte) (StoredEvent, error)
+	GetDueSignalEvents(ctx context.Context, signalID string, now time.Time, limit int) ([]StoredEvent, error)
+	UpdateSignalEvent(ctx context.Context, signalID string, event StoredEvent) error
+	RemoveSignalEvent(ctx context.Context, signalID string, seq uint64) error
+	MoveSignalEventToDeadLetter(ctx context.Context, signalID string, event StoredEvent) error
+	GetDeadLetterSignalEvents(ctx context.Context, signalID string, limit int) ([]StoredEvent, error)
+	CountSignalEvents(ctx context.Context, signalID string) (pending int, deadLetters int, err error)
+}
+
+// Codec converts signal payloads to and from their persisted form.
+type Codec[T any] struct {
+	Marshal   func(T) ([]byte, error)
+	Unmarshal func(context.Context, []byte) (T, error)
+}
+
+// QueueStats describes the state of a signal queue.
+type QueueStats struct {
+	ID      string
+	Durable bool
+	// Queued is the number of events buffered in memory waiting for a worker
+	Queued int
+	// Processing is the number of events currently handled by workers
+	Processing int
+	// Pending is the number of persisted events not yet delivered successfully
+	Pending int
+	// DeadLetters is the number of persisted events that exhausted their delivery attempts
+	DeadLetters int
+}
+
+// SetStore attaches a durable backend to the signal.
+// Emitted events are persisted before they are queued, acknowledged after all handlers succeed,
+// retried with exponential backoff when a handler fails and dead-lettered after Config.MaxAttempts attempts.
+// Events left in the store by a previous run are redelivered once workers are started.
+// Must be called before starting workers.
+func (s *Signal[T]) SetStore(store Store, codec Codec[T]) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.started {
+		return errors.Errorf("signal workers are already started for %v signal, cannot set store", s.id)
+	}
+	if store == nil {
+		return errors.Errorf("store must not be nil for %v signal", s.id)
+	}
+	if codec.Marshal == nil || codec.Unmarshal == nil {
+		return errors.Errorf("codec must define both marshal and unmarshal for %v signal", s.id)
+	}
+	s.store = store
+	s.codec = codec
+	return nil
+}
+
+// Stats returns the current state of the signal queue.
+func (s *Signal[T]) Stats(ctx context.Context) (QueueStats, error) {
+	stats := QueueStats{
+		ID:         s.id,
+		Durable:    s.store != nil,
+		Queued:     len(s.queue),
+		Processing: int(s.processing.Load()),
+	}
+	if s.store == nil {
+		return stats, nil
+	}
+
+	pending, deadLetters, err := s.store.CountSignalEvents(ctx, s.id)
+	if err != nil {
+		return QueueStats{}, errors.Errorf("failed to count events for %v signal: %w", s.id, err)
+	}
+	stats.Pending = pending
+	stats.DeadLetters = deadLetters
+	return stats, nil
+}
+
+// DeadLetters returns up to limit dead-lettered events of the signal, oldest first.
+// Returns nothing for in-memory signals.
+func (s *Signal[T]) DeadLetters(ctx context.Context, limit int) ([]StoredEvent, error) {
+	if s.store == nil {
+		return nil, nil
+	}
+	events, err := s.store.GetDeadLetterSignalEvents(ctx, s.id, limit)
+	if err != nil {
+		return nil, errors.Errorf("failed to get dead letters for %v signal: %w", s.id, err)
+	}
+	return events, nil
+}
+
+// emitDurable persists the event and then tries to hand it to a worker.
+// Once persisted the event is never lost: if the queue stays full for the whole timeout
+// it is picked up later by the redelivery loop.
+func (s *Signal[T]) emitDurable(ctx context.Context, payload T, timeout time.Duration) error {
+	data, err := s.codec.Marshal(payload)
+	if err != nil {
+		return errors.Errorf("failed to encode event for %v signal: %w", s.id, err)
+	}
+
+	stored, err := s.store.SaveSignalEvent(context.WithoutCancel(ctx), s.id, data)
+	if err != nil {
+		return errors.Errorf("failed to persist event for %v signal: %w", s.id, err)
+	}
+
+	// redelivery loop may have already dispatched the event
+	if _, loaded := s.inFlight.LoadOrStore(stored.Seq, struct{}{}); loaded {
+		return nil
+	}
+
+	event := Event[T]{Payload: payload, Ctx: ctx, stored: &stored}
+	if timeout <= 0 {
+		select {
+		case s.queue <- event:
+		default:
+			s.inFlight.Delete(stored.Seq)
+		}
+		return nil
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case s.queue <- event:
+	case <-timer.C:
+		s.inFlight.Delete(stored.Seq)
+		slog.Warn("signal queue is full, persisted event will be redelivered",
+			slog.String("signal", s.id),
+			slog.Uint64("seq", stored.Seq))
+	}
+	return nil
+}
+
+// settle records the outcome of a delivery attempt in the store.
+func (s *Signal[T]) settle(ctx context.Context, stored *StoredEvent, handlerErr error) {
+	defer s.inFlight.Delete(stored.Seq)
+	ctx = context.WithoutCancel(ctx)
+
+	if handlerErr == nil {
+		if err := s.store.RemoveSignalEvent(ctx, s.id, stored.Seq); err != nil {
+			slog.Error("failed to acknowledge signal event",
+				slog.Any("error", err),
+				slog.String("signal", s.id),
+				slog.Uint64("seq", stored.Seq))
+		}
+		return
+	}
+
+	event := *stored
+	event.Attempts++
+	event.LastError = handlerErr.Error()
+
+	if s.cfg.MaxAttempts > 0 && event.Attempts >= uint32(s.cfg.MaxAttempts) {
+		slog.Warn("signal event exhausted delivery attempts, moving to dead letters",
+			slog.String("signal", s.id),
+			slog.Uint64("seq", event.Seq),
+			slog.Uint64("attempts", uint64(event.Attempts)),
+			slog.String("lastError", event.LastError))
+		if err := s.store.MoveSignalEventToDeadLetter(ctx, s.id, event); err != nil {
+			slog.Error("failed to dead-letter signal event",
+				slog.Any("error", err),
+				slog.String("signal", s.id),
+				slog.Uint64("seq", event.Seq))
+		}
+		return
+	}
+
+	event.NextAttemptAt = time.Now().Add(s.retryBackoff(event.Attempts))
+	if err := s.store.UpdateSignalEvent(ctx, s.id, event); err != nil {
+		slog.Error("failed to schedule signal event retry",
+			slog.Any("error", err),
+			slog.String("signal", s.id),
+			slog.Uint64("seq", event.Seq))
+	}
+}
+
+// retryBackoff returns the delay before the next delivery attempt after the given number of failed attempts.
+func (s *Signal[T]) retryBackoff(attempts uint32) time.Duration {
+	backoff := s.cfg.RetryBackoff
+	for i := uint32(1); i < attempts && i <= maxBackoffDoublings; i++ {
+		backoff *= 2
+		if s.cfg.MaxRetryBackoff > 0 && backoff >= s.cfg.MaxRetryBackoff {
+			break
+		}
+	}
+	if s.cfg.MaxRetryBackoff > 0 {
+		backoff = min(backoff, s.cfg.MaxRetryBackoff)
+	}
+	return backoff
+}
+
+// redeliver periodically dispatches persisted events that are due, starting with
+// the ones left over from a previous run.
+func (s *Signal[T]) redeliver(ctx context.Context) {
+	ticker := time.NewTicker(s.redeliveryInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.redeliverDue(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("failed to redeliver signal events", slog.Any("error", err), slog.String("signal", s.id))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Signal[T]) redeliverDue(ctx context.Context) error {
+	events, err := s.store.GetDueSignalEvents(ctx, s.id, time.Now(), cap(s.queue))
+	if err != nil {
+		return errors.Errorf("failed to get due events: %w", err)
+	}
+
+	for i := range events {
+		stored := &events[i]
+		if _, loaded := s.inFlight.LoadOrStore(stored.Seq, struct{}{}); loaded {
+			continue
+		}
+
+		payload, err := s.codec.Unmarshal(ctx, stored.Payload)
+		if err != nil {
+			s.settle(ctx, stored, errors.Errorf("failed to decode event: %w", err))
+			continue
+		}
+
+		select {
+		case s.queue <- Event[T]{Payload: payload, Ctx: ctx, stored: stored}:
+		case <-ctx.Done():
+			s.inFlight.Delete(stored.Seq)
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package signals
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	mu          sync.Mutex
+	seq         uint64
+	pending     map[uint64]StoredEvent
+	deadLetters map[uint64]StoredEvent
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		pending:     make(map[uint64]StoredEvent),
+		deadLetters: make(map[uint64]StoredEvent),
+	}
+}
+
+func (m *memoryStore) SaveSignalEvent(_ context.Context, _ string, payload []byte) (StoredEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.seq++
+	now := time.Now()
+	event := StoredEvent{Seq: m.seq, Payload: payload, NextAttemptAt: now, CreatedAt: now}
+	m.pending[event.Seq] = event
+	return event, nil
+}
+
+func (m *memoryStore) GetDueSignalEvents(_ context.Context, _ string, now time.Time, limit int) ([]StoredEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var events []StoredEvent
+	for _, event := range m.pending {
+		if !event.NextAttemptAt.After(now) {
+			events = append(events, event)
+		}
+	}
+	slices.SortFunc(events, func(a, b StoredEvent) int { return int(a.Seq) - int(b.Seq) })
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+func (m *memoryStore) UpdateSignalEvent(_ context.Context, _ string, event StoredEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pending[event.Seq] = event
+	return nil
+}
+
+func (m *memoryStore) RemoveSignalEvent(_ context.Context, _ string, seq uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.pending, seq)
+	return nil
+}
+
+func (m *memoryStore) MoveSignalEventToDeadLetter(_ context.Context, _ string, event StoredEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.pending, event.Seq)
+	m.deadLetters[event.Seq] = event
+	return nil
+}
+
+func (m *memoryStore) GetDeadLetterSignalEvents(_ context.Context, _ string, _ int) ([]StoredEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var events []StoredEvent
+	for _, event := range m.deadLetters {
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (m *memoryStore) CountSignalEvents(_ context.Context, _ string) (int, int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.pending), len(m.deadLetters), nil
+}
+
+var intCodec = Codec[int]{
+	Marshal: func(v int) ([]byte, error) { return []byte(strconv.Itoa(v)), nil },
+	Unmarshal: func(_ context.Context, data []byte) (int, error) {
+		return strconv.Atoi(string(data))
+	},
+}
+
+func durableTestConfig() Config {
+	cfg := DefaultConfig()
+	cfg.Durable = true
+	cfg.MaxAttempts = 3
+	cfg.RetryBackoff = time.Millisecond
+	cfg.MaxRetryBackoff = 5 * time.Millisecond
+	return cfg
+}
+
+func newDurableTestSignal(t *testing.T, store Store, handler SignalListener[int]) *Signal[int] {
+	t.Helper()
+	sig := New[int](durableTestConfig(), "durable-test", handler)
+	sig.redeliveryInterval = 5 * time.Millisecond
+	require.NoError(t, sig.SetStore(store, intCodec))
+	return sig
+}
+
+func TestDurable_AcknowledgesDeliveredEvents(t *testing.T) {
+	t.Parallel()
+
+	store := newMemoryStore()
+	var received atomic.Int64
+	sig := newDurableTestSignal(t, store, func(_ context.Context, v int) error {
+		received.Add(int64(v))
+		return nil
+	})
+	require.NoError(t, sig.StartWorkers(t.Context()))
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, sig.Emit(i))
+	}
+
+	require.Eventually(t, func() bool {
+		stats, err := sig.Stats(t.Context())
+		require.NoError(t, err)
+		return received.Load() == 6 && stats.Pending == 0
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestDurable_RetriesFailedEventsWithBackoff(t *testing.T) {
+	t.Parallel()
+
+	store := newMemoryStore()
+	var calls atomic.Int64
+	sig := newDurableTestSignal(t, store, func(_ context.Context, _ int) error {
+		if calls.Add(1) < 3 {
+			return errors.New("transient failure")
+		}
+		return nil
+	})
+	require.NoError(t, sig.StartWorkers(t.Context()))
+	require.NoError(t, sig.Emit(42))
+
+	require.Eventually(t, func() bool {
+		pending, deadLetters, err := store.CountSignalEvents(t.Context(), "durable-test")
+		require.NoError(t, err)
+		return calls.Load() == 3 && pending == 0 && deadLetters == 0
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestDurable_DeadLettersAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	store := newMemoryStore()
+	var calls atomic.Int64
+	sig := newDurableTestSignal(t, store, func(_ context.Context, _ int) error {
+		calls.Add(1)
+		return errors.New("permanent failure")
+	})
+	require.NoError(t, sig.StartWorkers(t.Context()))
+	require.NoError(t, sig.Emit(7))
+
+	require.Eventually(t, func() bool {
+		stats, err := sig.Stats(t.Context())
+		require.NoError(t, err)
+		return stats.DeadLetters == 1 && stats.Pending == 0
+	}, time.Second, 5*time.Millisecond)
+	require.EqualValues(t, 3, calls.Load())
+
+	deadLetters, err := sig.DeadLetters(t.Context(), 10)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	require.EqualValues(t, 3, deadLetters[0].Attempts)
+	require.Equal(t, "permanent failure", deadLetters[0].LastError)
+	require.Equal(t, []byte("7"), deadLetters[0].Payload)
+}
+
+func TestDurable_RecoversEventsPersistedByPreviousRun(t *testing.T) {
+	t.Parallel()
+
+	store := newMemoryStore()
+	_, err := store.SaveSignalEvent(t.Context(), "durable-test", []byte("5"))
+	require.NoError(t, err)
+
+	received := make(chan int, 1)
+	sig := newDurableTestSignal(t, store, func(_ context.Context, v int) error {
+		received <- v
+		return nil
+	})
+	require.NoError(t, sig.StartWorkers(t.Context()))
+
+	select {
+	case v := <-received:
+		require.Equal(t, 5, v)
+	case <-time.After(time.Second):
+		t.Fatal("persisted event was not redelivered")
+	}
+}
+
+func TestDurable_KeepsEventWhenQueueIsFull(t *testing.T) {
+	t.Parallel()
+
+	store := newMemoryStore()
+	cfg := durableTestConfig()
+	cfg.BufferSize = 1
+	sig := New[int](cfg, "durable-test", func(_ context.Context, _ int) error { return nil })
+	require.NoError(t, sig.SetStore(store, intCodec))
+
+	// workers are not started, so the second event does not fit into the queue
+	require.NoError(t, sig.EmitWithTimeout(1, 10*time.Millisecond))
+	require.NoError(t, sig.EmitWithTimeout(2, 10*time.Millisecond))
+
+	stats, err := sig.Stats(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, stats.Queued)
+	require.Equal(t, 2, stats.Pending)
+}
+
+func TestSetStore_FailsAfterWorkersStarted(t *testing.T) {
+	t.Parallel()
+
+	sig := New[int](durableTestConfig(), "durable-test", func(_ context.Context, _ int) error { return nil })
+	require.NoError(t, sig.StartWorkers(t.Context()))
+	require.Error(t, sig.SetStore(newMemoryStore(), intCodec))
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	sig := New[int](Config{RetryBackoff: time.Second, MaxRetryBackoff: 5 * time.Second}, "backoff")
+	require.Equal(t, time.Second, sig.retryBackoff(1))
+	require.Equal(t, 2*time.Second, sig.retryBackoff(2))
+	require.Equal(t, 4*time.Second, sig.retryBackoff(3))
+	require.Equal(t, 5*time.Second, sig.retryBackoff(4))
+	require.Equal(t, 5*time.Second, sig.retryBackoff(100))
+}
@@ -22,11 +22,19 @@ type Signal[T any] struct {
 	handlers   []SignalListener[T]
 	maxWorkers int
 	id         string
+	cfg        Config
+
+	// Durable backend, nil for in-memory signals
+	store              Store
+	codec              Codec[T]
+	inFlight           sync.Map // map[seq]struct{}
+	redeliveryInterval time.Duration
 
 	// Internal state
-	started bool
-	stopped atomic.Bool
-	mutex   sync.RWMutex
+	started    bool
+	stopped    atomic.Bool
+	processing atomic.Int64
+	mutex      sync.RWMutex
 }
 
 // Config defines the configuration for a Signal instance.
@@ -35,12 +43,23 @@ type Config struct {
 	BufferSize int `mapstructure:"buffer-size" validate:"gte=5"`
 	// WorkerCount sets the number of worker goroutines to process events (5-100)
 	WorkerCount int `mapstructure:"worker-count" validate:"gte=5,lte=100"`
+	// Durable enables persisting events in a Store for signals that have one attached
+	Durable bool `mapstructure:"durable"`
+	// MaxAttempts is the number of delivery attempts before a durable event is dead-lettered (0 retries forever)
+	MaxAttempts int `mapstructure:"max-attempts" validate:"gte=0"`
+	// RetryBackoff is the delay before the first redelivery of a failed durable event, doubled on every attempt
+	RetryBackoff time.Duration `mapstructure:"retry-backoff" validate:"gte=0"`
+	// MaxRetryBackoff caps the redelivery delay of failed durable events
+	MaxRetryBackoff time.Duration `mapstructure:"max-retry-backoff" validate:"gte=0"`
 }
 
 func DefaultConfig() Config {
 	return Config{
-		BufferSize:  10,
-		WorkerCount: 10,
+		BufferSize:      10,
+		WorkerCount:     10,
+		MaxAttempts:     5,
+		RetryBackoff:    time.Second,
+		MaxRetryBackoff: time.Minute,
 	}
 }
 
@@ -50,6 +69,9 @@ type Event[T any] struct {
 	Payload T
 	// Ctx is the context associated with this event for cancellation and timeout handling
 	Ctx context.Context
+
+	// stored is the persisted form of the event, nil for in-memory signals
+	stored *StoredEvent
 }
 
 // SignalListener defines the function signature for handling signal events.
@@ -70,13 +92,20 @@ func New[T any](cfg Config, id string, handlers ...SignalListener[T]) *Signal[T]
 	}
 
 	return &Signal[T]{
-		queue:      make(chan Event[T], cfg.BufferSize),
-		handlers:   validHandlers,
-		maxWorkers: cfg.WorkerCount,
-		id:         id,
+		queue:              make(chan Event[T], cfg.BufferSize),
+		handlers:           validHandlers,
+		maxWorkers:         cfg.WorkerCount,
+		id:                 id,
+		cfg:                cfg,
+		redeliveryInterval: RedeliveryInterval,
 	}
 }
 
+// ID returns the identifier of the signal.
+func (s *Signal[T]) ID() string {
+	return s.id
+}
+
 // SetHandlers sets the event handlers for this signal.
 // Returns an error if workers are started or handlers are already set, as handlers cannot be replaced.
 // This method is thread-safe and should be called before starting workers.
@@ -132,6 +161,10 @@ func (s *Signal[T]) EmitWithTimeout(payload T, timeout time.Duration) error {
 	}
 	s.mutex.RUnlock()
 
+	if s.store != nil {
+		return s.emitDurable(context.Background(), payload, timeout)
+	}
+
 	event := Event[T]{Payload: payload}
 
 	emitCtx, cancel := context.WithTimeout(context.Background(), timeout)
@@ -147,11 +180,16 @@ func (s *Signal[T]) EmitWithTimeout(payload T, timeout time.Duration) error {
 
 // EmitNonBlocking attempts to send an event without blocking.
 // Returns true if the event was sent, false if the queue is full or workers have stopped.
+// For durable signals the event counts as sent once it is persisted.
 func (s *Signal[T]) EmitNonBlocking(ctx context.Context, payload T) bool {
 	if s.stopped.Load() {
 		return false
 	}
 
+	if s.store != nil {
+		return s.emitDurable(ctx, payload, 0) == nil
+	}
+
 	select {
 	case s.queue <- Event[T]{Payload: payload, Ctx: ctx}:
 		return true
@@ -195,6 +233,8 @@ func (s *Signal[T]) StartWorkers(ctx context.Context) error {
 						slog.Info("signal queue closed, shutting down worker", slog.Int("worker", j), slog.String("signal", s.id))
 						return
 					}
+					s.processing.Add(1)
+					var handlerErrs []error
 					// Execute all handlers regardless of errors
 					for i, handler := range handlers {
 						if err := handler(ctx, event.Payload); err != nil {
@@ -204,14 +244,23 @@ func (s *Signal[T]) StartWorkers(ctx context.Context) error {
 								slog.Int("worker", j),
 								slog.Any("event", event.Payload),
 								slog.String("signal", s.id))
+							handlerErrs = append(handlerErrs, err)
 							// Continue executing remaining handlers
 						}
 					}
+					if event.stored != nil {
+						s.settle(ctx, event.stored, errors.Join(handlerErrs...))
+					}
+					s.processing.Add(-1)
 				}
 			}
 		}(i)
 	}
 
+	if s.store != nil {
+		go s.redeliver(ctx)
+	}
+
 	go func() {
 		// wait for all workers to finish
 		shutdownWG.Wait()
@@ -58,7 +58,8 @@ type IEvmClient interface {
 	GetValSetHeader(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.ValidatorSetHeader, error)
 	GetVotingPowers(ctx context.Context, address symbiotic.CrossChainAddress, timestamp symbiotic.Timestamp) ([]symbiotic.OperatorVotingPower, error)
 	GetKeys(ctx context.Context, address symbiotic.CrossChainAddress, timestamp symbiotic.Timestamp) ([]symbiotic.OperatorWithKeys, error)
-	CommitValsetHeader(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte) (symbiotic.TxResult, error)
+	CommitValsetHeader(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte, opts ...symbiotic.EVMOption) (symbiotic.TxResult, error)
+	SimulateCommitValsetHeader(ctx context.Context, addr symbiotic.CrossChainAddress, header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData, proof []byte) (symbiotic.TxSimulation, error)
 	RegisterOperator(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.TxResult, error)
 	RegisterKey(ctx context.Context, addr symbiotic.CrossChainAddress, keyTag symbiotic.KeyTag, key symbiotic.CompactPublicKey, signature symbiotic.RawSignature, extraData []byte) (symbiotic.TxResult, error)
 	InvalidateOldSignatures(ctx context.Context, addr symbiotic.CrossChainAddress) (symbiotic.TxResult, error)
@@ -146,6 +147,11 @@ type Config struct {
 	Metrics           metrics
 	MaxCalls          int
 	FallbackGasPrices map[uint64]uint64 // Per-chain gas price in wei when eth_maxPriorityFeePerGas is not supported (default: 2 GWei)
+	// DryRun makes write methods only simulate their transactions, the simulation is returned in TxResult.DryRun
+	DryRun bool
+	// UnsignedFrom makes write methods build their transactions unsigned from this address for an external signer,
+	// e.g. a multisig, instead of signing and sending them. The transaction is returned in TxResult.Unsigned.
+	UnsignedFrom common.Address
 }
 
 func (c Config) Validate() error {
@@ -908,6 +914,12 @@ func (e *Client) formatEVMContractError(meta metadata, originalErr error) error
 }
 
 func (e *Client) formatEVMError(err error) error {
+	if data, ok := revertData(err); ok {
+		if reason, ok := decodeRevert(data); ok {
+			return errors.Errorf("%w: %s", err, reason)
+		}
+	}
+
 	type jsonError interface {
 		Error() string
 		ErrorData() interface{}
@@ -1005,15 +1017,7 @@ func (e *Client) getOperatorRegistryContract(addr symbiotic.CrossChainAddress) (
 }
 
 func findErrorBySelector(errSelector string) (abi.Error, bool) {
-	errorDefs := map[string]*bind.MetaData{
-		"keyRegistry":         gen.KeyRegistryMetaData,
-		"operatorRegistry":    gen.OperatorRegistryMetaData,
-		"settlement":          gen.SettlementMetaData,
-		"driver":              gen.ValSetDriverMetaData,
-		"votingPowerProvider": gen.VotingPowerProviderMetaData,
-	}
-
-	for contract, meta := range errorDefs {
+	for contract, meta := range bundledABIs {
 		contractAbi, err := meta.GetAbi()
 		if err != nil {
 			slog.Warn("Failed to get ABI", "contract", contract, "error", err)
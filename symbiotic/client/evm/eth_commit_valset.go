@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -19,7 +20,64 @@ func (e *Client) CommitValsetHeader(
 	header symbiotic.ValidatorSetHeader,
 	extraData []symbiotic.ExtraData,
 	proof []byte,
+	opts ...symbiotic.EVMOption,
 ) (_ symbiotic.TxResult, err error) {
+	headerDTO, extraDataDTO := commitValsetHeaderArgs(header, extraData)
+
+	settlement, err := e.getSettlementContract(addr)
+	if err != nil {
+		return symbiotic.TxResult{}, errors.Errorf("failed to get settlement contract: %w", err)
+	}
+
+	tx, err := e.doTransaction(ctx, "CommitValsetHeader", addr, func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+		return settlement.CommitValSetHeader(txOpts, headerDTO, extraDataDTO, proof)
+	}, opts...)
+	if err != nil {
+		return tx, errors.Errorf("failed to commit valset header: %w", err)
+	}
+	if tx.DryRun != nil || tx.Unsigned != nil {
+		return tx, nil
+	}
+
+	slog.DebugContext(ctx, "Valset header committed", "receipt", tx)
+	e.metrics.ObserveCommitValsetHeaderParams(addr.ChainId, tx.GasUsed, tx.EffectiveGasPrice)
+
+	return tx, nil
+}
+
+// SimulateCommitValsetHeader predicts the outcome of CommitValsetHeader with eth_call without sending a transaction.
+// A commit that would revert is returned as an error along with the simulation.
+func (e *Client) SimulateCommitValsetHeader(
+	ctx context.Context,
+	addr symbiotic.CrossChainAddress,
+	header symbiotic.ValidatorSetHeader,
+	extraData []symbiotic.ExtraData,
+	proof []byte,
+) (_ symbiotic.TxSimulation, err error) {
+	headerDTO, extraDataDTO := commitValsetHeaderArgs(header, extraData)
+
+	settlement, err := e.getSettlementContract(addr)
+	if err != nil {
+		return symbiotic.TxSimulation{}, errors.Errorf("failed to get settlement contract: %w", err)
+	}
+
+	txOpts, err := e.newTransactOpts(addr.ChainId)
+	if err != nil {
+		return symbiotic.TxSimulation{}, err
+	}
+	tmCtx, cancel := context.WithTimeout(ctx, e.cfg.RequestTimeout)
+	defer cancel()
+	defer func(now time.Time) {
+		e.observeMetrics("SimulateCommitValsetHeader", addr.ChainId, err, now)
+	}(time.Now())
+	txOpts.Context = tmCtx
+
+	return e.simulateTransaction(tmCtx, addr.ChainId, txOpts, func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
+		return settlement.CommitValSetHeader(txOpts, headerDTO, extraDataDTO, proof)
+	})
+}
+
+func commitValsetHeaderArgs(header symbiotic.ValidatorSetHeader, extraData []symbiotic.ExtraData) (gen.ISettlementValSetHeader, []gen.ISettlementExtraData) {
 	headerDTO := gen.ISettlementValSetHeader{
 		Version:            header.Version,
 		RequiredKeyTag:     uint8(header.RequiredKeyTag),
@@ -36,20 +94,5 @@ func (e *Client) CommitValsetHeader(
 		extraDataDTO[i].Value = extraData.Value
 	}
 
-	settlement, err := e.getSettlementContract(addr)
-	if err != nil {
-		return symbiotic.TxResult{}, errors.Errorf("failed to get settlement contract: %w", err)
-	}
-
-	tx, err := e.doTransaction(ctx, "CommitValsetHeader", addr, func(txOpts *bind.TransactOpts) (*types.Transaction, error) {
-		return settlement.CommitValSetHeader(txOpts, headerDTO, extraDataDTO, proof)
-	})
-	if err != nil {
-		return symbiotic.TxResult{}, errors.Errorf("failed to commit valset header: %w", err)
-	}
-
-	slog.DebugContext(ctx, "Valset header committed", "receipt", tx)
-	e.metrics.ObserveCommitValsetHeaderParams(addr.ChainId, tx.GasUsed, tx.EffectiveGasPrice)
-
-	return tx, nil
+	return headerDTO, extraDataDTO
 }
@@ -0,0 +1,104 @@
+package evm
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/symbiotic/client/evm/gen"
+)
+
+// bundledABIs are the contract ABIs of symbiotic/client/evm/abi, custom errors are decoded against all of them
+// since a call may revert in any contract it reaches.
+var bundledABIs = map[string]*bind.MetaData{
+	"keyRegistry":         gen.KeyRegistryMetaData,
+	"operatorRegistry":    gen.OperatorRegistryMetaData,
+	"settlement":          gen.SettlementMetaData,
+	"driver":              gen.ValSetDriverMetaData,
+	"votingPowerProvider": gen.VotingPowerProviderMetaData,
+}
+
+// revertData returns the data a JSON-RPC call reverted with, if the error carries any.
+func revertData(err error) ([]byte, bool) {
+	type jsonError interface {
+		ErrorData() interface{}
+	}
+	var errData jsonError
+	if !errors.As(err, &errData) {
+		return nil, false
+	}
+	encoded, ok := errData.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+	data, decodeErr := hexutil.Decode(encoded)
+	if decodeErr != nil || len(data) < 4 {
+		return nil, false
+	}
+	return data, true
+}
+
+// decodeRevert decodes revert data into a readable reason: the message of Error(string), the reason of
+// Panic(uint256) or a custom error of the bundled ABIs together with its arguments.
+func decodeRevert(data []byte) (string, bool) {
+	if len(data) < 4 {
+		return "", false
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason, true
+	}
+
+	for contract, meta := range bundledABIs {
+		contractAbi, err := meta.GetAbi()
+		if err != nil {
+			slog.Warn("Failed to get ABI", "contract", contract, "error", err)
+			continue
+		}
+		for _, errDef := range contractAbi.Errors {
+			if !bytes.Equal(errDef.ID[:4], data[:4]) {
+				continue
+			}
+			return formatCustomError(errDef, data), true
+		}
+	}
+	return "", false
+}
+
+// formatCustomError formats a custom error with its decoded arguments, e.g. InvalidEpoch(epoch=5),
+// the signature is returned if the arguments can't be decoded.
+func formatCustomError(errDef abi.Error, data []byte) string {
+	if len(errDef.Inputs) == 0 {
+		return errDef.Name + "()"
+	}
+	values, err := errDef.Inputs.Unpack(data[4:])
+	if err != nil {
+		return errDef.String()
+	}
+
+	args := make([]string, len(values))
+	for i, value := range values {
+		name := errDef.Inputs[i].Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		args[i] = fmt.Sprintf("%s=%v", name, formatArgument(value))
+	}
+	return errDef.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func formatArgument(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []byte:
+		return hexutil.Encode(v)
+	case [32]byte:
+		return hexutil.Encode(v[:])
+	default:
+		return v
+	}
+}
@@ -0,0 +1,76 @@
+package evm
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/symbioticfi/relay/symbiotic/client/evm/gen"
+)
+
+type testRPCError struct {
+	data interface{}
+}
+
+func (e testRPCError) Error() string          { return "execution reverted" }
+func (e testRPCError) ErrorCode() int         { return 3 }
+func (e testRPCError) ErrorData() interface{} { return e.data }
+
+func packError(t *testing.T, name string, args ...interface{}) []byte {
+	t.Helper()
+
+	contractAbi, err := gen.VotingPowerProviderMetaData.GetAbi()
+	require.NoError(t, err)
+	errDef, ok := contractAbi.Errors[name]
+	require.True(t, ok)
+	packed, err := errDef.Inputs.Pack(args...)
+	require.NoError(t, err)
+	return append(errDef.ID[:4:4], packed...)
+}
+
+func TestDecodeRevert(t *testing.T) {
+	t.Run("custom error with arguments", func(t *testing.T) {
+		account := common.HexToAddress("0x01")
+		reason, ok := decodeRevert(packError(t, "InvalidAccountNonce", account, big.NewInt(7)))
+		require.True(t, ok)
+		require.Equal(t, "InvalidAccountNonce(account="+account.Hex()+", currentNonce=7)", reason)
+	})
+
+	t.Run("custom error of another bundled abi", func(t *testing.T) {
+		contractAbi, err := gen.SettlementMetaData.GetAbi()
+		require.NoError(t, err)
+		selector := contractAbi.Errors["Settlement_InvalidEpoch"].ID
+		reason, ok := decodeRevert(selector[:4])
+		require.True(t, ok)
+		require.Equal(t, "Settlement_InvalidEpoch()", reason)
+	})
+
+	t.Run("error string", func(t *testing.T) {
+		data := hexutil.MustDecode("0x08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000004" +
+			"6f6f707300000000000000000000000000000000000000000000000000000000")
+		reason, ok := decodeRevert(data)
+		require.True(t, ok)
+		require.Equal(t, "oops", reason)
+	})
+
+	t.Run("unknown selector", func(t *testing.T) {
+		_, ok := decodeRevert([]byte{0xde, 0xad, 0xbe, 0xef})
+		require.False(t, ok)
+	})
+}
+
+func TestFormatEVMError_DecodesRevertData(t *testing.T) {
+	client := &Client{}
+	account := common.HexToAddress("0x01")
+	err := client.formatEVMError(testRPCError{data: hexutil.Encode(packError(t, "InvalidAccountNonce", account, big.NewInt(7)))})
+	require.ErrorContains(t, err, "execution reverted: InvalidAccountNonce(account="+account.Hex()+", currentNonce=7)")
+
+	plain := errors.New("connection refused")
+	require.Equal(t, plain, client.formatEVMError(plain))
+}
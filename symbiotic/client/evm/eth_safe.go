@@ -0,0 +1,57 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/go-errors/errors"
+
+	"github.com/symbioticfi/relay/internal/entity"
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// safeABI is the part of the Safe multisig ABI needed to propose transactions
+var safeABI = sync.OnceValues(func() (abi.ABI, error) {
+	return abi.JSON(strings.NewReader(`[{"type":"function","name":"nonce","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"}]`))
+})
+
+// GetSafeNonce returns the nonce the next transaction of the Safe multisig has to use.
+func (e *Client) GetSafeNonce(ctx context.Context, safe symbiotic.CrossChainAddress) (_ *big.Int, err error) {
+	toCtx, cancel := context.WithTimeout(ctx, e.cfg.RequestTimeout)
+	defer cancel()
+	defer func(now time.Time) {
+		e.observeMetrics("GetSafeNonce", safe.ChainId, err, now)
+	}(time.Now())
+
+	client, ok := e.conns[safe.ChainId]
+	if !ok {
+		return nil, errors.Errorf("no connection for chain ID %d: %w", safe.ChainId, entity.ErrChainNotFound)
+	}
+	parsed, err := safeABI()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse safe abi: %w", err)
+	}
+	data, err := parsed.Pack("nonce")
+	if err != nil {
+		return nil, errors.Errorf("failed to pack nonce call: %w", err)
+	}
+
+	out, err := client.CallContract(toCtx, ethereum.CallMsg{To: &safe.Address, Data: data}, nil)
+	if err != nil {
+		return nil, errors.Errorf("failed to call nonce of safe %s: %w", safe.Address.Hex(), err)
+	}
+	values, err := parsed.Unpack("nonce", out)
+	if err != nil || len(values) != 1 {
+		return nil, errors.Errorf("failed to unpack nonce of safe %s, is it a Safe multisig?: %v", safe.Address.Hex(), err)
+	}
+	nonce, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, errors.Errorf("unexpected nonce type %T", values[0])
+	}
+	return nonce, nil
+}
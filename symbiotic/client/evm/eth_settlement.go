@@ -7,8 +7,10 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/go-errors/errors"
 
 	keyprovider "github.com/symbioticfi/relay/internal/usecase/key-provider"
@@ -40,25 +42,13 @@ func (e *Client) AddSettlement(
 	})
 }
 
-func (e *Client) doTransaction(ctx context.Context, method string, addr symbiotic.CrossChainAddress, f func(opts *bind.TransactOpts) (*types.Transaction, error), opts ...symbiotic.EVMOption) (symbiotic.TxResult, error) {
+func (e *Client) doTransaction(ctx context.Context, method string, addr symbiotic.CrossChainAddress, f func(opts *bind.TransactOpts) (*types.Transaction, error), opts ...symbiotic.EVMOption) (_ symbiotic.TxResult, err error) {
 	evmOpts := symbiotic.AppliedEVMOptions(opts...)
 
-	pk, err := e.cfg.KeyProvider.GetPrivateKeyByNamespaceTypeId(
-		keyprovider.EVM_KEY_NAMESPACE,
-		symbiotic.KeyTypeEcdsaSecp256k1,
-		int(addr.ChainId),
-	)
+	txOpts, err := e.newTransactOpts(addr.ChainId)
 	if err != nil {
 		return symbiotic.TxResult{}, err
 	}
-	ecdsaKey, err := crypto.ToECDSA(pk.Bytes())
-	if err != nil {
-		return symbiotic.TxResult{}, err
-	}
-	txOpts, err := bind.NewKeyedTransactorWithChainID(ecdsaKey, new(big.Int).SetUint64(addr.ChainId))
-	if err != nil {
-		return symbiotic.TxResult{}, errors.Errorf("failed to create new keyed transactor: %w", err)
-	}
 	tmCtx, cancel := context.WithTimeout(ctx, e.cfg.RequestTimeout)
 	defer cancel()
 	defer func(now time.Time) {
@@ -66,35 +56,30 @@ func (e *Client) doTransaction(ctx context.Context, method string, addr symbioti
 	}(time.Now())
 	txOpts.Context = tmCtx
 
-	if !e.conns[addr.ChainId].hasMaxPriorityFeePerGasMethod {
-		gasPrice, ok := e.cfg.FallbackGasPrices[addr.ChainId]
-		if !ok {
-			gasPrice = 2_000_000_000 // default: 2 GWei
+	if evmOpts.MaxFeePerGas != nil {
+		if err := e.capFeePerGas(tmCtx, addr.ChainId, txOpts, evmOpts.MaxFeePerGas); err != nil {
+			return symbiotic.TxResult{}, err
 		}
-		txOpts.GasPrice = new(big.Int).SetUint64(gasPrice)
 	}
 
-	// If GasLimitMultiplier is set, estimate gas and apply multiplier
+	// every transaction is simulated first so that reverts are reported with their decoded reason before anything is sent
+	simulation, err := e.simulateTransaction(tmCtx, addr.ChainId, txOpts, f)
+	if e.cfg.DryRun {
+		return symbiotic.TxResult{DryRun: &simulation}, err
+	}
+	if err != nil {
+		return symbiotic.TxResult{}, err
+	}
+
 	if evmOpts.GasLimitMultiplier > 0 {
-		txOpts.NoSend = true
-		dryRunTx, err := f(txOpts)
-		if err != nil {
-			return symbiotic.TxResult{}, e.formatEVMError(err)
-		}
+		txOpts.GasLimit = uint64(float64(simulation.GasEstimate) * evmOpts.GasLimitMultiplier)
+	}
 
-		msg := ethereum.CallMsg{
-			From:  txOpts.From,
-			To:    dryRunTx.To(),
-			Data:  dryRunTx.Data(),
-			Value: dryRunTx.Value(),
-		}
-		estimatedGas, err := e.conns[addr.ChainId].EstimateGas(tmCtx, msg)
-		if err != nil {
-			return symbiotic.TxResult{}, errors.Errorf("failed to estimate gas: %w", err)
+	if e.cfg.UnsignedFrom != (common.Address{}) {
+		if txOpts.GasLimit == 0 {
+			txOpts.GasLimit = simulation.GasEstimate
 		}
-
-		txOpts.GasLimit = uint64(float64(estimatedGas) * evmOpts.GasLimitMultiplier)
-		txOpts.NoSend = false
+		return e.buildUnsignedTransaction(addr.ChainId, txOpts, f)
 	}
 
 	tx, err := f(txOpts)
@@ -102,6 +87,10 @@ func (e *Client) doTransaction(ctx context.Context, method string, addr symbioti
 		return symbiotic.TxResult{}, e.formatEVMError(err)
 	}
 
+	if evmOpts.OnTxSent != nil {
+		evmOpts.OnTxSent(tx.Hash())
+	}
+
 	receipt, err := bind.WaitMined(ctx, e.conns[addr.ChainId], tx)
 	if err != nil {
 		return symbiotic.TxResult{}, errors.Errorf("failed to wait for tx mining: %w", err)
@@ -121,3 +110,190 @@ func (e *Client) doTransaction(ctx context.Context, method string, addr symbioti
 		EffectiveGasPrice: receipt.EffectiveGasPrice,
 	}, nil
 }
+
+// newTransactOpts creates transact options signed by the EVM key of the chain, or unsigned ones from
+// Config.UnsignedFrom, with the fallback legacy gas price set if the chain doesn't support EIP-1559 fee suggestions.
+func (e *Client) newTransactOpts(chainID uint64) (*bind.TransactOpts, error) {
+	var txOpts *bind.TransactOpts
+	if e.cfg.UnsignedFrom != (common.Address{}) {
+		txOpts = &bind.TransactOpts{
+			From: e.cfg.UnsignedFrom,
+			// transactions are signed externally, bind gets them back as built
+			Signer: func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				return tx, nil
+			},
+		}
+	} else {
+		pk, err := e.cfg.KeyProvider.GetPrivateKeyByNamespaceTypeId(
+			keyprovider.EVM_KEY_NAMESPACE,
+			symbiotic.KeyTypeEcdsaSecp256k1,
+			int(chainID),
+		)
+		if err != nil {
+			return nil, err
+		}
+		ecdsaKey, err := crypto.ToECDSA(pk.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		txOpts, err = bind.NewKeyedTransactorWithChainID(ecdsaKey, new(big.Int).SetUint64(chainID))
+		if err != nil {
+			return nil, errors.Errorf("failed to create new keyed transactor: %w", err)
+		}
+	}
+
+	if !e.conns[chainID].hasMaxPriorityFeePerGasMethod {
+		gasPrice, ok := e.cfg.FallbackGasPrices[chainID]
+		if !ok {
+			gasPrice = 2_000_000_000 // default: 2 GWei
+		}
+		txOpts.GasPrice = new(big.Int).SetUint64(gasPrice)
+	}
+
+	return txOpts, nil
+}
+
+// capFeePerGas sets the fees of txOpts so that the transaction pays at most maxFeePerGas per gas.
+func (e *Client) capFeePerGas(ctx context.Context, chainID uint64, txOpts *bind.TransactOpts, maxFeePerGas *big.Int) error {
+	if txOpts.GasPrice != nil {
+		txOpts.GasPrice = minBigInt(txOpts.GasPrice, maxFeePerGas)
+		return nil
+	}
+
+	client := e.conns[chainID]
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return errors.Errorf("failed to get latest header: %w", err)
+	}
+	if head.BaseFee == nil {
+		gasPrice, err := client.SuggestGasPrice(ctx)
+		if err != nil {
+			return errors.Errorf("failed to suggest gas price: %w", err)
+		}
+		txOpts.GasPrice = minBigInt(gasPrice, maxFeePerGas)
+		return nil
+	}
+
+	tip, err := client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return errors.Errorf("failed to suggest gas tip cap: %w", err)
+	}
+	txOpts.GasTipCap = minBigInt(tip, maxFeePerGas)
+	// same fee cap as bind uses by default, bounded by the maximum
+	feeCap := new(big.Int).Add(txOpts.GasTipCap, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	txOpts.GasFeeCap = minBigInt(feeCap, maxFeePerGas)
+	return nil
+}
+
+// buildUnsignedTransaction builds the transaction for an external signer without sending it.
+func (e *Client) buildUnsignedTransaction(chainID uint64, txOpts *bind.TransactOpts, f func(opts *bind.TransactOpts) (*types.Transaction, error)) (symbiotic.TxResult, error) {
+	txOpts.NoSend = true
+	tx, err := f(txOpts)
+	if err != nil {
+		return symbiotic.TxResult{}, errors.Errorf("failed to build unsigned transaction: %w", e.formatEVMError(err))
+	}
+
+	unsigned := &symbiotic.UnsignedTx{
+		ChainID:  chainID,
+		From:     txOpts.From,
+		To:       *tx.To(),
+		Value:    tx.Value(),
+		Calldata: tx.Data(),
+		Nonce:    tx.Nonce(),
+		GasLimit: tx.Gas(),
+	}
+	if tx.Type() == types.LegacyTxType {
+		unsigned.GasPrice = tx.GasPrice()
+	} else {
+		unsigned.GasFeeCap = tx.GasFeeCap()
+		unsigned.GasTipCap = tx.GasTipCap()
+	}
+	return symbiotic.TxResult{Unsigned: unsigned}, nil
+}
+
+// simulateTransaction builds the transaction without sending it and simulates it with eth_call at the pending block.
+// The simulation is also returned if the transaction would revert, the error then carries the decoded revert reason.
+func (e *Client) simulateTransaction(ctx context.Context, chainID uint64, txOpts *bind.TransactOpts, f func(opts *bind.TransactOpts) (*types.Transaction, error)) (symbiotic.TxSimulation, error) {
+	simOpts := *txOpts
+	simOpts.NoSend = true
+	// a gas limit keeps bind from estimating gas, so that a revert is reported by eth_call below
+	simOpts.GasLimit = simulationGasLimit
+
+	tx, err := f(&simOpts)
+	if err != nil {
+		return symbiotic.TxSimulation{}, errors.Errorf("failed to build transaction: %w", e.formatEVMError(err))
+	}
+
+	simulation := symbiotic.TxSimulation{
+		From:     simOpts.From,
+		To:       *tx.To(),
+		Calldata: tx.Data(),
+	}
+	msg := ethereum.CallMsg{
+		From:  simOpts.From,
+		To:    tx.To(),
+		Data:  tx.Data(),
+		Value: tx.Value(),
+	}
+
+	client := e.conns[chainID]
+	if _, err := client.CallContract(ctx, msg, big.NewInt(rpc.PendingBlockNumber.Int64())); err != nil {
+		err = e.formatEVMError(err)
+		simulation.RevertReason = err.Error()
+		return simulation, errors.Errorf("transaction would revert: %w", err)
+	}
+	simulation.GasEstimate, err = client.EstimateGas(ctx, msg)
+	if err != nil {
+		return simulation, errors.Errorf("failed to estimate gas: %w", e.formatEVMError(err))
+	}
+	simulation.FeePerGas, err = e.suggestFeePerGas(ctx, chainID, txOpts)
+	if err != nil {
+		return simulation, err
+	}
+
+	return simulation, nil
+}
+
+// simulationGasLimit is the gas limit of transactions that are only built for simulation
+const simulationGasLimit = 30_000_000
+
+// suggestFeePerGas returns the fee per gas in wei a transaction sent now would pay on the chain,
+// bounded by the fees set in txOpts.
+func (e *Client) suggestFeePerGas(ctx context.Context, chainID uint64, txOpts *bind.TransactOpts) (*big.Int, error) {
+	if txOpts.GasPrice != nil {
+		return txOpts.GasPrice, nil
+	}
+
+	client := e.conns[chainID]
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Errorf("failed to get latest header: %w", err)
+	}
+	if head.BaseFee == nil {
+		gasPrice, err := client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, errors.Errorf("failed to suggest gas price: %w", err)
+		}
+		return gasPrice, nil
+	}
+
+	tip := txOpts.GasTipCap
+	if tip == nil {
+		tip, err = client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, errors.Errorf("failed to suggest gas tip cap: %w", err)
+		}
+	}
+	feePerGas := new(big.Int).Add(head.BaseFee, tip)
+	if txOpts.GasFeeCap != nil {
+		feePerGas = minBigInt(feePerGas, txOpts.GasFeeCap)
+	}
+	return feePerGas, nil
+}
+
+func minBigInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) <= 0 {
+		return a
+	}
+	return b
+}
@@ -7,7 +7,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -1581,3 +1584,89 @@ func TestGetConfig_GetEpochDurationError_ReturnsError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to get current epoch duration")
 	assert.Equal(t, symbiotic.NetworkConfig{}, config)
 }
+
+func TestCapFeePerGas_DynamicFees_CapsTipAndFeeCap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockConn := mocks.NewMockconn(ctrl)
+	client := &Client{
+		conns: map[uint64]clientWithInfo{
+			1: {conn: mockConn, hasMaxPriorityFeePerGasMethod: true},
+		},
+	}
+
+	mockConn.EXPECT().HeaderByNumber(gomock.Any(), gomock.Nil()).Return(&types.Header{BaseFee: big.NewInt(100)}, nil).Times(2)
+	mockConn.EXPECT().SuggestGasTipCap(gomock.Any()).Return(big.NewInt(10), nil).Times(2)
+
+	txOpts := &bind.TransactOpts{}
+	require.NoError(t, client.capFeePerGas(t.Context(), 1, txOpts, big.NewInt(150)))
+	require.Equal(t, big.NewInt(10), txOpts.GasTipCap)
+	require.Equal(t, big.NewInt(150), txOpts.GasFeeCap)
+
+	txOpts = &bind.TransactOpts{}
+	require.NoError(t, client.capFeePerGas(t.Context(), 1, txOpts, big.NewInt(1000)))
+	require.Equal(t, big.NewInt(10), txOpts.GasTipCap)
+	require.Equal(t, big.NewInt(210), txOpts.GasFeeCap)
+}
+
+func TestCapFeePerGas_LegacyGasPrice_CapsGasPrice(t *testing.T) {
+	client := &Client{conns: map[uint64]clientWithInfo{1: {}}}
+
+	txOpts := &bind.TransactOpts{GasPrice: big.NewInt(2_000_000_000)}
+	require.NoError(t, client.capFeePerGas(t.Context(), 1, txOpts, big.NewInt(1_000_000_000)))
+	require.Equal(t, big.NewInt(1_000_000_000), txOpts.GasPrice)
+}
+
+func TestBuildUnsignedTransaction_UnsignedFrom_ReturnsTransactionForExternalSigner(t *testing.T) {
+	safe := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	target := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	client := &Client{
+		cfg:   Config{UnsignedFrom: safe},
+		conns: map[uint64]clientWithInfo{1: {}},
+	}
+
+	txOpts, err := client.newTransactOpts(1)
+	require.NoError(t, err)
+	require.Equal(t, safe, txOpts.From)
+	txOpts.GasLimit = 50_000
+
+	result, err := client.buildUnsignedTransaction(1, txOpts, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		require.True(t, opts.NoSend)
+		tx := types.NewTx(&types.LegacyTx{Nonce: 3, To: &target, Gas: opts.GasLimit, GasPrice: opts.GasPrice, Data: []byte{0xde, 0xad}})
+		return opts.Signer(opts.From, tx)
+	})
+	require.NoError(t, err)
+	require.Equal(t, &symbiotic.UnsignedTx{
+		ChainID:  1,
+		From:     safe,
+		To:       target,
+		Value:    big.NewInt(0),
+		Calldata: []byte{0xde, 0xad},
+		Nonce:    3,
+		GasLimit: 50_000,
+		GasPrice: big.NewInt(2_000_000_000),
+	}, result.Unsigned)
+}
+
+func TestGetSafeNonce_ReturnsNonceOfSafe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	safe := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	mockConn := mocks.NewMockconn(ctrl)
+	client := &Client{
+		cfg:   Config{RequestTimeout: time.Second},
+		conns: map[uint64]clientWithInfo{1: {conn: mockConn}},
+	}
+
+	mockConn.EXPECT().CallContract(gomock.Any(), gomock.Any(), gomock.Nil()).DoAndReturn(
+		func(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+			require.Equal(t, safe, *msg.To)
+			return common.LeftPadBytes([]byte{7}, 32), nil
+		})
+
+	nonce, err := client.GetSafeNonce(t.Context(), symbiotic.CrossChainAddress{ChainId: 1, Address: safe})
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), nonce)
+}
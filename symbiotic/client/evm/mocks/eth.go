@@ -50,18 +50,23 @@ func (m *MockIEvmClient) EXPECT() *MockIEvmClientMockRecorder {
 }
 
 // CommitValsetHeader mocks base method.
-func (m *MockIEvmClient) CommitValsetHeader(ctx context.Context, addr entity.CrossChainAddress, header entity.ValidatorSetHeader, extraData []entity.ExtraData, proof []byte) (entity.TxResult, error) {
+func (m *MockIEvmClient) CommitValsetHeader(ctx context.Context, addr entity.CrossChainAddress, header entity.ValidatorSetHeader, extraData []entity.ExtraData, proof []byte, opts ...entity.EVMOption) (entity.TxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CommitValsetHeader", ctx, addr, header, extraData, proof)
+	varargs := []any{ctx, addr, header, extraData, proof}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitValsetHeader", varargs...)
 	ret0, _ := ret[0].(entity.TxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CommitValsetHeader indicates an expected call of CommitValsetHeader.
-func (mr *MockIEvmClientMockRecorder) CommitValsetHeader(ctx, addr, header, extraData, proof any) *gomock.Call {
+func (mr *MockIEvmClientMockRecorder) CommitValsetHeader(ctx, addr, header, extraData, proof any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitValsetHeader", reflect.TypeOf((*MockIEvmClient)(nil).CommitValsetHeader), ctx, addr, header, extraData, proof)
+	varargs := append([]any{ctx, addr, header, extraData, proof}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitValsetHeader", reflect.TypeOf((*MockIEvmClient)(nil).CommitValsetHeader), varargs...)
 }
 
 // GetCaptureTimestampFromValsetHeaderAt mocks base method.
@@ -463,6 +468,21 @@ func (mr *MockIEvmClientMockRecorder) SetGenesis(ctx, addr, header, extraData an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGenesis", reflect.TypeOf((*MockIEvmClient)(nil).SetGenesis), ctx, addr, header, extraData)
 }
 
+// SimulateCommitValsetHeader mocks base method.
+func (m *MockIEvmClient) SimulateCommitValsetHeader(ctx context.Context, addr entity.CrossChainAddress, header entity.ValidatorSetHeader, extraData []entity.ExtraData, proof []byte) (entity.TxSimulation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateCommitValsetHeader", ctx, addr, header, extraData, proof)
+	ret0, _ := ret[0].(entity.TxSimulation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateCommitValsetHeader indicates an expected call of SimulateCommitValsetHeader.
+func (mr *MockIEvmClientMockRecorder) SimulateCommitValsetHeader(ctx, addr, header, extraData, proof any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateCommitValsetHeader", reflect.TypeOf((*MockIEvmClient)(nil).SimulateCommitValsetHeader), ctx, addr, header, extraData, proof)
+}
+
 // UnregisterOperatorVotingPowerProvider mocks base method.
 func (m *MockIEvmClient) UnregisterOperatorVotingPowerProvider(ctx context.Context, addr entity.CrossChainAddress) (entity.TxResult, error) {
 	m.ctrl.T.Helper()
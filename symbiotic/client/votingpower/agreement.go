@@ -0,0 +1,203 @@
+package votingpower
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-errors/errors"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+// AgreementPolicy defines how responses of provider replicas are combined.
+type AgreementPolicy string
+
+const (
+	// AgreementFirstHealthy returns the response of the first replica that answers successfully.
+	AgreementFirstHealthy AgreementPolicy = "first-healthy"
+	// AgreementMajority requires more than half of the configured replicas to return identical voting powers.
+	AgreementMajority AgreementPolicy = "majority"
+	// AgreementAllEqual requires every configured replica to return identical voting powers.
+	AgreementAllEqual AgreementPolicy = "all-equal"
+)
+
+// ErrReplicasDisagree is returned when replica responses do not satisfy the agreement policy.
+var ErrReplicasDisagree = errors.New("external voting power provider replicas disagree")
+
+func ParseAgreementPolicy(input string) (AgreementPolicy, error) {
+	switch policy := AgreementPolicy(strings.TrimSpace(input)); policy {
+	case "":
+		return AgreementFirstHealthy, nil
+	case AgreementFirstHealthy, AgreementMajority, AgreementAllEqual:
+		return policy, nil
+	default:
+		return "", errors.Errorf("unknown agreement policy %q, expected one of %s, %s, %s",
+			input, AgreementFirstHealthy, AgreementMajority, AgreementAllEqual)
+	}
+}
+
+func (c *Client) firstHealthy(
+	ctx context.Context,
+	p provider,
+	address symbiotic.CrossChainAddress,
+	timestamp symbiotic.Timestamp,
+) ([]symbiotic.OperatorVotingPower, error) {
+	var lastErr error
+	for i, r := range p.replicas {
+		result, err := r.getVotingPowers(ctx, address, timestamp)
+		if err == nil {
+			return result, nil
+		}
+		lastErr = err
+		if i < len(p.replicas)-1 {
+			slog.WarnContext(ctx, "External voting power provider replica failed, trying next one",
+				"providerId", providerIDString(p.id),
+				"url", r.cfg.URL,
+				"error", err,
+			)
+		}
+	}
+	return nil, lastErr
+}
+
+type replicaResult struct {
+	url    string
+	result []symbiotic.OperatorVotingPower
+	err    error
+}
+
+func (c *Client) agreed(
+	ctx context.Context,
+	p provider,
+	address symbiotic.CrossChainAddress,
+	timestamp symbiotic.Timestamp,
+) ([]symbiotic.OperatorVotingPower, error) {
+	results := make([]replicaResult, len(p.replicas))
+	var wg sync.WaitGroup
+	for i, r := range p.replicas {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := r.getVotingPowers(ctx, address, timestamp)
+			results[i] = replicaResult{url: r.cfg.URL, result: result, err: err}
+		}()
+	}
+	wg.Wait()
+
+	// group successful responses by their canonical representation
+	groups := make(map[string][]int)
+	var order []string
+	var failed []replicaResult
+	for i, res := range results {
+		if res.err != nil {
+			failed = append(failed, res)
+			slog.WarnContext(ctx, "External voting power provider replica failed",
+				"providerId", providerIDString(p.id),
+				"url", res.url,
+				"error", res.err,
+			)
+			continue
+		}
+		key := canonicalVotingPowers(res.result)
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	if len(groups) > 1 {
+		c.reportDisagreement(ctx, p, timestamp, results)
+	}
+
+	required := len(p.replicas)
+	if p.policy == AgreementMajority {
+		required = len(p.replicas)/2 + 1
+	}
+
+	for _, key := range order {
+		if len(groups[key]) >= required {
+			return results[groups[key][0]].result, nil
+		}
+	}
+
+	if len(groups) <= 1 && len(failed) > 0 {
+		return nil, errors.Errorf("external provider %s: %d of %d replicas failed, %s policy requires %d agreeing replicas: %w",
+			providerIDString(p.id), len(failed), len(p.replicas), p.policy, required, failed[0].err)
+	}
+
+	return nil, errors.Errorf("external provider %s: %s policy requires %d agreeing replicas out of %d, got %d distinct responses: %w",
+		providerIDString(p.id), p.policy, required, len(p.replicas), len(groups), ErrReplicasDisagree)
+}
+
+// reportDisagreement logs, for every operator whose voting power differs between replicas,
+// the value returned by each replica and reports the disagreement to metrics.
+func (c *Client) reportDisagreement(ctx context.Context, p provider, timestamp symbiotic.Timestamp, results []replicaResult) {
+	if c.metrics != nil {
+		c.metrics.ObserveExternalVotingPowerDisagreement(providerIDString(p.id), string(p.policy))
+	}
+
+	perOperator := make(map[common.Address]map[int]string)
+	for i, res := range results {
+		if res.err != nil {
+			continue
+		}
+		for _, vp := range res.result {
+			if perOperator[vp.Operator] == nil {
+				perOperator[vp.Operator] = make(map[int]string, len(results))
+			}
+			perOperator[vp.Operator][i] = vp.Vaults[0].VotingPower.String()
+		}
+	}
+
+	operators := make([]common.Address, 0, len(perOperator))
+	for op := range perOperator {
+		operators = append(operators, op)
+	}
+	slices.SortFunc(operators, func(a, b common.Address) int { return a.Cmp(b) })
+
+	for _, op := range operators {
+		values := make([]string, 0, len(results))
+		first, mismatch := "", false
+		for i, res := range results {
+			if res.err != nil {
+				continue
+			}
+			value, ok := perOperator[op][i]
+			if !ok {
+				value = "<missing>"
+			}
+			if len(values) == 0 {
+				first = value
+			} else if value != first {
+				mismatch = true
+			}
+			values = append(values, res.url+"="+value)
+		}
+		if !mismatch {
+			continue
+		}
+		slog.WarnContext(ctx, "External voting power provider replicas disagree on operator voting power",
+			"providerId", providerIDString(p.id),
+			"policy", p.policy,
+			"timestamp", timestamp,
+			"operator", op.Hex(),
+			"votingPowers", strings.Join(values, ", "),
+		)
+	}
+}
+
+// canonicalVotingPowers builds a comparable representation of a normalized provider response.
+func canonicalVotingPowers(vps []symbiotic.OperatorVotingPower) string {
+	var b strings.Builder
+	for _, vp := range vps {
+		b.WriteString(vp.Operator.Hex())
+		b.WriteByte('=')
+		b.WriteString(vp.Vaults[0].VotingPower.String())
+		b.WriteByte(';')
+	}
+	return b.String()
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-errors/errors"
+	"github.com/samber/lo"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
@@ -31,6 +32,8 @@ const (
 type ProviderID [10]byte
 
 // ProviderConfig describes one external voting power provider.
+// The top-level endpoint fields describe the primary replica, additional
+// replicas serving the same provider id can be listed in Replicas.
 type ProviderConfig struct {
 	ID         string            `mapstructure:"id"`
 	URL        string            `mapstructure:"url"`
@@ -39,25 +42,82 @@ type ProviderConfig struct {
 	ServerName string            `mapstructure:"server-name"`
 	Headers    map[string]string `mapstructure:"headers"`
 	Timeout    time.Duration     `mapstructure:"timeout"`
+	Replicas   []ReplicaConfig   `mapstructure:"replicas"`
+	Agreement  AgreementPolicy   `mapstructure:"agreement"`
+}
+
+// ReplicaConfig describes one endpoint serving voting powers for a provider id.
+type ReplicaConfig struct {
+	URL        string            `mapstructure:"url"`
+	Secure     bool              `mapstructure:"secure"`
+	CACertFile string            `mapstructure:"ca-cert-file"`
+	ServerName string            `mapstructure:"server-name"`
+	Headers    map[string]string `mapstructure:"headers"`
+	Timeout    time.Duration     `mapstructure:"timeout"`
+}
+
+func (c ProviderConfig) replicaConfigs() []ReplicaConfig {
+	out := make([]ReplicaConfig, 0, len(c.Replicas)+1)
+	out = append(out, ReplicaConfig{
+		URL:        c.URL,
+		Secure:     c.Secure,
+		CACertFile: c.CACertFile,
+		ServerName: c.ServerName,
+		Headers:    c.Headers,
+		Timeout:    c.Timeout,
+	})
+	for _, r := range c.Replicas {
+		if r.Timeout == 0 {
+			r.Timeout = c.Timeout
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+type metrics interface {
+	ObserveExternalVotingPowerDisagreement(providerID string, policy string)
+}
+
+// Option configures optional Client dependencies.
+type Option func(*Client)
+
+// WithMetrics sets the metrics sink used to report replica disagreements.
+func WithMetrics(m metrics) Option {
+	return func(c *Client) {
+		c.metrics = m
+	}
 }
 
 // Client routes GetVotingPowers calls to configured external providers.
 type Client struct {
 	providers map[ProviderID]provider
+	metrics   metrics
 }
 
 type provider struct {
-	cfg    ProviderConfig
+	id       ProviderID
+	policy   AgreementPolicy
+	replicas []replica
+}
+
+type replica struct {
+	cfg    ReplicaConfig
 	conn   *grpc.ClientConn
 	client votingpowerv1.VotingPowerProviderServiceClient
 }
 
 // NewClient creates a new external voting power client and validates provider connectivity.
-func NewClient(ctx context.Context, cfgs []ProviderConfig) (*Client, error) {
+// A provider backed by several replicas only requires one of them to be reachable on startup.
+func NewClient(ctx context.Context, cfgs []ProviderConfig, opts ...Option) (*Client, error) {
 	c := &Client{
 		providers: make(map[ProviderID]provider, len(cfgs)),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
 	orderedIDs := make([]ProviderID, 0, len(cfgs))
+	replicaCfgs := make(map[ProviderID][]ReplicaConfig, len(cfgs))
 
 	for _, cfg := range cfgs {
 		id, err := ParseProviderID(cfg.ID)
@@ -67,32 +127,57 @@ func NewClient(ctx context.Context, cfgs []ProviderConfig) (*Client, error) {
 		if cfg.URL == "" {
 			return nil, errors.Errorf("provider %s: url is required", providerIDString(id))
 		}
+		for i, r := range cfg.Replicas {
+			if r.URL == "" {
+				return nil, errors.Errorf("provider %s: replica %d url is required", providerIDString(id), i+1)
+			}
+		}
+		policy, err := ParseAgreementPolicy(string(cfg.Agreement))
+		if err != nil {
+			return nil, errors.Errorf("provider %s: %w", providerIDString(id), err)
+		}
 		if _, ok := c.providers[id]; ok {
 			return nil, errors.Errorf("duplicate provider id: %s", providerIDString(id))
 		}
-		c.providers[id] = provider{cfg: cfg}
+		c.providers[id] = provider{id: id, policy: policy}
+		replicaCfgs[id] = cfg.replicaConfigs()
 		orderedIDs = append(orderedIDs, id)
 	}
 
 	for _, id := range orderedIDs {
 		p := c.providers[id]
-		conn, err := dial(ctx, p.cfg)
-		if err != nil {
-			_ = c.Close()
-			return nil, errors.Errorf("dial provider %s: %w", providerIDString(id), err)
+		var lastErr error
+		for _, rCfg := range replicaCfgs[id] {
+			conn, err := dial(ctx, rCfg, len(replicaCfgs[id]) > 1)
+			if err != nil {
+				_ = c.Close()
+				return nil, errors.Errorf("dial provider %s: %w", providerIDString(id), err)
+			}
+			if !isReady(conn) {
+				lastErr = errors.Errorf("replica %s is not reachable", rCfg.URL)
+				slog.WarnContext(ctx, "External voting power provider replica is not reachable, will retry on demand",
+					"providerId", providerIDString(id),
+					"url", rCfg.URL,
+				)
+			}
+			p.replicas = append(p.replicas, replica{
+				cfg:    rCfg,
+				conn:   conn,
+				client: votingpowerv1.NewVotingPowerProviderServiceClient(conn),
+			})
+			c.providers[id] = p
 		}
-
-		c.providers[id] = provider{
-			cfg:    p.cfg,
-			conn:   conn,
-			client: votingpowerv1.NewVotingPowerProviderServiceClient(conn),
+		if lastErr != nil && !lo.SomeBy(p.replicas, func(r replica) bool { return isReady(r.conn) }) {
+			_ = c.Close()
+			return nil, errors.Errorf("dial provider %s: no reachable replicas: %w", providerIDString(id), lastErr)
 		}
 	}
 
 	return c, nil
 }
 
-// Public API stays the same.
+// GetVotingPowers queries the replicas of the provider encoded in address and
+// returns the voting powers accepted by the provider agreement policy.
 func (c *Client) GetVotingPowers(
 	ctx context.Context,
 	address symbiotic.CrossChainAddress,
@@ -104,7 +189,18 @@ func (c *Client) GetVotingPowers(
 		return nil, errors.Errorf("external provider id %s is not configured", providerIDString(id))
 	}
 
-	timeout := p.cfg.Timeout
+	if p.policy == AgreementFirstHealthy {
+		return c.firstHealthy(ctx, p, address, timestamp)
+	}
+	return c.agreed(ctx, p, address, timestamp)
+}
+
+func (r replica) getVotingPowers(
+	ctx context.Context,
+	address symbiotic.CrossChainAddress,
+	timestamp symbiotic.Timestamp,
+) ([]symbiotic.OperatorVotingPower, error) {
+	timeout := r.cfg.Timeout
 	if timeout == 0 {
 		timeout = defaultTimeout
 	}
@@ -112,15 +208,15 @@ func (c *Client) GetVotingPowers(
 	callCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	if len(p.cfg.Headers) > 0 {
-		callCtx = metadata.NewOutgoingContext(callCtx, metadata.New(p.cfg.Headers))
+	if len(r.cfg.Headers) > 0 {
+		callCtx = metadata.NewOutgoingContext(callCtx, metadata.New(r.cfg.Headers))
 	}
 
-	resp, err := p.client.GetVotingPowersAt(callCtx, &votingpowerv1.GetVotingPowersAtRequest{
+	resp, err := r.client.GetVotingPowersAt(callCtx, &votingpowerv1.GetVotingPowersAtRequest{
 		Timestamp: uint64(timestamp),
 	})
 	if err != nil {
-		return nil, errors.Errorf("external provider %s GetVotingPowersAt failed: %w", providerIDString(id), err)
+		return nil, errors.Errorf("external provider %s (%s) GetVotingPowersAt failed: %w", providerIDString(providerIDFromAddress(address.Address)), r.cfg.URL, err)
 	}
 
 	agg := map[common.Address]*big.Int{}
@@ -163,15 +259,18 @@ func (c *Client) GetVotingPowers(
 func (c *Client) Close() error {
 	var firstErr error
 	for id, p := range c.providers {
-		if p.conn == nil {
-			continue
-		}
-		if err := p.conn.Close(); err != nil && firstErr == nil {
-			firstErr = err
-			slog.Warn("failed to close external voting power provider connection",
-				"providerId", providerIDString(id),
-				"error", err,
-			)
+		for _, r := range p.replicas {
+			if r.conn == nil {
+				continue
+			}
+			if err := r.conn.Close(); err != nil && firstErr == nil {
+				firstErr = err
+				slog.Warn("failed to close external voting power provider connection",
+					"providerId", providerIDString(id),
+					"url", r.cfg.URL,
+					"error", err,
+				)
+			}
 		}
 	}
 	return firstErr
@@ -192,7 +291,10 @@ func ParseProviderID(input string) (ProviderID, error) {
 	return id, nil
 }
 
-func dial(ctx context.Context, cfg ProviderConfig) (*grpc.ClientConn, error) {
+// dial connects to a replica endpoint. When allowUnready is set, a connection that
+// does not become ready within the timeout is returned as is, so that grpc keeps
+// reconnecting in the background.
+func dial(ctx context.Context, cfg ReplicaConfig, allowUnready bool) (*grpc.ClientConn, error) {
 	timeout := cfg.Timeout
 	if timeout == 0 {
 		timeout = defaultTimeout
@@ -222,13 +324,20 @@ func dial(ctx context.Context, cfg ProviderConfig) (*grpc.ClientConn, error) {
 		}
 
 		if !conn.WaitForStateChange(dialCtx, state) {
+			if allowUnready {
+				return conn, nil
+			}
 			_ = conn.Close()
 			return nil, errors.Errorf("failed to connect to external provider: %w", dialCtx.Err())
 		}
 	}
 }
 
-func buildTLSConfig(cfg ProviderConfig) (*tls.Config, error) {
+func isReady(conn *grpc.ClientConn) bool {
+	return conn.GetState() == connectivity.Ready
+}
+
+func buildTLSConfig(cfg ReplicaConfig) (*tls.Config, error) {
 	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
 	if cfg.ServerName != "" {
 		tlsCfg.ServerName = cfg.ServerName
@@ -320,3 +320,135 @@ func TestClient_Close(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, client.Close())
 }
+
+type testMetrics struct {
+	mu            sync.Mutex
+	disagreements int
+}
+
+func (m *testMetrics) ObserveExternalVotingPowerDisagreement(_ string, _ string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.disagreements++
+}
+
+func fixedVotingPower(votingPower string) func(context.Context, *votingpowerv1.GetVotingPowersAtRequest, int) (*votingpowerv1.GetVotingPowersAtResponse, error) {
+	return func(_ context.Context, _ *votingpowerv1.GetVotingPowersAtRequest, _ int) (*votingpowerv1.GetVotingPowersAtResponse, error) {
+		return &votingpowerv1.GetVotingPowersAtResponse{VotingPowers: []*votingpowerv1.OperatorVotingPower{
+			{Operator: "0x0000000000000000000000000000000000000001", VotingPower: votingPower},
+		}}, nil
+	}
+}
+
+func failingVotingPower(_ context.Context, _ *votingpowerv1.GetVotingPowersAtRequest, _ int) (*votingpowerv1.GetVotingPowersAtResponse, error) {
+	return nil, context.DeadlineExceeded
+}
+
+func TestClient_GetVotingPowers_FirstHealthyFallsBackToReplica(t *testing.T) {
+	primary := &testServer{fn: failingVotingPower}
+	secondary := &testServer{fn: fixedVotingPower("42")}
+	primaryURL, _ := startTestServer(t, primary)
+	secondaryURL, _ := startTestServer(t, secondary)
+	id := testProviderID()
+
+	client, err := NewClient(context.Background(), []ProviderConfig{{
+		ID:       providerIDString(id),
+		URL:      primaryURL,
+		Replicas: []ReplicaConfig{{URL: secondaryURL}},
+	}})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+
+	result, err := client.GetVotingPowers(context.Background(), providerAddress(id), 100)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, 0, result[0].Vaults[0].VotingPower.Cmp(big.NewInt(42)))
+	require.Equal(t, 1, primary.callCount())
+	require.Equal(t, 1, secondary.callCount())
+}
+
+func TestClient_GetVotingPowers_MajorityAgreement(t *testing.T) {
+	first := &testServer{fn: fixedVotingPower("10")}
+	second := &testServer{fn: fixedVotingPower("99")}
+	third := &testServer{fn: fixedVotingPower("10")}
+	firstURL, _ := startTestServer(t, first)
+	secondURL, _ := startTestServer(t, second)
+	thirdURL, _ := startTestServer(t, third)
+	id := testProviderID()
+	mtr := &testMetrics{}
+
+	client, err := NewClient(context.Background(), []ProviderConfig{{
+		ID:        providerIDString(id),
+		URL:       firstURL,
+		Replicas:  []ReplicaConfig{{URL: secondURL}, {URL: thirdURL}},
+		Agreement: AgreementMajority,
+	}}, WithMetrics(mtr))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+
+	result, err := client.GetVotingPowers(context.Background(), providerAddress(id), 100)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, 0, result[0].Vaults[0].VotingPower.Cmp(big.NewInt(10)))
+	require.Equal(t, 1, mtr.disagreements)
+}
+
+func TestClient_GetVotingPowers_MajorityNotReached(t *testing.T) {
+	first := &testServer{fn: fixedVotingPower("10")}
+	second := &testServer{fn: fixedVotingPower("20")}
+	third := &testServer{fn: failingVotingPower}
+	firstURL, _ := startTestServer(t, first)
+	secondURL, _ := startTestServer(t, second)
+	thirdURL, _ := startTestServer(t, third)
+	id := testProviderID()
+
+	client, err := NewClient(context.Background(), []ProviderConfig{{
+		ID:        providerIDString(id),
+		URL:       firstURL,
+		Replicas:  []ReplicaConfig{{URL: secondURL}, {URL: thirdURL}},
+		Agreement: AgreementMajority,
+	}})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+
+	_, err = client.GetVotingPowers(context.Background(), providerAddress(id), 100)
+	require.ErrorIs(t, err, ErrReplicasDisagree)
+}
+
+func TestClient_GetVotingPowers_AllEqualRequiresEveryReplica(t *testing.T) {
+	first := &testServer{fn: fixedVotingPower("10")}
+	second := &testServer{fn: failingVotingPower}
+	firstURL, _ := startTestServer(t, first)
+	secondURL, _ := startTestServer(t, second)
+	id := testProviderID()
+
+	client, err := NewClient(context.Background(), []ProviderConfig{{
+		ID:        providerIDString(id),
+		URL:       firstURL,
+		Replicas:  []ReplicaConfig{{URL: secondURL}},
+		Agreement: AgreementAllEqual,
+	}})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+
+	_, err = client.GetVotingPowers(context.Background(), providerAddress(id), 100)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "1 of 2 replicas failed")
+
+	second.mu.Lock()
+	second.fn = fixedVotingPower("10")
+	second.mu.Unlock()
+	result, err := client.GetVotingPowers(context.Background(), providerAddress(id), 100)
+	require.NoError(t, err)
+	require.Equal(t, 0, result[0].Vaults[0].VotingPower.Cmp(big.NewInt(10)))
+}
+
+func TestNewClient_UnknownAgreementPolicy(t *testing.T) {
+	_, err := NewClient(context.Background(), []ProviderConfig{{
+		ID:        providerIDString(testProviderID()),
+		URL:       "127.0.0.1:1",
+		Agreement: "quorum",
+	}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown agreement policy")
+}
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"math/big"
 	"slices"
+	"time"
 
 	"github.com/symbioticfi/relay/symbiotic/usecase/ssz"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/go-errors/errors"
 	"github.com/samber/lo"
 )
@@ -154,6 +156,16 @@ type SignatureRequest struct {
 	KeyTag        KeyTag
 	RequiredEpoch Epoch
 	Message       RawMessage
+	// TypedData is set when Message is the EIP-712 encoding of structured data, nil for raw messages
+	TypedData *apitypes.TypedData
+	// Deadline is the time the request expires at, zero if it never expires
+	Deadline time.Time
+	// Submitted is set for requests submitted through the API of this relay, only they can be cancelled
+	Submitted bool
+	// Cancelled is set once the request is cancelled on this relay
+	Cancelled bool
+	// Priority is the scheduling class of the request, unspecified requests are classified by key tag
+	Priority PriorityClass
 }
 
 type SignatureRequestWithID struct {
@@ -169,6 +181,9 @@ type Signature struct {
 	Epoch       Epoch          // Epoch for validation
 	PublicKey   PublicKey      // parse based on KeyTag (for bls will contain g1+g2)
 	Signature   RawSignature   // parse based on KeyTag
+	// HeaderCommitment is the signed content of validator set header signatures, nil for other signatures
+	// and for header signatures whose content is unknown
+	HeaderCommitment *ValSetHeaderCommitment
 }
 
 // RequestID calculates the request id based on Hash(MessageHash, KeyTag, Epoch)
@@ -203,6 +218,13 @@ func (ap AggregationProof) RequestID() common.Hash {
 	return requestID(ap.KeyTag, ap.Epoch, ap.MessageHash)
 }
 
+// Improves reports whether the proof is a cheaper proof of the same request than the given one.
+// BlsBn254Simple proofs carry every non-signer, so a shorter proof has fewer non-signers and costs less gas
+// to verify, while ZK proofs have a constant size and never improve on each other.
+func (ap AggregationProof) Improves(other AggregationProof) bool {
+	return ap.RequestID() == other.RequestID() && len(ap.Proof) < len(other.Proof)
+}
+
 // ProofCommitKey represents a proof commit key with its parsed epoch and hash for sorting
 type ProofCommitKey struct {
 	Epoch     Epoch
@@ -531,6 +553,65 @@ func (v ValidatorSet) IsActiveCommitter(
 	return false
 }
 
+// CommitterFailoverRank returns how far the committer with the given key is from the
+// active committer of the current slot in round-robin order, together with the start of that slot.
+// Rank 0 means the node is the active committer, rank 1 is the next committer to take over and so on.
+// Returns false if the node is not a committer, the slot duration is zero or the current time is before the capture timestamp.
+func (v ValidatorSet) CommitterFailoverRank(
+	committerSlotDuration uint64,
+	currentTime Timestamp,
+	requiredKey []byte,
+) (uint64, Timestamp, bool) {
+	if committerSlotDuration == 0 || currentTime < v.CaptureTimestamp {
+		return 0, 0, false
+	}
+
+	position := -1
+	for i, validatorIndex := range v.CommitterIndices {
+		key, found := v.Validators[validatorIndex].FindKeyByKeyTag(v.RequiredKeyTag)
+		if found && bytes.Equal(key, requiredKey) {
+			position = i
+			break
+		}
+	}
+	if position < 0 {
+		return 0, 0, false
+	}
+
+	committersCount := uint64(len(v.CommitterIndices))
+	currentSlot := uint64(currentTime-v.CaptureTimestamp) / committerSlotDuration
+	slotStart := v.CaptureTimestamp + Timestamp(currentSlot*committerSlotDuration)
+	activePosition := currentSlot % committersCount
+
+	return (uint64(position) + committersCount - activePosition) % committersCount, slotStart, true
+}
+
+// AggregatorOrder returns the aggregator indices in the order in which they aggregate the request:
+// by keccak256(requestID || operator), so that the primary aggregator differs between requests
+// and every node derives the same order.
+func (v ValidatorSet) AggregatorOrder(requestID common.Hash) []uint32 {
+	order := slices.Clone(v.AggregatorIndices)
+	priority := make(map[uint32][]byte, len(order))
+	for _, index := range order {
+		priority[index] = crypto.Keccak256(requestID.Bytes(), v.Validators[index].Operator.Bytes())
+	}
+	slices.SortFunc(order, func(a, b uint32) int {
+		return bytes.Compare(priority[a], priority[b])
+	})
+	return order
+}
+
+// AggregatorFailoverRank returns the position of the aggregator with the given key in the aggregator order of the request.
+// Rank 0 means the node is the primary aggregator, rank 1 is the first backup and so on.
+// Returns false if the node is not an aggregator.
+func (v ValidatorSet) AggregatorFailoverRank(requestID common.Hash, requiredKey []byte) (uint64, bool) {
+	index, ok := v.findMembership(v.AggregatorIndices, requiredKey)
+	if !ok {
+		return 0, false
+	}
+	return uint64(slices.Index(v.AggregatorOrder(requestID), index)), true
+}
+
 func (v ValidatorSet) FindValidatorByKey(keyTag KeyTag, publicKey []byte) (Validator, bool) { // DON'T USE INSIDE LOOPS
 	return v.Validators.FindValidatorByKey(keyTag, publicKey)
 }
@@ -742,6 +823,10 @@ type TxResult struct {
 	TxHash            common.Hash
 	GasUsed           uint64
 	EffectiveGasPrice *big.Int
+	// DryRun is the simulation of a transaction that was not sent because the client is in dry-run mode
+	DryRun *TxSimulation
+	// Unsigned is the transaction built for an external signer, it is neither signed nor sent
+	Unsigned *UnsignedTx
 }
 
 type ChainURL struct {
@@ -415,6 +415,129 @@ func TestValidatorSet_IsActiveCommitter(t *testing.T) {
 	})
 }
 
+func TestValidatorSet_CommitterFailoverRank(t *testing.T) {
+	keyTag := KeyTag(1)
+	keys := [][]byte{[]byte("committer1_key"), []byte("committer2_key"), []byte("committer3_key"), []byte("non_committer_key")}
+
+	validators := make(Validators, 0, len(keys))
+	for i, key := range keys {
+		validators = append(validators, Validator{
+			Operator:    common.BigToAddress(big.NewInt(int64(i + 1))),
+			VotingPower: VotingPower{big.NewInt(100)},
+			IsActive:    true,
+			Keys:        []ValidatorKey{{Tag: keyTag, Payload: key}},
+		})
+	}
+
+	validatorSet := ValidatorSet{
+		RequiredKeyTag:   keyTag,
+		CaptureTimestamp: 1000,
+		Validators:       validators,
+		CommitterIndices: []uint32{0, 1, 2},
+	}
+
+	t.Run("ranks follow round-robin order from the active committer", func(t *testing.T) {
+		// slot 1 (1100-1199) belongs to the second committer
+		rank, slotStart, ok := validatorSet.CommitterFailoverRank(100, 1150, keys[1])
+		require.True(t, ok)
+		require.Zero(t, rank)
+		require.Equal(t, Timestamp(1100), slotStart)
+
+		rank, _, ok = validatorSet.CommitterFailoverRank(100, 1150, keys[2])
+		require.True(t, ok)
+		require.Equal(t, uint64(1), rank)
+
+		rank, _, ok = validatorSet.CommitterFailoverRank(100, 1150, keys[0])
+		require.True(t, ok)
+		require.Equal(t, uint64(2), rank)
+	})
+
+	t.Run("ranks wrap around", func(t *testing.T) {
+		// slot 5 (1500-1599) belongs to the third committer
+		rank, slotStart, ok := validatorSet.CommitterFailoverRank(100, 1599, keys[0])
+		require.True(t, ok)
+		require.Equal(t, uint64(1), rank)
+		require.Equal(t, Timestamp(1500), slotStart)
+	})
+
+	t.Run("not applicable", func(t *testing.T) {
+		_, _, ok := validatorSet.CommitterFailoverRank(100, 1150, keys[3])
+		require.False(t, ok, "non-committer has no rank")
+
+		_, _, ok = validatorSet.CommitterFailoverRank(0, 1150, keys[0])
+		require.False(t, ok, "zero slot duration has no slots")
+
+		_, _, ok = validatorSet.CommitterFailoverRank(100, 999, keys[0])
+		require.False(t, ok, "no slot before capture timestamp")
+	})
+}
+
+func TestValidatorSet_AggregatorFailoverRank(t *testing.T) {
+	keyTag := KeyTag(1)
+	keys := [][]byte{[]byte("aggregator1_key"), []byte("aggregator2_key"), []byte("aggregator3_key"), []byte("non_aggregator_key")}
+
+	validators := make(Validators, 0, len(keys))
+	for i, key := range keys {
+		validators = append(validators, Validator{
+			Operator:    common.BigToAddress(big.NewInt(int64(i + 1))),
+			VotingPower: VotingPower{big.NewInt(100)},
+			IsActive:    true,
+			Keys:        []ValidatorKey{{Tag: keyTag, Payload: key}},
+		})
+	}
+
+	validatorSet := ValidatorSet{
+		RequiredKeyTag:    keyTag,
+		Validators:        validators,
+		AggregatorIndices: []uint32{0, 1, 2},
+	}
+
+	t.Run("ranks are a permutation of the aggregators", func(t *testing.T) {
+		requestID := common.HexToHash("0x01")
+		order := validatorSet.AggregatorOrder(requestID)
+		require.ElementsMatch(t, validatorSet.AggregatorIndices, order)
+		require.Equal(t, order, validatorSet.AggregatorOrder(requestID), "order must be deterministic")
+
+		for expectedRank, index := range order {
+			rank, ok := validatorSet.AggregatorFailoverRank(requestID, keys[index])
+			require.True(t, ok)
+			require.Equal(t, uint64(expectedRank), rank)
+		}
+	})
+
+	t.Run("primary differs between requests", func(t *testing.T) {
+		primaries := make(map[uint32]struct{})
+		for i := range 32 {
+			primaries[validatorSet.AggregatorOrder(common.BigToHash(big.NewInt(int64(i))))[0]] = struct{}{}
+		}
+		require.Len(t, primaries, 3)
+	})
+
+	t.Run("not an aggregator", func(t *testing.T) {
+		_, ok := validatorSet.AggregatorFailoverRank(common.HexToHash("0x01"), keys[3])
+		require.False(t, ok)
+	})
+}
+
+func TestAggregationProof_Improves(t *testing.T) {
+	proof := AggregationProof{
+		MessageHash: common.HexToHash("0x01").Bytes(),
+		KeyTag:      15,
+		Epoch:       3,
+		Proof:       make([]byte, 228),
+	}
+
+	shorter := proof
+	shorter.Proof = make([]byte, 226)
+	require.True(t, shorter.Improves(proof))
+	require.False(t, proof.Improves(shorter))
+	require.False(t, proof.Improves(proof))
+
+	otherRequest := shorter
+	otherRequest.Epoch = 4
+	require.False(t, otherRequest.Improves(proof))
+}
+
 func TestPaddedUint64(t *testing.T) {
 	tests := []struct {
 		name     string
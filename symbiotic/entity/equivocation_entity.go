@@ -0,0 +1,193 @@
+package entity
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/go-errors/errors"
+)
+
+// ValSetHeaderCommitPrimaryType is the EIP-712 primary type of the message validators sign to commit a validator set header
+const ValSetHeaderCommitPrimaryType = "ValSetHeaderCommit"
+
+var valSetHeaderCommitTypes = apitypes.Types{
+	"EIP712Domain": []apitypes.Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+	},
+	ValSetHeaderCommitPrimaryType: []apitypes.Type{
+		{Name: "subnetwork", Type: "bytes32"},
+		{Name: "epoch", Type: "uint48"},
+		{Name: "headerHash", Type: "bytes32"},
+		{Name: "extraDataHash", Type: "bytes32"},
+	},
+}
+
+// ValSetHeaderCommitTypedData returns the EIP-712 typed data validators sign to commit the header of a validator set
+func ValSetHeaderCommitTypedData(networkData NetworkData, epoch Epoch, headerHash common.Hash, extraDataHash common.Hash) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: valSetHeaderCommitTypes,
+		Domain: apitypes.TypedDataDomain{
+			Name:    networkData.Eip712Data.Name,
+			Version: networkData.Eip712Data.Version,
+		},
+		PrimaryType: ValSetHeaderCommitPrimaryType,
+		Message: map[string]interface{}{
+			"subnetwork":    networkData.Subnetwork,
+			"epoch":         new(big.Int).SetUint64(uint64(epoch)),
+			"headerHash":    headerHash,
+			"extraDataHash": extraDataHash,
+		},
+	}
+}
+
+// ValSetHeaderCommitment is the content of a signed validator set header commitment,
+// it is enough to rebuild the signed EIP-712 message without knowing the domain name and version
+type ValSetHeaderCommitment struct {
+	DomainSeparator common.Hash
+	Subnetwork      common.Hash
+	Epoch           Epoch
+	HeaderHash      common.Hash
+	ExtraDataHash   common.Hash
+}
+
+// ValSetHeaderCommitmentFromTypedData extracts the commitment from ValSetHeaderCommit typed data
+func ValSetHeaderCommitmentFromTypedData(typedData apitypes.TypedData) (ValSetHeaderCommitment, error) {
+	if typedData.PrimaryType != ValSetHeaderCommitPrimaryType {
+		return ValSetHeaderCommitment{}, errors.Errorf("primary type %q is not %s", typedData.PrimaryType, ValSetHeaderCommitPrimaryType)
+	}
+
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return ValSetHeaderCommitment{}, errors.Errorf("failed to hash domain: %w", err)
+	}
+
+	fields := make(map[string][]byte, len(valSetHeaderCommitTypes[ValSetHeaderCommitPrimaryType]))
+	for _, field := range valSetHeaderCommitTypes[ValSetHeaderCommitPrimaryType] {
+		value, ok := typedData.Message[field.Name]
+		if !ok {
+			return ValSetHeaderCommitment{}, errors.Errorf("typed data message has no %s", field.Name)
+		}
+		encoded, err := typedData.EncodePrimitiveValue(field.Type, value, 1)
+		if err != nil {
+			return ValSetHeaderCommitment{}, errors.Errorf("failed to encode %s: %w", field.Name, err)
+		}
+		fields[field.Name] = encoded
+	}
+
+	return ValSetHeaderCommitment{
+		DomainSeparator: common.BytesToHash(domainSeparator),
+		Subnetwork:      common.BytesToHash(fields["subnetwork"]),
+		Epoch:           Epoch(new(big.Int).SetBytes(fields["epoch"]).Uint64()),
+		HeaderHash:      common.BytesToHash(fields["headerHash"]),
+		ExtraDataHash:   common.BytesToHash(fields["extraDataHash"]),
+	}, nil
+}
+
+// Message returns the signed EIP-712 message: "\x19\x01" || domainSeparator || hashStruct(commitment)
+func (c ValSetHeaderCommitment) Message() RawMessage {
+	typeHash := crypto.Keccak256([]byte("ValSetHeaderCommit(bytes32 subnetwork,uint48 epoch,bytes32 headerHash,bytes32 extraDataHash)"))
+	structHash := crypto.Keccak256(
+		typeHash,
+		c.Subnetwork.Bytes(),
+		common.BigToHash(new(big.Int).SetUint64(uint64(c.Epoch))).Bytes(),
+		c.HeaderHash.Bytes(),
+		c.ExtraDataHash.Bytes(),
+	)
+
+	message := make([]byte, 0, 2+2*common.HashLength)
+	message = append(message, 0x19, 0x01)
+	message = append(message, c.DomainSeparator.Bytes()...)
+	message = append(message, structHash...)
+	return message
+}
+
+// ConflictsWith reports whether both commitments are for the header of the same validator set of the same network
+// but commit different contents
+func (c ValSetHeaderCommitment) ConflictsWith(other ValSetHeaderCommitment) bool {
+	return c.DomainSeparator == other.DomainSeparator &&
+		c.Subnetwork == other.Subnetwork &&
+		c.Epoch == other.Epoch &&
+		(c.HeaderHash != other.HeaderHash || c.ExtraDataHash != other.ExtraDataHash)
+}
+
+// SignedHeaderCommitment is a validator set header commitment with a validator signature over its message
+type SignedHeaderCommitment struct {
+	Commitment ValSetHeaderCommitment
+	Signature  RawSignature
+}
+
+// EquivocationEvidence proves that a validator key signed two conflicting header commitments of the same epoch.
+// It is self-verifying: both messages are rebuilt from the commitments and both signatures verify against PublicKey.
+type EquivocationEvidence struct {
+	Operator  common.Address
+	KeyTag    KeyTag
+	PublicKey RawPublicKey
+	// First is the commitment signature seen first by this relay, Second the conflicting one
+	First      SignedHeaderCommitment
+	Second     SignedHeaderCommitment
+	DetectedAt time.Time
+}
+
+// Epoch returns the epoch of the validator set header the validator equivocated on
+func (e EquivocationEvidence) Epoch() Epoch {
+	return e.First.Commitment.Epoch
+}
+
+// AbiEncode encodes the evidence for a slashing contract as
+// (address operator, uint8 keyTag, bytes publicKey, bytes32 domainSeparator, bytes32 subnetwork, uint48 epoch,
+// (bytes32 headerHash, bytes32 extraDataHash, bytes signature) first, (bytes32 headerHash, bytes32 extraDataHash, bytes signature) second)
+func (e EquivocationEvidence) AbiEncode() ([]byte, error) {
+	signedCommitment, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "headerHash", Type: "bytes32"},
+		{Name: "extraDataHash", Type: "bytes32"},
+		{Name: "signature", Type: "bytes"},
+	})
+	if err != nil {
+		return nil, errors.Errorf("failed to create signed commitment type: %w", err)
+	}
+
+	arguments := abi.Arguments{
+		{Name: "operator", Type: abi.Type{T: abi.AddressTy, Size: 20}},
+		{Name: "keyTag", Type: abi.Type{T: abi.UintTy, Size: 8}},
+		{Name: "publicKey", Type: abi.Type{T: abi.BytesTy}},
+		{Name: "domainSeparator", Type: abi.Type{T: abi.FixedBytesTy, Size: 32}},
+		{Name: "subnetwork", Type: abi.Type{T: abi.FixedBytesTy, Size: 32}},
+		{Name: "epoch", Type: abi.Type{T: abi.UintTy, Size: 48}},
+		{Name: "first", Type: signedCommitment},
+		{Name: "second", Type: signedCommitment},
+	}
+
+	type abiSignedCommitment struct {
+		HeaderHash    [32]byte
+		ExtraDataHash [32]byte
+		Signature     []byte
+	}
+	toABI := func(s SignedHeaderCommitment) abiSignedCommitment {
+		return abiSignedCommitment{
+			HeaderHash:    s.Commitment.HeaderHash,
+			ExtraDataHash: s.Commitment.ExtraDataHash,
+			Signature:     s.Signature,
+		}
+	}
+
+	pack, err := arguments.Pack(
+		e.Operator,
+		uint8(e.KeyTag),
+		[]byte(e.PublicKey),
+		[32]byte(e.First.Commitment.DomainSeparator),
+		[32]byte(e.First.Commitment.Subnetwork),
+		new(big.Int).SetUint64(uint64(e.First.Commitment.Epoch)),
+		toABI(e.First),
+		toABI(e.Second),
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to pack arguments: %w", err)
+	}
+
+	return pack, nil
+}
@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValSetHeaderCommitment_MessageMatchesTypedData(t *testing.T) {
+	t.Parallel()
+
+	networkData := NetworkData{
+		Subnetwork: common.HexToHash("0x5b"),
+		Eip712Data: Eip712Domain{Name: "Middleware", Version: "1"},
+	}
+	typedData := ValSetHeaderCommitTypedData(networkData, 42, common.HexToHash("0x01"), common.HexToHash("0x02"))
+
+	expected, err := EncodeTypedData(typedData)
+	require.NoError(t, err)
+
+	commitment, err := ValSetHeaderCommitmentFromTypedData(typedData)
+	require.NoError(t, err)
+	require.Equal(t, Epoch(42), commitment.Epoch)
+	require.Equal(t, networkData.Subnetwork, commitment.Subnetwork)
+	require.Equal(t, expected, commitment.Message())
+
+	// typed data loses its go types when it is stored as json
+	data, err := json.Marshal(typedData)
+	require.NoError(t, err)
+	parsed, err := ParseTypedData(data)
+	require.NoError(t, err)
+
+	fromJSON, err := ValSetHeaderCommitmentFromTypedData(parsed)
+	require.NoError(t, err)
+	require.Equal(t, commitment, fromJSON)
+}
+
+func TestValSetHeaderCommitmentFromTypedData_WrongPrimaryType(t *testing.T) {
+	t.Parallel()
+
+	typedData := ValSetHeaderCommitTypedData(NetworkData{}, 1, common.Hash{}, common.Hash{})
+	typedData.PrimaryType = "Mail"
+
+	_, err := ValSetHeaderCommitmentFromTypedData(typedData)
+	require.ErrorContains(t, err, "is not ValSetHeaderCommit")
+}
+
+func TestValSetHeaderCommitment_ConflictsWith(t *testing.T) {
+	t.Parallel()
+
+	commitment := ValSetHeaderCommitment{
+		DomainSeparator: common.HexToHash("0xd0"),
+		Subnetwork:      common.HexToHash("0x5b"),
+		Epoch:           3,
+		HeaderHash:      common.HexToHash("0x01"),
+		ExtraDataHash:   common.HexToHash("0x02"),
+	}
+
+	require.False(t, commitment.ConflictsWith(commitment))
+
+	otherHeader := commitment
+	otherHeader.HeaderHash = common.HexToHash("0x03")
+	require.True(t, commitment.ConflictsWith(otherHeader))
+
+	otherExtraData := commitment
+	otherExtraData.ExtraDataHash = common.HexToHash("0x03")
+	require.True(t, commitment.ConflictsWith(otherExtraData))
+
+	otherEpoch := otherHeader
+	otherEpoch.Epoch = 4
+	require.False(t, commitment.ConflictsWith(otherEpoch))
+
+	otherNetwork := otherHeader
+	otherNetwork.DomainSeparator = common.HexToHash("0xd1")
+	require.False(t, commitment.ConflictsWith(otherNetwork))
+}
+
+func TestEquivocationEvidence_AbiEncode(t *testing.T) {
+	t.Parallel()
+
+	commitment := ValSetHeaderCommitment{Epoch: 3, HeaderHash: common.HexToHash("0x01")}
+	conflicting := commitment
+	conflicting.HeaderHash = common.HexToHash("0x02")
+
+	evidence := EquivocationEvidence{
+		Operator:  common.HexToAddress("0xabc"),
+		KeyTag:    15,
+		PublicKey: []byte{0x01, 0x02},
+		First:     SignedHeaderCommitment{Commitment: commitment, Signature: []byte{0x0a}},
+		Second:    SignedHeaderCommitment{Commitment: conflicting, Signature: []byte{0x0b}},
+	}
+
+	encoded, err := evidence.AbiEncode()
+	require.NoError(t, err)
+	require.Equal(t, common.LeftPadBytes(evidence.Operator.Bytes(), 32), encoded[:32])
+	require.Equal(t, common.LeftPadBytes([]byte{15}, 32), encoded[32:64])
+	require.Equal(t, common.LeftPadBytes([]byte{3}, 32), encoded[5*32:6*32])
+}
@@ -1,5 +1,11 @@
 package entity
 
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
 type BlockNumber string
 
 const (
@@ -10,6 +16,10 @@ const (
 type EVMOptions struct {
 	BlockNumber        BlockNumber
 	GasLimitMultiplier float64
+	// OnTxSent is called with the transaction hash as soon as the transaction is sent, before it is mined
+	OnTxSent func(txHash common.Hash)
+	// MaxFeePerGas caps the fee per gas of sent transactions in wei, the suggested fee is used if nil
+	MaxFeePerGas *big.Int
 }
 
 func AppliedEVMOptions(opts ...EVMOption) *EVMOptions {
@@ -36,3 +46,50 @@ func WithGasLimitMultiplier(multiplier float64) EVMOption {
 		o.GasLimitMultiplier = multiplier
 	}
 }
+
+func WithTxSentHook(hook func(txHash common.Hash)) EVMOption {
+	return func(o *EVMOptions) {
+		o.OnTxSent = hook
+	}
+}
+
+func WithMaxFeePerGas(maxFeePerGas *big.Int) EVMOption {
+	return func(o *EVMOptions) {
+		o.MaxFeePerGas = maxFeePerGas
+	}
+}
+
+// TxSimulation is the predicted outcome of a transaction that was simulated with eth_call instead of being sent
+type TxSimulation struct {
+	From     common.Address
+	To       common.Address
+	Calldata []byte
+	// RevertReason is the decoded revert of a transaction that would revert, empty if it would succeed
+	RevertReason string
+	GasEstimate  uint64
+	// FeePerGas is the fee per gas in wei the transaction would pay if sent now
+	FeePerGas *big.Int
+}
+
+// Cost returns the predicted cost of the transaction in wei.
+func (s TxSimulation) Cost() *big.Int {
+	if s.FeePerGas == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(s.GasEstimate), s.FeePerGas)
+}
+
+// UnsignedTx is a transaction built for an external signer such as a multisig
+type UnsignedTx struct {
+	ChainID  uint64
+	From     common.Address
+	To       common.Address
+	Value    *big.Int
+	Calldata []byte
+	Nonce    uint64
+	GasLimit uint64
+	// GasPrice is set for legacy transactions, GasFeeCap and GasTipCap for dynamic fee transactions
+	GasPrice  *big.Int
+	GasFeeCap *big.Int
+	GasTipCap *big.Int
+}
@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MessageBatch is a set of messages signed at once through the Merkle root of their leaves.
+// The root is the message of the signature request identified by RequestID.
+type MessageBatch struct {
+	RequestID common.Hash
+	Epoch     Epoch
+	KeyTag    KeyTag
+	Root      common.Hash
+	// Leaves are hashes of the batch messages in request order
+	Leaves []common.Hash
+}
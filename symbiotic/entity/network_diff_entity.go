@@ -0,0 +1,251 @@
+package entity
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ConfigChange is a changed field of the network config.
+// Scalar fields are reported with From and To, list fields with the Added and Removed entries.
+type ConfigChange struct {
+	Field   string   `json:"field"`
+	From    string   `json:"from,omitempty"`
+	To      string   `json:"to,omitempty"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// DiffNetworkConfigs returns the changes from one network config to another in the order of the config fields
+func DiffNetworkConfigs(from, to NetworkConfig) []ConfigChange {
+	var changes []ConfigChange
+	scalar := func(field, fromValue, toValue string) {
+		if fromValue != toValue {
+			changes = append(changes, ConfigChange{Field: field, From: fromValue, To: toValue})
+		}
+	}
+	list := func(field string, fromValues, toValues []string) {
+		added, removed := diffStrings(fromValues, toValues)
+		if len(added) > 0 || len(removed) > 0 {
+			changes = append(changes, ConfigChange{Field: field, Added: added, Removed: removed})
+		}
+	}
+
+	list("VotingPowerProviders", crossChainAddressStrings(from.VotingPowerProviders), crossChainAddressStrings(to.VotingPowerProviders))
+	scalar("KeysProvider", crossChainAddressString(from.KeysProvider), crossChainAddressString(to.KeysProvider))
+	list("Settlements", crossChainAddressStrings(from.Settlements), crossChainAddressStrings(to.Settlements))
+	scalar("VerificationType", from.VerificationType.String(), to.VerificationType.String())
+	scalar("MaxVotingPower", votingPowerString(from.MaxVotingPower), votingPowerString(to.MaxVotingPower))
+	scalar("MinInclusionVotingPower", votingPowerString(from.MinInclusionVotingPower), votingPowerString(to.MinInclusionVotingPower))
+	scalar("MaxValidatorsCount", votingPowerString(from.MaxValidatorsCount), votingPowerString(to.MaxValidatorsCount))
+	list("RequiredKeyTags", keyTagStrings(from.RequiredKeyTags), keyTagStrings(to.RequiredKeyTags))
+	scalar("RequiredHeaderKeyTag", from.RequiredHeaderKeyTag.String(), to.RequiredHeaderKeyTag.String())
+	for _, keyTag := range quorumThresholdKeyTags(from.QuorumThresholds, to.QuorumThresholds) {
+		scalar(
+			fmt.Sprintf("QuorumThresholds[%s]", keyTag),
+			quorumThresholdString(from.QuorumThresholds, keyTag),
+			quorumThresholdString(to.QuorumThresholds, keyTag),
+		)
+	}
+	scalar("EpochDuration", strconv.FormatUint(from.EpochDuration, 10), strconv.FormatUint(to.EpochDuration, 10))
+	scalar("NumAggregators", strconv.FormatUint(from.NumAggregators, 10), strconv.FormatUint(to.NumAggregators, 10))
+	scalar("NumCommitters", strconv.FormatUint(from.NumCommitters, 10), strconv.FormatUint(to.NumCommitters, 10))
+	scalar("CommitterSlotDuration", strconv.FormatUint(from.CommitterSlotDuration, 10), strconv.FormatUint(to.CommitterSlotDuration, 10))
+
+	return changes
+}
+
+// ValidatorChangeType tells how an active validator changed between validator sets
+type ValidatorChangeType string
+
+const (
+	// ValidatorJoined is a validator that is active only in the later validator set
+	ValidatorJoined ValidatorChangeType = "joined"
+	// ValidatorLeft is a validator that is active only in the earlier validator set
+	ValidatorLeft ValidatorChangeType = "left"
+	// ValidatorUpdated is a validator active in both validator sets with a different voting power or keys
+	ValidatorUpdated ValidatorChangeType = "updated"
+)
+
+// ValidatorChange is a change of an active validator, the voting power of the side the validator is not active on is omitted
+type ValidatorChange struct {
+	Operator        common.Address      `json:"operator"`
+	Type            ValidatorChangeType `json:"type"`
+	VotingPowerFrom *VotingPower        `json:"votingPowerFrom,omitempty"`
+	VotingPowerTo   *VotingPower        `json:"votingPowerTo,omitempty"`
+	// ChangedKeyTags are the key tags whose key was added, removed or replaced
+	ChangedKeyTags []KeyTag `json:"changedKeyTags,omitempty"`
+}
+
+// ValidatorSetDiff is the difference between the active validators of two validator sets
+type ValidatorSetDiff struct {
+	FromEpoch            Epoch             `json:"fromEpoch"`
+	ToEpoch              Epoch             `json:"toEpoch"`
+	ActiveValidatorsFrom int64             `json:"activeValidatorsFrom"`
+	ActiveValidatorsTo   int64             `json:"activeValidatorsTo"`
+	TotalVotingPowerFrom VotingPower       `json:"totalVotingPowerFrom"`
+	TotalVotingPowerTo   VotingPower       `json:"totalVotingPowerTo"`
+	QuorumThresholdFrom  VotingPower       `json:"quorumThresholdFrom"`
+	QuorumThresholdTo    VotingPower       `json:"quorumThresholdTo"`
+	Validators           []ValidatorChange `json:"validators"`
+}
+
+// DiffValidatorSets returns the changes of the active validators from one validator set to another sorted by operator.
+// Inactive validators are treated as absent, a validator becoming active joins and one becoming inactive leaves.
+func DiffValidatorSets(from, to ValidatorSet) ValidatorSetDiff {
+	diff := ValidatorSetDiff{
+		FromEpoch:            from.Epoch,
+		ToEpoch:              to.Epoch,
+		ActiveValidatorsFrom: from.GetTotalActiveValidators(),
+		ActiveValidatorsTo:   to.GetTotalActiveValidators(),
+		TotalVotingPowerFrom: from.GetTotalActiveVotingPower(),
+		TotalVotingPowerTo:   to.GetTotalActiveVotingPower(),
+		QuorumThresholdFrom:  from.QuorumThreshold,
+		QuorumThresholdTo:    to.QuorumThreshold,
+		Validators:           []ValidatorChange{},
+	}
+
+	fromActive := activeValidatorsByOperator(from.Validators)
+	toActive := activeValidatorsByOperator(to.Validators)
+
+	for operator, before := range fromActive {
+		after, ok := toActive[operator]
+		if !ok {
+			diff.Validators = append(diff.Validators, ValidatorChange{
+				Operator:        operator,
+				Type:            ValidatorLeft,
+				VotingPowerFrom: &before.VotingPower,
+			})
+			continue
+		}
+		changedKeyTags := diffValidatorKeys(before.Keys, after.Keys)
+		if before.VotingPower.Cmp(after.VotingPower.Int) != 0 || len(changedKeyTags) > 0 {
+			diff.Validators = append(diff.Validators, ValidatorChange{
+				Operator:        operator,
+				Type:            ValidatorUpdated,
+				VotingPowerFrom: &before.VotingPower,
+				VotingPowerTo:   &after.VotingPower,
+				ChangedKeyTags:  changedKeyTags,
+			})
+		}
+	}
+	for operator, after := range toActive {
+		if _, ok := fromActive[operator]; !ok {
+			diff.Validators = append(diff.Validators, ValidatorChange{
+				Operator:      operator,
+				Type:          ValidatorJoined,
+				VotingPowerTo: &after.VotingPower,
+			})
+		}
+	}
+
+	slices.SortFunc(diff.Validators, func(a, b ValidatorChange) int {
+		return a.Operator.Cmp(b.Operator)
+	})
+	return diff
+}
+
+func activeValidatorsByOperator(validators Validators) map[common.Address]Validator {
+	active := make(map[common.Address]Validator, len(validators))
+	for _, validator := range validators {
+		if validator.IsActive {
+			active[validator.Operator] = validator
+		}
+	}
+	return active
+}
+
+func diffValidatorKeys(from, to []ValidatorKey) []KeyTag {
+	var changed []KeyTag
+	for _, key := range from {
+		payload, ok := findValidatorKey(to, key.Tag)
+		if !ok || !bytes.Equal(payload, key.Payload) {
+			changed = append(changed, key.Tag)
+		}
+	}
+	for _, key := range to {
+		if _, ok := findValidatorKey(from, key.Tag); !ok {
+			changed = append(changed, key.Tag)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+func findValidatorKey(keys []ValidatorKey, keyTag KeyTag) (CompactPublicKey, bool) {
+	for _, key := range keys {
+		if key.Tag == keyTag {
+			return key.Payload, true
+		}
+	}
+	return nil, false
+}
+
+// diffStrings returns the entries only in to and only in from, keeping their order
+func diffStrings(from, to []string) (added, removed []string) {
+	for _, value := range to {
+		if !slices.Contains(from, value) {
+			added = append(added, value)
+		}
+	}
+	for _, value := range from {
+		if !slices.Contains(to, value) {
+			removed = append(removed, value)
+		}
+	}
+	return added, removed
+}
+
+func crossChainAddressString(address CrossChainAddress) string {
+	return fmt.Sprintf("%d:%s", address.ChainId, address.Address.Hex())
+}
+
+func crossChainAddressStrings(addresses []CrossChainAddress) []string {
+	values := make([]string, len(addresses))
+	for i, address := range addresses {
+		values[i] = crossChainAddressString(address)
+	}
+	return values
+}
+
+func keyTagStrings(keyTags []KeyTag) []string {
+	values := make([]string, len(keyTags))
+	for i, keyTag := range keyTags {
+		values[i] = keyTag.String()
+	}
+	return values
+}
+
+func votingPowerString(votingPower VotingPower) string {
+	if votingPower.Int == nil {
+		return "0"
+	}
+	return votingPower.String()
+}
+
+// quorumThresholdKeyTags returns the sorted key tags that have a quorum threshold in any of the lists
+func quorumThresholdKeyTags(from, to []QuorumThreshold) []KeyTag {
+	var keyTags []KeyTag
+	for _, threshold := range slices.Concat(from, to) {
+		if !slices.Contains(keyTags, threshold.KeyTag) {
+			keyTags = append(keyTags, threshold.KeyTag)
+		}
+	}
+	slices.Sort(keyTags)
+	return keyTags
+}
+
+// quorumThresholdString formats the quorum threshold of the key tag as a percentage, empty if it has none
+func quorumThresholdString(thresholds []QuorumThreshold, keyTag KeyTag) string {
+	for _, threshold := range thresholds {
+		if threshold.KeyTag == keyTag && threshold.QuorumThreshold.Int != nil {
+			pct := new(big.Float).Quo(new(big.Float).SetInt(threshold.QuorumThreshold.Int), new(big.Float).SetInt(maxThreshold()))
+			return new(big.Float).Mul(pct, big.NewFloat(100)).Text('f', 5) + " %"
+		}
+	}
+	return ""
+}
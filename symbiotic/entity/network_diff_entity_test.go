@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffNetworkConfigs(t *testing.T) {
+	settlement := CrossChainAddress{ChainId: 1, Address: common.HexToAddress("0x01")}
+	newSettlement := CrossChainAddress{ChainId: 2, Address: common.HexToAddress("0x02")}
+	from := NetworkConfig{
+		Settlements:          []CrossChainAddress{settlement},
+		MaxVotingPower:       ToVotingPower(big.NewInt(100)),
+		RequiredKeyTags:      []KeyTag{15},
+		RequiredHeaderKeyTag: 15,
+		QuorumThresholds:     []QuorumThreshold{{KeyTag: 15, QuorumThreshold: ToQuorumThresholdPct(big.NewInt(500_000_000_000_000_000))}},
+		NumAggregators:       1,
+		NumCommitters:        1,
+	}
+
+	require.Empty(t, DiffNetworkConfigs(from, from))
+
+	to := from
+	to.Settlements = []CrossChainAddress{settlement, newSettlement}
+	to.RequiredKeyTags = []KeyTag{15, 16}
+	to.QuorumThresholds = []QuorumThreshold{{KeyTag: 15, QuorumThreshold: ToQuorumThresholdPct(big.NewInt(666_666_666_666_666_667))}}
+	to.NumCommitters = 3
+
+	require.Equal(t, []ConfigChange{
+		{Field: "Settlements", Added: []string{"2:" + newSettlement.Address.Hex()}},
+		{Field: "RequiredKeyTags", Added: []string{KeyTag(16).String()}},
+		{Field: "QuorumThresholds[" + KeyTag(15).String() + "]", From: "50.00000 %", To: "66.66667 %"},
+		{Field: "NumCommitters", From: "1", To: "3"},
+	}, DiffNetworkConfigs(from, to))
+}
+
+func TestDiffValidatorSets(t *testing.T) {
+	validator := func(operator string, votingPower int64, active bool, key byte) Validator {
+		return Validator{
+			Operator:    common.HexToAddress(operator),
+			VotingPower: ToVotingPower(big.NewInt(votingPower)),
+			IsActive:    active,
+			Keys:        []ValidatorKey{{Tag: 15, Payload: CompactPublicKey{key}}},
+		}
+	}
+	from := ValidatorSet{
+		Epoch:           1,
+		QuorumThreshold: ToVotingPower(big.NewInt(201)),
+		Validators: Validators{
+			validator("0x01", 100, true, 1),
+			validator("0x02", 100, true, 2),
+			validator("0x03", 100, true, 3),
+			validator("0x04", 100, true, 4),
+			validator("0x05", 100, false, 5),
+		},
+	}
+	to := ValidatorSet{
+		Epoch:           2,
+		QuorumThreshold: ToVotingPower(big.NewInt(301)),
+		Validators: Validators{
+			validator("0x01", 100, true, 1),
+			validator("0x02", 150, true, 2),
+			validator("0x03", 100, true, 33),
+			validator("0x04", 100, false, 4),
+			validator("0x05", 100, true, 5),
+			validator("0x06", 50, true, 6),
+		},
+	}
+
+	diff := DiffValidatorSets(from, to)
+	require.Equal(t, Epoch(1), diff.FromEpoch)
+	require.Equal(t, Epoch(2), diff.ToEpoch)
+	require.Equal(t, int64(4), diff.ActiveValidatorsFrom)
+	require.Equal(t, int64(5), diff.ActiveValidatorsTo)
+	require.Equal(t, "400", diff.TotalVotingPowerFrom.String())
+	require.Equal(t, "500", diff.TotalVotingPowerTo.String())
+
+	types := make([]ValidatorChangeType, len(diff.Validators))
+	for i, change := range diff.Validators {
+		types[i] = change.Type
+	}
+	require.Equal(t, []ValidatorChangeType{ValidatorUpdated, ValidatorUpdated, ValidatorLeft, ValidatorJoined, ValidatorJoined}, types)
+
+	require.Equal(t, "150", diff.Validators[0].VotingPowerTo.String())
+	require.Empty(t, diff.Validators[0].ChangedKeyTags)
+	require.Equal(t, []KeyTag{15}, diff.Validators[1].ChangedKeyTags)
+	require.Nil(t, diff.Validators[2].VotingPowerTo)
+	require.Nil(t, diff.Validators[4].VotingPowerFrom)
+	require.Equal(t, common.HexToAddress("0x06"), diff.Validators[4].Operator)
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/go-errors/errors"
+)
+
+// SafeTxPrimaryType is the EIP-712 primary type owners of a Safe multisig sign to execute a transaction
+const SafeTxPrimaryType = "SafeTx"
+
+// SafeOperationCall is the Safe operation of a regular call, the other operation is a delegatecall
+const SafeOperationCall = 0
+
+var safeTxTypes = apitypes.Types{
+	// the domain of Safe contracts since v1.3.0
+	"EIP712Domain": []apitypes.Type{
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	SafeTxPrimaryType: []apitypes.Type{
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "data", Type: "bytes"},
+		{Name: "operation", Type: "uint8"},
+		{Name: "safeTxGas", Type: "uint256"},
+		{Name: "baseGas", Type: "uint256"},
+		{Name: "gasPrice", Type: "uint256"},
+		{Name: "gasToken", Type: "address"},
+		{Name: "refundReceiver", Type: "address"},
+		{Name: "nonce", Type: "uint256"},
+	},
+}
+
+// SafeTxTypedData returns the EIP-712 typed data the owners of a Safe sign to execute the transaction with the given Safe nonce.
+// The transaction is a call without gas refund, so the Safe forwards all gas and the executor pays for it.
+func SafeTxTypedData(tx UnsignedTx, safeNonce *big.Int) apitypes.TypedData {
+	value := tx.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	return apitypes.TypedData{
+		Types: safeTxTypes,
+		Domain: apitypes.TypedDataDomain{
+			ChainId:           math.NewHexOrDecimal256(int64(tx.ChainID)),
+			VerifyingContract: tx.From.Hex(),
+		},
+		PrimaryType: SafeTxPrimaryType,
+		Message: map[string]interface{}{
+			"to":             tx.To.Hex(),
+			"value":          value,
+			"data":           tx.Calldata,
+			"operation":      big.NewInt(SafeOperationCall),
+			"safeTxGas":      new(big.Int),
+			"baseGas":        new(big.Int),
+			"gasPrice":       new(big.Int),
+			"gasToken":       common.Address{}.Hex(),
+			"refundReceiver": common.Address{}.Hex(),
+			"nonce":          safeNonce,
+		},
+	}
+}
+
+// SafeTxHash returns the hash the owners of the Safe sign, it is the contractTransactionHash of the Safe Transaction Service
+func SafeTxHash(tx UnsignedTx, safeNonce *big.Int) (common.Hash, error) {
+	hash, _, err := apitypes.TypedDataAndHash(SafeTxTypedData(tx, safeNonce))
+	if err != nil {
+		return common.Hash{}, errors.Errorf("failed to hash safe transaction: %w", err)
+	}
+	return common.BytesToHash(hash), nil
+}
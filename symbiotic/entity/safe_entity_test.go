@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSafeTxHash(t *testing.T) {
+	tx := UnsignedTx{
+		ChainID:  11155111,
+		From:     common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		To:       common.HexToAddress("0x2222222222222222222222222222222222222222"),
+		Calldata: common.FromHex("0xdeadbeef"),
+	}
+	nonce := big.NewInt(7)
+
+	typedData := SafeTxTypedData(tx, nonce)
+	// type hashes of the Safe contracts
+	require.Equal(t,
+		common.HexToHash("0xbb8310d486368db6bd6f849402fdd73ad53d316b5a4b2644ad6efe0f941286d8"),
+		common.BytesToHash(typedData.TypeHash(SafeTxPrimaryType)),
+	)
+	require.Equal(t,
+		common.HexToHash("0x47e79534a245952e8b16893a336b85a3d9ea9fa8c573f3d803afb92a79469218"),
+		common.BytesToHash(typedData.TypeHash("EIP712Domain")),
+	)
+
+	// the hash as computed by Safe.getTransactionHash
+	word := func(v *big.Int) []byte { return common.LeftPadBytes(v.Bytes(), 32) }
+	structHash := crypto.Keccak256(
+		typedData.TypeHash(SafeTxPrimaryType),
+		common.LeftPadBytes(tx.To.Bytes(), 32),
+		word(big.NewInt(0)),
+		crypto.Keccak256(tx.Calldata),
+		word(big.NewInt(SafeOperationCall)),
+		word(big.NewInt(0)),
+		word(big.NewInt(0)),
+		word(big.NewInt(0)),
+		word(big.NewInt(0)),
+		word(big.NewInt(0)),
+		word(nonce),
+	)
+	domainSeparator := crypto.Keccak256(
+		typedData.TypeHash("EIP712Domain"),
+		word(new(big.Int).SetUint64(tx.ChainID)),
+		common.LeftPadBytes(tx.From.Bytes(), 32),
+	)
+	expected := common.BytesToHash(crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, structHash))
+
+	hash, err := SafeTxHash(tx, nonce)
+	require.NoError(t, err)
+	require.Equal(t, expected, hash)
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SettlementCommitStatus is the state of a validator set header commit to a single settlement.
+type SettlementCommitStatus uint8
+
+const (
+	// SettlementCommitPending means the header still has to be committed to the settlement
+	SettlementCommitPending SettlementCommitStatus = iota
+	// SettlementCommitSubmitted means a commit transaction was sent and is not confirmed yet
+	SettlementCommitSubmitted
+	// SettlementCommitConfirmed means the header is committed to the settlement
+	SettlementCommitConfirmed
+	// SettlementCommitFailed means the last commit attempt failed
+	SettlementCommitFailed
+)
+
+func (s SettlementCommitStatus) String() string {
+	switch s {
+	case SettlementCommitPending:
+		return "Pending"
+	case SettlementCommitSubmitted:
+		return "Submitted"
+	case SettlementCommitConfirmed:
+		return "Confirmed"
+	case SettlementCommitFailed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+func (s SettlementCommitStatus) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + s.String() + "\""), nil
+}
+
+// SettlementCommitState tracks the commit of a validator set header to one settlement.
+type SettlementCommitState struct {
+	Epoch      Epoch
+	Settlement CrossChainAddress
+	Status     SettlementCommitStatus
+	// TxHash is the hash of the latest known commit transaction, zero if none was sent
+	TxHash common.Hash
+	// Attempts is the number of commit transactions sent by this node
+	Attempts uint32
+	// LastError is the error of the latest failed attempt
+	LastError string
+	UpdatedAt time.Time
+}
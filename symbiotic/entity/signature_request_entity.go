@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+)
+
+// SignatureRequestStatus is the lifecycle state of a signature request on a relay.
+type SignatureRequestStatus uint8
+
+const (
+	// SignatureRequestPending means no signatures of the request are collected yet
+	SignatureRequestPending SignatureRequestStatus = iota
+	// SignatureRequestSigned means signatures are being collected, for non aggregation key tags this is final
+	SignatureRequestSigned
+	// SignatureRequestAggregated means the aggregation proof of the request is available
+	SignatureRequestAggregated
+	// SignatureRequestExpired means the deadline of the request passed before it was aggregated
+	SignatureRequestExpired
+	// SignatureRequestCancelled means the request was cancelled on the relay
+	SignatureRequestCancelled
+)
+
+func (s SignatureRequestStatus) String() string {
+	switch s {
+	case SignatureRequestPending:
+		return "Pending"
+	case SignatureRequestSigned:
+		return "Signed"
+	case SignatureRequestAggregated:
+		return "Aggregated"
+	case SignatureRequestExpired:
+		return "Expired"
+	case SignatureRequestCancelled:
+		return "Cancelled"
+	default:
+		return "Unknown"
+	}
+}
+
+func (s SignatureRequestStatus) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + s.String() + "\""), nil
+}
+
+// Expired reports whether the deadline of the request passed.
+func (r SignatureRequest) Expired(now time.Time) bool {
+	return !r.Deadline.IsZero() && !now.Before(r.Deadline)
+}
+
+// Active reports whether relays should still work on the request, i.e. it is neither cancelled nor expired.
+func (r SignatureRequest) Active(now time.Time) bool {
+	return !r.Cancelled && !r.Expired(now)
+}
+
+// Status returns the lifecycle state of the request given whether it has signatures and an aggregation proof.
+// Cancellation is final, a proof aggregated before the deadline keeps the request aggregated after it passes.
+func (r SignatureRequest) Status(now time.Time, signed, aggregated bool) SignatureRequestStatus {
+	switch {
+	case r.Cancelled:
+		return SignatureRequestCancelled
+	case aggregated:
+		return SignatureRequestAggregated
+	case r.Expired(now):
+		return SignatureRequestExpired
+	case signed:
+		return SignatureRequestSigned
+	default:
+		return SignatureRequestPending
+	}
+}
+
+// PriorityClass is the scheduling class of a signature request, signing and aggregation serve classes by weight.
+type PriorityClass uint8
+
+const (
+	// PriorityUnspecified means the class is derived from the key tag of the request
+	PriorityUnspecified PriorityClass = iota
+	PriorityLow
+	PriorityNormal
+	PriorityHigh
+	// PriorityHeader is reserved for validator set header requests, it is always served first
+	PriorityHeader
+)
+
+func (p PriorityClass) String() string {
+	switch p {
+	case PriorityUnspecified:
+		return "unspecified"
+	case PriorityLow:
+		return "low"
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	case PriorityHeader:
+		return "header"
+	default:
+		return "unknown"
+	}
+}
+
+func (p PriorityClass) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + p.String() + "\""), nil
+}
+
+// ParsePriorityClass parses the class configurable for key tags and API clients, the header class is reserved.
+func ParsePriorityClass(s string) (PriorityClass, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "low":
+		return PriorityLow, nil
+	case "normal":
+		return PriorityNormal, nil
+	case "high":
+		return PriorityHigh, nil
+	case "header":
+		return PriorityUnspecified, errors.New("priority class header is reserved for validator set headers")
+	default:
+		return PriorityUnspecified, errors.Errorf("unknown priority class %q, expected low, normal or high", s)
+	}
+}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignatureRequest_Expired(t *testing.T) {
+	now := time.Now()
+
+	require.False(t, SignatureRequest{}.Expired(now))
+	require.False(t, SignatureRequest{Deadline: now.Add(time.Second)}.Expired(now))
+	require.True(t, SignatureRequest{Deadline: now}.Expired(now))
+	require.True(t, SignatureRequest{Deadline: now.Add(-time.Second)}.Expired(now))
+}
+
+func TestSignatureRequest_Active(t *testing.T) {
+	now := time.Now()
+
+	require.True(t, SignatureRequest{}.Active(now))
+	require.False(t, SignatureRequest{Cancelled: true}.Active(now))
+	require.False(t, SignatureRequest{Deadline: now.Add(-time.Second)}.Active(now))
+}
+
+func TestSignatureRequest_Status(t *testing.T) {
+	now := time.Now()
+	expired := now.Add(-time.Second)
+
+	tests := []struct {
+		name       string
+		req        SignatureRequest
+		signed     bool
+		aggregated bool
+		expected   SignatureRequestStatus
+	}{
+		{name: "pending", expected: SignatureRequestPending},
+		{name: "signed", signed: true, expected: SignatureRequestSigned},
+		{name: "aggregated", signed: true, aggregated: true, expected: SignatureRequestAggregated},
+		{name: "expired", req: SignatureRequest{Deadline: expired}, signed: true, expected: SignatureRequestExpired},
+		{name: "aggregated before expiry", req: SignatureRequest{Deadline: expired}, signed: true, aggregated: true, expected: SignatureRequestAggregated},
+		{name: "cancelled", req: SignatureRequest{Cancelled: true}, signed: true, expected: SignatureRequestCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.req.Status(now, tt.signed, tt.aggregated))
+		})
+	}
+}
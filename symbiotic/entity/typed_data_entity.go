@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/go-errors/errors"
+)
+
+// EncodeTypedData returns the EIP-712 encoding of typed data: "\x19\x01" || domainSeparator || hashStruct(message).
+// Keccak256 of the encoding is the digest wallets sign with eth_signTypedData, so it is used as the signed message.
+func EncodeTypedData(typedData apitypes.TypedData) (RawMessage, error) {
+	if _, ok := typedData.Types["EIP712Domain"]; !ok {
+		return nil, errors.New("typed data types must include EIP712Domain")
+	}
+	if _, ok := typedData.Types[typedData.PrimaryType]; !ok {
+		return nil, errors.Errorf("primary type %q is not defined in typed data types", typedData.PrimaryType)
+	}
+
+	_, encoded, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, errors.Errorf("failed to encode typed data: %w", err)
+	}
+	return RawMessage(encoded), nil
+}
+
+// ParseTypedData decodes typed data from its JSON form. Numbers are kept as decimal strings
+// so that uint256 values are not truncated to float64 and the data re-encodes identically after storage.
+func ParseTypedData(data []byte) (apitypes.TypedData, error) {
+	var typedData apitypes.TypedData
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&typedData); err != nil {
+		return apitypes.TypedData{}, errors.Errorf("failed to decode typed data: %w", err)
+	}
+	typedData.Message = numbersToStrings(typedData.Message).(map[string]interface{})
+	return typedData, nil
+}
+
+// ParseTypedDataMessage decodes the message part of typed data from its JSON form, see ParseTypedData.
+func ParseTypedDataMessage(data []byte) (apitypes.TypedDataMessage, error) {
+	var message apitypes.TypedDataMessage
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&message); err != nil {
+		return nil, errors.Errorf("failed to decode typed data message: %w", err)
+	}
+	if message == nil {
+		return nil, errors.New("typed data message must be a JSON object")
+	}
+	return numbersToStrings(message).(map[string]interface{}), nil
+}
+
+func numbersToStrings(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		return v.String()
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = numbersToStrings(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = numbersToStrings(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
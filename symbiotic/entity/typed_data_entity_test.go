@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+)
+
+// mailTypedData is the example from the EIP-712 specification
+const mailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func TestEncodeTypedData_MatchesSpecDigest(t *testing.T) {
+	typedData, err := ParseTypedData([]byte(mailTypedData))
+	require.NoError(t, err)
+
+	encoded, err := EncodeTypedData(typedData)
+	require.NoError(t, err)
+	require.Len(t, encoded, 66)
+	require.Equal(t, []byte{0x19, 0x01}, []byte(encoded[:2]))
+	require.Equal(t, common.HexToHash("0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"), crypto.Keccak256Hash(encoded))
+}
+
+func TestParseTypedData_KeepsLargeIntegersAcrossRoundTrip(t *testing.T) {
+	const data = `{
+		"types": {
+			"EIP712Domain": [{"name": "name", "type": "string"}],
+			"Transfer": [{"name": "amount", "type": "uint256"}]
+		},
+		"primaryType": "Transfer",
+		"domain": {"name": "Token"},
+		"message": {"amount": 115792089237316195423570985008687907853269984665640564039457584007913129639935}
+	}`
+
+	typedData, err := ParseTypedData([]byte(data))
+	require.NoError(t, err)
+	require.Equal(t, "115792089237316195423570985008687907853269984665640564039457584007913129639935", typedData.Message["amount"])
+
+	encoded, err := EncodeTypedData(typedData)
+	require.NoError(t, err)
+
+	stored, err := json.Marshal(typedData)
+	require.NoError(t, err)
+	restored, err := ParseTypedData(stored)
+	require.NoError(t, err)
+
+	reencoded, err := EncodeTypedData(restored)
+	require.NoError(t, err)
+	require.Equal(t, encoded, reencoded)
+}
+
+func TestEncodeTypedData_RejectsIncompleteTypes(t *testing.T) {
+	typedData, err := ParseTypedData([]byte(mailTypedData))
+	require.NoError(t, err)
+
+	withoutDomain := typedData
+	withoutDomain.Types = apitypes.Types{}
+	for name, fields := range typedData.Types {
+		if name != "EIP712Domain" {
+			withoutDomain.Types[name] = fields
+		}
+	}
+	_, err = EncodeTypedData(withoutDomain)
+	require.ErrorContains(t, err, "EIP712Domain")
+
+	unknownPrimary := typedData
+	unknownPrimary.PrimaryType = "Letter"
+	_, err = EncodeTypedData(unknownPrimary)
+	require.ErrorContains(t, err, "Letter")
+}
+
+func TestParseTypedDataMessage_RejectsNonObject(t *testing.T) {
+	_, err := ParseTypedDataMessage([]byte(`null`))
+	require.Error(t, err)
+
+	_, err = ParseTypedDataMessage([]byte(`[1, 2]`))
+	require.Error(t, err)
+}
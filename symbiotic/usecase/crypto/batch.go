@@ -0,0 +1,90 @@
+package crypto
+
+import (
+	"github.com/go-errors/errors"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto/bls12381"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto/blsBn254"
+)
+
+// BatchItem is a signature verified as part of a batch, all items of a batch have the same key type.
+type BatchItem struct {
+	PublicKey   PublicKey
+	MessageHash symbiotic.RawMessageHash
+	Signature   symbiotic.RawSignature
+}
+
+// SupportsBatchVerification reports whether signatures of the key type can be verified with a single multi-pairing.
+func SupportsBatchVerification(keyType symbiotic.KeyType) bool {
+	return keyType == symbiotic.KeyTypeBlsBn254 || keyType == symbiotic.KeyTypeBls12381
+}
+
+// VerifyBatch verifies all signatures at once, it fails if any of them is invalid but doesn't tell which one.
+// Key types without batch support are verified one by one.
+func VerifyBatch(keyType symbiotic.KeyType, items []BatchItem) error {
+	switch keyType {
+	case symbiotic.KeyTypeBlsBn254:
+		batch := make([]blsBn254.BatchItem, 0, len(items))
+		for _, item := range items {
+			pk, ok := item.PublicKey.(*blsBn254.PublicKey)
+			if !ok {
+				return errors.Errorf("unexpected public key type %T for bls bn254 batch", item.PublicKey)
+			}
+			batch = append(batch, blsBn254.BatchItem{PublicKey: pk, MessageHash: item.MessageHash, Signature: item.Signature})
+		}
+		return blsBn254.VerifyBatch(batch)
+	case symbiotic.KeyTypeBls12381:
+		batch := make([]bls12381.BatchItem, 0, len(items))
+		for _, item := range items {
+			pk, ok := item.PublicKey.(*bls12381.PublicKey)
+			if !ok {
+				return errors.Errorf("unexpected public key type %T for bls12381 batch", item.PublicKey)
+			}
+			batch = append(batch, bls12381.BatchItem{PublicKey: pk, MessageHash: item.MessageHash, Signature: item.Signature})
+		}
+		return bls12381.VerifyBatch(batch)
+	case symbiotic.KeyTypeEcdsaSecp256k1, symbiotic.KeyTypeInvalid:
+	}
+
+	for _, item := range items {
+		if err := item.PublicKey.VerifyWithHash(item.MessageHash, item.Signature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyEach verifies the signatures as a batch and returns the verification error of every item, nil for valid ones.
+// A failing batch is bisected until the invalid signatures are found, so a few bad signatures cost a few
+// extra multi-pairings instead of one pairing check per signature.
+func VerifyEach(keyType symbiotic.KeyType, items []BatchItem) []error {
+	errs := make([]error, len(items))
+	if !SupportsBatchVerification(keyType) {
+		for i, item := range items {
+			errs[i] = item.PublicKey.VerifyWithHash(item.MessageHash, item.Signature)
+		}
+		return errs
+	}
+
+	bisect(keyType, items, errs)
+	return errs
+}
+
+func bisect(keyType symbiotic.KeyType, items []BatchItem, errs []error) {
+	if len(items) == 0 {
+		return
+	}
+	if len(items) == 1 {
+		// the single check reports the precise reason
+		errs[0] = items[0].PublicKey.VerifyWithHash(items[0].MessageHash, items[0].Signature)
+		return
+	}
+	if VerifyBatch(keyType, items) == nil {
+		return
+	}
+
+	mid := len(items) / 2
+	bisect(keyType, items[:mid], errs[:mid])
+	bisect(keyType, items[mid:], errs[mid:])
+}
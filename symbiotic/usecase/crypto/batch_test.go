@@ -0,0 +1,66 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func newBatch(t *testing.T, keyType symbiotic.KeyType, size int) []BatchItem {
+	t.Helper()
+
+	items := make([]BatchItem, 0, size)
+	for range size {
+		private, err := GeneratePrivateKey(keyType)
+		require.NoError(t, err)
+
+		msg := make([]byte, 32)
+		_, err = rand.Read(msg)
+		require.NoError(t, err)
+
+		sig, hash, err := private.Sign(msg)
+		require.NoError(t, err)
+		items = append(items, BatchItem{PublicKey: private.PublicKey(), MessageHash: hash, Signature: sig})
+	}
+	return items
+}
+
+func TestVerifyEach(t *testing.T) {
+	for name, keyType := range map[string]symbiotic.KeyType{
+		"bls bn254":       symbiotic.KeyTypeBlsBn254,
+		"bls12381":        symbiotic.KeyTypeBls12381,
+		"ecdsa secp256k1": symbiotic.KeyTypeEcdsaSecp256k1,
+	} {
+		t.Run(name, func(t *testing.T) {
+			items := newBatch(t, keyType, 11)
+			require.NoError(t, VerifyBatch(keyType, items))
+			for _, err := range VerifyEach(keyType, items) {
+				require.NoError(t, err)
+			}
+
+			invalid := map[int]bool{0: true, 5: true, 6: true, 10: true}
+			for i := range invalid {
+				items[i].Signature = items[(i+1)%len(items)].Signature
+			}
+			require.Error(t, VerifyBatch(keyType, items))
+
+			errs := VerifyEach(keyType, items)
+			require.Len(t, errs, len(items))
+			for i, err := range errs {
+				if invalid[i] {
+					require.Error(t, err, "item %d", i)
+				} else {
+					require.NoError(t, err, "item %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyBatch_UnexpectedPublicKeyType(t *testing.T) {
+	items := newBatch(t, symbiotic.KeyTypeBls12381, 2)
+	require.Error(t, VerifyBatch(symbiotic.KeyTypeBlsBn254, items))
+}
@@ -0,0 +1,118 @@
+package bls12381
+
+import (
+	"crypto/rand"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/go-errors/errors"
+)
+
+// batchScalarBits is the size of the random coefficients of a batch, a batch with an invalid signature
+// passes with probability 2^-128
+const batchScalarBits = 128
+
+// BatchItem is a signature verified as part of a batch.
+type BatchItem struct {
+	PublicKey   *PublicKey
+	MessageHash MessageHash
+	Signature   Signature
+}
+
+// VerifyBatch verifies all signatures with a multi-pairing over a random linear combination,
+// e(Σ r_i·σ_i, g2) == Π e(r_i·H(m_i), pk_i). Signatures of the same message share one pairing,
+// e(H(m), Σ r_i·pk_i), so a batch costs one pairing per distinct message plus one.
+// It fails if any signature is invalid but doesn't tell which one.
+func VerifyBatch(items []BatchItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	// items grouped by message in order of first appearance
+	var groups [][]int
+	groupOf := make(map[string]int)
+	coefficients := make([]*big.Int, len(items))
+
+	var sigSum bls12381.G1Jac
+	for i, item := range items {
+		if item.PublicKey == nil {
+			return errors.Errorf("bls12381: nil public key at batch index %d", i)
+		}
+		if len(item.MessageHash) != MessageHashLength {
+			return errors.Errorf("bls12381: invalid message hash length at batch index %d", i)
+		}
+
+		g1Sig := bls12381.G1Affine{}
+		if _, err := g1Sig.SetBytes(item.Signature); err != nil {
+			return errors.Errorf("bls12381: failed to set big into G1 at batch index %d: %w", i, err)
+		}
+
+		r, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), batchScalarBits))
+		if err != nil {
+			return errors.Errorf("bls12381: failed to generate batch coefficient: %w", err)
+		}
+		// zero would drop the signature from the check
+		r.Add(r, big.NewInt(1))
+		coefficients[i] = r
+
+		var rSig bls12381.G1Affine
+		rSig.ScalarMultiplication(&g1Sig, r)
+		sigSum.AddMixed(&rSig)
+
+		group, ok := groupOf[string(item.MessageHash)]
+		if !ok {
+			group = len(groups)
+			groupOf[string(item.MessageHash)] = group
+			groups = append(groups, nil)
+		}
+		groups[group] = append(groups[group], i)
+	}
+
+	g1P := make([]bls12381.G1Affine, 0, len(groups)+1)
+	g1Q := make([]bls12381.G2Affine, 0, len(groups)+1)
+	for _, group := range groups {
+		g1Hash, err := HashToG1(items[group[0]].MessageHash)
+		if err != nil {
+			return errors.Errorf("bls12381: failed to hash message to G1: %w", err)
+		}
+
+		if len(group) == 1 {
+			// scaling in G1 is cheaper than in G2
+			var rHash bls12381.G1Affine
+			rHash.ScalarMultiplication(g1Hash, coefficients[group[0]])
+			g1P = append(g1P, rHash)
+			g1Q = append(g1Q, items[group[0]].PublicKey.g2PubKey)
+			continue
+		}
+
+		var keySum bls12381.G2Jac
+		for _, i := range group {
+			var rKey bls12381.G2Affine
+			rKey.ScalarMultiplication(&items[i].PublicKey.g2PubKey, coefficients[i])
+			keySum.AddMixed(&rKey)
+		}
+		var keySumAffine bls12381.G2Affine
+		keySumAffine.FromJacobian(&keySum)
+
+		g1P = append(g1P, *g1Hash)
+		g1Q = append(g1Q, keySumAffine)
+	}
+
+	_, _, _, g2Gen := bls12381.Generators()
+
+	var negSigSum bls12381.G1Affine
+	negSigSum.FromJacobian(&sigSum)
+	negSigSum.Neg(&negSigSum)
+
+	g1P = append(g1P, negSigSum)
+	g1Q = append(g1Q, g2Gen)
+
+	ok, err := bls12381.PairingCheck(g1P, g1Q)
+	if err != nil {
+		return errors.Errorf("bls12381: pairing check failed: %w", err)
+	}
+	if !ok {
+		return errors.Errorf("bls12381: invalid signature in batch")
+	}
+	return nil
+}
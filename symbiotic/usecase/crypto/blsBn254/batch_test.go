@@ -0,0 +1,94 @@
+package blsBn254
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newBatch(t *testing.T, size int) []BatchItem {
+	t.Helper()
+
+	items := make([]BatchItem, 0, size)
+	for range size {
+		private, err := GenerateKey()
+		require.NoError(t, err)
+
+		sig, hash, err := private.Sign(randData(t))
+		require.NoError(t, err)
+
+		public, ok := private.PublicKey().(*PublicKey)
+		require.True(t, ok)
+		items = append(items, BatchItem{PublicKey: public, MessageHash: hash, Signature: sig})
+	}
+	return items
+}
+
+func TestVerifyBatch(t *testing.T) {
+	t.Run("empty batch", func(t *testing.T) {
+		require.NoError(t, VerifyBatch(nil))
+	})
+
+	t.Run("valid signatures", func(t *testing.T) {
+		require.NoError(t, VerifyBatch(newBatch(t, 8)))
+	})
+
+	t.Run("same message signed by several keys", func(t *testing.T) {
+		items := newBatch(t, 4)
+		msg := randData(t)
+		for i := range items {
+			private, err := GenerateKey()
+			require.NoError(t, err)
+			sig, hash, err := private.Sign(msg)
+			require.NoError(t, err)
+			public, ok := private.PublicKey().(*PublicKey)
+			require.True(t, ok)
+			items[i] = BatchItem{PublicKey: public, MessageHash: hash, Signature: sig}
+		}
+		require.NoError(t, VerifyBatch(items))
+	})
+
+	t.Run("same message with the signature of another signer", func(t *testing.T) {
+		// signatures of one message share a pairing, a swap between their signers must still fail
+		items := newBatch(t, 2)
+		msg := randData(t)
+		for range 3 {
+			private, err := GenerateKey()
+			require.NoError(t, err)
+			sig, hash, err := private.Sign(msg)
+			require.NoError(t, err)
+			public, ok := private.PublicKey().(*PublicKey)
+			require.True(t, ok)
+			items = append(items, BatchItem{PublicKey: public, MessageHash: hash, Signature: sig})
+		}
+		require.NoError(t, VerifyBatch(items))
+
+		items[2].Signature, items[3].Signature = items[3].Signature, items[2].Signature
+		require.Error(t, VerifyBatch(items))
+	})
+
+	t.Run("signature of another key", func(t *testing.T) {
+		items := newBatch(t, 8)
+		items[3].Signature = items[4].Signature
+		require.Error(t, VerifyBatch(items))
+	})
+
+	t.Run("swapped signatures", func(t *testing.T) {
+		// each signature is valid on its own, only their pairing with the keys is wrong
+		items := newBatch(t, 2)
+		items[0].Signature, items[1].Signature = items[1].Signature, items[0].Signature
+		require.Error(t, VerifyBatch(items))
+	})
+
+	t.Run("malformed signature", func(t *testing.T) {
+		items := newBatch(t, 2)
+		items[1].Signature = []byte{1, 2, 3}
+		require.Error(t, VerifyBatch(items))
+	})
+
+	t.Run("invalid message hash length", func(t *testing.T) {
+		items := newBatch(t, 2)
+		items[0].MessageHash = items[0].MessageHash[:16]
+		require.Error(t, VerifyBatch(items))
+	})
+}
@@ -0,0 +1,76 @@
+package hd
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+)
+
+const hardenedOffset = 0x80000000
+
+var bip32MasterKey = []byte("Bitcoin seed")
+
+// DeriveSecp256k1 derives a secp256k1 private key from the seed along the BIP-32 path.
+func DeriveSecp256k1(seed []byte, path accounts.DerivationPath) ([]byte, error) {
+	mac := hmac.New(sha512.New, bip32MasterKey)
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	key, chainCode := sum[:32], sum[32:]
+	if err := checkSecp256k1Key(key); err != nil {
+		return nil, err
+	}
+
+	for _, index := range path {
+		var err error
+		key, chainCode, err = deriveSecp256k1Child(key, chainCode, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+func deriveSecp256k1Child(key, chainCode []byte, index uint32) ([]byte, []byte, error) {
+	var data []byte
+	if index >= hardenedOffset {
+		data = append([]byte{0}, key...)
+	} else {
+		privateKey, err := crypto.ToECDSA(key)
+		if err != nil {
+			return nil, nil, errors.Errorf("hd: invalid parent key: %w", err)
+		}
+		data = crypto.CompressPubkey(&privateKey.PublicKey)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	tweak := new(big.Int).SetBytes(sum[:32])
+	if tweak.Cmp(n) >= 0 {
+		return nil, nil, errors.Errorf("hd: invalid child key at index %d, use another index", index)
+	}
+	child := tweak.Add(tweak, new(big.Int).SetBytes(key))
+	child.Mod(child, n)
+	if child.Sign() == 0 {
+		return nil, nil, errors.Errorf("hd: invalid child key at index %d, use another index", index)
+	}
+
+	return child.FillBytes(make([]byte, 32)), sum[32:], nil
+}
+
+func checkSecp256k1Key(key []byte) error {
+	k := new(big.Int).SetBytes(key)
+	if k.Sign() == 0 || k.Cmp(crypto.S256().Params().N) >= 0 {
+		return errors.New("hd: seed produces an invalid master key")
+	}
+	return nil
+}
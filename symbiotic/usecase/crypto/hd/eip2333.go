@@ -0,0 +1,115 @@
+package hd
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/go-errors/errors"
+)
+
+const (
+	lamportChunks    = 255
+	lamportChunkSize = sha256.Size
+)
+
+var blsKeygenSalt = []byte("BLS-SIG-KEYGEN-SALT-")
+
+// DeriveBLS derives a BLS private key from the seed along the path as specified by EIP-2333.
+// The scalar field order r selects the curve, EIP-2333 defines the derivation for BLS12-381
+// and the same construction is used for BN254.
+func DeriveBLS(seed []byte, path []uint32, r *big.Int) (*big.Int, error) {
+	sk, err := deriveMasterSK(seed, r)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range path {
+		sk, err = deriveChildSK(sk, index, r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sk, nil
+}
+
+func deriveMasterSK(seed []byte, r *big.Int) (*big.Int, error) {
+	if len(seed) < 32 {
+		return nil, errors.New("hd: seed must be at least 32 bytes")
+	}
+	return hkdfModR(seed, r)
+}
+
+func deriveChildSK(parentSK *big.Int, index uint32, r *big.Int) (*big.Int, error) {
+	compressedLamportPK, err := parentSKToLamportPK(parentSK, index)
+	if err != nil {
+		return nil, err
+	}
+	return hkdfModR(compressedLamportPK, r)
+}
+
+func parentSKToLamportPK(parentSK *big.Int, index uint32) ([]byte, error) {
+	salt := binary.BigEndian.AppendUint32(nil, index)
+	ikm := parentSK.FillBytes(make([]byte, 32))
+
+	lamport0, err := ikmToLamportSK(ikm, salt)
+	if err != nil {
+		return nil, err
+	}
+	notIKM := make([]byte, len(ikm))
+	for i, b := range ikm {
+		notIKM[i] = ^b
+	}
+	lamport1, err := ikmToLamportSK(notIKM, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	lamportPK := make([]byte, 0, 2*lamportChunks*lamportChunkSize)
+	for _, lamport := range [][]byte{lamport0, lamport1} {
+		for i := 0; i < lamportChunks; i++ {
+			chunk := sha256.Sum256(lamport[i*lamportChunkSize : (i+1)*lamportChunkSize])
+			lamportPK = append(lamportPK, chunk[:]...)
+		}
+	}
+	compressed := sha256.Sum256(lamportPK)
+	return compressed[:], nil
+}
+
+func ikmToLamportSK(ikm, salt []byte) ([]byte, error) {
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, errors.Errorf("hd: hkdf extract failed: %w", err)
+	}
+	okm, err := hkdf.Expand(sha256.New, prk, "", lamportChunks*lamportChunkSize)
+	if err != nil {
+		return nil, errors.Errorf("hd: hkdf expand failed: %w", err)
+	}
+	return okm, nil
+}
+
+// hkdfModR is HKDF_mod_r of EIP-2333 with an empty key info.
+func hkdfModR(ikm []byte, r *big.Int) (*big.Int, error) {
+	// L = ceil((3 * ceil(log2(r))) / 16), 48 bytes for both BLS12-381 and BN254
+	l := (3*r.BitLen() + 15) / 16
+	info := string([]byte{byte(l >> 8), byte(l)})
+	secret := append(append([]byte{}, ikm...), 0)
+
+	salt := blsKeygenSalt
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		hashedSalt := sha256.Sum256(salt)
+		salt = hashedSalt[:]
+
+		prk, err := hkdf.Extract(sha256.New, secret, salt)
+		if err != nil {
+			return nil, errors.Errorf("hd: hkdf extract failed: %w", err)
+		}
+		okm, err := hkdf.Expand(sha256.New, prk, info, l)
+		if err != nil {
+			return nil, errors.Errorf("hd: hkdf expand failed: %w", err)
+		}
+		sk.SetBytes(okm).Mod(sk, r)
+	}
+	return sk, nil
+}
@@ -0,0 +1,56 @@
+package hd
+
+import (
+	"math/big"
+	"strings"
+
+	bls12381fr "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/go-errors/errors"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	"github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+// DerivePrivateKey derives a private key of the key type from the seed along the path.
+// Secp256k1 keys are derived with BIP-32, e.g. m/44'/60'/0'/0/0.
+// BLS keys are derived with EIP-2333 whose paths have no hardened components, e.g. m/12381/60/2/15.
+func DerivePrivateKey(seed []byte, keyType symbiotic.KeyType, path string) (crypto.PrivateKey, error) {
+	if !strings.HasPrefix(path, "m/") {
+		return nil, errors.Errorf("hd: derivation path %q must start with m/", path)
+	}
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, errors.Errorf("hd: invalid derivation path %q: %w", path, err)
+	}
+
+	switch keyType {
+	case symbiotic.KeyTypeEcdsaSecp256k1:
+		key, err := DeriveSecp256k1(seed, derivationPath)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.NewPrivateKey(keyType, key)
+	case symbiotic.KeyTypeBlsBn254:
+		return deriveBLSKey(seed, keyType, derivationPath, bn254fr.Modulus())
+	case symbiotic.KeyTypeBls12381:
+		return deriveBLSKey(seed, keyType, derivationPath, bls12381fr.Modulus())
+	case symbiotic.KeyTypeInvalid:
+		return nil, errors.New("hd: unsupported key type")
+	}
+	return nil, errors.New("hd: unsupported key type")
+}
+
+func deriveBLSKey(seed []byte, keyType symbiotic.KeyType, path accounts.DerivationPath, r *big.Int) (crypto.PrivateKey, error) {
+	for _, index := range path {
+		if index >= hardenedOffset {
+			return nil, errors.New("hd: bls derivation paths must not contain hardened components")
+		}
+	}
+	sk, err := DeriveBLS(seed, path, r)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.NewPrivateKey(keyType, sk.Bytes())
+}
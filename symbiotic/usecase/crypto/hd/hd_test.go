@@ -0,0 +1,132 @@
+package hd
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	bls12381fr "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestSeedFromMnemonic(t *testing.T) {
+	// BIP-39 reference vector
+	seed, err := SeedFromMnemonic(testMnemonic, "TREZOR")
+	require.NoError(t, err)
+	require.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04", common.Bytes2Hex(seed))
+
+	// extra whitespace is ignored
+	spaced, err := SeedFromMnemonic("  "+strings.ReplaceAll(testMnemonic, " ", "\n ")+" ", "TREZOR")
+	require.NoError(t, err)
+	require.Equal(t, seed, spaced)
+
+	_, err = SeedFromMnemonic(strings.Replace(testMnemonic, "about", "abandon", 1), "")
+	require.Error(t, err)
+}
+
+func TestNewMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(mnemonic), 24)
+
+	_, err = SeedFromMnemonic(mnemonic, "")
+	require.NoError(t, err)
+}
+
+func TestDerivePrivateKey_Secp256k1(t *testing.T) {
+	seed, err := SeedFromMnemonic(testMnemonic, "")
+	require.NoError(t, err)
+
+	// first account of the mnemonic in Ethereum wallets
+	key, err := DerivePrivateKey(seed, symbiotic.KeyTypeEcdsaSecp256k1, "m/44'/60'/0'/0/0")
+	require.NoError(t, err)
+	ecdsaKey, err := ethCrypto.ToECDSA(key.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94"), ethCrypto.PubkeyToAddress(ecdsaKey.PublicKey))
+}
+
+func TestDeriveBLS_EIP2333Vectors(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     string
+		master   string
+		index    uint32
+		childKey string
+	}{
+		{
+			name:     "case 0",
+			seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			master:   "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+			index:    0,
+			childKey: "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+		},
+		{
+			name:     "case 1",
+			seed:     "3141592653589793238462643383279502884197169399375105820974944592",
+			master:   "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+			index:    3141592653,
+			childKey: "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed := common.Hex2Bytes(tt.seed)
+
+			master, err := DeriveBLS(seed, nil, bls12381fr.Modulus())
+			require.NoError(t, err)
+			require.Equal(t, tt.master, master.String())
+
+			child, err := DeriveBLS(seed, []uint32{tt.index}, bls12381fr.Modulus())
+			require.NoError(t, err)
+			require.Equal(t, tt.childKey, child.String())
+		})
+	}
+}
+
+func TestDerivePrivateKey_BLS(t *testing.T) {
+	seed, err := SeedFromMnemonic(testMnemonic, "")
+	require.NoError(t, err)
+
+	for _, keyType := range []symbiotic.KeyType{symbiotic.KeyTypeBlsBn254, symbiotic.KeyTypeBls12381} {
+		key, err := DerivePrivateKey(seed, keyType, "m/12381/60/2/15")
+		require.NoError(t, err)
+
+		again, err := DerivePrivateKey(seed, keyType, "m/12381/60/2/15")
+		require.NoError(t, err)
+		require.Equal(t, key.Bytes(), again.Bytes())
+
+		other, err := DerivePrivateKey(seed, keyType, "m/12381/60/2/14")
+		require.NoError(t, err)
+		require.NotEqual(t, key.Bytes(), other.Bytes())
+
+		_, _, err = key.Sign([]byte("message"))
+		require.NoError(t, err)
+	}
+
+	bn254Key, err := DerivePrivateKey(seed, symbiotic.KeyTypeBlsBn254, "m/12381/60/2/15")
+	require.NoError(t, err)
+	bls12381Key, err := DerivePrivateKey(seed, symbiotic.KeyTypeBls12381, "m/12381/60/2/15")
+	require.NoError(t, err)
+	require.NotEqual(t, new(big.Int).SetBytes(bn254Key.Bytes()), new(big.Int).SetBytes(bls12381Key.Bytes()))
+}
+
+func TestDerivePrivateKey_InvalidPath(t *testing.T) {
+	seed, err := SeedFromMnemonic(testMnemonic, "")
+	require.NoError(t, err)
+
+	_, err = DerivePrivateKey(seed, symbiotic.KeyTypeBlsBn254, "m/12381'/60/2/15")
+	require.ErrorContains(t, err, "hardened")
+
+	_, err = DerivePrivateKey(seed, symbiotic.KeyTypeEcdsaSecp256k1, "44'/60'/0'/0/0")
+	require.ErrorContains(t, err, "must start with m/")
+
+	_, err = DerivePrivateKey(seed, symbiotic.KeyTypeEcdsaSecp256k1, "m/x")
+	require.Error(t, err)
+}
@@ -0,0 +1,33 @@
+package hd
+
+import (
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// mnemonicEntropyBits is the entropy of generated mnemonics, 256 bits give 24 words.
+const mnemonicEntropyBits = 256
+
+// NewMnemonic generates a random 24 words BIP-39 mnemonic.
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropyBits)
+	if err != nil {
+		return "", errors.Errorf("hd: failed to generate entropy: %w", err)
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return "", errors.Errorf("hd: failed to create mnemonic: %w", err)
+	}
+	return mnemonic, nil
+}
+
+// SeedFromMnemonic validates the BIP-39 mnemonic and returns its seed, the passphrase is the optional "25th word".
+func SeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, errors.New("hd: invalid mnemonic")
+	}
+	return bip39.NewSeed(mnemonic, passphrase), nil
+}
@@ -0,0 +1,91 @@
+// Package merkle builds Merkle trees over message batches.
+//
+// The layout is compatible with OpenZeppelin MerkleProof.verify:
+//   - leaf = keccak256(bytes.concat(keccak256(message))), hashing twice prevents second preimage attacks
+//     with 64 byte messages
+//   - node = keccak256(abi.encodePacked(min(a, b), max(a, b))), pairs are sorted so the proof does not carry positions
+//   - leaves keep the order of messages, a node without a sibling is promoted to the next level unchanged
+package merkle
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+)
+
+// HashLeaf returns the leaf of a message.
+func HashLeaf(message []byte) common.Hash {
+	inner := crypto.Keccak256(message)
+	return crypto.Keccak256Hash(inner)
+}
+
+// HashLeaves returns leaves of all messages in order.
+func HashLeaves(messages [][]byte) []common.Hash {
+	leaves := make([]common.Hash, len(messages))
+	for i, message := range messages {
+		leaves[i] = HashLeaf(message)
+	}
+	return leaves
+}
+
+// Root returns the root of the tree built over the leaves.
+func Root(leaves []common.Hash) (common.Hash, error) {
+	if len(leaves) == 0 {
+		return common.Hash{}, errors.New("merkle tree requires at least one leaf")
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		level = nextLevel(level)
+	}
+	return level[0], nil
+}
+
+// Proof returns the sibling path from the leaf at index to the root.
+func Proof(leaves []common.Hash, index int) ([]common.Hash, error) {
+	if index < 0 || index >= len(leaves) {
+		return nil, errors.Errorf("leaf index %d out of range [0, %d)", index, len(leaves))
+	}
+
+	var proof []common.Hash
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = nextLevel(level)
+		index /= 2
+	}
+	return proof, nil
+}
+
+// Verify checks that the leaf is included in the tree with the given root.
+func Verify(proof []common.Hash, root, leaf common.Hash) bool {
+	computed := leaf
+	for _, sibling := range proof {
+		computed = hashPair(computed, sibling)
+	}
+	return computed == root
+}
+
+func nextLevel(level []common.Hash) []common.Hash {
+	next := make([]common.Hash, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			continue
+		}
+		next = append(next, hashPair(level[i], level[i+1]))
+	}
+	return next
+}
+
+func hashPair(a, b common.Hash) common.Hash {
+	if bytes.Compare(a.Bytes(), b.Bytes()) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a.Bytes(), b.Bytes())
+}
@@ -0,0 +1,83 @@
+package merkle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashLeaf_MatchesDoubleKeccak(t *testing.T) {
+	t.Parallel()
+
+	message := []byte("hello")
+	require.Equal(t, crypto.Keccak256Hash(crypto.Keccak256(message)), HashLeaf(message))
+}
+
+func TestRoot_SingleLeafIsRoot(t *testing.T) {
+	t.Parallel()
+
+	leaf := HashLeaf([]byte("only"))
+	root, err := Root([]common.Hash{leaf})
+	require.NoError(t, err)
+	require.Equal(t, leaf, root)
+
+	proof, err := Proof([]common.Hash{leaf}, 0)
+	require.NoError(t, err)
+	require.Empty(t, proof)
+}
+
+func TestRoot_Empty(t *testing.T) {
+	t.Parallel()
+
+	_, err := Root(nil)
+	require.Error(t, err)
+}
+
+func TestRoot_TwoLeavesSortedPair(t *testing.T) {
+	t.Parallel()
+
+	a, b := HashLeaf([]byte("a")), HashLeaf([]byte("b"))
+	root, err := Root([]common.Hash{a, b})
+	require.NoError(t, err)
+
+	rootSwapped, err := Root([]common.Hash{b, a})
+	require.NoError(t, err)
+	require.Equal(t, root, rootSwapped, "pairs are hashed in sorted order")
+	require.Equal(t, hashPair(a, b), root)
+}
+
+func TestProof_VerifiesEveryLeaf(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{1, 2, 3, 4, 5, 7, 8, 13, 100} {
+		t.Run(fmt.Sprintf("size %d", size), func(t *testing.T) {
+			messages := make([][]byte, size)
+			for i := range messages {
+				messages[i] = []byte(fmt.Sprintf("message-%d", i))
+			}
+			leaves := HashLeaves(messages)
+			root, err := Root(leaves)
+			require.NoError(t, err)
+
+			for i, leaf := range leaves {
+				proof, err := Proof(leaves, i)
+				require.NoError(t, err)
+				require.True(t, Verify(proof, root, leaf), "leaf %d", i)
+				require.False(t, Verify(proof, root, HashLeaf([]byte("other"))), "leaf %d", i)
+			}
+		})
+	}
+}
+
+func TestProof_IndexOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	leaves := HashLeaves([][]byte{[]byte("a")})
+	_, err := Proof(leaves, 1)
+	require.Error(t, err)
+	_, err = Proof(leaves, -1)
+	require.Error(t, err)
+}
@@ -112,28 +112,67 @@ type dtoOperatorVotingPower struct {
 	votingPowers []symbiotic.OperatorVotingPower
 }
 
+// ValidatorSetInputs are the on-chain data a validator set is derived from
+type ValidatorSetInputs struct {
+	CaptureTimestamp symbiotic.Timestamp
+	// VotingPowers are the operator voting powers reported by each voting power provider of the config
+	VotingPowers []ProviderVotingPowers
+	Keys         []symbiotic.OperatorWithKeys
+}
+
+// ProviderVotingPowers are the operator voting powers reported by a voting power provider of the given chain
+type ProviderVotingPowers struct {
+	ChainID      uint64
+	VotingPowers []symbiotic.OperatorVotingPower
+}
+
 func (v *Deriver) GetValidatorSet(ctx context.Context, epoch symbiotic.Epoch, config symbiotic.NetworkConfig) (symbiotic.ValidatorSet, error) {
+	inputs, err := v.GetValidatorSetInputs(ctx, epoch, config)
+	if err != nil {
+		return symbiotic.ValidatorSet{}, err
+	}
+	return v.DeriveValidatorSet(ctx, epoch, config, inputs)
+}
+
+// GetValidatorSetInputs fetches the voting powers and keys captured at the start of the epoch
+func (v *Deriver) GetValidatorSetInputs(ctx context.Context, epoch symbiotic.Epoch, config symbiotic.NetworkConfig) (ValidatorSetInputs, error) {
 	timestamp, err := v.evmClient.GetEpochStart(ctx, epoch)
 	if err != nil {
-		return symbiotic.ValidatorSet{}, errors.Errorf("failed to get epoch start timestamp: %w", err)
+		return ValidatorSetInputs{}, errors.Errorf("failed to get epoch start timestamp: %w", err)
 	}
 	slog.DebugContext(ctx, "Got current valset timestamp", "timestamp", strconv.Itoa(int(timestamp)), "epoch", epoch)
 
 	// Get voting powers from all voting power providers.
 	allVotingPowers, err := v.getVotingPowersFromProviders(ctx, config.VotingPowerProviders, timestamp)
 	if err != nil {
-		return symbiotic.ValidatorSet{}, err
+		return ValidatorSetInputs{}, err
 	}
 
 	// Get keys from the keys provider
 	keys, err := v.evmClient.GetKeys(ctx, config.KeysProvider, timestamp)
 	if err != nil {
-		return symbiotic.ValidatorSet{}, errors.Errorf("failed to get keys: %w", err)
+		return ValidatorSetInputs{}, errors.Errorf("failed to get keys: %w", err)
 	}
 	slog.DebugContext(ctx, "Got keys from provider", "provider", config.KeysProvider.Address.Hex(), "keys", keys)
 
+	return ValidatorSetInputs{
+		CaptureTimestamp: timestamp,
+		VotingPowers: lo.Map(allVotingPowers, func(item dtoOperatorVotingPower, _ int) ProviderVotingPowers {
+			return ProviderVotingPowers{ChainID: item.chainId, VotingPowers: item.votingPowers}
+		}),
+		Keys: keys,
+	}, nil
+}
+
+// DeriveValidatorSet applies the inclusion rules of the config to the inputs and assigns the scheduler roles,
+// it makes no calls so that inputs can be modified to simulate the resulting validator set.
+func (v *Deriver) DeriveValidatorSet(ctx context.Context, epoch symbiotic.Epoch, config symbiotic.NetworkConfig, inputs ValidatorSetInputs) (symbiotic.ValidatorSet, error) {
+	allVotingPowers := lo.Map(inputs.VotingPowers, func(item ProviderVotingPowers, _ int) dtoOperatorVotingPower {
+		return dtoOperatorVotingPower{chainId: item.ChainID, votingPowers: item.VotingPowers}
+	})
+
 	// form validators list from voting powers and keys using config
-	validators := v.formValidators(config, allVotingPowers, keys)
+	validators := v.formValidators(config, allVotingPowers, inputs.Keys)
 
 	// calc new quorum threshold
 	quorumThreshold, err := config.CalcQuorumThreshold(validators.GetTotalActiveVotingPower())
@@ -145,7 +184,7 @@ func (v *Deriver) GetValidatorSet(ctx context.Context, epoch symbiotic.Epoch, co
 		Version:           valsetVersion,
 		RequiredKeyTag:    config.RequiredHeaderKeyTag,
 		Epoch:             epoch,
-		CaptureTimestamp:  timestamp,
+		CaptureTimestamp:  inputs.CaptureTimestamp,
 		QuorumThreshold:   quorumThreshold,
 		Validators:        validators,
 		Status:            symbiotic.HeaderDerived,
@@ -0,0 +1,172 @@
+package valsetDeriver
+
+import (
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-errors/errors"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+	symbioticCrypto "github.com/symbioticfi/relay/symbiotic/usecase/crypto"
+)
+
+// SimulatedVault is the vault stake added by a simulation is attributed to
+var SimulatedVault = common.Address{}
+
+// Clone returns a copy of the inputs that can be modified without changing the original
+func (in ValidatorSetInputs) Clone() ValidatorSetInputs {
+	clone := ValidatorSetInputs{
+		CaptureTimestamp: in.CaptureTimestamp,
+		VotingPowers:     make([]ProviderVotingPowers, len(in.VotingPowers)),
+		Keys:             slices.Clone(in.Keys),
+	}
+	for i, provider := range in.VotingPowers {
+		clone.VotingPowers[i] = ProviderVotingPowers{
+			ChainID:      provider.ChainID,
+			VotingPowers: make([]symbiotic.OperatorVotingPower, len(provider.VotingPowers)),
+		}
+		for j, operator := range provider.VotingPowers {
+			clone.VotingPowers[i].VotingPowers[j] = symbiotic.OperatorVotingPower{
+				Operator: operator.Operator,
+				Vaults:   slices.Clone(operator.Vaults),
+			}
+		}
+	}
+	return clone
+}
+
+// OperatorVotingPower returns the voting power of the operator summed over all providers and vaults
+func (in ValidatorSetInputs) OperatorVotingPower(operator common.Address) (*big.Int, bool) {
+	total := new(big.Int)
+	found := false
+	for _, provider := range in.VotingPowers {
+		for _, votingPower := range provider.VotingPowers {
+			if votingPower.Operator != operator {
+				continue
+			}
+			found = true
+			for _, vault := range votingPower.Vaults {
+				total.Add(total, vault.VotingPower.Int)
+			}
+		}
+	}
+	return total, found
+}
+
+// ChangeStake adds the delta to the voting power of an existing operator. Added stake is attributed to SimulatedVault
+// of the first provider of the operator, removed stake is taken from the largest vaults first.
+func (in *ValidatorSetInputs) ChangeStake(operator common.Address, delta *big.Int) error {
+	current, ok := in.OperatorVotingPower(operator)
+	if !ok {
+		return errors.Errorf("operator %s has no voting power to change", operator.Hex())
+	}
+
+	if delta.Sign() >= 0 {
+		for i := range in.VotingPowers {
+			for j := range in.VotingPowers[i].VotingPowers {
+				if in.VotingPowers[i].VotingPowers[j].Operator == operator {
+					addSimulatedStake(&in.VotingPowers[i].VotingPowers[j], delta)
+					return nil
+				}
+			}
+		}
+	}
+
+	remaining := new(big.Int).Neg(delta)
+	if remaining.Cmp(current) > 0 {
+		return errors.Errorf("can't remove %s voting power from operator %s with %s", remaining, operator.Hex(), current)
+	}
+	for remaining.Sign() > 0 {
+		i, j, k := in.largestVault(operator)
+		vault := &in.VotingPowers[i].VotingPowers[j].Vaults[k]
+		if vault.VotingPower.Cmp(remaining) <= 0 {
+			remaining.Sub(remaining, vault.VotingPower.Int)
+			in.VotingPowers[i].VotingPowers[j].Vaults = slices.Delete(in.VotingPowers[i].VotingPowers[j].Vaults, k, k+1)
+			continue
+		}
+		vault.VotingPower = symbiotic.ToVotingPower(new(big.Int).Sub(vault.VotingPower.Int, remaining))
+		remaining.SetInt64(0)
+	}
+	return nil
+}
+
+// AddOperator adds an operator with the voting power attributed to SimulatedVault of the first provider and the given keys
+func (in *ValidatorSetInputs) AddOperator(operator common.Address, votingPower *big.Int, keys []symbiotic.ValidatorKey) error {
+	if _, ok := in.OperatorVotingPower(operator); ok {
+		return errors.Errorf("operator %s already has voting power", operator.Hex())
+	}
+	if len(in.VotingPowers) == 0 {
+		return errors.New("no voting power provider to add the operator to")
+	}
+
+	added := symbiotic.OperatorVotingPower{Operator: operator}
+	addSimulatedStake(&added, votingPower)
+	in.VotingPowers[0].VotingPowers = append(in.VotingPowers[0].VotingPowers, added)
+
+	in.Keys = slices.DeleteFunc(in.Keys, func(item symbiotic.OperatorWithKeys) bool { return item.Operator == operator })
+	in.Keys = append(in.Keys, symbiotic.OperatorWithKeys{Operator: operator, Keys: keys})
+	return nil
+}
+
+// PlaceholderKeys derives keys for the key tags of an operator added by a simulation. The keys are derived from the
+// operator address and key tag only and are not meant to sign, but the same operator always gets the same keys,
+// so the header hash and the aggregator and committer roles picked from it are the same on every run.
+func PlaceholderKeys(operator common.Address, keyTags []symbiotic.KeyTag) ([]symbiotic.ValidatorKey, error) {
+	keys := make([]symbiotic.ValidatorKey, 0, len(keyTags))
+	for _, keyTag := range keyTags {
+		seed := crypto.Keccak256([]byte("simulated operator key"), operator.Bytes(), []byte{uint8(keyTag)})
+		key, err := symbioticCrypto.NewPrivateKey(keyTag.Type(), seed)
+		if err != nil {
+			return nil, errors.Errorf("failed to derive placeholder key for key tag %v: %w", keyTag, err)
+		}
+		keys = append(keys, symbiotic.ValidatorKey{Tag: keyTag, Payload: key.PublicKey().OnChain()})
+	}
+	return keys, nil
+}
+
+// RemoveOperator removes the voting power and keys of an operator
+func (in *ValidatorSetInputs) RemoveOperator(operator common.Address) error {
+	if _, ok := in.OperatorVotingPower(operator); !ok {
+		return errors.Errorf("operator %s has no voting power to remove", operator.Hex())
+	}
+	for i := range in.VotingPowers {
+		in.VotingPowers[i].VotingPowers = slices.DeleteFunc(in.VotingPowers[i].VotingPowers, func(item symbiotic.OperatorVotingPower) bool {
+			return item.Operator == operator
+		})
+	}
+	in.Keys = slices.DeleteFunc(in.Keys, func(item symbiotic.OperatorWithKeys) bool { return item.Operator == operator })
+	return nil
+}
+
+// largestVault returns the indices of the vault of the operator with the most voting power, the operator must have one
+func (in ValidatorSetInputs) largestVault(operator common.Address) (provider, operatorIndex, vault int) {
+	var largest *big.Int
+	for i, providerVotingPowers := range in.VotingPowers {
+		for j, votingPower := range providerVotingPowers.VotingPowers {
+			if votingPower.Operator != operator {
+				continue
+			}
+			for k, v := range votingPower.Vaults {
+				if largest == nil || v.VotingPower.Cmp(largest) > 0 {
+					largest, provider, operatorIndex, vault = v.VotingPower.Int, i, j, k
+				}
+			}
+		}
+	}
+	return provider, operatorIndex, vault
+}
+
+func addSimulatedStake(operator *symbiotic.OperatorVotingPower, amount *big.Int) {
+	for k, vault := range operator.Vaults {
+		if vault.Vault == SimulatedVault {
+			operator.Vaults[k].VotingPower = symbiotic.ToVotingPower(new(big.Int).Add(vault.VotingPower.Int, amount))
+			return
+		}
+	}
+	operator.Vaults = append(operator.Vaults, symbiotic.VaultVotingPower{
+		Vault:       SimulatedVault,
+		VotingPower: symbiotic.ToVotingPower(new(big.Int).Set(amount)),
+	})
+}
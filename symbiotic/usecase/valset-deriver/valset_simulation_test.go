@@ -0,0 +1,154 @@
+package valsetDeriver
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	symbiotic "github.com/symbioticfi/relay/symbiotic/entity"
+)
+
+func simulationInputs() ValidatorSetInputs {
+	operator := func(address string, votingPowers ...int64) symbiotic.OperatorVotingPower {
+		vaults := make([]symbiotic.VaultVotingPower, len(votingPowers))
+		for i, votingPower := range votingPowers {
+			vaults[i] = symbiotic.VaultVotingPower{
+				Vault:       common.BigToAddress(big.NewInt(int64(0x100 + i))),
+				VotingPower: symbiotic.ToVotingPower(big.NewInt(votingPower)),
+			}
+		}
+		return symbiotic.OperatorVotingPower{Operator: common.HexToAddress(address), Vaults: vaults}
+	}
+	keys := func(address string) symbiotic.OperatorWithKeys {
+		return symbiotic.OperatorWithKeys{
+			Operator: common.HexToAddress(address),
+			Keys:     []symbiotic.ValidatorKey{{Tag: 15, Payload: common.HexToAddress(address).Bytes()}},
+		}
+	}
+	return ValidatorSetInputs{
+		CaptureTimestamp: 1000,
+		VotingPowers: []ProviderVotingPowers{{
+			ChainID: 1,
+			VotingPowers: []symbiotic.OperatorVotingPower{
+				operator("0x01", 300, 200),
+				operator("0x02", 400),
+				operator("0x03", 100),
+			},
+		}},
+		Keys: []symbiotic.OperatorWithKeys{keys("0x01"), keys("0x02"), keys("0x03")},
+	}
+}
+
+func TestValidatorSetInputs_ChangeStake(t *testing.T) {
+	inputs := simulationInputs()
+	simulated := inputs.Clone()
+	operator := common.HexToAddress("0x01")
+
+	require.NoError(t, simulated.ChangeStake(operator, big.NewInt(50)))
+	votingPower, _ := simulated.OperatorVotingPower(operator)
+	require.Equal(t, big.NewInt(550), votingPower)
+	require.Equal(t, SimulatedVault, simulated.VotingPowers[0].VotingPowers[0].Vaults[2].Vault)
+
+	// the largest vault is drained first
+	require.NoError(t, simulated.ChangeStake(operator, big.NewInt(-350)))
+	votingPower, _ = simulated.OperatorVotingPower(operator)
+	require.Equal(t, big.NewInt(200), votingPower)
+	require.Len(t, simulated.VotingPowers[0].VotingPowers[0].Vaults, 2)
+	require.Equal(t, big.NewInt(150), simulated.VotingPowers[0].VotingPowers[0].Vaults[0].VotingPower.Int)
+
+	require.ErrorContains(t, simulated.ChangeStake(operator, big.NewInt(-201)), "can't remove")
+	require.ErrorContains(t, simulated.ChangeStake(common.HexToAddress("0x09"), big.NewInt(1)), "has no voting power")
+
+	// the original inputs are unchanged
+	votingPower, _ = inputs.OperatorVotingPower(operator)
+	require.Equal(t, big.NewInt(500), votingPower)
+	require.Len(t, inputs.VotingPowers[0].VotingPowers[0].Vaults, 2)
+}
+
+func TestDeriver_DeriveValidatorSet_Simulation(t *testing.T) {
+	deriver, err := NewDeriver(nil, nil)
+	require.NoError(t, err)
+	config := symbiotic.NetworkConfig{
+		MaxVotingPower:          symbiotic.ToVotingPower(big.NewInt(0)),
+		MinInclusionVotingPower: symbiotic.ToVotingPower(big.NewInt(150)),
+		MaxValidatorsCount:      symbiotic.ToVotingPower(big.NewInt(0)),
+		RequiredHeaderKeyTag:    15,
+		QuorumThresholds: []symbiotic.QuorumThreshold{
+			{KeyTag: 15, QuorumThreshold: symbiotic.ToQuorumThresholdPct(big.NewInt(500_000_000_000_000_000))},
+		},
+		NumAggregators: 1,
+		NumCommitters:  1,
+	}
+	inputs := simulationInputs()
+
+	valset, err := deriver.DeriveValidatorSet(t.Context(), 5, config, inputs)
+	require.NoError(t, err)
+	require.Equal(t, symbiotic.Epoch(5), valset.Epoch)
+	require.Equal(t, symbiotic.Timestamp(1000), valset.CaptureTimestamp)
+	require.Equal(t, int64(2), valset.GetTotalActiveValidators())
+	require.Equal(t, "451", valset.QuorumThreshold.String())
+
+	// enough stake includes the third operator, a new operator without keys stays inactive
+	simulated := inputs.Clone()
+	require.NoError(t, simulated.ChangeStake(common.HexToAddress("0x03"), big.NewInt(100)))
+	require.NoError(t, simulated.AddOperator(common.HexToAddress("0x04"), big.NewInt(1000), nil))
+
+	simulatedValset, err := deriver.DeriveValidatorSet(t.Context(), 5, config, simulated)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), simulatedValset.GetTotalActiveValidators())
+	require.Equal(t, "551", simulatedValset.QuorumThreshold.String())
+	require.Len(t, simulatedValset.AggregatorIndices, 1)
+	require.Len(t, simulatedValset.CommitterIndices, 1)
+
+	require.NoError(t, simulated.RemoveOperator(common.HexToAddress("0x02")))
+	simulatedValset, err = deriver.DeriveValidatorSet(t.Context(), 5, config, simulated)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), simulatedValset.GetTotalActiveValidators())
+	require.Len(t, simulatedValset.Validators, 3)
+}
+
+func TestDeriver_DeriveValidatorSet_SimulationIsReproducible(t *testing.T) {
+	deriver, err := NewDeriver(nil, nil)
+	require.NoError(t, err)
+	keyTag := symbiotic.KeyTag(15)
+	config := symbiotic.NetworkConfig{
+		MaxVotingPower:          symbiotic.ToVotingPower(big.NewInt(0)),
+		MinInclusionVotingPower: symbiotic.ToVotingPower(big.NewInt(0)),
+		MaxValidatorsCount:      symbiotic.ToVotingPower(big.NewInt(0)),
+		RequiredKeyTags:         []symbiotic.KeyTag{keyTag},
+		RequiredHeaderKeyTag:    keyTag,
+		QuorumThresholds: []symbiotic.QuorumThreshold{
+			{KeyTag: keyTag, QuorumThreshold: symbiotic.ToQuorumThresholdPct(big.NewInt(500_000_000_000_000_000))},
+		},
+		NumAggregators: 1,
+		NumCommitters:  2,
+	}
+	operator := common.HexToAddress("0x04")
+
+	simulate := func() symbiotic.ValidatorSet {
+		keys, err := PlaceholderKeys(operator, config.RequiredKeyTags)
+		require.NoError(t, err)
+		inputs := simulationInputs()
+		require.NoError(t, inputs.AddOperator(operator, big.NewInt(1000), keys))
+		valset, err := deriver.DeriveValidatorSet(t.Context(), 5, config, inputs)
+		require.NoError(t, err)
+		return valset
+	}
+
+	first, second := simulate(), simulate()
+	firstHeader, err := first.GetHeader()
+	require.NoError(t, err)
+	secondHeader, err := second.GetHeader()
+	require.NoError(t, err)
+	require.Equal(t, firstHeader, secondHeader)
+	require.Equal(t, first.AggregatorIndices, second.AggregatorIndices)
+	require.Equal(t, first.CommitterIndices, second.CommitterIndices)
+
+	otherKeys, err := PlaceholderKeys(common.HexToAddress("0x05"), config.RequiredKeyTags)
+	require.NoError(t, err)
+	keys, err := PlaceholderKeys(operator, config.RequiredKeyTags)
+	require.NoError(t, err)
+	require.NotEqual(t, keys, otherKeys)
+}